@@ -28,6 +28,7 @@ import (
 	"github.com/letsencrypt/boulder/goodkey"
 	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/precert"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
 
@@ -82,6 +83,7 @@ type certificateAuthorityImpl struct {
 	orphanCount        *prometheus.CounterVec
 	adoptedOrphanCount *prometheus.CounterVec
 	signErrorCount     *prometheus.CounterVec
+	mismatchCount      *prometheus.CounterVec
 }
 
 // makeIssuerMaps processes a list of issuers into a set of maps, mapping
@@ -168,6 +170,14 @@ func NewCertificateAuthorityImpl(
 		[]string{"type"})
 	stats.MustRegister(adoptedOrphanCount)
 
+	mismatchCount := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "precert_final_mismatches",
+			Help: "Number of final certificates which did not correspond to their precertificate, by issuer",
+		},
+		[]string{"issuer"})
+	stats.MustRegister(mismatchCount)
+
 	ca = &certificateAuthorityImpl{
 		sa:                 sa,
 		pa:                 pa,
@@ -185,6 +195,7 @@ func NewCertificateAuthorityImpl(
 		orphanCount:        orphanCount,
 		adoptedOrphanCount: adoptedOrphanCount,
 		signErrorCount:     signErrorCount,
+		mismatchCount:      mismatchCount,
 		clk:                clk,
 		ecdsaAllowList:     ecdsaAllowList,
 	}
@@ -298,12 +309,12 @@ func (ca *certificateAuthorityImpl) IssueCertificateForPrecertificate(ctx contex
 		return nil, berrors.InternalServerError("Incomplete cert for precertificate request")
 	}
 
-	precert, err := x509.ParseCertificate(req.DER)
+	parsedPrecert, err := x509.ParseCertificate(req.DER)
 	if err != nil {
 		return nil, err
 	}
 
-	serialHex := core.SerialToString(precert.SerialNumber)
+	serialHex := core.SerialToString(parsedPrecert.SerialNumber)
 	if _, err = ca.sa.GetCertificate(ctx, &sapb.Serial{Serial: serialHex}); err == nil {
 		err = berrors.InternalServerError("issuance of duplicate final certificate requested: %s", serialHex)
 		ca.log.AuditErr(err.Error())
@@ -321,12 +332,12 @@ func (ca *certificateAuthorityImpl) IssueCertificateForPrecertificate(ctx contex
 		scts = append(scts, sct)
 	}
 
-	issuer, ok := ca.issuers.byNameID[issuance.GetIssuerNameID(precert)]
+	issuer, ok := ca.issuers.byNameID[issuance.GetIssuerNameID(parsedPrecert)]
 	if !ok {
-		return nil, berrors.InternalServerError("no issuer found for Issuer Name %s", precert.Issuer)
+		return nil, berrors.InternalServerError("no issuer found for Issuer Name %s", parsedPrecert.Issuer)
 	}
 
-	issuanceReq, err := issuance.RequestFromPrecert(precert, scts)
+	issuanceReq, err := issuance.RequestFromPrecert(parsedPrecert, scts)
 	if err != nil {
 		return nil, err
 	}
@@ -335,20 +346,31 @@ func (ca *certificateAuthorityImpl) IssueCertificateForPrecertificate(ctx contex
 		return nil, err
 	}
 	ca.signatureCount.With(prometheus.Labels{"purpose": string(certType), "issuer": issuer.Name()}).Inc()
+	// A final certificate which doesn't match its precertificate would be a
+	// CT mis-issuance, so make sure that the certificate we just signed is
+	// exactly the precertificate minus its poison, plus the SCTs, before we
+	// store or return it.
+	err = precert.Correspond(req.DER, certDER)
+	if err != nil {
+		ca.mismatchCount.With(prometheus.Labels{"issuer": issuer.Name()}).Inc()
+		ca.log.AuditErrf("Signed final certificate does not correspond to precertificate: serial=[%s] precertificate=[%s] certificate=[%s] err=[%v]",
+			serialHex, hex.EncodeToString(req.DER), hex.EncodeToString(certDER), err)
+		return nil, berrors.InternalServerError("final certificate for serial %s does not correspond to its precertificate: %s", serialHex, err)
+	}
 	ca.log.AuditInfof("Signing success: serial=[%s] names=[%s] csr=[%s] certificate=[%s]",
-		serialHex, strings.Join(precert.DNSNames, ", "), hex.EncodeToString(req.DER),
+		serialHex, strings.Join(parsedPrecert.DNSNames, ", "), hex.EncodeToString(req.DER),
 		hex.EncodeToString(certDER))
-	err = ca.storeCertificate(ctx, req.RegistrationID, req.OrderID, precert.SerialNumber, certDER, int64(issuer.Cert.NameID()))
+	err = ca.storeCertificate(ctx, req.RegistrationID, req.OrderID, parsedPrecert.SerialNumber, certDER, int64(issuer.Cert.NameID()))
 	if err != nil {
 		return nil, err
 	}
 	return &corepb.Certificate{
 		RegistrationID: req.RegistrationID,
-		Serial:         core.SerialToString(precert.SerialNumber),
+		Serial:         core.SerialToString(parsedPrecert.SerialNumber),
 		Der:            certDER,
 		Digest:         core.Fingerprint256(certDER),
-		Issued:         precert.NotBefore.UnixNano(),
-		Expires:        precert.NotAfter.UnixNano(),
+		Issued:         parsedPrecert.NotBefore.UnixNano(),
+		Expires:        parsedPrecert.NotAfter.UnixNano(),
 	}, nil
 }
 
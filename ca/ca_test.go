@@ -906,6 +906,100 @@ func TestIssueCertificateForPrecertificateDuplicateSerial(t *testing.T) {
 	}
 }
 
+// TestIssueCertificateForPrecertificateMismatch checks that the CA refuses to
+// return or store a final certificate which doesn't correspond to its
+// precertificate. It simulates a profile change between the two issuances by
+// issuing the final certificate from issuers with a different OCSP URL.
+func TestIssueCertificateForPrecertificateMismatch(t *testing.T) {
+	testCtx := setup(t)
+	ca, err := NewCertificateAuthorityImpl(
+		&mockSA{},
+		testCtx.pa,
+		testCtx.ocsp,
+		testCtx.boulderIssuers,
+		nil,
+		testCtx.certExpiry,
+		testCtx.certBackdate,
+		testCtx.serialPrefix,
+		testCtx.maxNames,
+		testCtx.keyPolicy,
+		nil,
+		testCtx.logger,
+		testCtx.stats,
+		testCtx.signatureCount,
+		testCtx.signErrorCount,
+		testCtx.fc)
+	test.AssertNotError(t, err, "Failed to create CA")
+
+	issueReq := capb.IssueCertificateRequest{Csr: CNandSANCSR, RegistrationID: arbitraryRegID, OrderID: 0}
+	precert, err := ca.IssuePrecertificate(ctx, &issueReq)
+	test.AssertNotError(t, err, "Failed to issue precert")
+
+	var changedIssuers []*issuance.Issuer
+	for _, issuer := range testCtx.boulderIssuers {
+		profile, err := issuance.NewProfile(
+			issuance.ProfileConfig{
+				AllowMustStaple: true,
+				AllowCTPoison:   true,
+				AllowSCTList:    true,
+				AllowCommonName: true,
+				Policies: []issuance.PolicyInformation{
+					{OID: "2.23.140.1.2.1"},
+				},
+				MaxValidityPeriod:   cmd.ConfigDuration{Duration: time.Hour * 8760},
+				MaxValidityBackdate: cmd.ConfigDuration{Duration: time.Hour},
+			},
+			issuance.IssuerConfig{
+				UseForECDSALeaves: true,
+				UseForRSALeaves:   true,
+				IssuerURL:         "http://not-example.com/issuer-url",
+				OCSPURL:           "http://not-example.com/other-ocsp",
+				CRLURL:            "http://not-example.com/crl",
+			},
+		)
+		test.AssertNotError(t, err, "Failed to create profile")
+		changed := *issuer
+		changed.Profile = profile
+		changedIssuers = append(changedIssuers, &changed)
+	}
+
+	sa := &mockSA{}
+	log := blog.NewMock()
+	changedCA, err := NewCertificateAuthorityImpl(
+		sa,
+		testCtx.pa,
+		testCtx.ocsp,
+		changedIssuers,
+		nil,
+		testCtx.certExpiry,
+		testCtx.certBackdate,
+		testCtx.serialPrefix,
+		testCtx.maxNames,
+		testCtx.keyPolicy,
+		nil,
+		log,
+		testCtx.stats,
+		testCtx.signatureCount,
+		testCtx.signErrorCount,
+		testCtx.fc)
+	test.AssertNotError(t, err, "Failed to create CA")
+
+	sctBytes, err := makeSCTs()
+	test.AssertNotError(t, err, "Failed to make SCTs")
+	_, err = changedCA.IssueCertificateForPrecertificate(ctx, &capb.IssueCertificateForPrecertificateRequest{
+		DER:            precert.DER,
+		SCTs:           sctBytes,
+		RegistrationID: arbitraryRegID,
+		OrderID:        0,
+	})
+	test.AssertError(t, err, "Issued a final certificate which doesn't match its precertificate")
+	test.AssertErrorIs(t, err, berrors.InternalServer)
+	test.AssertContains(t, err.Error(), "does not correspond to its precertificate")
+	test.Assert(t, sa.certificate.DER == nil, "Stored a final certificate which doesn't match its precertificate")
+	test.AssertEquals(t, len(log.GetAllMatching("Signed final certificate does not correspond to precertificate")), 1)
+	test.AssertMetricWithLabelsEquals(t, changedCA.mismatchCount, prometheus.Labels{"issuer": changedIssuers[1].Name()}, 1)
+}
+
 type queueSA struct {
 	mockSA
 
@@ -13,7 +13,9 @@ import (
 	"os/user"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/jmhodges/clock"
 	"github.com/letsencrypt/boulder/cmd"
@@ -24,14 +26,20 @@ import (
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/policy"
 	"github.com/letsencrypt/boulder/privatekey"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
+	"github.com/letsencrypt/boulder/ratelimit"
 	"github.com/letsencrypt/boulder/revocation"
 	"github.com/letsencrypt/boulder/sa"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 const usageString = `
+admin-revoker is deprecated: use the admin command, which talks only to the RA
+and SA over gRPC, instead.
+
 usage:
   list-reasons           -config <path>
   serial-revoke          -config <path> <serial>           <reason-code>
@@ -39,6 +47,19 @@ usage:
   reg-revoke             -config <path> <registration-id>  <reason-code>
   private-key-block      -config <path> -dry-run=<bool>    <priv-key-path>
   private-key-revoke     -config <path> -dry-run=<bool>    <priv-key-path>
+  rl-override-add        -config <path> -reason <reason>   -ticket <ticket> -expires <duration>
+                         <limit-name> <key> <registration-id> <threshold>
+  rl-override-list       -config <path> -include-expired=<bool>
+  rl-override-expire     -config <path> <override-id>
+  account-policy-set     -config <path> -suffixes <list>   -exact <list> -wildcards=<bool>
+                         <registration-id>
+  account-policy-get     -config <path> <registration-id>
+  account-policy-remove  -config <path> <registration-id>
+  account-suspend        -config <path> -reason <reason>   -expires <duration> <registration-id>
+  account-unsuspend      -config <path> <registration-id>
+  order-approval-list    -config <path>
+  order-approve          -config <path> -reason <reason>   <order-id>
+  order-reject           -config <path> -reason <reason>   <order-id>
 
 
 descriptions:
@@ -55,6 +76,29 @@ descriptions:
                          table. <priv-key-path> is expected to be the path to a PEM
                          formatted file containing an RSA or ECDSA private key
 
+  rl-override-add        Adds a rate limit override, which the RA picks up on its next
+                         refresh. <limit-name> is a name from the rate limit policy file,
+                         e.g. certificatesPerName. An empty <key> with a non-zero
+                         <registration-id> overrides the limit for that account.
+  rl-override-list       Lists rate limit overrides
+  rl-override-expire     Expires a rate limit override immediately
+  account-policy-set     Restricts an account to issuing for the given identifiers,
+                         replacing any existing policy. Enforced by the RA when the
+                         AccountIdentifierPolicies feature is enabled.
+  account-policy-get     Shows an account's identifier policy
+  account-policy-remove  Removes an account's identifier policy, leaving it unrestricted
+  account-suspend        Suspends an account, refusing its new orders, finalizations and
+                         validations until the suspension expires. The account can still
+                         fetch and revoke its certificates. <reason> is shown to the
+                         subscriber. Enforced by the RA when the AccountSuspensions
+                         feature is enabled.
+  account-unsuspend      Lifts an account's suspension before it expires
+  order-approval-list    Lists finalized orders awaiting approval because they include
+                         names from ApprovalRequiredNames in the hostname policy
+  order-approve          Approves an order awaiting approval and issues its certificate
+  order-reject           Rejects an order awaiting approval, making it invalid. <reason>
+                         is shown to the subscriber in the order's error.
+
 flags:
   all:
     -config              File path to the configuration file for this service (required)
@@ -63,6 +107,28 @@ flags:
     -dry-run             true (default): only queries for affected certificates. false: will
                          perform the requested block or revoke action. Only implemented for
                          private-key-block and private-key-revoke.
+
+  rl-override-add:
+    -reason              Why the override is needed (required)
+    -ticket              The ticket tracking the override request (required)
+    -expires             How long the override lasts, e.g. 2160h (default 90 days)
+
+  account-suspend:
+    -reason              Why the account is suspended, shown to the subscriber (required)
+    -expires             How long the suspension lasts, e.g. 168h (default 90 days)
+
+  order-approve | order-reject:
+    -reason              Why the order is approved or rejected (required)
+
+  rl-override-list:
+    -include-expired     false (default): only list unexpired overrides
+
+  account-policy-set:
+    -suffixes            Comma separated domains which may be issued for, along with
+                         their subdomains
+    -exact               Comma separated domains which may be issued for, but not their
+                         subdomains
+    -wildcards           false (default): true permits wildcards under the -suffixes
 `
 
 type Config struct {
@@ -447,6 +513,213 @@ func getPublicKeySPKIHash(pubKey crypto.PublicKey) ([]byte, error) {
 	return spkiHash[:], nil
 }
 
+func (r *revoker) addRateLimitOverride(ctx context.Context, limit, key string, regID, threshold int64, reason, ticket string, lifetime time.Duration) error {
+	override := ratelimit.Override{
+		Limit:          limit,
+		Key:            key,
+		RegistrationID: regID,
+		Threshold:      threshold,
+	}
+	err := override.Validate()
+	if err != nil {
+		return err
+	}
+	u, err := user.Current()
+	if err != nil {
+		return err
+	}
+
+	added, err := r.sac.AddRateLimitOverride(ctx, &sapb.RateLimitOverride{
+		Limit:          limit,
+		Key:            key,
+		RegistrationID: regID,
+		Threshold:      threshold,
+		Owner:          u.Username,
+		Reason:         reason,
+		Ticket:         ticket,
+		Expires:        r.clk.Now().Add(lifetime).UnixNano(),
+	})
+	if err != nil {
+		return err
+	}
+	r.log.AuditInfof("Added rate limit override: id=[%d] limit=[%s] key=[%s] regID=[%d] threshold=[%d] owner=[%s] ticket=[%s] reason=[%s] expires=[%s]",
+		added.Id, added.Limit, added.Key, added.RegistrationID, added.Threshold, added.Owner, added.Ticket, added.Reason, time.Unix(0, added.Expires).UTC())
+	return nil
+}
+
+func (r *revoker) listRateLimitOverrides(ctx context.Context, includeExpired bool) error {
+	resp, err := r.sac.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{IncludeExpired: includeExpired})
+	if err != nil {
+		return err
+	}
+	for _, o := range resp.Overrides {
+		fmt.Printf("%d\t%s\t%q\t%d\t%d\t%s\t%s\t%s\t%q\n",
+			o.Id, o.Limit, o.Key, o.RegistrationID, o.Threshold,
+			time.Unix(0, o.Expires).UTC().Format(time.RFC3339), o.Owner, o.Ticket, o.Reason)
+	}
+	return nil
+}
+
+func (r *revoker) expireRateLimitOverride(ctx context.Context, id int64) error {
+	_, err := r.sac.ExpireRateLimitOverride(ctx, &sapb.ExpireRateLimitOverrideRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	u, err := user.Current()
+	if err != nil {
+		return err
+	}
+	r.log.AuditInfof("Expired rate limit override: id=[%d] by=[%s]", id, u.Username)
+	return nil
+}
+
+func (r *revoker) setAccountPolicy(ctx context.Context, regID int64, suffixes, exactNames []string, allowWildcards bool) error {
+	err := policy.AccountPolicy{
+		Suffixes:       suffixes,
+		ExactNames:     exactNames,
+		AllowWildcards: allowWildcards,
+	}.Validate()
+	if err != nil {
+		return err
+	}
+	// Make sure the account exists before restricting it.
+	_, err = r.sac.GetRegistration(ctx, &sapb.RegistrationID{Id: regID})
+	if err != nil {
+		return fmt.Errorf("couldn't fetch registration: %w", err)
+	}
+	u, err := user.Current()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.sac.SetAccountIdentifierPolicy(ctx, &sapb.AccountIdentifierPolicy{
+		RegistrationID: regID,
+		Suffixes:       suffixes,
+		ExactNames:     exactNames,
+		AllowWildcards: allowWildcards,
+		UpdatedBy:      u.Username,
+	})
+	if err != nil {
+		return err
+	}
+	r.log.AuditInfof("Set account identifier policy: regID=[%d] suffixes=[%s] exactNames=[%s] allowWildcards=[%t] by=[%s]",
+		regID, strings.Join(suffixes, ","), strings.Join(exactNames, ","), allowWildcards, u.Username)
+	return nil
+}
+
+func (r *revoker) getAccountPolicy(ctx context.Context, regID int64) error {
+	p, err := r.sac.GetAccountIdentifierPolicy(ctx, &sapb.RegistrationID{Id: regID})
+	if err != nil {
+		if errors.Is(err, berrors.NotFound) {
+			fmt.Printf("Registration %d has no identifier policy and is unrestricted\n", regID)
+			return nil
+		}
+		return err
+	}
+	fmt.Printf("suffixes:        %s\n", strings.Join(p.Suffixes, ","))
+	fmt.Printf("exact names:     %s\n", strings.Join(p.ExactNames, ","))
+	fmt.Printf("allow wildcards: %t\n", p.AllowWildcards)
+	fmt.Printf("updated:         %s by %s\n", time.Unix(0, p.Updated).UTC().Format(time.RFC3339), p.UpdatedBy)
+	return nil
+}
+
+func (r *revoker) removeAccountPolicy(ctx context.Context, regID int64) error {
+	_, err := r.sac.RemoveAccountIdentifierPolicy(ctx, &sapb.RegistrationID{Id: regID})
+	if err != nil {
+		return err
+	}
+	u, err := user.Current()
+	if err != nil {
+		return err
+	}
+	r.log.AuditInfof("Removed account identifier policy: regID=[%d] by=[%s]", regID, u.Username)
+	return nil
+}
+
+func (r *revoker) suspendAccount(ctx context.Context, regID int64, reason string, lifetime time.Duration) error {
+	u, err := user.Current()
+	if err != nil {
+		return err
+	}
+	_, err = r.rac.SuspendAccount(ctx, &rapb.SuspendAccountRequest{
+		RegistrationID: regID,
+		Reason:         reason,
+		AdminName:      u.Username,
+		Expires:        r.clk.Now().Add(lifetime).UnixNano(),
+	})
+	return err
+}
+
+func (r *revoker) unsuspendAccount(ctx context.Context, regID int64) error {
+	u, err := user.Current()
+	if err != nil {
+		return err
+	}
+	_, err = r.rac.UnsuspendAccount(ctx, &rapb.UnsuspendAccountRequest{
+		RegistrationID: regID,
+		AdminName:      u.Username,
+	})
+	return err
+}
+
+func (r *revoker) listOrderApprovals(ctx context.Context) error {
+	resp, err := r.sac.GetPendingOrderApprovals(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+	for _, a := range resp.Approvals {
+		fmt.Printf("%d\t%d\t%s\t%s\n",
+			a.OrderID, a.RegistrationID, time.Unix(0, a.Created).UTC().Format(time.RFC3339), strings.Join(a.Names, ","))
+	}
+	return nil
+}
+
+func (r *revoker) approveOrder(ctx context.Context, orderID int64, reason string) error {
+	u, err := user.Current()
+	if err != nil {
+		return err
+	}
+	order, err := r.rac.ApproveOrder(ctx, &rapb.OrderApprovalRequest{
+		OrderID:   orderID,
+		AdminName: u.Username,
+		Reason:    reason,
+	})
+	if err != nil {
+		return err
+	}
+	r.log.AuditInfof("Approved order: orderID=[%d] serial=[%s] by=[%s]", orderID, order.CertificateSerial, u.Username)
+	return nil
+}
+
+func (r *revoker) rejectOrder(ctx context.Context, orderID int64, reason string) error {
+	u, err := user.Current()
+	if err != nil {
+		return err
+	}
+	_, err = r.rac.RejectOrder(ctx, &rapb.OrderApprovalRequest{
+		OrderID:   orderID,
+		AdminName: u.Username,
+		Reason:    reason,
+	})
+	if err != nil {
+		return err
+	}
+	r.log.AuditInfof("Rejected order: orderID=[%d] by=[%s]", orderID, u.Username)
+	return nil
+}
+
+// splitList splits a comma separated flag value, dropping empty entries.
+func splitList(s string) []string {
+	var list []string
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry != "" {
+			list = append(list, entry)
+		}
+	}
+	return list
+}
+
 func main() {
 	usage := func() {
 		fmt.Fprint(os.Stderr, usageString)
@@ -464,6 +737,13 @@ func main() {
 		true,
 		"true (default): only queries for affected certificates. false: will perform the requested block or revoke action",
 	)
+	reason := flagSet.String("reason", "", "Why the rate limit override is needed, why the account is suspended, or why the order is approved or rejected")
+	ticket := flagSet.String("ticket", "", "The ticket tracking the rate limit override request")
+	expires := flagSet.Duration("expires", 90*24*time.Hour, "How long the rate limit override or account suspension lasts")
+	includeExpired := flagSet.Bool("include-expired", false, "Include expired rate limit overrides")
+	suffixes := flagSet.String("suffixes", "", "Comma separated domains the account may issue for, along with their subdomains")
+	exactNames := flagSet.String("exact", "", "Comma separated domains the account may issue for, but not their subdomains")
+	wildcards := flagSet.Bool("wildcards", false, "Permit wildcards under the suffixes")
 	err := flagSet.Parse(os.Args[2:])
 	cmd.FailOnError(err, "Error parsing flagset")
 
@@ -561,6 +841,94 @@ func main() {
 			cmd.FailOnError(err, "")
 		}
 
+	case command == "rl-override-add" && len(args) == 4:
+		// 1: limit name, 2: key, 3: registration ID, 4: threshold
+		regID, err := strconv.ParseInt(args[2], 10, 64)
+		cmd.FailOnError(err, "Registration ID argument must be an integer")
+		threshold, err := strconv.ParseInt(args[3], 10, 64)
+		cmd.FailOnError(err, "Threshold argument must be an integer")
+		if *reason == "" || *ticket == "" {
+			cmd.Fail("-reason and -ticket are required")
+		}
+
+		err = r.addRateLimitOverride(ctx, args[0], args[1], regID, threshold, *reason, *ticket, *expires)
+		cmd.FailOnError(err, "Couldn't add rate limit override")
+
+	case command == "rl-override-list":
+		err := r.listRateLimitOverrides(ctx, *includeExpired)
+		cmd.FailOnError(err, "Couldn't list rate limit overrides")
+
+	case command == "rl-override-expire" && len(args) == 1:
+		// 1: override ID
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		cmd.FailOnError(err, "Override ID argument must be an integer")
+
+		err = r.expireRateLimitOverride(ctx, id)
+		cmd.FailOnError(err, "Couldn't expire rate limit override")
+
+	case command == "account-policy-set" && len(args) == 1:
+		// 1: registration ID
+		regID, err := strconv.ParseInt(args[0], 10, 64)
+		cmd.FailOnError(err, "Registration ID argument must be an integer")
+
+		err = r.setAccountPolicy(ctx, regID, splitList(*suffixes), splitList(*exactNames), *wildcards)
+		cmd.FailOnError(err, "Couldn't set account identifier policy")
+
+	case command == "account-policy-get" && len(args) == 1:
+		// 1: registration ID
+		regID, err := strconv.ParseInt(args[0], 10, 64)
+		cmd.FailOnError(err, "Registration ID argument must be an integer")
+
+		err = r.getAccountPolicy(ctx, regID)
+		cmd.FailOnError(err, "Couldn't get account identifier policy")
+
+	case command == "account-policy-remove" && len(args) == 1:
+		// 1: registration ID
+		regID, err := strconv.ParseInt(args[0], 10, 64)
+		cmd.FailOnError(err, "Registration ID argument must be an integer")
+
+		err = r.removeAccountPolicy(ctx, regID)
+		cmd.FailOnError(err, "Couldn't remove account identifier policy")
+
+	case command == "account-suspend" && len(args) == 1:
+		// 1: registration ID
+		regID, err := strconv.ParseInt(args[0], 10, 64)
+		cmd.FailOnError(err, "Registration ID argument must be an integer")
+		if *reason == "" {
+			cmd.Fail("-reason is required")
+		}
+
+		err = r.suspendAccount(ctx, regID, *reason, *expires)
+		cmd.FailOnError(err, "Couldn't suspend account")
+
+	case command == "account-unsuspend" && len(args) == 1:
+		// 1: registration ID
+		regID, err := strconv.ParseInt(args[0], 10, 64)
+		cmd.FailOnError(err, "Registration ID argument must be an integer")
+
+		err = r.unsuspendAccount(ctx, regID)
+		cmd.FailOnError(err, "Couldn't unsuspend account")
+
+	case command == "order-approval-list":
+		err := r.listOrderApprovals(ctx)
+		cmd.FailOnError(err, "Couldn't list orders awaiting approval")
+
+	case (command == "order-approve" || command == "order-reject") && len(args) == 1:
+		// 1: order ID
+		orderID, err := strconv.ParseInt(args[0], 10, 64)
+		cmd.FailOnError(err, "Order ID argument must be an integer")
+		if *reason == "" {
+			cmd.Fail("-reason is required")
+		}
+
+		if command == "order-approve" {
+			err = r.approveOrder(ctx, orderID, *reason)
+			cmd.FailOnError(err, "Couldn't approve order")
+		} else {
+			err = r.rejectOrder(ctx, orderID, *reason)
+			cmd.FailOnError(err, "Couldn't reject order")
+		}
+
 	default:
 		usage()
 	}
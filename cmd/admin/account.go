@@ -0,0 +1,344 @@
+package notmain
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/policy"
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+// accountInfo describes an account along with any restrictions placed on it.
+type accountInfo struct {
+	ID                int64              `json:"id"`
+	Status            string             `json:"status"`
+	Contact           []string           `json:"contact"`
+	Agreement         string             `json:"agreement"`
+	InitialIP         string             `json:"initialIP"`
+	CreatedAt         time.Time          `json:"createdAt"`
+	KeySPKIHash       string             `json:"keySPKIHash"`
+	UnexpiredCerts    int                `json:"unexpiredCertificates"`
+	KeyHistory        []accountKey       `json:"keyHistory"`
+	PausedIdentifiers []string           `json:"pausedIdentifiers"`
+	Suspension        *accountSuspension `json:"suspension,omitempty"`
+	IdentifierPolicy  *identifierPolicy  `json:"identifierPolicy,omitempty"`
+}
+
+// accountKey is an entry in an account's key history. KeySHA256 is the
+// base64 encoded digest stored with the registration, and Added is zero for
+// keys which predate the history.
+type accountKey struct {
+	RegistrationID int64      `json:"registrationID"`
+	KeySHA256      string     `json:"keySHA256"`
+	Added          *time.Time `json:"added,omitempty"`
+	Replaced       *time.Time `json:"replaced,omitempty"`
+}
+
+func accountKeysFromPB(keys *sapb.AccountKeys) []accountKey {
+	history := make([]accountKey, len(keys.Keys))
+	for i, k := range keys.Keys {
+		history[i] = accountKey{RegistrationID: k.RegistrationID, KeySHA256: k.KeySHA256}
+		if k.Added != 0 {
+			added := time.Unix(0, k.Added).UTC()
+			history[i].Added = &added
+		}
+		if k.Replaced != 0 {
+			replaced := time.Unix(0, k.Replaced).UTC()
+			history[i].Replaced = &replaced
+		}
+	}
+	return history
+}
+
+type identifierPolicy struct {
+	Suffixes       []string `json:"suffixes"`
+	ExactNames     []string `json:"exactNames"`
+	AllowWildcards bool     `json:"allowWildcards"`
+}
+
+type accountSuspension struct {
+	Reason      string    `json:"reason"`
+	SuspendedBy string    `json:"suspendedBy"`
+	Created     time.Time `json:"created"`
+	Expires     time.Time `json:"expires"`
+}
+
+type accountShow struct {
+	regID int64
+}
+
+func (s *accountShow) Desc() string {
+	return "Show an account, its suspension, identifier policy and paused identifiers"
+}
+
+func (s *accountShow) Flags(f *flag.FlagSet) {
+	f.Int64Var(&s.regID, "reg-id", 0, "Registration ID of the account (required)")
+}
+
+func (s *accountShow) Run(ctx context.Context, a *admin) error {
+	if s.regID == 0 {
+		return errors.New("-reg-id is required")
+	}
+	regID := &sapb.RegistrationID{Id: s.regID}
+	reg, err := a.sac.GetRegistration(ctx, regID)
+	if err != nil {
+		return err
+	}
+	var key jose.JSONWebKey
+	err = key.UnmarshalJSON(reg.Key)
+	if err != nil {
+		return fmt.Errorf("parsing account key: %w", err)
+	}
+	keyDigest, err := core.KeyDigest(key)
+	if err != nil {
+		return err
+	}
+	info := accountInfo{
+		ID:          reg.Id,
+		Status:      reg.Status,
+		Contact:     reg.Contact,
+		Agreement:   reg.Agreement,
+		InitialIP:   net.IP(reg.InitialIP).String(),
+		CreatedAt:   time.Unix(0, reg.CreatedAt).UTC(),
+		KeySPKIHash: hex.EncodeToString(keyDigest[:]),
+	}
+
+	stream, err := a.sac.GetSerialsByAccount(ctx, regID)
+	if err != nil {
+		return err
+	}
+	serials, err := collectSerials(stream)
+	if err != nil {
+		return err
+	}
+	info.UnexpiredCerts = len(serials)
+
+	keys, err := a.sac.GetAccountKeys(ctx, &sapb.GetAccountKeysRequest{RegistrationID: s.regID})
+	if err != nil {
+		return err
+	}
+	info.KeyHistory = accountKeysFromPB(keys)
+
+	paused, err := a.sac.CheckIdentifiersPaused(ctx, &sapb.CheckIdentifiersPausedRequest{RegistrationID: s.regID})
+	if err != nil {
+		return err
+	}
+	info.PausedIdentifiers = paused.Identifiers
+
+	suspension, err := a.sac.GetAccountSuspension(ctx, regID)
+	if err == nil {
+		info.Suspension = &accountSuspension{
+			Reason:      suspension.Reason,
+			SuspendedBy: suspension.SuspendedBy,
+			Created:     time.Unix(0, suspension.Created).UTC(),
+			Expires:     time.Unix(0, suspension.Expires).UTC(),
+		}
+	} else if !errors.Is(err, berrors.NotFound) {
+		return err
+	}
+
+	p, err := a.sac.GetAccountIdentifierPolicy(ctx, regID)
+	if err == nil {
+		info.IdentifierPolicy = &identifierPolicy{
+			Suffixes:       p.Suffixes,
+			ExactNames:     p.ExactNames,
+			AllowWildcards: p.AllowWildcards,
+		}
+	} else if !errors.Is(err, berrors.NotFound) {
+		return err
+	}
+	return a.output(info)
+}
+
+type accountKeyLookup struct {
+	keySHA256 string
+}
+
+func (s *accountKeyLookup) Desc() string {
+	return "List the accounts which have used a key, and when"
+}
+
+func (s *accountKeyLookup) Flags(f *flag.FlagSet) {
+	f.StringVar(&s.keySHA256, "key-sha256", "", "Base64 encoded SHA-256 digest of the key's JWK, as stored with the registration (required)")
+}
+
+func (s *accountKeyLookup) Run(ctx context.Context, a *admin) error {
+	if s.keySHA256 == "" {
+		return errors.New("-key-sha256 is required")
+	}
+	keys, err := a.sac.GetAccountKeys(ctx, &sapb.GetAccountKeysRequest{KeySHA256: s.keySHA256})
+	if err != nil {
+		return err
+	}
+	return a.output(accountKeysFromPB(keys))
+}
+
+type accountSuspend struct {
+	regID   int64
+	reason  string
+	expires time.Duration
+}
+
+func (s *accountSuspend) Desc() string {
+	return "Suspend an account, refusing its new orders, finalizations and validations"
+}
+
+func (s *accountSuspend) Flags(f *flag.FlagSet) {
+	f.Int64Var(&s.regID, "reg-id", 0, "Registration ID of the account (required)")
+	f.StringVar(&s.reason, "reason", "", "Why the account is suspended, shown to the subscriber (required)")
+	f.DurationVar(&s.expires, "expires", 90*24*time.Hour, "How long the suspension lasts")
+}
+
+func (s *accountSuspend) Run(ctx context.Context, a *admin) error {
+	if s.regID == 0 || s.reason == "" {
+		return errors.New("-reg-id and -reason are required")
+	}
+	expires := a.clk.Now().Add(s.expires)
+	_, err := a.rac.SuspendAccount(ctx, &rapb.SuspendAccountRequest{
+		RegistrationID: s.regID,
+		Reason:         s.reason,
+		AdminName:      a.user,
+		Expires:        expires.UnixNano(),
+	})
+	if err != nil {
+		return err
+	}
+	return a.output(struct {
+		DryRun         bool      `json:"dryRun"`
+		RegistrationID int64     `json:"registrationID"`
+		Expires        time.Time `json:"expires"`
+	}{a.dryRun, s.regID, expires.UTC()})
+}
+
+type accountUnsuspend struct {
+	regID int64
+}
+
+func (s *accountUnsuspend) Desc() string {
+	return "Lift an account's suspension before it expires"
+}
+
+func (s *accountUnsuspend) Flags(f *flag.FlagSet) {
+	f.Int64Var(&s.regID, "reg-id", 0, "Registration ID of the account (required)")
+}
+
+func (s *accountUnsuspend) Run(ctx context.Context, a *admin) error {
+	if s.regID == 0 {
+		return errors.New("-reg-id is required")
+	}
+	_, err := a.rac.UnsuspendAccount(ctx, &rapb.UnsuspendAccountRequest{
+		RegistrationID: s.regID,
+		AdminName:      a.user,
+	})
+	if err != nil {
+		return err
+	}
+	return a.output(struct {
+		DryRun         bool  `json:"dryRun"`
+		RegistrationID int64 `json:"registrationID"`
+	}{a.dryRun, s.regID})
+}
+
+type accountPolicySet struct {
+	regID     int64
+	suffixes  string
+	exact     string
+	wildcards bool
+}
+
+func (s *accountPolicySet) Desc() string {
+	return "Restrict an account to issuing for the given identifiers"
+}
+
+func (s *accountPolicySet) Flags(f *flag.FlagSet) {
+	f.Int64Var(&s.regID, "reg-id", 0, "Registration ID of the account (required)")
+	f.StringVar(&s.suffixes, "suffixes", "", "Comma separated domains the account may issue for, along with their subdomains")
+	f.StringVar(&s.exact, "exact", "", "Comma separated domains the account may issue for, but not their subdomains")
+	f.BoolVar(&s.wildcards, "wildcards", false, "Permit wildcards under the suffixes")
+}
+
+func (s *accountPolicySet) Run(ctx context.Context, a *admin) error {
+	if s.regID == 0 {
+		return errors.New("-reg-id is required")
+	}
+	p := policy.AccountPolicy{
+		Suffixes:       splitList(s.suffixes),
+		ExactNames:     splitList(s.exact),
+		AllowWildcards: s.wildcards,
+	}
+	err := p.Validate()
+	if err != nil {
+		return err
+	}
+	// Make sure the account exists before restricting it.
+	_, err = a.sac.GetRegistration(ctx, &sapb.RegistrationID{Id: s.regID})
+	if err != nil {
+		return fmt.Errorf("couldn't fetch registration: %w", err)
+	}
+	_, err = a.sac.SetAccountIdentifierPolicy(ctx, &sapb.AccountIdentifierPolicy{
+		RegistrationID: s.regID,
+		Suffixes:       p.Suffixes,
+		ExactNames:     p.ExactNames,
+		AllowWildcards: p.AllowWildcards,
+		UpdatedBy:      a.user,
+	})
+	if err != nil {
+		return err
+	}
+	a.log.AuditInfof("Set account identifier policy: regID=[%d] suffixes=[%s] exactNames=[%s] allowWildcards=[%t] by=[%s] dryRun=[%t]",
+		s.regID, strings.Join(p.Suffixes, ","), strings.Join(p.ExactNames, ","), p.AllowWildcards, a.user, a.dryRun)
+	return a.output(struct {
+		DryRun           bool             `json:"dryRun"`
+		RegistrationID   int64            `json:"registrationID"`
+		IdentifierPolicy identifierPolicy `json:"identifierPolicy"`
+	}{a.dryRun, s.regID, identifierPolicy(p)})
+}
+
+type accountPolicyRemove struct {
+	regID int64
+}
+
+func (s *accountPolicyRemove) Desc() string {
+	return "Remove an account's identifier policy, leaving it unrestricted"
+}
+
+func (s *accountPolicyRemove) Flags(f *flag.FlagSet) {
+	f.Int64Var(&s.regID, "reg-id", 0, "Registration ID of the account (required)")
+}
+
+func (s *accountPolicyRemove) Run(ctx context.Context, a *admin) error {
+	if s.regID == 0 {
+		return errors.New("-reg-id is required")
+	}
+	_, err := a.sac.RemoveAccountIdentifierPolicy(ctx, &sapb.RegistrationID{Id: s.regID})
+	if err != nil {
+		return err
+	}
+	a.log.AuditInfof("Removed account identifier policy: regID=[%d] by=[%s] dryRun=[%t]", s.regID, a.user, a.dryRun)
+	return a.output(struct {
+		DryRun         bool  `json:"dryRun"`
+		RegistrationID int64 `json:"registrationID"`
+	}{a.dryRun, s.regID})
+}
+
+// splitList splits a comma separated flag value, dropping empty entries.
+func splitList(s string) []string {
+	var list []string
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry != "" {
+			list = append(list, entry)
+		}
+	}
+	return list
+}
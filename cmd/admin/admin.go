@@ -0,0 +1,172 @@
+package notmain
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"os/user"
+
+	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/boulder/cmd"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// admin holds the clients and settings shared by all subcommands.
+type admin struct {
+	rac rapb.RegistrationAuthorityClient
+	sac sapb.StorageAuthorityClient
+	clk clock.Clock
+	log blog.Logger
+	out io.Writer
+
+	// dryRun is set when the RA and SA clients only log mutating RPCs rather
+	// than making them.
+	dryRun bool
+	// user is the name of the operator running the tool, which is recorded
+	// with every change it makes.
+	user string
+}
+
+func newAdmin(c Config, dryRun bool) *admin {
+	logger := cmd.NewLogger(c.Syslog)
+
+	tlsConfig, err := c.Admin.TLS.Load()
+	cmd.FailOnError(err, "TLS config")
+
+	clk := cmd.Clock()
+
+	clientMetrics := bgrpc.NewClientMetrics(metrics.NoopRegisterer)
+	raConn, err := bgrpc.ClientSetup(c.Admin.RAService, tlsConfig, clientMetrics, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to RA")
+	var rac rapb.RegistrationAuthorityClient = rapb.NewRegistrationAuthorityClient(raConn)
+
+	saConn, err := bgrpc.ClientSetup(c.Admin.SAService, tlsConfig, clientMetrics, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+	var sac sapb.StorageAuthorityClient = sapb.NewStorageAuthorityClient(saConn)
+
+	if dryRun {
+		rac = dryRunRAC{rac, logger}
+		sac = dryRunSAC{sac, logger}
+	}
+
+	u, err := user.Current()
+	cmd.FailOnError(err, "Failed to determine the current user")
+
+	return &admin{
+		rac:    rac,
+		sac:    sac,
+		clk:    clk,
+		log:    logger,
+		out:    os.Stdout,
+		dryRun: dryRun,
+		user:   u.Username,
+	}
+}
+
+// output writes the result of a subcommand to stdout as JSON.
+func (a *admin) output(v interface{}) error {
+	enc := json.NewEncoder(a.out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// dryRunRAC is an RA client which logs mutating RPCs instead of making them.
+// Read-only RPCs are passed through to the embedded client.
+type dryRunRAC struct {
+	rapb.RegistrationAuthorityClient
+	log blog.Logger
+}
+
+func (d dryRunRAC) AdministrativelyRevokeCertificate(_ context.Context, req *rapb.AdministrativelyRevokeCertificateRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	serial := req.Serial
+	if serial == "" {
+		serial = "(from certificate)"
+	}
+	d.log.Infof("Dry run: would revoke certificate serial=[%s] code=[%d] skipBlockKey=[%t]", serial, req.Code, req.SkipBlockKey)
+	return &emptypb.Empty{}, nil
+}
+
+func (d dryRunRAC) SuspendAccount(_ context.Context, req *rapb.SuspendAccountRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	d.log.Infof("Dry run: would suspend account: %s", req)
+	return &emptypb.Empty{}, nil
+}
+
+func (d dryRunRAC) UnsuspendAccount(_ context.Context, req *rapb.UnsuspendAccountRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	d.log.Infof("Dry run: would unsuspend account: %s", req)
+	return &emptypb.Empty{}, nil
+}
+
+func (d dryRunRAC) ApproveOrder(_ context.Context, req *rapb.OrderApprovalRequest, _ ...grpc.CallOption) (*corepb.Order, error) {
+	d.log.Infof("Dry run: would approve order: %s", req)
+	return &corepb.Order{Id: req.OrderID}, nil
+}
+
+func (d dryRunRAC) RejectOrder(_ context.Context, req *rapb.OrderApprovalRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	d.log.Infof("Dry run: would reject order: %s", req)
+	return &emptypb.Empty{}, nil
+}
+
+// dryRunSAC is an SA client which logs mutating RPCs instead of making them.
+// Read-only RPCs are passed through to the embedded client.
+type dryRunSAC struct {
+	sapb.StorageAuthorityClient
+	log blog.Logger
+}
+
+func (d dryRunSAC) AddBlockedKey(_ context.Context, req *sapb.AddBlockedKeyRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	d.log.Infof("Dry run: would block key: %s", req)
+	return &emptypb.Empty{}, nil
+}
+
+func (d dryRunSAC) AddBlockedName(_ context.Context, req *sapb.AddBlockedNameRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	d.log.Infof("Dry run: would block name: %s", req)
+	return &emptypb.Empty{}, nil
+}
+
+func (d dryRunSAC) AddRateLimitOverride(_ context.Context, req *sapb.RateLimitOverride, _ ...grpc.CallOption) (*sapb.RateLimitOverride, error) {
+	d.log.Infof("Dry run: would add rate limit override: %s", req)
+	return req, nil
+}
+
+func (d dryRunSAC) ExpireRateLimitOverride(_ context.Context, req *sapb.ExpireRateLimitOverrideRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	d.log.Infof("Dry run: would expire rate limit override: %s", req)
+	return &emptypb.Empty{}, nil
+}
+
+func (d dryRunSAC) SetAccountIdentifierPolicy(_ context.Context, req *sapb.AccountIdentifierPolicy, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	d.log.Infof("Dry run: would set account identifier policy: %s", req)
+	return &emptypb.Empty{}, nil
+}
+
+func (d dryRunSAC) RemoveAccountIdentifierPolicy(_ context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	d.log.Infof("Dry run: would remove account identifier policy: %s", req)
+	return &emptypb.Empty{}, nil
+}
+
+func (d dryRunSAC) AddIncident(_ context.Context, req *sapb.Incident, _ ...grpc.CallOption) (*sapb.Incident, error) {
+	d.log.Infof("Dry run: would add incident: %s", req)
+	return req, nil
+}
+
+func (d dryRunSAC) AddIncidentSerials(_ context.Context, req *sapb.AddIncidentSerialsRequest, _ ...grpc.CallOption) (*sapb.AddIncidentSerialsResponse, error) {
+	d.log.Infof("Dry run: would add %d serials to incident %d", len(req.Serials), req.IncidentID)
+	return &sapb.AddIncidentSerialsResponse{Added: int64(len(req.Serials))}, nil
+}
+
+func (d dryRunSAC) AddIncidentSerialsIssuedBetween(_ context.Context, req *sapb.AddIncidentSerialsIssuedBetweenRequest, _ ...grpc.CallOption) (*sapb.AddIncidentSerialsResponse, error) {
+	d.log.Infof("Dry run: would add serials issued in a range to incident: %s", req)
+	return &sapb.AddIncidentSerialsResponse{}, nil
+}
+
+func (d dryRunSAC) MarkIncidentSerialsNotified(_ context.Context, req *sapb.MarkIncidentSerialsNotifiedRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	d.log.Infof("Dry run: would mark %d serials notified for incident %d", len(req.Serials), req.IncidentID)
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,490 @@
+package notmain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	berrors "github.com/letsencrypt/boulder/errors"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/mocks"
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+	"github.com/letsencrypt/boulder/revocation"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+	"golang.org/x/crypto/ocsp"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// mockSA serves the certificates in certs, which are precertificates if they
+// are in precerts, with the statuses in statuses.
+type mockSA struct {
+	mocks.StorageAuthority
+	certs    map[string][]byte
+	precerts map[string]bool
+	statuses map[string]string
+	blocked  []*sapb.AddBlockedNameRequest
+	byName   []*sapb.GetSerialsByNameRequest
+
+	incidentSerials []*sapb.IncidentSerial
+	addedSerials    []*sapb.AddIncidentSerialsRequest
+	notified        []*sapb.MarkIncidentSerialsNotifiedRequest
+
+	searches []*sapb.SearchCertificatesByNameRequest
+}
+
+func (m *mockSA) GetCertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	der, ok := m.certs[req.Serial]
+	if !ok || m.precerts[req.Serial] {
+		return nil, berrors.NotFoundError("no certificate with serial %s", req.Serial)
+	}
+	return &corepb.Certificate{Serial: req.Serial, Der: der}, nil
+}
+
+func (m *mockSA) GetPrecertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	der, ok := m.certs[req.Serial]
+	if !ok {
+		return nil, berrors.NotFoundError("no precertificate with serial %s", req.Serial)
+	}
+	return &corepb.Certificate{Serial: req.Serial, Der: der}, nil
+}
+
+func (m *mockSA) GetCertificateStatus(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.CertificateStatus, error) {
+	status, ok := m.statuses[req.Serial]
+	if !ok {
+		return nil, berrors.NotFoundError("no status for serial %s", req.Serial)
+	}
+	return &corepb.CertificateStatus{Serial: req.Serial, Status: status}, nil
+}
+
+func (m *mockSA) GetSerialsByAccount(_ context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (sapb.StorageAuthority_GetSerialsByAccountClient, error) {
+	var serials []*sapb.Serial
+	for serial := range m.certs {
+		serials = append(serials, &sapb.Serial{Serial: serial})
+	}
+	return &mocks.SerialStream{Serials: serials}, nil
+}
+
+func (m *mockSA) GetSerialsByName(_ context.Context, req *sapb.GetSerialsByNameRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_GetSerialsByNameClient, error) {
+	m.byName = append(m.byName, req)
+	var serials []*sapb.Serial
+	for serial := range m.certs {
+		serials = append(serials, &sapb.Serial{Serial: serial})
+	}
+	return &mocks.SerialStream{Serials: serials}, nil
+}
+
+func (m *mockSA) AddBlockedName(_ context.Context, req *sapb.AddBlockedNameRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.blocked = append(m.blocked, req)
+	return &emptypb.Empty{}, nil
+}
+
+func (m *mockSA) SearchCertificatesByName(_ context.Context, req *sapb.SearchCertificatesByNameRequest, _ ...grpc.CallOption) (*sapb.CertificateSearchResults, error) {
+	m.searches = append(m.searches, req)
+	return &sapb.CertificateSearchResults{
+		Results: []*sapb.CertificateSearchResult{
+			{Id: 1, Serial: "01", Name: req.Name, RegistrationID: 1, Status: string(core.OCSPStatusRevoked), RevokedReason: int64(ocsp.KeyCompromise)},
+			{Id: 2, Serial: "02", Name: req.Name, RegistrationID: 2},
+		},
+		LastID: 2,
+	}, nil
+}
+
+func (m *mockSA) GetAccountKeys(_ context.Context, req *sapb.GetAccountKeysRequest, _ ...grpc.CallOption) (*sapb.AccountKeys, error) {
+	return &sapb.AccountKeys{
+		Keys: []*sapb.AccountKey{
+			{RegistrationID: 1, KeySHA256: req.KeySHA256, Replaced: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC).UnixNano()},
+			{RegistrationID: 2, KeySHA256: req.KeySHA256, Added: time.Date(2022, 2, 2, 0, 0, 0, 0, time.UTC).UnixNano()},
+		},
+	}, nil
+}
+
+func (m *mockSA) SerialsForIncident(_ context.Context, req *sapb.SerialsForIncidentRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_SerialsForIncidentClient, error) {
+	return &mocks.IncidentSerialStream{Serials: m.incidentSerials}, nil
+}
+
+func (m *mockSA) AddIncidentSerials(_ context.Context, req *sapb.AddIncidentSerialsRequest, _ ...grpc.CallOption) (*sapb.AddIncidentSerialsResponse, error) {
+	m.addedSerials = append(m.addedSerials, req)
+	return &sapb.AddIncidentSerialsResponse{Added: int64(len(req.Serials) - 1), Unknown: req.Serials[:1]}, nil
+}
+
+func (m *mockSA) MarkIncidentSerialsNotified(_ context.Context, req *sapb.MarkIncidentSerialsNotifiedRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.notified = append(m.notified, req)
+	return &emptypb.Empty{}, nil
+}
+
+// mockRA records the requests to revoke certificates.
+type mockRA struct {
+	rapb.RegistrationAuthorityClient
+	sync.Mutex
+	revocations []*rapb.AdministrativelyRevokeCertificateRequest
+}
+
+func (m *mockRA) AdministrativelyRevokeCertificate(_ context.Context, req *rapb.AdministrativelyRevokeCertificateRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.revocations = append(m.revocations, req)
+	return &emptypb.Empty{}, nil
+}
+
+func setup(t *testing.T, dryRun bool) (*admin, *mockSA, *mockRA, *bytes.Buffer) {
+	msa := &mockSA{
+		certs:    make(map[string][]byte),
+		precerts: make(map[string]bool),
+		statuses: make(map[string]string),
+	}
+	for i, status := range []core.OCSPStatus{core.OCSPStatusGood, core.OCSPStatusGood, core.OCSPStatusRevoked} {
+		serial, cert := test.ThrowAwayCert(t, 1)
+		msa.certs[serial] = cert.Raw
+		msa.precerts[serial] = i == 1
+		msa.statuses[serial] = string(status)
+	}
+	mra := &mockRA{}
+	log := blog.NewMock()
+	var out bytes.Buffer
+	a := &admin{
+		rac:    mra,
+		sac:    msa,
+		clk:    clock.NewFake(),
+		log:    log,
+		out:    &out,
+		dryRun: dryRun,
+		user:   "tester",
+	}
+	if dryRun {
+		a.rac = dryRunRAC{mra, log}
+		a.sac = dryRunSAC{msa, log}
+	}
+	return a, msa, mra, &out
+}
+
+func TestParseReason(t *testing.T) {
+	reason, err := parseReason("keyCompromise")
+	test.AssertNotError(t, err, "parseReason failed")
+	test.AssertEquals(t, reason, revocation.Reason(ocsp.KeyCompromise))
+	reason, err = parseReason("SUPERSEDED")
+	test.AssertNotError(t, err, "parseReason failed")
+	test.AssertEquals(t, reason, revocation.Reason(ocsp.Superseded))
+	_, err = parseReason("sevenIsUnused")
+	test.AssertError(t, err, "parsed unknown reason")
+}
+
+func TestCertRevokeByAccount(t *testing.T) {
+	a, _, mra, out := setup(t, false)
+
+	err := (&certRevoke{regID: 1, reason: "superseded", opts: revokeOptions{parallelism: 2}}).Run(context.Background(), a)
+	test.AssertNotError(t, err, "cert-revoke failed")
+
+	var result revokeResult
+	err = json.Unmarshal(out.Bytes(), &result)
+	test.AssertNotError(t, err, "output wasn't JSON")
+	test.Assert(t, !result.DryRun, "result claims to be a dry run")
+	test.AssertEquals(t, result.Reason, "superseded")
+	test.AssertEquals(t, len(result.Revoked), 2)
+	test.AssertEquals(t, len(result.AlreadyRevoked), 1)
+	test.AssertEquals(t, len(result.Failed), 0)
+
+	// Both the final certificate and the precertificate were revoked using
+	// their DER, with the operator's name.
+	test.AssertEquals(t, len(mra.revocations), 2)
+	for _, req := range mra.revocations {
+		test.Assert(t, len(req.Cert) > 0, "revoked without the certificate")
+		test.AssertEquals(t, req.Code, int64(ocsp.Superseded))
+		test.AssertEquals(t, req.AdminName, "tester")
+	}
+}
+
+func TestCertRevokeDryRun(t *testing.T) {
+	a, _, mra, out := setup(t, true)
+
+	err := (&certRevoke{regID: 1, reason: "keyCompromise", opts: revokeOptions{parallelism: 1}}).Run(context.Background(), a)
+	test.AssertNotError(t, err, "cert-revoke failed")
+
+	var result revokeResult
+	err = json.Unmarshal(out.Bytes(), &result)
+	test.AssertNotError(t, err, "output wasn't JSON")
+	test.Assert(t, result.DryRun, "result doesn't claim to be a dry run")
+	test.AssertEquals(t, len(result.Revoked), 2)
+	test.AssertEquals(t, len(mra.revocations), 0)
+	test.AssertEquals(t, len(a.log.(*blog.Mock).GetAllMatching("Dry run: would revoke certificate")), 2)
+}
+
+func TestCertRevokeByDomain(t *testing.T) {
+	a, msa, mra, out := setup(t, false)
+	fc := a.clk.(clock.FakeClock)
+	start := fc.Now()
+
+	err := (&certRevoke{
+		domain:            "example.com",
+		includeSubdomains: true,
+		reason:            "cessationOfOperation",
+		opts: revokeOptions{
+			parallelism: 1,
+			batchSize:   2,
+			batchPause:  time.Minute,
+			rate:        1,
+		},
+	}).Run(context.Background(), a)
+	test.AssertNotError(t, err, "cert-revoke failed")
+	test.AssertEquals(t, len(msa.byName), 1)
+	test.AssertEquals(t, msa.byName[0].Name, "example.com")
+	test.Assert(t, msa.byName[0].IncludeSubdomains, "subdomains weren't requested")
+
+	var result revokeResult
+	err = json.Unmarshal(out.Bytes(), &result)
+	test.AssertNotError(t, err, "output wasn't JSON")
+	test.AssertEquals(t, len(result.Revoked), 2)
+	test.AssertEquals(t, len(mra.revocations), 2)
+	// The serial without a final certificate was revoked using its
+	// precertificate.
+	test.AssertEquals(t, len(result.Precertificates), 1)
+	test.Assert(t, msa.precerts[result.Precertificates[0]], "reported a final certificate as a precertificate")
+
+	// Three serials in batches of two at one per second: one second between
+	// the first two, then the pause between batches.
+	test.AssertEquals(t, fc.Since(start), time.Minute+time.Second)
+
+	// Dry runs list the matching certificates without pacing.
+	a, _, _, _ = setup(t, true)
+	fc = a.clk.(clock.FakeClock)
+	start = fc.Now()
+	err = (&certRevoke{
+		domain: "example.com",
+		reason: "unspecified",
+		opts:   revokeOptions{parallelism: 1, batchSize: 1, batchPause: time.Hour, rate: 1},
+	}).Run(context.Background(), a)
+	test.AssertNotError(t, err, "cert-revoke failed")
+	test.AssertEquals(t, fc.Since(start), time.Duration(0))
+}
+
+func TestCertRevokeFlags(t *testing.T) {
+	a, _, _, _ := setup(t, true)
+	ctx := context.Background()
+
+	err := (&certRevoke{reason: "unspecified", opts: revokeOptions{parallelism: 1}}).Run(ctx, a)
+	test.AssertError(t, err, "revoked without a selector")
+	err = (&certRevoke{serial: "00", regID: 1, reason: "unspecified", opts: revokeOptions{parallelism: 1}}).Run(ctx, a)
+	test.AssertError(t, err, "revoked with two selectors")
+	err = (&certRevoke{serial: "00", reason: "unspecified", opts: revokeOptions{skipBlockKey: true, parallelism: 1}}).Run(ctx, a)
+	test.AssertError(t, err, "skipped blocking the key for a reason other than keyCompromise")
+	err = (&certRevoke{privateKey: "key.pem", reason: "unspecified", opts: revokeOptions{parallelism: 1}}).Run(ctx, a)
+	test.AssertError(t, err, "revoked by private key for a reason other than keyCompromise")
+	err = (&certRevoke{serial: "00", includeSubdomains: true, reason: "unspecified", opts: revokeOptions{parallelism: 1}}).Run(ctx, a)
+	test.AssertError(t, err, "included subdomains without -domain")
+	err = (&certRevoke{domain: "example.com", reason: "unspecified", opts: revokeOptions{parallelism: 1, rate: -1}}).Run(ctx, a)
+	test.AssertError(t, err, "accepted a negative rate")
+
+	// Revocation failures are reported and returned as an error.
+	a, _, _, out := setup(t, true)
+	err = (&certRevoke{serial: "00", reason: "unspecified", opts: revokeOptions{parallelism: 1}}).Run(ctx, a)
+	test.AssertError(t, err, "revoking an unknown serial succeeded")
+	var result revokeResult
+	err = json.Unmarshal(out.Bytes(), &result)
+	test.AssertNotError(t, err, "output wasn't JSON")
+	test.AssertEquals(t, len(result.Failed), 1)
+}
+
+func TestBlockDomain(t *testing.T) {
+	a, msa, _, _ := setup(t, true)
+	err := (&blockDomain{domain: "example.com", comment: "court order"}).Run(context.Background(), a)
+	test.AssertNotError(t, err, "block-domain failed")
+	test.AssertEquals(t, len(msa.blocked), 0)
+
+	a, msa, _, _ = setup(t, false)
+	err = (&blockDomain{domain: "example.com"}).Run(context.Background(), a)
+	test.AssertError(t, err, "blocked a domain without a comment")
+	err = (&blockDomain{domain: "example.com", comment: "court order"}).Run(context.Background(), a)
+	test.AssertNotError(t, err, "block-domain failed")
+	test.AssertEquals(t, len(msa.blocked), 1)
+	test.AssertEquals(t, msa.blocked[0].AddedBy, "tester")
+}
+
+// addIncidentSerials gives msa an incident containing its certificates, which
+// expire in a day, along with a serial which has no status and one which has
+// expired.
+func addIncidentSerials(a *admin, msa *mockSA) {
+	notAfter := a.clk.Now().Add(24 * time.Hour).UnixNano()
+	var serials []string
+	for serial := range msa.certs {
+		serials = append(serials, serial)
+	}
+	sort.Strings(serials)
+	for i, serial := range serials {
+		msa.incidentSerials = append(msa.incidentSerials, &sapb.IncidentSerial{
+			Serial:         serial,
+			RegistrationID: int64(i%2 + 1),
+			Status:         msa.statuses[serial],
+			NotAfter:       notAfter,
+		})
+	}
+	msa.incidentSerials = append(msa.incidentSerials,
+		&sapb.IncidentSerial{Serial: "nostatus", RegistrationID: 1},
+		&sapb.IncidentSerial{Serial: "expired", RegistrationID: 1, Status: string(core.OCSPStatusGood), NotAfter: a.clk.Now().Add(-time.Hour).UnixNano()},
+	)
+}
+
+func TestIncidentRevoke(t *testing.T) {
+	a, msa, mra, out := setup(t, false)
+	addIncidentSerials(a, msa)
+
+	err := (&incidentRevoke{reason: "superseded", opts: revokeOptions{parallelism: 1}}).Run(context.Background(), a)
+	test.AssertError(t, err, "revoked without an incident ID")
+
+	err = (&incidentRevoke{id: 1, reason: "superseded", opts: revokeOptions{parallelism: 2}}).Run(context.Background(), a)
+	test.AssertNotError(t, err, "incident-revoke failed")
+
+	// The serials without a status or which have expired were skipped.
+	var result revokeResult
+	err = json.Unmarshal(out.Bytes(), &result)
+	test.AssertNotError(t, err, "output wasn't JSON")
+	test.AssertEquals(t, len(result.Revoked), 2)
+	test.AssertEquals(t, len(result.AlreadyRevoked), 1)
+	test.AssertEquals(t, len(result.Failed), 0)
+	test.AssertEquals(t, len(mra.revocations), 2)
+}
+
+func TestIncidentSerials(t *testing.T) {
+	a, msa, _, out := setup(t, true)
+	addIncidentSerials(a, msa)
+
+	err := (&incidentSerials{id: 1, limit: 2}).Run(context.Background(), a)
+	test.AssertNotError(t, err, "incident-serials failed")
+	var serials []incidentSerial
+	err = json.Unmarshal(out.Bytes(), &serials)
+	test.AssertNotError(t, err, "output wasn't JSON")
+	test.AssertEquals(t, len(serials), 2)
+	test.AssertEquals(t, serials[0].Serial, msa.incidentSerials[0].Serial)
+}
+
+func TestIncidentLoad(t *testing.T) {
+	serialsFile := filepath.Join(t.TempDir(), "serials")
+	var contents strings.Builder
+	for i := 0; i < incidentSerialsBatch+1; i++ {
+		fmt.Fprintf(&contents, "%036x\n", i)
+	}
+	err := ioutil.WriteFile(serialsFile, []byte(contents.String()), 0600)
+	test.AssertNotError(t, err, "writing serials file")
+
+	a, msa, _, out := setup(t, false)
+	err = (&incidentLoad{id: 1}).Run(context.Background(), a)
+	test.AssertError(t, err, "loaded serials without a source")
+	err = (&incidentLoad{id: 1, serialsFile: serialsFile, issuedAfter: "2022-01-01T00:00:00Z"}).Run(context.Background(), a)
+	test.AssertError(t, err, "loaded serials from two sources")
+
+	err = (&incidentLoad{id: 1, serialsFile: serialsFile}).Run(context.Background(), a)
+	test.AssertNotError(t, err, "incident-load failed")
+	test.AssertEquals(t, len(msa.addedSerials), 2)
+	test.AssertEquals(t, len(msa.addedSerials[0].Serials), incidentSerialsBatch)
+	test.AssertEquals(t, len(msa.addedSerials[1].Serials), 1)
+
+	// The mock reports the first serial of each batch as unknown.
+	var result struct {
+		Added   int64    `json:"added"`
+		Unknown []string `json:"unknown"`
+	}
+	err = json.Unmarshal(out.Bytes(), &result)
+	test.AssertNotError(t, err, "output wasn't JSON")
+	test.AssertEquals(t, result.Added, int64(incidentSerialsBatch-1))
+	test.AssertEquals(t, len(result.Unknown), 2)
+}
+
+func TestIncidentNotify(t *testing.T) {
+	a, msa, _, _ := setup(t, false)
+	a.clk.(clock.FakeClock).Set(time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC))
+	addIncidentSerials(a, msa)
+	// Accounts aren't told about serials which are revoked or they've already
+	// been notified of.
+	msa.incidentSerials[0].LastNoticeSent = a.clk.Now().UnixNano()
+	msa.incidentSerials[1].Status = string(core.OCSPStatusGood)
+	msa.incidentSerials[2].Status = string(core.OCSPStatusRevoked)
+
+	recipientsFile := filepath.Join(t.TempDir(), "recipients.csv")
+	err := (&incidentNotify{id: 1, recipientsFile: recipientsFile}).Run(context.Background(), a)
+	test.AssertNotError(t, err, "incident-notify failed")
+
+	// Only the second serial is good, unexpired and unnotified.
+	contents, err := ioutil.ReadFile(recipientsFile)
+	test.AssertNotError(t, err, "reading recipients file")
+	test.AssertEquals(t, string(contents), fmt.Sprintf("id,serials\n2,%s\n", msa.incidentSerials[1].Serial))
+	test.AssertEquals(t, len(msa.notified), 1)
+	test.AssertDeepEquals(t, msa.notified[0].Serials, []string{msa.incidentSerials[1].Serial})
+
+	// The recipients file isn't overwritten.
+	err = (&incidentNotify{id: 1, recipientsFile: recipientsFile}).Run(context.Background(), a)
+	test.AssertError(t, err, "overwrote the recipients file")
+
+	// Dry runs write the file without marking the serials notified.
+	a, msa, _, _ = setup(t, true)
+	addIncidentSerials(a, msa)
+	recipientsFile = filepath.Join(t.TempDir(), "recipients.csv")
+	err = (&incidentNotify{id: 1, recipientsFile: recipientsFile}).Run(context.Background(), a)
+	test.AssertNotError(t, err, "incident-notify failed")
+	test.AssertEquals(t, len(msa.notified), 0)
+	test.AssertEquals(t, len(a.log.(*blog.Mock).GetAllMatching("Dry run: would mark 2 serials notified")), 1)
+}
+
+func TestCertSearch(t *testing.T) {
+	a, msa, _, out := setup(t, true)
+
+	err := (&certSearch{limit: 10}).Run(context.Background(), a)
+	test.AssertError(t, err, "searched without a domain")
+	err = (&certSearch{domain: "example.com", issuedAfter: "yesterday"}).Run(context.Background(), a)
+	test.AssertError(t, err, "accepted an invalid time")
+
+	err = (&certSearch{
+		domain:            "example.com",
+		includeSubdomains: true,
+		issuedAfter:       "2022-01-01T00:00:00Z",
+		afterID:           5,
+		limit:             10,
+	}).Run(context.Background(), a)
+	test.AssertNotError(t, err, "cert-search failed")
+	test.AssertEquals(t, len(msa.searches), 1)
+	req := msa.searches[0]
+	test.Assert(t, req.IncludeSubdomains, "subdomains weren't requested")
+	test.AssertEquals(t, req.Issued.Earliest, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano())
+	test.AssertEquals(t, req.Issued.Latest, int64(0))
+	test.AssertEquals(t, req.AfterID, int64(5))
+	test.AssertEquals(t, req.Limit, int64(10))
+
+	var result struct {
+		Results     []certSearchResult `json:"results"`
+		NextAfterID int64              `json:"nextAfterID"`
+	}
+	err = json.Unmarshal(out.Bytes(), &result)
+	test.AssertNotError(t, err, "output wasn't JSON")
+	test.AssertEquals(t, len(result.Results), 2)
+	test.AssertEquals(t, result.Results[0].RevokedReason, "keyCompromise")
+	test.AssertEquals(t, result.Results[1].Status, "unknown")
+	test.AssertEquals(t, result.NextAfterID, int64(2))
+}
+
+func TestAccountKeyLookup(t *testing.T) {
+	a, _, _, out := setup(t, true)
+
+	err := (&accountKeyLookup{}).Run(context.Background(), a)
+	test.AssertError(t, err, "looked up without a key")
+
+	err = (&accountKeyLookup{keySHA256: "digest"}).Run(context.Background(), a)
+	test.AssertNotError(t, err, "account-key-lookup failed")
+	var history []accountKey
+	err = json.Unmarshal(out.Bytes(), &history)
+	test.AssertNotError(t, err, "output wasn't JSON")
+	test.AssertEquals(t, len(history), 2)
+	test.Assert(t, history[0].Added == nil, "added time set for a key which predates the history")
+	test.AssertEquals(t, *history[0].Replaced, time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC))
+	test.AssertEquals(t, history[1].RegistrationID, int64(2))
+	test.Assert(t, history[1].Replaced == nil, "replaced time set for a current key")
+}
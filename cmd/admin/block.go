@@ -0,0 +1,146 @@
+package notmain
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/letsencrypt/boulder/privatekey"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// spkiHash returns the SHA-256 hash of the public key's SubjectPublicKeyInfo,
+// as stored in the blockedKeys and keyHashToSerial tables.
+func spkiHash(pubKey crypto.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(der)
+	return hash[:], nil
+}
+
+// blockKeyHash adds the SPKI hash to the blockedKeys table, returning false
+// if it was already blocked.
+func (a *admin) blockKeyHash(ctx context.Context, keyHash []byte, comment string) (bool, error) {
+	exists, err := a.sac.KeyBlocked(ctx, &sapb.KeyBlockedRequest{KeyHash: keyHash})
+	if err != nil {
+		return false, err
+	}
+	if exists.Exists {
+		return false, nil
+	}
+	_, err = a.sac.AddBlockedKey(ctx, &sapb.AddBlockedKeyRequest{
+		KeyHash: keyHash,
+		Added:   a.clk.Now().UnixNano(),
+		Source:  "admin",
+		Comment: fmt.Sprintf("%s by %s", comment, a.user),
+	})
+	if err != nil {
+		return false, err
+	}
+	a.log.AuditInfof("Blocked key: spkiHash=[%x] by=[%s] comment=[%s] dryRun=[%t]", keyHash, a.user, comment, a.dryRun)
+	return true, nil
+}
+
+type blockKey struct {
+	privateKey string
+	spkiHash   string
+	comment    string
+}
+
+func (s *blockKey) Desc() string {
+	return "Block issuance for a key. bad-key-revoker revokes its existing certificates"
+}
+
+func (s *blockKey) Flags(f *flag.FlagSet) {
+	f.StringVar(&s.privateKey, "private-key", "", "Path to a PEM private key to block")
+	f.StringVar(&s.spkiHash, "spki-hash", "", "Hex SHA-256 hash of the SubjectPublicKeyInfo of a key to block")
+	f.StringVar(&s.comment, "comment", "", "Why the key is blocked (required)")
+}
+
+func (s *blockKey) Run(ctx context.Context, a *admin) error {
+	if (s.privateKey == "") == (s.spkiHash == "") {
+		return errors.New("exactly one of -private-key and -spki-hash is required")
+	}
+	if s.comment == "" {
+		return errors.New("-comment is required")
+	}
+	var keyHash []byte
+	var err error
+	if s.privateKey != "" {
+		_, publicKey, err := privatekey.Load(s.privateKey)
+		if err != nil {
+			return err
+		}
+		keyHash, err = spkiHash(publicKey)
+		if err != nil {
+			return err
+		}
+	} else {
+		keyHash, err = hex.DecodeString(s.spkiHash)
+		if err != nil {
+			return fmt.Errorf("decoding -spki-hash: %w", err)
+		}
+		if len(keyHash) != sha256.Size {
+			return fmt.Errorf("-spki-hash must be a %d byte SHA-256 hash", sha256.Size)
+		}
+	}
+
+	stream, err := a.sac.GetSerialsByKey(ctx, &sapb.SPKIHash{KeyHash: keyHash})
+	if err != nil {
+		return err
+	}
+	serials, err := collectSerials(stream)
+	if err != nil {
+		return err
+	}
+	blocked, err := a.blockKeyHash(ctx, keyHash, s.comment)
+	if err != nil {
+		return err
+	}
+	return a.output(struct {
+		DryRun         bool   `json:"dryRun"`
+		SPKIHash       string `json:"spkiHash"`
+		AlreadyBlocked bool   `json:"alreadyBlocked"`
+		UnexpiredCerts int    `json:"unexpiredCertificates"`
+	}{a.dryRun, hex.EncodeToString(keyHash), !blocked, len(serials)})
+}
+
+type blockDomain struct {
+	domain  string
+	comment string
+}
+
+func (s *blockDomain) Desc() string {
+	return "Block issuance for a domain and its subdomains. Enforced by the RA with the BlockedNames feature"
+}
+
+func (s *blockDomain) Flags(f *flag.FlagSet) {
+	f.StringVar(&s.domain, "domain", "", "Domain to block, along with all of its subdomains (required)")
+	f.StringVar(&s.comment, "comment", "", "Why the domain is blocked (required)")
+}
+
+func (s *blockDomain) Run(ctx context.Context, a *admin) error {
+	if s.domain == "" || s.comment == "" {
+		return errors.New("-domain and -comment are required")
+	}
+	_, err := a.sac.AddBlockedName(ctx, &sapb.AddBlockedNameRequest{
+		Name:    s.domain,
+		AddedBy: a.user,
+		Comment: s.comment,
+	})
+	if err != nil {
+		return err
+	}
+	a.log.AuditInfof("Blocked domain: name=[%s] by=[%s] comment=[%s] dryRun=[%t]", s.domain, a.user, s.comment, a.dryRun)
+	return a.output(struct {
+		DryRun bool   `json:"dryRun"`
+		Domain string `json:"domain"`
+	}{a.dryRun, s.domain})
+}
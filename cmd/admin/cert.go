@@ -0,0 +1,522 @@
+package notmain
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/privatekey"
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+	"github.com/letsencrypt/boulder/revocation"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"golang.org/x/crypto/ocsp"
+)
+
+// certInfo describes a certificate or, if no final certificate was issued, a
+// precertificate.
+type certInfo struct {
+	Serial          string     `json:"serial"`
+	RegistrationID  int64      `json:"registrationID"`
+	Precertificate  bool       `json:"precertificate"`
+	Names           []string   `json:"names"`
+	Issuer          string     `json:"issuer"`
+	NotBefore       time.Time  `json:"notBefore"`
+	NotAfter        time.Time  `json:"notAfter"`
+	SPKIHash        string     `json:"spkiHash"`
+	Status          string     `json:"status"`
+	OCSPLastUpdated time.Time  `json:"ocspLastUpdated"`
+	RevokedDate     *time.Time `json:"revokedDate,omitempty"`
+	RevokedReason   string     `json:"revokedReason,omitempty"`
+}
+
+// getCert returns the final certificate with the given serial or, if there
+// isn't one, its precertificate. The returned bool is true if the
+// precertificate was returned.
+func (a *admin) getCert(ctx context.Context, serial string) (*corepb.Certificate, bool, error) {
+	cert, err := a.sac.GetCertificate(ctx, &sapb.Serial{Serial: serial})
+	if err == nil {
+		return cert, false, nil
+	}
+	if !errors.Is(err, berrors.NotFound) {
+		return nil, false, err
+	}
+	cert, err = a.sac.GetPrecertificate(ctx, &sapb.Serial{Serial: serial})
+	if err != nil {
+		return nil, false, err
+	}
+	return cert, true, nil
+}
+
+func (a *admin) getCertInfo(ctx context.Context, serial string) (*certInfo, error) {
+	cert, precert, err := a.getCert(ctx, serial)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := x509.ParseCertificate(cert.Der)
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate %s: %w", serial, err)
+	}
+	status, err := a.sac.GetCertificateStatus(ctx, &sapb.Serial{Serial: serial})
+	if err != nil {
+		return nil, err
+	}
+	spkiHash := sha256.Sum256(parsed.RawSubjectPublicKeyInfo)
+	info := &certInfo{
+		Serial:          serial,
+		RegistrationID:  cert.RegistrationID,
+		Precertificate:  precert,
+		Names:           parsed.DNSNames,
+		Issuer:          parsed.Issuer.CommonName,
+		NotBefore:       parsed.NotBefore,
+		NotAfter:        parsed.NotAfter,
+		SPKIHash:        hex.EncodeToString(spkiHash[:]),
+		Status:          status.Status,
+		OCSPLastUpdated: time.Unix(0, status.OcspLastUpdated).UTC(),
+	}
+	if status.Status == string(core.OCSPStatusRevoked) {
+		revokedDate := time.Unix(0, status.RevokedDate).UTC()
+		info.RevokedDate = &revokedDate
+		info.RevokedReason = revocation.ReasonToString[revocation.Reason(status.RevokedReason)]
+	}
+	return info, nil
+}
+
+type certShow struct {
+	serial string
+}
+
+func (s *certShow) Desc() string {
+	return "Show a certificate's names, account and revocation status"
+}
+
+func (s *certShow) Flags(f *flag.FlagSet) {
+	f.StringVar(&s.serial, "serial", "", "Hex serial of the certificate (required)")
+}
+
+func (s *certShow) Run(ctx context.Context, a *admin) error {
+	if s.serial == "" {
+		return errors.New("-serial is required")
+	}
+	info, err := a.getCertInfo(ctx, s.serial)
+	if err != nil {
+		return err
+	}
+	return a.output(info)
+}
+
+// certSearchResult is the JSON form of a certificate found by cert-search.
+type certSearchResult struct {
+	Serial         string     `json:"serial"`
+	Name           string     `json:"name"`
+	RegistrationID int64      `json:"registrationID"`
+	NotBefore      time.Time  `json:"notBefore"`
+	NotAfter       *time.Time `json:"notAfter,omitempty"`
+	Status         string     `json:"status"`
+	RevokedReason  string     `json:"revokedReason,omitempty"`
+}
+
+func newCertSearchResult(r *sapb.CertificateSearchResult) certSearchResult {
+	result := certSearchResult{
+		Serial:         r.Serial,
+		Name:           r.Name,
+		RegistrationID: r.RegistrationID,
+		NotBefore:      time.Unix(0, r.NotBefore).UTC(),
+		Status:         r.Status,
+	}
+	if r.Status == "" {
+		result.Status = "unknown"
+	}
+	if r.NotAfter != 0 {
+		notAfter := time.Unix(0, r.NotAfter).UTC()
+		result.NotAfter = &notAfter
+	}
+	if r.Status == string(core.OCSPStatusRevoked) {
+		result.RevokedReason = revocation.ReasonToString[revocation.Reason(r.RevokedReason)]
+	}
+	return result
+}
+
+type certSearch struct {
+	domain            string
+	includeSubdomains bool
+	issuedAfter       string
+	issuedBefore      string
+	afterID           int64
+	limit             int64
+}
+
+func (s *certSearch) Desc() string {
+	return "Find certificates, including expired ones, by name and when they were issued"
+}
+
+func (s *certSearch) Flags(f *flag.FlagSet) {
+	f.StringVar(&s.domain, "domain", "", "Name to search for (required)")
+	f.BoolVar(&s.includeSubdomains, "include-subdomains", false, "Also find certificates for any subdomain of the name, including wildcards")
+	f.StringVar(&s.issuedAfter, "issued-after", "", "Only find certificates issued at or after this RFC 3339 time")
+	f.StringVar(&s.issuedBefore, "issued-before", "", "Only find certificates issued before this RFC 3339 time")
+	f.Int64Var(&s.afterID, "after-id", 0, "Resume a search from the nextAfterID of a previous page")
+	f.Int64Var(&s.limit, "limit", 100, "Maximum number of results to return")
+}
+
+func (s *certSearch) Run(ctx context.Context, a *admin) error {
+	if s.domain == "" {
+		return errors.New("-domain is required")
+	}
+	issued := &sapb.Range{}
+	for _, t := range []struct {
+		flag  string
+		value string
+		dest  *int64
+	}{
+		{"-issued-after", s.issuedAfter, &issued.Earliest},
+		{"-issued-before", s.issuedBefore, &issued.Latest},
+	} {
+		if t.value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, t.value)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", t.flag, err)
+		}
+		*t.dest = parsed.UnixNano()
+	}
+	resp, err := a.sac.SearchCertificatesByName(ctx, &sapb.SearchCertificatesByNameRequest{
+		Name:              s.domain,
+		IncludeSubdomains: s.includeSubdomains,
+		Issued:            issued,
+		AfterID:           s.afterID,
+		Limit:             s.limit,
+	})
+	if err != nil {
+		return err
+	}
+	results := make([]certSearchResult, len(resp.Results))
+	for i, r := range resp.Results {
+		results[i] = newCertSearchResult(r)
+	}
+	return a.output(struct {
+		Results []certSearchResult `json:"results"`
+		// NextAfterID is zero when there are no more results.
+		NextAfterID int64 `json:"nextAfterID"`
+	}{results, resp.LastID})
+}
+
+// revokeResult is the output of revoking a set of certificates.
+type revokeResult struct {
+	DryRun         bool              `json:"dryRun"`
+	Reason         string            `json:"reason"`
+	Revoked        []string          `json:"revoked"`
+	AlreadyRevoked []string          `json:"alreadyRevoked"`
+	Failed         map[string]string `json:"failed"`
+	// Precertificates lists the serials in Revoked which had no final
+	// certificate, and so were revoked using their precertificate.
+	Precertificates []string `json:"precertificates,omitempty"`
+	KeyBlocked      bool     `json:"keyBlocked,omitempty"`
+}
+
+// revokeOptions controls how revokeSerials paces its revocations.
+type revokeOptions struct {
+	skipBlockKey bool
+	parallelism  int
+	// batchSize, if non-zero, splits the serials into batches which are each
+	// revoked to completion, with batchPause between them.
+	batchSize  int
+	batchPause time.Duration
+	// rate, if non-zero, is the maximum number of revocations started per
+	// second.
+	rate float64
+}
+
+type certRevoke struct {
+	serial            string
+	serialsFile       string
+	regID             int64
+	privateKey        string
+	domain            string
+	includeSubdomains bool
+	reason            string
+	opts              revokeOptions
+}
+
+func (s *certRevoke) Desc() string {
+	return "Revoke certificates by serial, serials file, account, private key or domain"
+}
+
+func (s *certRevoke) Flags(f *flag.FlagSet) {
+	f.StringVar(&s.serial, "serial", "", "Hex serial of a certificate to revoke")
+	f.StringVar(&s.serialsFile, "serials-file", "", "Path to a file of hex serials to revoke, one per line")
+	f.Int64Var(&s.regID, "reg-id", 0, "Revoke all unexpired certificates issued to this account")
+	f.StringVar(&s.privateKey, "private-key", "", "Path to a PEM private key. Revokes all unexpired certificates for its public key as keyCompromise and blocks the key")
+	f.StringVar(&s.domain, "domain", "", "Revoke all unexpired certificates and precertificates containing this name")
+	f.BoolVar(&s.includeSubdomains, "include-subdomains", false, "With -domain, also revoke certificates for any subdomain of the name, including wildcards")
+	f.StringVar(&s.reason, "reason", "unspecified", "Revocation reason name, e.g. keyCompromise")
+	f.BoolVar(&s.opts.skipBlockKey, "skip-block-key", false, "Don't block the keys of certificates revoked for keyCompromise")
+	f.IntVar(&s.opts.parallelism, "parallelism", 5, "Number of certificates to revoke concurrently")
+	f.IntVar(&s.opts.batchSize, "batch-size", 0, "If non-zero, revoke certificates in batches of this size")
+	f.DurationVar(&s.opts.batchPause, "batch-pause", time.Minute, "With -batch-size, how long to wait between batches")
+	f.Float64Var(&s.opts.rate, "rate", 0, "If non-zero, the maximum number of revocations per second")
+}
+
+// parseReason returns the revocation reason with the given name.
+func parseReason(name string) (revocation.Reason, error) {
+	for reason, reasonName := range revocation.ReasonToString {
+		if strings.EqualFold(name, reasonName) {
+			return reason, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown revocation reason %q", name)
+}
+
+func (s *certRevoke) Run(ctx context.Context, a *admin) error {
+	selectors := 0
+	for _, set := range []bool{s.serial != "", s.serialsFile != "", s.regID != 0, s.privateKey != "", s.domain != ""} {
+		if set {
+			selectors++
+		}
+	}
+	if selectors != 1 {
+		return errors.New("exactly one of -serial, -serials-file, -reg-id, -private-key and -domain is required")
+	}
+	if s.includeSubdomains && s.domain == "" {
+		return errors.New("-include-subdomains only applies to -domain")
+	}
+	if s.opts.parallelism < 1 {
+		return errors.New("-parallelism must be at least 1")
+	}
+	if s.opts.batchSize < 0 || s.opts.batchPause < 0 || s.opts.rate < 0 {
+		return errors.New("-batch-size, -batch-pause and -rate must not be negative")
+	}
+	reason, err := parseReason(s.reason)
+	if err != nil {
+		return err
+	}
+	if s.opts.skipBlockKey && reason != ocsp.KeyCompromise {
+		return errors.New("-skip-block-key only applies to -reason keyCompromise")
+	}
+
+	var serials []string
+	var keyHash []byte
+	switch {
+	case s.serial != "":
+		serials = []string{s.serial}
+	case s.serialsFile != "":
+		serials, err = readSerials(s.serialsFile)
+		if err != nil {
+			return err
+		}
+	case s.regID != 0:
+		_, err = a.sac.GetRegistration(ctx, &sapb.RegistrationID{Id: s.regID})
+		if err != nil {
+			return fmt.Errorf("couldn't fetch registration: %w", err)
+		}
+		stream, err := a.sac.GetSerialsByAccount(ctx, &sapb.RegistrationID{Id: s.regID})
+		if err != nil {
+			return err
+		}
+		serials, err = collectSerials(stream)
+		if err != nil {
+			return err
+		}
+	case s.privateKey != "":
+		if reason != ocsp.KeyCompromise {
+			return errors.New("revoking by private key requires -reason keyCompromise")
+		}
+		_, publicKey, err := privatekey.Load(s.privateKey)
+		if err != nil {
+			return err
+		}
+		keyHash, err = spkiHash(publicKey)
+		if err != nil {
+			return err
+		}
+		stream, err := a.sac.GetSerialsByKey(ctx, &sapb.SPKIHash{KeyHash: keyHash})
+		if err != nil {
+			return err
+		}
+		serials, err = collectSerials(stream)
+		if err != nil {
+			return err
+		}
+		// The key is blocked once, below, after all of its certificates have
+		// been revoked, rather than racing bad-key-revoker for each of them.
+		s.opts.skipBlockKey = true
+	case s.domain != "":
+		stream, err := a.sac.GetSerialsByName(ctx, &sapb.GetSerialsByNameRequest{
+			Name:              s.domain,
+			IncludeSubdomains: s.includeSubdomains,
+		})
+		if err != nil {
+			return err
+		}
+		serials, err = collectSerials(stream)
+		if err != nil {
+			return err
+		}
+	}
+
+	result := a.revokeSerials(ctx, serials, reason, s.opts)
+	if keyHash != nil && len(result.Failed) == 0 {
+		blocked, err := a.blockKeyHash(ctx, keyHash, "revoked by private key")
+		if err != nil {
+			return err
+		}
+		result.KeyBlocked = blocked
+	}
+	err = a.output(result)
+	if err != nil {
+		return err
+	}
+	if len(result.Failed) > 0 {
+		return fmt.Errorf("failed to revoke %d of %d certificates", len(result.Failed), len(serials))
+	}
+	return nil
+}
+
+// collectSerials reads all of the serials from a stream from the SA.
+func collectSerials(stream interface{ Recv() (*sapb.Serial, error) }) ([]string, error) {
+	var serials []string
+	for {
+		serial, err := stream.Recv()
+		if err == io.EOF {
+			return serials, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		serials = append(serials, serial.Serial)
+	}
+}
+
+// readSerials reads a file of serials, one per line, ignoring blank lines.
+func readSerials(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var serials []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		serial := strings.TrimSpace(scanner.Text())
+		if serial != "" {
+			serials = append(serials, serial)
+		}
+	}
+	return serials, scanner.Err()
+}
+
+// revokeSerials revokes each of the certificates through the RA, which updates
+// OCSP, using opts.parallelism workers. Certificates which are already revoked
+// are skipped. Failures are recorded in the result rather than stopping the
+// remaining revocations. Batching and rate limiting are skipped in dry runs,
+// since nothing is revoked.
+func (a *admin) revokeSerials(ctx context.Context, serials []string, reason revocation.Reason, opts revokeOptions) *revokeResult {
+	result := &revokeResult{
+		DryRun:         a.dryRun,
+		Reason:         revocation.ReasonToString[reason],
+		Revoked:        []string{},
+		AlreadyRevoked: []string{},
+		Failed:         make(map[string]string),
+	}
+	batchSize := len(serials)
+	if opts.batchSize > 0 && !a.dryRun {
+		batchSize = opts.batchSize
+	}
+	var interval time.Duration
+	if opts.rate > 0 && !a.dryRun {
+		interval = time.Duration(float64(time.Second) / opts.rate)
+	}
+
+	var mu sync.Mutex
+	for start := 0; start < len(serials); start += batchSize {
+		if start > 0 {
+			a.log.Infof("Revoked %d of %d certificates, pausing for %s", start, len(serials), opts.batchPause)
+			a.clk.Sleep(opts.batchPause)
+		}
+		end := start + batchSize
+		if end > len(serials) {
+			end = len(serials)
+		}
+
+		wg := new(sync.WaitGroup)
+		work := make(chan string, opts.parallelism)
+		for i := 0; i < opts.parallelism; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for serial := range work {
+					revoked, precert, err := a.revokeSerial(ctx, serial, reason, opts.skipBlockKey)
+					mu.Lock()
+					switch {
+					case err != nil:
+						a.log.Warningf("failed to revoke %q: %s", serial, err)
+						result.Failed[serial] = err.Error()
+					case revoked:
+						result.Revoked = append(result.Revoked, serial)
+						if precert {
+							result.Precertificates = append(result.Precertificates, serial)
+						}
+					default:
+						result.AlreadyRevoked = append(result.AlreadyRevoked, serial)
+					}
+					mu.Unlock()
+				}
+			}()
+		}
+		for i, serial := range serials[start:end] {
+			if i > 0 && interval > 0 {
+				a.clk.Sleep(interval)
+			}
+			work <- serial
+		}
+		close(work)
+		wg.Wait()
+	}
+
+	sort.Strings(result.Revoked)
+	sort.Strings(result.AlreadyRevoked)
+	sort.Strings(result.Precertificates)
+	return result
+}
+
+// revokeSerial revokes a single certificate, returning false if it was
+// already revoked. The second return value is true if there was no final
+// certificate, and so the precertificate was revoked instead.
+func (a *admin) revokeSerial(ctx context.Context, serial string, reason revocation.Reason, skipBlockKey bool) (bool, bool, error) {
+	status, err := a.sac.GetCertificateStatus(ctx, &sapb.Serial{Serial: serial})
+	if err != nil {
+		return false, false, err
+	}
+	if status.Status != string(core.OCSPStatusGood) {
+		return false, false, nil
+	}
+	cert, precert, err := a.getCert(ctx, serial)
+	if err != nil {
+		return false, false, err
+	}
+	_, err = a.rac.AdministrativelyRevokeCertificate(ctx, &rapb.AdministrativelyRevokeCertificateRequest{
+		Cert:         cert.Der,
+		Code:         int64(reason),
+		AdminName:    a.user,
+		SkipBlockKey: skipBlockKey,
+	})
+	if err != nil {
+		return false, false, err
+	}
+	return true, precert, nil
+}
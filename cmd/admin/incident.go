@@ -0,0 +1,446 @@
+package notmain
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/revocation"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// incidentSerialsBatch is the number of serials sent to the SA at a time when
+// loading them from a file or marking them notified, which is the most the SA
+// accepts.
+const incidentSerialsBatch = 500
+
+// incident is the JSON form of an incident.
+type incident struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	URL       string    `json:"url"`
+	RenewBy   time.Time `json:"renewBy"`
+	CreatedBy string    `json:"createdBy"`
+	Created   time.Time `json:"created"`
+}
+
+func newIncident(i *sapb.Incident) incident {
+	return incident{
+		ID:        i.Id,
+		Name:      i.Name,
+		URL:       i.Url,
+		RenewBy:   time.Unix(0, i.RenewBy).UTC(),
+		CreatedBy: i.CreatedBy,
+		Created:   time.Unix(0, i.Created).UTC(),
+	}
+}
+
+// incidentSerial is the JSON form of one of an incident's serials.
+type incidentSerial struct {
+	Serial         string     `json:"serial"`
+	RegistrationID int64      `json:"registrationID"`
+	Status         string     `json:"status"`
+	RevokedReason  string     `json:"revokedReason,omitempty"`
+	NotAfter       *time.Time `json:"notAfter,omitempty"`
+	LastNoticeSent *time.Time `json:"lastNoticeSent,omitempty"`
+}
+
+func newIncidentSerial(s *sapb.IncidentSerial) incidentSerial {
+	is := incidentSerial{
+		Serial:         s.Serial,
+		RegistrationID: s.RegistrationID,
+		Status:         s.Status,
+	}
+	if s.Status == "" {
+		is.Status = "unknown"
+	}
+	if s.Status == string(core.OCSPStatusRevoked) {
+		is.RevokedReason = revocation.ReasonToString[revocation.Reason(s.RevokedReason)]
+	}
+	if s.NotAfter != 0 {
+		notAfter := time.Unix(0, s.NotAfter).UTC()
+		is.NotAfter = &notAfter
+	}
+	if s.LastNoticeSent != 0 {
+		sent := time.Unix(0, s.LastNoticeSent).UTC()
+		is.LastNoticeSent = &sent
+	}
+	return is
+}
+
+// forEachIncidentSerial streams the incident's serials from the SA, calling f
+// with each of them. It stops at the first error from f.
+func (a *admin) forEachIncidentSerial(ctx context.Context, req *sapb.SerialsForIncidentRequest, f func(*sapb.IncidentSerial) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := a.sac.SerialsForIncident(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		serial, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = f(serial)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+type incidentCreate struct {
+	name    string
+	url     string
+	renewBy time.Duration
+}
+
+func (s *incidentCreate) Desc() string {
+	return "Create an incident, to which affected serials can then be loaded"
+}
+
+func (s *incidentCreate) Flags(f *flag.FlagSet) {
+	f.StringVar(&s.name, "name", "", "Short, unique name for the incident, e.g. 2022-01-tls-alpn (required)")
+	f.StringVar(&s.url, "url", "", "URL of the incident's public description (required)")
+	f.DurationVar(&s.renewBy, "renew-by", 5*24*time.Hour, "How long subscribers have to replace the affected certificates")
+}
+
+func (s *incidentCreate) Run(ctx context.Context, a *admin) error {
+	if s.name == "" || s.url == "" {
+		return errors.New("-name and -url are required")
+	}
+	if s.renewBy <= 0 {
+		return errors.New("-renew-by must be positive")
+	}
+	added, err := a.sac.AddIncident(ctx, &sapb.Incident{
+		Name:      s.name,
+		Url:       s.url,
+		RenewBy:   a.clk.Now().Add(s.renewBy).UnixNano(),
+		CreatedBy: a.user,
+	})
+	if err != nil {
+		return err
+	}
+	a.log.AuditInfof("Created incident: id=[%d] name=[%s] url=[%s] renewBy=[%s] by=[%s] dryRun=[%t]",
+		added.Id, added.Name, added.Url, time.Unix(0, added.RenewBy).UTC(), a.user, a.dryRun)
+	return a.output(struct {
+		DryRun   bool     `json:"dryRun"`
+		Incident incident `json:"incident"`
+	}{a.dryRun, newIncident(added)})
+}
+
+type incidentList struct{}
+
+func (s *incidentList) Desc() string {
+	return "List incidents"
+}
+
+func (s *incidentList) Flags(*flag.FlagSet) {}
+
+func (s *incidentList) Run(ctx context.Context, a *admin) error {
+	resp, err := a.sac.GetIncidents(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+	incidents := make([]incident, len(resp.Incidents))
+	for i, inc := range resp.Incidents {
+		incidents[i] = newIncident(inc)
+	}
+	return a.output(incidents)
+}
+
+type incidentLoad struct {
+	id           int64
+	serialsFile  string
+	issuedAfter  string
+	issuedBefore string
+	issuerID     int64
+}
+
+func (s *incidentLoad) Desc() string {
+	return "Load serials into an incident from a file, or by when and by which issuer they were issued"
+}
+
+func (s *incidentLoad) Flags(f *flag.FlagSet) {
+	f.Int64Var(&s.id, "id", 0, "ID of the incident (required)")
+	f.StringVar(&s.serialsFile, "serials-file", "", "Path to a file of hex serials, one per line")
+	f.StringVar(&s.issuedAfter, "issued-after", "", "Load serials issued at or after this RFC 3339 time, instead of -serials-file")
+	f.StringVar(&s.issuedBefore, "issued-before", "", "With -issued-after, load serials issued before this RFC 3339 time")
+	f.Int64Var(&s.issuerID, "issuer-id", 0, "With -issued-after, only load serials from the issuer with this ID")
+}
+
+func (s *incidentLoad) Run(ctx context.Context, a *admin) error {
+	if s.id == 0 {
+		return errors.New("-id is required")
+	}
+	byTime := s.issuedAfter != "" || s.issuedBefore != "" || s.issuerID != 0
+	if (s.serialsFile == "") == !byTime {
+		return errors.New("exactly one of -serials-file and -issued-after/-issued-before is required")
+	}
+
+	result := struct {
+		DryRun  bool     `json:"dryRun"`
+		Added   int64    `json:"added"`
+		Unknown []string `json:"unknown"`
+	}{DryRun: a.dryRun, Unknown: []string{}}
+
+	if s.serialsFile != "" {
+		serials, err := readSerials(s.serialsFile)
+		if err != nil {
+			return err
+		}
+		for start := 0; start < len(serials); start += incidentSerialsBatch {
+			end := start + incidentSerialsBatch
+			if end > len(serials) {
+				end = len(serials)
+			}
+			resp, err := a.sac.AddIncidentSerials(ctx, &sapb.AddIncidentSerialsRequest{
+				IncidentID: s.id,
+				Serials:    serials[start:end],
+			})
+			if err != nil {
+				return err
+			}
+			result.Added += resp.Added
+			result.Unknown = append(result.Unknown, resp.Unknown...)
+		}
+	} else {
+		if s.issuedAfter == "" || s.issuedBefore == "" {
+			return errors.New("-issued-after and -issued-before are both required")
+		}
+		earliest, err := time.Parse(time.RFC3339, s.issuedAfter)
+		if err != nil {
+			return fmt.Errorf("parsing -issued-after: %w", err)
+		}
+		latest, err := time.Parse(time.RFC3339, s.issuedBefore)
+		if err != nil {
+			return fmt.Errorf("parsing -issued-before: %w", err)
+		}
+		if !latest.After(earliest) {
+			return errors.New("-issued-before must be after -issued-after")
+		}
+		var afterID int64
+		for {
+			resp, err := a.sac.AddIncidentSerialsIssuedBetween(ctx, &sapb.AddIncidentSerialsIssuedBetweenRequest{
+				IncidentID: s.id,
+				Issued:     &sapb.Range{Earliest: earliest.UnixNano(), Latest: latest.UnixNano()},
+				IssuerID:   s.issuerID,
+				AfterID:    afterID,
+			})
+			if err != nil {
+				return err
+			}
+			result.Added += resp.Added
+			if resp.LastID == 0 {
+				break
+			}
+			afterID = resp.LastID
+			a.log.Infof("Loaded %d serials into incident %d", result.Added, s.id)
+		}
+	}
+
+	a.log.AuditInfof("Loaded serials into incident: id=[%d] added=[%d] unknown=[%d] by=[%s] dryRun=[%t]",
+		s.id, result.Added, len(result.Unknown), a.user, a.dryRun)
+	return a.output(result)
+}
+
+type incidentSerials struct {
+	id    int64
+	after string
+	limit int
+}
+
+func (s *incidentSerials) Desc() string {
+	return "List an incident's serials with their status and account"
+}
+
+func (s *incidentSerials) Flags(f *flag.FlagSet) {
+	f.Int64Var(&s.id, "id", 0, "ID of the incident (required)")
+	f.StringVar(&s.after, "after", "", "Only list serials after this one")
+	f.IntVar(&s.limit, "limit", 1000, "Maximum number of serials to list, or 0 for all of them")
+}
+
+// errLimitReached stops forEachIncidentSerial once enough serials have been
+// read.
+var errLimitReached = errors.New("limit reached")
+
+func (s *incidentSerials) Run(ctx context.Context, a *admin) error {
+	if s.id == 0 {
+		return errors.New("-id is required")
+	}
+	serials := []incidentSerial{}
+	err := a.forEachIncidentSerial(ctx, &sapb.SerialsForIncidentRequest{IncidentID: s.id, AfterSerial: s.after}, func(serial *sapb.IncidentSerial) error {
+		serials = append(serials, newIncidentSerial(serial))
+		if s.limit > 0 && len(serials) >= s.limit {
+			return errLimitReached
+		}
+		return nil
+	})
+	if err != nil && err != errLimitReached {
+		return err
+	}
+	return a.output(serials)
+}
+
+type incidentRevoke struct {
+	id     int64
+	reason string
+	opts   revokeOptions
+}
+
+func (s *incidentRevoke) Desc() string {
+	return "Revoke an incident's unexpired certificates"
+}
+
+func (s *incidentRevoke) Flags(f *flag.FlagSet) {
+	f.Int64Var(&s.id, "id", 0, "ID of the incident (required)")
+	f.StringVar(&s.reason, "reason", "unspecified", "Revocation reason name, e.g. superseded")
+	f.IntVar(&s.opts.parallelism, "parallelism", 5, "Number of certificates to revoke concurrently")
+	f.IntVar(&s.opts.batchSize, "batch-size", 0, "If non-zero, revoke certificates in batches of this size")
+	f.DurationVar(&s.opts.batchPause, "batch-pause", time.Minute, "With -batch-size, how long to wait between batches")
+	f.Float64Var(&s.opts.rate, "rate", 0, "If non-zero, the maximum number of revocations per second")
+}
+
+func (s *incidentRevoke) Run(ctx context.Context, a *admin) error {
+	if s.id == 0 {
+		return errors.New("-id is required")
+	}
+	if s.opts.parallelism < 1 {
+		return errors.New("-parallelism must be at least 1")
+	}
+	if s.opts.batchSize < 0 || s.opts.batchPause < 0 || s.opts.rate < 0 {
+		return errors.New("-batch-size, -batch-pause and -rate must not be negative")
+	}
+	reason, err := parseReason(s.reason)
+	if err != nil {
+		return err
+	}
+
+	// Serials without a status never had a precertificate issued, so there's
+	// nothing to revoke. Those which are already revoked are passed through
+	// so that they're reported as such.
+	var serials []string
+	now := a.clk.Now().UnixNano()
+	err = a.forEachIncidentSerial(ctx, &sapb.SerialsForIncidentRequest{IncidentID: s.id}, func(serial *sapb.IncidentSerial) error {
+		if serial.Status != "" && serial.NotAfter > now {
+			serials = append(serials, serial.Serial)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	result := a.revokeSerials(ctx, serials, reason, s.opts)
+	a.log.AuditInfof("Revoked incident's certificates: id=[%d] reason=[%s] revoked=[%d] alreadyRevoked=[%d] failed=[%d] by=[%s] dryRun=[%t]",
+		s.id, result.Reason, len(result.Revoked), len(result.AlreadyRevoked), len(result.Failed), a.user, a.dryRun)
+	err = a.output(result)
+	if err != nil {
+		return err
+	}
+	if len(result.Failed) > 0 {
+		return fmt.Errorf("failed to revoke %d of %d certificates", len(result.Failed), len(serials))
+	}
+	return nil
+}
+
+type incidentNotify struct {
+	id             int64
+	recipientsFile string
+}
+
+func (s *incidentNotify) Desc() string {
+	return "Write a notify-mailer recipients file of accounts with unrevoked, unexpired and unnotified certificates in an incident"
+}
+
+func (s *incidentNotify) Flags(f *flag.FlagSet) {
+	f.Int64Var(&s.id, "id", 0, "ID of the incident (required)")
+	f.StringVar(&s.recipientsFile, "recipients-file", "", "Path to write the recipients CSV to (required)")
+}
+
+// Run writes one row per account, with the columns "id" and "serials", the
+// latter being the account's affected serials separated by spaces. Unless
+// this is a dry run, the serials are then marked notified, so that running
+// the subcommand again only picks up serials loaded since.
+func (s *incidentNotify) Run(ctx context.Context, a *admin) error {
+	if s.id == 0 || s.recipientsFile == "" {
+		return errors.New("-id and -recipients-file are required")
+	}
+
+	byAccount := make(map[int64][]string)
+	var toMark []string
+	now := a.clk.Now().UnixNano()
+	err := a.forEachIncidentSerial(ctx, &sapb.SerialsForIncidentRequest{IncidentID: s.id}, func(serial *sapb.IncidentSerial) error {
+		if serial.Status == string(core.OCSPStatusGood) && serial.NotAfter > now && serial.LastNoticeSent == 0 {
+			byAccount[serial.RegistrationID] = append(byAccount[serial.RegistrationID], serial.Serial)
+			toMark = append(toMark, serial.Serial)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	regIDs := make([]int64, 0, len(byAccount))
+	for regID := range byAccount {
+		regIDs = append(regIDs, regID)
+	}
+	sort.Slice(regIDs, func(i, j int) bool { return regIDs[i] < regIDs[j] })
+
+	file, err := os.OpenFile(s.recipientsFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0640)
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(file)
+	_ = w.Write([]string{"id", "serials"})
+	for _, regID := range regIDs {
+		_ = w.Write([]string{strconv.FormatInt(regID, 10), strings.Join(byAccount[regID], " ")})
+	}
+	w.Flush()
+	err = w.Error()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(toMark); start += incidentSerialsBatch {
+		end := start + incidentSerialsBatch
+		if end > len(toMark) {
+			end = len(toMark)
+		}
+		_, err := a.sac.MarkIncidentSerialsNotified(ctx, &sapb.MarkIncidentSerialsNotifiedRequest{
+			IncidentID: s.id,
+			Serials:    toMark[start:end],
+			Notified:   now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	a.log.AuditInfof("Wrote incident recipients: id=[%d] accounts=[%d] serials=[%d] file=[%s] by=[%s] dryRun=[%t]",
+		s.id, len(regIDs), len(toMark), s.recipientsFile, a.user, a.dryRun)
+	return a.output(struct {
+		DryRun   bool   `json:"dryRun"`
+		File     string `json:"file"`
+		Accounts int    `json:"accounts"`
+		Serials  int    `json:"serials"`
+	}{a.dryRun, s.recipientsFile, len(regIDs), len(toMark)})
+}
@@ -0,0 +1,125 @@
+package notmain
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/features"
+)
+
+// Config holds the admin tool's configuration. Unlike admin-revoker it has no
+// database configuration: everything goes through the RA and SA. Logs share
+// stdout with the JSON output, so Syslog.StdoutLevel should be kept at 3
+// (errors) or below.
+type Config struct {
+	Admin struct {
+		// TLS configures the client certificate used to authenticate to the
+		// RA and SA.
+		TLS cmd.TLSConfig
+
+		RAService *cmd.GRPCClientConfig
+		SAService *cmd.GRPCClientConfig
+
+		Features map[string]bool
+	}
+
+	Syslog cmd.SyslogConfig
+}
+
+// subcommand is implemented by each of the admin tool's subcommands. Flags
+// registers the subcommand's flags, which are parsed before Run is called.
+type subcommand interface {
+	Desc() string
+	Flags(*flag.FlagSet)
+	Run(context.Context, *admin) error
+}
+
+// subcommands lists all of the admin tool's subcommands by name.
+var subcommands = map[string]subcommand{
+	"cert-show":             &certShow{},
+	"cert-revoke":           &certRevoke{},
+	"cert-search":           &certSearch{},
+	"account-show":          &accountShow{},
+	"account-key-lookup":    &accountKeyLookup{},
+	"account-suspend":       &accountSuspend{},
+	"account-unsuspend":     &accountUnsuspend{},
+	"account-policy-set":    &accountPolicySet{},
+	"account-policy-remove": &accountPolicyRemove{},
+	"block-key":             &blockKey{},
+	"block-domain":          &blockDomain{},
+	"rl-override-add":       &rlOverrideAdd{},
+	"rl-override-list":      &rlOverrideList{},
+	"rl-override-expire":    &rlOverrideExpire{},
+	"order-approval-list":   &orderApprovalList{},
+	"order-approve":         &orderDecide{approve: true},
+	"order-reject":          &orderDecide{approve: false},
+	"incident-create":       &incidentCreate{},
+	"incident-list":         &incidentList{},
+	"incident-load":         &incidentLoad{},
+	"incident-serials":      &incidentSerials{},
+	"incident-revoke":       &incidentRevoke{},
+	"incident-notify":       &incidentNotify{},
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: admin -config <path> [-dry-run=false] <subcommand> [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Every subcommand writes its result to stdout as JSON. Mutating subcommands\n")
+	fmt.Fprintf(os.Stderr, "only log what they would do unless -dry-run=false is given.\n\n")
+	fmt.Fprintf(os.Stderr, "subcommands:\n")
+	var names []string
+	for name := range subcommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-22s %s\n", name, subcommands[name].Desc())
+	}
+	fmt.Fprintf(os.Stderr, "\nRun 'admin -config <path> <subcommand> -h' for a subcommand's flags.\n")
+}
+
+func main() {
+	flagSet := flag.NewFlagSet("admin", flag.ExitOnError)
+	flagSet.Usage = usage
+	configFile := flagSet.String("config", "", "File path to the configuration file for this service")
+	dryRun := flagSet.Bool("dry-run", true, "true (default): only log the mutating RPCs which would be made. false: make them")
+	_ = flagSet.Parse(os.Args[1:])
+	if *configFile == "" || flagSet.NArg() == 0 {
+		usage()
+		os.Exit(1)
+	}
+
+	name := flagSet.Arg(0)
+	sc, ok := subcommands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown subcommand %q\n\n", name)
+		usage()
+		os.Exit(1)
+	}
+	subFlags := flag.NewFlagSet(name, flag.ExitOnError)
+	sc.Flags(subFlags)
+	_ = subFlags.Parse(flagSet.Args()[1:])
+	if subFlags.NArg() != 0 {
+		cmd.Fail(fmt.Sprintf("unexpected arguments: %s", strings.Join(subFlags.Args(), " ")))
+	}
+
+	var c Config
+	err := cmd.ReadConfigFile(*configFile, &c)
+	cmd.FailOnError(err, "Reading JSON config file into config structure")
+	err = features.Set(c.Admin.Features)
+	cmd.FailOnError(err, "Failed to set feature flags")
+
+	a := newAdmin(c, *dryRun)
+	defer a.log.AuditPanic()
+
+	err = sc.Run(context.Background(), a)
+	cmd.FailOnError(err, fmt.Sprintf("%s failed", name))
+}
+
+func init() {
+	cmd.RegisterCommand("admin", main)
+}
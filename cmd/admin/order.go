@@ -0,0 +1,91 @@
+package notmain
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"time"
+
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type orderApprovalList struct{}
+
+func (s *orderApprovalList) Desc() string {
+	return "List finalized orders awaiting approval"
+}
+
+func (s *orderApprovalList) Flags(*flag.FlagSet) {}
+
+func (s *orderApprovalList) Run(ctx context.Context, a *admin) error {
+	resp, err := a.sac.GetPendingOrderApprovals(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+	type approval struct {
+		OrderID        int64     `json:"orderID"`
+		RegistrationID int64     `json:"registrationID"`
+		Names          []string  `json:"names"`
+		Created        time.Time `json:"created"`
+	}
+	approvals := make([]approval, len(resp.Approvals))
+	for i, ap := range resp.Approvals {
+		approvals[i] = approval{
+			OrderID:        ap.OrderID,
+			RegistrationID: ap.RegistrationID,
+			Names:          ap.Names,
+			Created:        time.Unix(0, ap.Created).UTC(),
+		}
+	}
+	return a.output(approvals)
+}
+
+// orderDecide approves or rejects an order awaiting approval.
+type orderDecide struct {
+	approve bool
+	orderID int64
+	reason  string
+}
+
+func (s *orderDecide) Desc() string {
+	if s.approve {
+		return "Approve an order awaiting approval and issue its certificate"
+	}
+	return "Reject an order awaiting approval, making it invalid"
+}
+
+func (s *orderDecide) Flags(f *flag.FlagSet) {
+	f.Int64Var(&s.orderID, "order-id", 0, "ID of the order (required)")
+	f.StringVar(&s.reason, "reason", "", "Why the order is approved or rejected. Rejection reasons are shown to the subscriber (required)")
+}
+
+func (s *orderDecide) Run(ctx context.Context, a *admin) error {
+	if s.orderID == 0 || s.reason == "" {
+		return errors.New("-order-id and -reason are required")
+	}
+	req := &rapb.OrderApprovalRequest{
+		OrderID:   s.orderID,
+		AdminName: a.user,
+		Reason:    s.reason,
+	}
+	result := struct {
+		DryRun   bool   `json:"dryRun"`
+		OrderID  int64  `json:"orderID"`
+		Approved bool   `json:"approved"`
+		Serial   string `json:"serial,omitempty"`
+	}{DryRun: a.dryRun, OrderID: s.orderID, Approved: s.approve}
+	if s.approve {
+		order, err := a.rac.ApproveOrder(ctx, req)
+		if err != nil {
+			return err
+		}
+		result.Serial = order.CertificateSerial
+	} else {
+		_, err := a.rac.RejectOrder(ctx, req)
+		if err != nil {
+			return err
+		}
+	}
+	return a.output(result)
+}
@@ -0,0 +1,147 @@
+package notmain
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"time"
+
+	"github.com/letsencrypt/boulder/ratelimit"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// rateLimitOverride is the JSON form of a rate limit override.
+type rateLimitOverride struct {
+	ID             int64     `json:"id"`
+	Limit          string    `json:"limit"`
+	Key            string    `json:"key,omitempty"`
+	RegistrationID int64     `json:"registrationID,omitempty"`
+	Threshold      int64     `json:"threshold"`
+	Owner          string    `json:"owner"`
+	Reason         string    `json:"reason"`
+	Ticket         string    `json:"ticket"`
+	Expires        time.Time `json:"expires"`
+}
+
+func newRateLimitOverride(o *sapb.RateLimitOverride) rateLimitOverride {
+	return rateLimitOverride{
+		ID:             o.Id,
+		Limit:          o.Limit,
+		Key:            o.Key,
+		RegistrationID: o.RegistrationID,
+		Threshold:      o.Threshold,
+		Owner:          o.Owner,
+		Reason:         o.Reason,
+		Ticket:         o.Ticket,
+		Expires:        time.Unix(0, o.Expires).UTC(),
+	}
+}
+
+type rlOverrideAdd struct {
+	limit     string
+	key       string
+	regID     int64
+	threshold int64
+	reason    string
+	ticket    string
+	expires   time.Duration
+}
+
+func (s *rlOverrideAdd) Desc() string {
+	return "Add a rate limit override, which the RA picks up on its next refresh"
+}
+
+func (s *rlOverrideAdd) Flags(f *flag.FlagSet) {
+	f.StringVar(&s.limit, "limit", "", "Name of the limit from the rate limit policy file, e.g. certificatesPerName (required)")
+	f.StringVar(&s.key, "key", "", "Key the override applies to, e.g. a domain or IP address")
+	f.Int64Var(&s.regID, "reg-id", 0, "Registration ID the override applies to, instead of -key")
+	f.Int64Var(&s.threshold, "threshold", 0, "The overridden threshold (required)")
+	f.StringVar(&s.reason, "reason", "", "Why the override is needed (required)")
+	f.StringVar(&s.ticket, "ticket", "", "The ticket tracking the override request (required)")
+	f.DurationVar(&s.expires, "expires", 90*24*time.Hour, "How long the override lasts")
+}
+
+func (s *rlOverrideAdd) Run(ctx context.Context, a *admin) error {
+	if s.reason == "" || s.ticket == "" {
+		return errors.New("-reason and -ticket are required")
+	}
+	err := ratelimit.Override{
+		Limit:          s.limit,
+		Key:            s.key,
+		RegistrationID: s.regID,
+		Threshold:      s.threshold,
+	}.Validate()
+	if err != nil {
+		return err
+	}
+	added, err := a.sac.AddRateLimitOverride(ctx, &sapb.RateLimitOverride{
+		Limit:          s.limit,
+		Key:            s.key,
+		RegistrationID: s.regID,
+		Threshold:      s.threshold,
+		Owner:          a.user,
+		Reason:         s.reason,
+		Ticket:         s.ticket,
+		Expires:        a.clk.Now().Add(s.expires).UnixNano(),
+	})
+	if err != nil {
+		return err
+	}
+	a.log.AuditInfof("Added rate limit override: id=[%d] limit=[%s] key=[%s] regID=[%d] threshold=[%d] owner=[%s] ticket=[%s] reason=[%s] expires=[%s] dryRun=[%t]",
+		added.Id, added.Limit, added.Key, added.RegistrationID, added.Threshold, added.Owner, added.Ticket, added.Reason, time.Unix(0, added.Expires).UTC(), a.dryRun)
+	return a.output(struct {
+		DryRun   bool              `json:"dryRun"`
+		Override rateLimitOverride `json:"override"`
+	}{a.dryRun, newRateLimitOverride(added)})
+}
+
+type rlOverrideList struct {
+	includeExpired bool
+}
+
+func (s *rlOverrideList) Desc() string {
+	return "List rate limit overrides"
+}
+
+func (s *rlOverrideList) Flags(f *flag.FlagSet) {
+	f.BoolVar(&s.includeExpired, "include-expired", false, "Include expired overrides")
+}
+
+func (s *rlOverrideList) Run(ctx context.Context, a *admin) error {
+	resp, err := a.sac.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{IncludeExpired: s.includeExpired})
+	if err != nil {
+		return err
+	}
+	overrides := make([]rateLimitOverride, len(resp.Overrides))
+	for i, o := range resp.Overrides {
+		overrides[i] = newRateLimitOverride(o)
+	}
+	return a.output(overrides)
+}
+
+type rlOverrideExpire struct {
+	id int64
+}
+
+func (s *rlOverrideExpire) Desc() string {
+	return "Expire a rate limit override immediately"
+}
+
+func (s *rlOverrideExpire) Flags(f *flag.FlagSet) {
+	f.Int64Var(&s.id, "id", 0, "ID of the override (required)")
+}
+
+func (s *rlOverrideExpire) Run(ctx context.Context, a *admin) error {
+	if s.id == 0 {
+		return errors.New("-id is required")
+	}
+	_, err := a.sac.ExpireRateLimitOverride(ctx, &sapb.ExpireRateLimitOverrideRequest{Id: s.id})
+	if err != nil {
+		return err
+	}
+	a.log.AuditInfof("Expired rate limit override: id=[%d] by=[%s] dryRun=[%t]", s.id, a.user, a.dryRun)
+	return a.output(struct {
+		DryRun bool  `json:"dryRun"`
+		ID     int64 `json:"id"`
+	}{a.dryRun, s.id})
+}
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"context"
 	"crypto/x509"
+	"errors"
 	"flag"
 	"fmt"
 	"html/template"
+	"io"
 	"io/ioutil"
 	netmail "net/mail"
 	"os"
@@ -17,12 +19,13 @@ import (
 	"github.com/jmhodges/clock"
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
-	"github.com/letsencrypt/boulder/db"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	berrors "github.com/letsencrypt/boulder/errors"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/mail"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
-	"github.com/letsencrypt/boulder/sa"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/crypto/ocsp"
 	"google.golang.org/grpc"
@@ -49,10 +52,18 @@ type revoker interface {
 	AdministrativelyRevokeCertificate(ctx context.Context, in *rapb.AdministrativelyRevokeCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
+// blockedKeys is the part of the SA's gRPC interface that bad-key-revoker
+// relies on to find blocked keys and the certificates which use them.
+type blockedKeys interface {
+	StreamUncheckedBlockedKeys(ctx context.Context, req *emptypb.Empty, opts ...grpc.CallOption) (sapb.StorageAuthority_StreamUncheckedBlockedKeysClient, error)
+	StreamUnrevokedCertificatesByKey(ctx context.Context, req *sapb.SPKIHash, opts ...grpc.CallOption) (sapb.StorageAuthority_StreamUnrevokedCertificatesByKeyClient, error)
+	MarkBlockedKeyChecked(ctx context.Context, req *sapb.SPKIHash, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetRegistration(ctx context.Context, req *sapb.RegistrationID, opts ...grpc.CallOption) (*corepb.Registration, error)
+}
+
 type badKeyRevoker struct {
-	dbMap               *db.WrappedMap
+	saClient            blockedKeys
 	maxRevocations      int
-	serialBatchSize     int
 	raClient            revoker
 	mailer              mail.Mailer
 	emailSubject        string
@@ -76,78 +87,59 @@ func (ubk uncheckedBlockedKey) String() string {
 		ubk.RevokedBy, ubk.KeyHash)
 }
 
+// selectUncheckedKey returns the first blocked key which hasn't been checked
+// for extant unrevoked certificates, or a NotFound error if there are none.
 func (bkr *badKeyRevoker) selectUncheckedKey() (uncheckedBlockedKey, error) {
-	var row uncheckedBlockedKey
-	err := bkr.dbMap.SelectOne(
-		&row,
-		`SELECT keyHash, revokedBy
-		FROM blockedKeys
-		WHERE extantCertificatesChecked = false
-		LIMIT 1`,
-	)
-	return row, err
+	// Only the first key is needed, so the stream is cancelled once it's read.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := bkr.saClient.StreamUncheckedBlockedKeys(ctx, &emptypb.Empty{})
+	if err != nil {
+		return uncheckedBlockedKey{}, err
+	}
+	key, err := stream.Recv()
+	if err == io.EOF {
+		return uncheckedBlockedKey{}, berrors.NotFoundError("no unchecked blocked keys")
+	}
+	if err != nil {
+		return uncheckedBlockedKey{}, err
+	}
+	return uncheckedBlockedKey{KeyHash: key.KeyHash, RevokedBy: key.RevokedBy}, nil
 }
 
 // unrevokedCertificate represents a yet to be revoked certificate
 type unrevokedCertificate struct {
-	ID             int
 	Serial         string
 	DER            []byte
 	RegistrationID int64
-	Status         core.OCSPStatus
-	IsExpired      bool
 }
 
 func (uc unrevokedCertificate) String() string {
-	return fmt.Sprintf("id=%d serial=%s regID=%d status=%s expired=%t",
-		uc.ID, uc.Serial, uc.RegistrationID, uc.Status, uc.IsExpired)
+	return fmt.Sprintf("serial=%s regID=%d", uc.Serial, uc.RegistrationID)
 }
 
-// findUnrevoked looks for all unexpired, currently valid certificates which have a specific SPKI hash,
-// by looking first at the keyHashToSerial table and then the certificateStatus and certificates tables.
-// If the number of certificates it finds is larger than bkr.maxRevocations it'll error out.
+// findUnrevoked looks for all unexpired, currently valid certificates which
+// have a specific SPKI hash. If the number of certificates it finds is larger
+// than bkr.maxRevocations it'll error out.
 func (bkr *badKeyRevoker) findUnrevoked(unchecked uncheckedBlockedKey) ([]unrevokedCertificate, error) {
+	stream, err := bkr.saClient.StreamUnrevokedCertificatesByKey(context.Background(), &sapb.SPKIHash{KeyHash: unchecked.KeyHash})
+	if err != nil {
+		return nil, err
+	}
 	var unrevokedCerts []unrevokedCertificate
-	initialID := 0
 	for {
-		var batch []struct {
-			ID         int
-			CertSerial string
+		cert, err := stream.Recv()
+		if err == io.EOF {
+			break
 		}
-		_, err := bkr.dbMap.Select(
-			&batch,
-			"SELECT id, certSerial FROM keyHashToSerial WHERE keyHash = ? AND id > ? AND certNotAfter > ? ORDER BY id LIMIT ?",
-			unchecked.KeyHash,
-			initialID,
-			bkr.clk.Now(),
-			bkr.serialBatchSize,
-		)
 		if err != nil {
 			return nil, err
 		}
-		if len(batch) == 0 {
-			break
-		}
-		initialID = batch[len(batch)-1].ID
-		for _, serial := range batch {
-			var unrevokedCert unrevokedCertificate
-			err = bkr.dbMap.SelectOne(
-				&unrevokedCert,
-				`SELECT cs.id, cs.serial, c.registrationID, c.der, cs.status, cs.isExpired
-				FROM certificateStatus AS cs
-				JOIN precertificates AS c
-				ON cs.serial = c.serial
-				WHERE cs.serial = ?`,
-				serial.CertSerial,
-			)
-			if err != nil {
-				return nil, err
-			}
-			if unrevokedCert.IsExpired || unrevokedCert.Status == core.OCSPStatusRevoked {
-				continue
-			}
-			unrevokedCerts = append(unrevokedCerts, unrevokedCert)
-		}
+		unrevokedCerts = append(unrevokedCerts, unrevokedCertificate{
+			Serial:         cert.Serial,
+			DER:            cert.Der,
+			RegistrationID: cert.RegistrationID,
+		})
 	}
 	if len(unrevokedCerts) > bkr.maxRevocations {
 		return nil, fmt.Errorf("too many certificates to revoke associated with %x: got %d, max %d", unchecked.KeyHash, len(unrevokedCerts), bkr.maxRevocations)
@@ -155,10 +147,10 @@ func (bkr *badKeyRevoker) findUnrevoked(unchecked uncheckedBlockedKey) ([]unrevo
 	return unrevokedCerts, nil
 }
 
-// markRowChecked updates a row in the blockedKeys table to mark a keyHash
-// as having been checked for extant unrevoked certificates.
+// markRowChecked marks a blocked key as having been checked for extant
+// unrevoked certificates.
 func (bkr *badKeyRevoker) markRowChecked(unchecked uncheckedBlockedKey) error {
-	_, err := bkr.dbMap.Exec("UPDATE blockedKeys SET extantCertificatesChecked = true WHERE keyHash = ?", unchecked.KeyHash)
+	_, err := bkr.saClient.MarkBlockedKeyChecked(context.Background(), &sapb.SPKIHash{KeyHash: unchecked.KeyHash})
 	return err
 }
 
@@ -166,17 +158,14 @@ func (bkr *badKeyRevoker) markRowChecked(unchecked uncheckedBlockedKey) error {
 func (bkr *badKeyRevoker) resolveContacts(ids []int64) (map[int64][]string, error) {
 	idToEmail := map[int64][]string{}
 	for _, id := range ids {
-		var emails struct {
-			Contact []string
-		}
-		err := bkr.dbMap.SelectOne(&emails, "SELECT contact FROM registrations WHERE id = ?", id)
+		reg, err := bkr.saClient.GetRegistration(context.Background(), &sapb.RegistrationID{Id: id})
 		if err != nil {
-			// ErrNoRows is not acceptable here since there should always be a
-			// row for the registration, even if there are no contacts
+			// NotFound is not acceptable here since there should always be a
+			// registration, even if it has no contacts
 			return nil, err
 		}
-		if len(emails.Contact) != 0 {
-			for _, email := range emails.Contact {
+		if len(reg.Contact) != 0 {
+			for _, email := range reg.Contact {
 				idToEmail[id] = append(idToEmail[id], strings.TrimPrefix(email, "mailto:"))
 			}
 		} else {
@@ -229,12 +218,12 @@ func (bkr *badKeyRevoker) revokeCerts(revokerEmails []string, emailToCerts map[s
 		revokerEmailsMap[email] = true
 	}
 
-	alreadyRevoked := map[int]bool{}
+	alreadyRevoked := map[string]bool{}
 	for email, certs := range emailToCerts {
 		var revokedSerials []string
 		for _, cert := range certs {
 			revokedSerials = append(revokedSerials, cert.Serial)
-			if alreadyRevoked[cert.ID] {
+			if alreadyRevoked[cert.Serial] {
 				continue
 			}
 			_, err := bkr.raClient.AdministrativelyRevokeCertificate(context.Background(), &rapb.AdministrativelyRevokeCertificateRequest{
@@ -246,7 +235,7 @@ func (bkr *badKeyRevoker) revokeCerts(revokerEmails []string, emailToCerts map[s
 				return err
 			}
 			certsRevoked.Inc()
-			alreadyRevoked[cert.ID] = true
+			alreadyRevoked[cert.Serial] = true
 		}
 		// don't send emails to the person who revoked the certificate
 		if revokerEmailsMap[email] || email == "" {
@@ -268,7 +257,7 @@ func (bkr *badKeyRevoker) invoke() (bool, error) {
 	// select a row to process
 	unchecked, err := bkr.selectUncheckedKey()
 	if err != nil {
-		if db.IsNoRows(err) {
+		if errors.Is(err, berrors.NotFound) {
 			return true, nil
 		}
 		return false, err
@@ -345,19 +334,22 @@ func (bkr *badKeyRevoker) invoke() (bool, error) {
 
 type Config struct {
 	BadKeyRevoker struct {
+		// DB is DEPRECATED. Blocked keys and certificates are read from and
+		// updated through the SA, configured by SAService, and this is ignored.
 		DB        cmd.DBConfig
 		DebugAddr string
 
 		TLS       cmd.TLSConfig
 		RAService *cmd.GRPCClientConfig
+		SAService *cmd.GRPCClientConfig
 
 		// MaximumRevocations specifies the maximum number of certificates associated with
 		// a key hash that bad-key-revoker will attempt to revoke. If the number of certificates
 		// is higher than MaximumRevocations bad-key-revoker will error out and refuse to
 		// progress until this is addressed.
 		MaximumRevocations int
-		// FindCertificatesBatchSize specifies the maximum number of serials to select from the
-		// keyHashToSerial table at once
+		// FindCertificatesBatchSize is DEPRECATED. The SA pages through the
+		// certificates associated with a key hash, and this is ignored.
 		FindCertificatesBatchSize int
 
 		// Interval specifies the minimum duration bad-key-revoker
@@ -410,24 +402,6 @@ func main() {
 	scope.MustRegister(certsRevoked)
 	scope.MustRegister(mailErrors)
 
-	dbURL, err := config.BadKeyRevoker.DB.URL()
-	cmd.FailOnError(err, "Couldn't load DB URL")
-
-	dbSettings := sa.DbSettings{
-		MaxOpenConns:    config.BadKeyRevoker.DB.MaxOpenConns,
-		MaxIdleConns:    config.BadKeyRevoker.DB.MaxIdleConns,
-		ConnMaxLifetime: config.BadKeyRevoker.DB.ConnMaxLifetime.Duration,
-		ConnMaxIdleTime: config.BadKeyRevoker.DB.ConnMaxIdleTime.Duration,
-	}
-	dbMap, err := sa.NewDbMap(dbURL, dbSettings)
-	cmd.FailOnError(err, "Could not connect to database")
-	sa.SetSQLDebug(dbMap, logger)
-
-	dbAddr, dbUser, err := config.BadKeyRevoker.DB.DSNAddressAndUser()
-	cmd.FailOnError(err, "Could not determine address or user of DB DSN")
-
-	sa.InitDBMetrics(dbMap.Db, scope, dbSettings, dbAddr, dbUser)
-
 	tlsConfig, err := config.BadKeyRevoker.TLS.Load()
 	cmd.FailOnError(err, "TLS config")
 
@@ -436,6 +410,10 @@ func main() {
 	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to RA")
 	rac := rapb.NewRegistrationAuthorityClient(conn)
 
+	saConn, err := bgrpc.ClientSetup(config.BadKeyRevoker.SAService, tlsConfig, clientMetrics, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+	sac := sapb.NewStorageAuthorityClient(saConn)
+
 	var smtpRoots *x509.CertPool
 	if config.BadKeyRevoker.Mailer.SMTPTrustedRootFile != "" {
 		pem, err := ioutil.ReadFile(config.BadKeyRevoker.Mailer.SMTPTrustedRootFile)
@@ -473,9 +451,8 @@ func main() {
 	cmd.FailOnError(err, fmt.Sprintf("failed to parse email template %q: %s", config.BadKeyRevoker.Mailer.EmailTemplate, err))
 
 	bkr := &badKeyRevoker{
-		dbMap:               dbMap,
+		saClient:            sac,
 		maxRevocations:      config.BadKeyRevoker.MaximumRevocations,
-		serialBatchSize:     config.BadKeyRevoker.FindCertificatesBatchSize,
 		raClient:            rac,
 		mailer:              mailClient,
 		emailSubject:        config.BadKeyRevoker.Mailer.EmailSubject,
@@ -3,8 +3,10 @@ package notmain
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"html/template"
+	"net"
 	"strings"
 	"sync"
 	"testing"
@@ -13,11 +15,14 @@ import (
 	"github.com/jmhodges/clock"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/db"
+	berrors "github.com/letsencrypt/boulder/errors"
 	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/mocks"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
 	"github.com/letsencrypt/boulder/sa"
 	"github.com/letsencrypt/boulder/test"
+	isa "github.com/letsencrypt/boulder/test/inmem/sa"
 	"github.com/letsencrypt/boulder/test/vars"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -31,6 +36,15 @@ func randHash(t *testing.T) []byte {
 	return h
 }
 
+// newSA returns an SA client backed by the given database, for the
+// badKeyRevoker to read from and update.
+func newSA(t *testing.T, dbMap *db.WrappedMap, fc clock.Clock) isa.SA {
+	t.Helper()
+	ssa, err := sa.NewSQLStorageAuthority(dbMap, dbMap, fc, blog.NewMock(), metrics.NoopRegisterer, 1)
+	test.AssertNotError(t, err, "failed to create SA")
+	return isa.SA{Impl: ssa}
+}
+
 func insertBlockedRow(t *testing.T, dbMap *db.WrappedMap, fc clock.Clock, hash []byte, by int64, checked bool) {
 	t.Helper()
 	_, err := dbMap.Exec(`INSERT INTO blockedKeys
@@ -54,16 +68,16 @@ func TestSelectUncheckedRows(t *testing.T) {
 	fc := clock.NewFake()
 
 	bkr := &badKeyRevoker{
-		dbMap:  dbMap,
-		logger: blog.NewMock(),
-		clk:    fc,
+		saClient: newSA(t, dbMap, fc),
+		logger:   blog.NewMock(),
+		clk:      fc,
 	}
 
 	hashA, hashB, hashC := randHash(t), randHash(t), randHash(t)
 	insertBlockedRow(t, dbMap, fc, hashA, 1, true)
 	_, err = bkr.selectUncheckedKey()
 	test.AssertError(t, err, "selectUncheckedKey didn't fail with no rows to process")
-	test.Assert(t, db.IsNoRows(err), "returned error is not sql.ErrNoRows")
+	test.Assert(t, errors.Is(err, berrors.NotFound), "returned error is not NotFound")
 	insertBlockedRow(t, dbMap, fc, hashB, 1, false)
 	insertBlockedRow(t, dbMap, fc, hashC, 1, false)
 	row, err := bkr.selectUncheckedKey()
@@ -87,11 +101,11 @@ func insertRegistration(t *testing.T, dbMap *db.WrappedMap, fc clock.Clock, addr
 	}
 	res, err := dbMap.Exec(
 		"INSERT INTO registrations (jwk, jwk_sha256, contact, agreement, initialIP, createdAt, status, LockCol) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
-		[]byte{},
+		[]byte("{}"),
 		fmt.Sprintf("%x", jwkHash),
 		contactStr,
 		"yes",
-		[]byte{},
+		[]byte(net.ParseIP("127.0.0.1")),
 		fc.Now(),
 		string(core.StatusValid),
 		0,
@@ -184,7 +198,7 @@ func TestFindUnrevokedNoRows(t *testing.T) {
 	)
 	test.AssertNotError(t, err, "failed to insert test keyHashToSerial row")
 
-	bkr := &badKeyRevoker{dbMap: dbMap, serialBatchSize: 1, maxRevocations: 10, clk: fc}
+	bkr := &badKeyRevoker{saClient: newSA(t, dbMap, fc), maxRevocations: 10, clk: fc}
 	_, err = bkr.findUnrevoked(uncheckedBlockedKey{KeyHash: hashA})
 	test.Assert(t, db.IsNoRows(err), "expected NoRows error")
 }
@@ -198,7 +212,7 @@ func TestFindUnrevoked(t *testing.T) {
 
 	regID := insertRegistration(t, dbMap, fc)
 
-	bkr := &badKeyRevoker{dbMap: dbMap, serialBatchSize: 1, maxRevocations: 10, clk: fc}
+	bkr := &badKeyRevoker{saClient: newSA(t, dbMap, fc), maxRevocations: 10, clk: fc}
 
 	hashA := randHash(t)
 	// insert valid, unexpired
@@ -228,7 +242,7 @@ func TestResolveContacts(t *testing.T) {
 
 	fc := clock.NewFake()
 
-	bkr := &badKeyRevoker{dbMap: dbMap, clk: fc}
+	bkr := &badKeyRevoker{saClient: newSA(t, dbMap, fc), clk: fc}
 
 	regIDA := insertRegistration(t, dbMap, fc)
 	regIDB := insertRegistration(t, dbMap, fc, "example.com", "example-2.com")
@@ -275,25 +289,22 @@ func (mr *mockRevoker) AdministrativelyRevokeCertificate(ctx context.Context, in
 }
 
 func TestRevokeCerts(t *testing.T) {
-	dbMap, err := sa.NewDbMap(vars.DBConnSAFullPerms, sa.DbSettings{})
-	test.AssertNotError(t, err, "failed setting up db client")
-	defer test.ResetSATestDatabase(t)()
-
 	fc := clock.NewFake()
 	mm := &mocks.Mailer{}
 	mr := &mockRevoker{}
-	bkr := &badKeyRevoker{dbMap: dbMap, raClient: mr, mailer: mm, emailSubject: "testing", emailTemplate: testTemplate, clk: fc}
+	bkr := &badKeyRevoker{raClient: mr, mailer: mm, emailSubject: "testing", emailTemplate: testTemplate, clk: fc}
 
-	err = bkr.revokeCerts([]string{"revoker@example.com", "revoker-b@example.com"}, map[string][]unrevokedCertificate{
-		"revoker@example.com":   {{ID: 0, Serial: "ff"}},
-		"revoker-b@example.com": {{ID: 0, Serial: "ff"}},
-		"other@example.com":     {{ID: 1, Serial: "ee"}},
+	err := bkr.revokeCerts([]string{"revoker@example.com", "revoker-b@example.com"}, map[string][]unrevokedCertificate{
+		"revoker@example.com":   {{Serial: "ff"}},
+		"revoker-b@example.com": {{Serial: "ff"}},
+		"other@example.com":     {{Serial: "ee"}},
 	})
 	test.AssertNotError(t, err, "revokeCerts failed")
 	test.AssertEquals(t, len(mm.Messages), 1)
 	test.AssertEquals(t, mm.Messages[0].To, "other@example.com")
 	test.AssertEquals(t, mm.Messages[0].Subject, bkr.emailSubject)
 	test.AssertEquals(t, mm.Messages[0].Body, "ee\n")
+	test.AssertEquals(t, mr.revoked, 2)
 }
 
 func TestCertificateAbsent(t *testing.T) {
@@ -319,15 +330,14 @@ func TestCertificateAbsent(t *testing.T) {
 	test.AssertNotError(t, err, "failed to insert test keyHashToSerial row")
 
 	bkr := &badKeyRevoker{
-		dbMap:           dbMap,
-		maxRevocations:  1,
-		serialBatchSize: 1,
-		raClient:        &mockRevoker{},
-		mailer:          &mocks.Mailer{},
-		emailSubject:    "testing",
-		emailTemplate:   testTemplate,
-		logger:          blog.NewMock(),
-		clk:             fc,
+		saClient:       newSA(t, dbMap, fc),
+		maxRevocations: 1,
+		raClient:       &mockRevoker{},
+		mailer:         &mocks.Mailer{},
+		emailSubject:   "testing",
+		emailTemplate:  testTemplate,
+		logger:         blog.NewMock(),
+		clk:            fc,
 	}
 	_, err = bkr.invoke()
 	test.AssertError(t, err, "expected error when row in keyHashToSerial didn't have a matching cert")
@@ -343,15 +353,14 @@ func TestInvoke(t *testing.T) {
 	mm := &mocks.Mailer{}
 	mr := &mockRevoker{}
 	bkr := &badKeyRevoker{
-		dbMap:           dbMap,
-		maxRevocations:  10,
-		serialBatchSize: 1,
-		raClient:        mr,
-		mailer:          mm,
-		emailSubject:    "testing",
-		emailTemplate:   testTemplate,
-		logger:          blog.NewMock(),
-		clk:             fc,
+		saClient:       newSA(t, dbMap, fc),
+		maxRevocations: 10,
+		raClient:       mr,
+		mailer:         mm,
+		emailSubject:   "testing",
+		emailTemplate:  testTemplate,
+		logger:         blog.NewMock(),
+		clk:            fc,
 	}
 
 	// populate DB with all the test data
@@ -413,15 +422,15 @@ func TestInvokeRevokerHasNoExtantCerts(t *testing.T) {
 
 	mm := &mocks.Mailer{}
 	mr := &mockRevoker{}
-	bkr := &badKeyRevoker{dbMap: dbMap,
-		maxRevocations:  10,
-		serialBatchSize: 1,
-		raClient:        mr,
-		mailer:          mm,
-		emailSubject:    "testing",
-		emailTemplate:   testTemplate,
-		logger:          blog.NewMock(),
-		clk:             fc,
+	bkr := &badKeyRevoker{
+		saClient:       newSA(t, dbMap, fc),
+		maxRevocations: 10,
+		raClient:       mr,
+		mailer:         mm,
+		emailSubject:   "testing",
+		emailTemplate:  testTemplate,
+		logger:         blog.NewMock(),
+		clk:            fc,
 	}
 
 	// populate DB with all the test data
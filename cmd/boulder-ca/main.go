@@ -197,6 +197,11 @@ func main() {
 	err = pa.SetHostnamePolicyFile(c.CA.HostnamePolicyFile)
 	cmd.FailOnError(err, "Couldn't load hostname policy file")
 
+	// Unless the profile lints are configured with their own limit, hold
+	// certificates to the same maximum number of names as the CA itself.
+	if c.CA.Issuance.Profile.Lints.MaxNames == 0 {
+		c.CA.Issuance.Profile.Lints.MaxNames = c.CA.MaxNames
+	}
 	var boulderIssuers []*issuance.Issuer
 	boulderIssuers, err = loadBoulderIssuers(c.CA.Issuance.Profile, c.CA.Issuance.Issuers, c.CA.Issuance.IgnoredLints)
 	cmd.FailOnError(err, "Couldn't load issuers")
@@ -1,6 +1,7 @@
 package notmain
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
@@ -9,10 +10,12 @@ import (
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	"github.com/beeker1121/goque"
 	"github.com/honeycombio/beeline-go"
 	akamaipb "github.com/letsencrypt/boulder/akamai/proto"
 	capb "github.com/letsencrypt/boulder/ca/proto"
 	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/ctpolicy"
 	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
 	"github.com/letsencrypt/boulder/features"
@@ -23,6 +26,8 @@ import (
 	pubpb "github.com/letsencrypt/boulder/publisher/proto"
 	"github.com/letsencrypt/boulder/ra"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
+	"github.com/letsencrypt/boulder/ratelimits"
+	rocsp_config "github.com/letsencrypt/boulder/rocsp/config"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	vapb "github.com/letsencrypt/boulder/va/proto"
 )
@@ -34,6 +39,33 @@ type Config struct {
 
 		RateLimitPoliciesFilename string
 
+		// ShadowLimiterRedis, if set, configures a token bucket rate limiter
+		// backed by Redis which runs in shadow mode beside the limits in
+		// RateLimitPoliciesFilename. Its decisions are never enforced, but
+		// disagreements with the existing limits are logged.
+		ShadowLimiterRedis *rocsp_config.RedisConfig
+
+		// RateLimitOverridesRefresh, if non-zero, is how often the RA loads
+		// rate limit overrides from the SA and applies them on top of the
+		// limits in RateLimitPoliciesFilename.
+		RateLimitOverridesRefresh cmd.ConfigDuration
+
+		// AutoSuspend configures the automatic suspension of accounts which
+		// fail validation for the same name FailureThreshold times within
+		// Window. Suspensions last for Duration. A zero FailureThreshold
+		// disables automatic suspension.
+		AutoSuspend struct {
+			FailureThreshold int64
+			Window           cmd.ConfigDuration
+			Duration         cmd.ConfigDuration
+		}
+
+		// MaxConsecutiveFailures is the number of validations for an
+		// identifier an account may fail in a row before it is paused from
+		// ordering certificates for that identifier, until the subscriber
+		// unpauses it. Zero disables pausing.
+		MaxConsecutiveFailures int64
+
 		MaxContactsPerRegistration int
 
 		SAService           *cmd.GRPCClientConfig
@@ -51,6 +83,18 @@ type Config struct {
 		// creating another.
 		ReuseValidAuthz bool
 
+		// AuthzReuseProfiles configures, by profile name, how new orders
+		// reuse valid authorizations when ReuseValidAuthz is true. Orders
+		// can't select a profile yet, so only the "default" profile is used,
+		// and it must be present if any are configured.
+		AuthzReuseProfiles map[string]struct {
+			// MaxAge limits, by challenge type (e.g. "http-01"), how long
+			// after validation a valid authorization may be reused.
+			// Authorizations validated by challenge types which aren't listed
+			// may be reused until they expire.
+			MaxAge map[string]cmd.ConfigDuration
+		}
+
 		// AuthorizationLifetimeDays defines how long authorizations will be
 		// considered valid for. Given a value of 300 days when used with a 90-day
 		// cert lifetime, this allows creation of certs that will cover a whole
@@ -86,6 +130,10 @@ type Config struct {
 		// test them or because they are not yet approved by a browser/root
 		// program but we still want our certs to end up there.
 		InformationalCTLogs []ctconfig.LogDescription
+		// FinalCertQueue configures a durable queue for submitting final
+		// certificates to CT logs. If its Dir is empty, each final
+		// certificate submission is attempted once and never retried.
+		FinalCertQueue ctconfig.FinalCertQueueConfig
 
 		// IssuerCertPath is the path to the intermediate used to issue certificates.
 		// It is used to generate OCSP URLs to purge at revocation time.
@@ -209,7 +257,38 @@ func main() {
 			}
 		}
 	}
-	ctp = ctpolicy.New(pubc, c.RA.CTLogGroups2, c.RA.InformationalCTLogs, logger, scope)
+	var finalQueue *ctpolicy.FinalCertQueue
+	closeFinalQueue := func() {}
+	if c.RA.FinalCertQueue.Dir != "" {
+		qc := c.RA.FinalCertQueue
+		if qc.DeadLetterDir == "" {
+			cmd.Fail("FinalCertQueue.DeadLetterDir must be set when FinalCertQueue.Dir is")
+		}
+		if qc.MaxAttempts < 1 {
+			cmd.Fail("FinalCertQueue.MaxAttempts must be at least 1")
+		}
+		pending, err := goque.OpenQueue(qc.Dir)
+		cmd.FailOnError(err, "Failed to open final certificate queue")
+		deadLetter, err := goque.OpenQueue(qc.DeadLetterDir)
+		cmd.FailOnError(err, "Failed to open final certificate dead letter queue")
+		finalQueue = ctpolicy.NewFinalCertQueue(pending, deadLetter, qc.MaxAttempts,
+			qc.Backoff.Duration, qc.MaxBackoff.Duration, qc.BatchSize, clk, scope)
+		closeFinalQueue = func() {
+			_ = pending.Close()
+			_ = deadLetter.Close()
+		}
+	}
+	ctp = ctpolicy.New(pubc, c.RA.CTLogGroups2, c.RA.InformationalCTLogs, issuerCerts, finalQueue, logger, scope)
+	finalQueueCtx, stopFinalQueue := context.WithCancel(context.Background())
+	finalQueueStopped := make(chan struct{})
+	if finalQueue != nil {
+		go func() {
+			ctp.FinalCertLoop(finalQueueCtx)
+			close(finalQueueStopped)
+		}()
+	} else {
+		close(finalQueueStopped)
+	}
 
 	// TODO(patf): remove once RA.authorizationLifetimeDays is deployed
 	authorizationLifetime := 300 * 24 * time.Hour
@@ -259,10 +338,56 @@ func main() {
 	cmd.FailOnError(policyErr, "Couldn't load rate limit policies file")
 	rai.PA = pa
 
+	if c.RA.ShadowLimiterRedis != nil {
+		rdb, err := rocsp_config.MakeClusterClient(c.RA.ShadowLimiterRedis)
+		cmd.FailOnError(err, "Failed to create shadow rate limiter Redis client")
+		source := ratelimits.NewRedisSource(rdb, c.RA.ShadowLimiterRedis.Timeout.Duration, clk, scope)
+		rai.SetShadowLimiter(ratelimits.NewLimiter(clk, source, scope))
+	}
+
+	if c.RA.AutoSuspend.FailureThreshold > 0 {
+		if c.RA.AutoSuspend.Window.Duration == 0 || c.RA.AutoSuspend.Duration.Duration == 0 {
+			cmd.Fail("autoSuspend window and duration must be set when failureThreshold is")
+		}
+		rai.SetAutoSuspend(ra.AutoSuspendConfig{
+			FailureThreshold: c.RA.AutoSuspend.FailureThreshold,
+			Window:           c.RA.AutoSuspend.Window.Duration,
+			Duration:         c.RA.AutoSuspend.Duration.Duration,
+		})
+	}
+
+	rai.SetMaxConsecutiveFailures(c.RA.MaxConsecutiveFailures)
+
+	if len(c.RA.AuthzReuseProfiles) > 0 {
+		if _, ok := c.RA.AuthzReuseProfiles[ra.DefaultProfile]; !ok {
+			cmd.Fail(fmt.Sprintf("authzReuseProfiles: missing the %q profile", ra.DefaultProfile))
+		}
+		profiles := make(map[string]ra.AuthzReuseProfile, len(c.RA.AuthzReuseProfiles))
+		for name, profile := range c.RA.AuthzReuseProfiles {
+			maxAge := make(map[core.AcmeChallenge]time.Duration, len(profile.MaxAge))
+			for challType, age := range profile.MaxAge {
+				if !core.AcmeChallenge(challType).IsValid() {
+					cmd.Fail(fmt.Sprintf("authzReuseProfiles: profile %q: unknown challenge type %q", name, challType))
+				}
+				maxAge[core.AcmeChallenge(challType)] = age.Duration
+			}
+			profiles[name] = ra.AuthzReuseProfile{MaxAge: maxAge}
+		}
+		rai.SetAuthzReuseProfiles(profiles)
+	}
+
 	rai.VA = vac
 	rai.CA = cac
 	rai.SA = sac
 
+	if c.RA.RateLimitOverridesRefresh.Duration > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), c.RA.RateLimitOverridesRefresh.Duration)
+		err = rai.RefreshRateLimitOverrides(ctx)
+		cancel()
+		cmd.FailOnError(err, "Couldn't load rate limit overrides")
+		go rai.RateLimitOverridesLoop(c.RA.RateLimitOverridesRefresh.Duration)
+	}
+
 	serverMetrics := bgrpc.NewServerMetrics(scope)
 	grpcSrv, listener, err := bgrpc.NewServer(c.RA.GRPC, tlsConfig, serverMetrics, clk)
 	cmd.FailOnError(err, "Unable to setup RA gRPC server")
@@ -273,10 +398,21 @@ func main() {
 	go cmd.CatchSignals(logger, func() {
 		hs.Shutdown()
 		grpcSrv.GracefulStop()
+		// Final certificates are queued synchronously by the RPCs which issue
+		// them, so once GracefulStop has returned no more can be queued. Let
+		// the queue loop finish its current batch and close the queues.
+		stopFinalQueue()
+		<-finalQueueStopped
+		closeFinalQueue()
 	})
 
 	err = cmd.FilterShutdownErrors(grpcSrv.Serve(listener))
 	cmd.FailOnError(err, "RA gRPC service failed")
+	// When we get a SIGTERM, Serve returns once extant RPCs have been
+	// processed, but we want the process to stick around while the final
+	// certificate queue is drained and closed. Once that's done, CatchSignals
+	// will call os.Exit().
+	select {}
 }
 
 func init() {
@@ -2,6 +2,7 @@ package notmain
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -27,13 +28,88 @@ type Config struct {
 
 		// Max simultaneous SQL queries caused by a single RPC.
 		ParallelismPerRPC int
+
+		// ExpectedSchema selects the embedded migrations which must match
+		// those applied to the database for the SA to start, and which
+		// `boulder-sa migrate` applies: "current" (sa/_db, the default),
+		// "next" (sa/_db-next) or "none" to skip the startup check. For a
+		// SQLite database, "current" selects sa/_db-sqlite and there is no
+		// "next".
+		ExpectedSchema string
+
+		// ReadRoutes overrides which database individual read methods use,
+		// keyed by SA method name, e.g. "GetRegistration". Methods which
+		// aren't listed keep their defaults, which send rate limit counts and
+		// serial lookups to the read-only database and everything else to the
+		// primary.
+		ReadRoutes map[string]ReadRouteConfig
+
+		// ReplicaLagInterval, if set, is how often the SA measures the
+		// read-only database's replication lag, using the
+		// replicationHeartbeats table. It's required by read routes with a
+		// maxLag.
+		ReplicaLagInterval cmd.ConfigDuration
 	}
 
 	Syslog  cmd.SyslogConfig
 	Beeline cmd.BeelineConfig
 }
 
+// ReadRouteConfig routes an SA method's reads.
+type ReadRouteConfig struct {
+	// DB is "primary" or "replica", the read-only database.
+	DB string
+
+	// MaxLag, if set on a replica route, sends the method's reads to the
+	// primary whenever the replica's lag isn't known to be below it.
+	MaxLag cmd.ConfigDuration
+}
+
+// readRoutes converts ReadRoutes into the form the SA takes.
+func (c Config) readRoutes() (map[string]sa.ReadRoute, error) {
+	routes := make(map[string]sa.ReadRoute, len(c.SA.ReadRoutes))
+	for method, rc := range c.SA.ReadRoutes {
+		var route sa.ReadRoute
+		switch rc.DB {
+		case "primary":
+		case "replica":
+			route.Replica = true
+		default:
+			return nil, fmt.Errorf("read route for %q: unknown db %q", method, rc.DB)
+		}
+		route.MaxLag = rc.MaxLag.Duration
+		routes[method] = route
+	}
+	return routes, nil
+}
+
+// migrations returns the embedded migrations selected by ExpectedSchema for
+// the database's dialect, or nil if it is "none".
+func (c Config) migrations(dialect db.Dialect) ([]sa.Migration, error) {
+	switch c.SA.ExpectedSchema {
+	case "", "current":
+		if dialect == db.SQLite {
+			return sa.SQLiteMigrations()
+		}
+		return sa.Migrations(false)
+	case "next":
+		if dialect == db.SQLite {
+			return nil, fmt.Errorf("expectedSchema \"next\" isn't available for SQLite")
+		}
+		return sa.Migrations(true)
+	case "none":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown expectedSchema %q", c.SA.ExpectedSchema)
+	}
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		migrateMain(os.Args[2:])
+		return
+	}
+
 	grpcAddr := flag.String("addr", "", "gRPC listen address override")
 	debugAddr := flag.String("debug-addr", "", "Debug server address override")
 	configFile := flag.String("config", "", "File path to the configuration file for this service")
@@ -91,6 +167,13 @@ func main() {
 
 	dbMap := configureDb(scope, c.SA.DB)
 
+	migrations, err := c.migrations(dbMap.SQLDialect())
+	cmd.FailOnError(err, "Failed to load schema migrations")
+	if migrations != nil {
+		err = sa.CheckSchema(dbMap, migrations)
+		cmd.FailOnError(err, "Schema check failed; run `boulder-sa migrate status` for details")
+	}
+
 	dbReadOnlyURL, err := c.SA.ReadOnlyDB.URL()
 	cmd.FailOnError(err, "Couldn't load read-only DB URL")
 
@@ -110,6 +193,17 @@ func main() {
 	sai, err := sa.NewSQLStorageAuthority(dbMap, dbReadOnlyMap, clk, logger, scope, parallel)
 	cmd.FailOnError(err, "Failed to create SA impl")
 
+	var lagMonitor *sa.ReplicaLagMonitor
+	if c.SA.ReplicaLagInterval.Duration > 0 {
+		lagMonitor = sa.NewReplicaLagMonitor(dbMap, dbReadOnlyMap, c.SA.ReplicaLagInterval.Duration, clk, logger, scope)
+		go lagMonitor.Run()
+	}
+
+	readRoutes, err := c.readRoutes()
+	cmd.FailOnError(err, "Invalid readRoutes")
+	err = sai.SetReadRoutes(readRoutes, lagMonitor)
+	cmd.FailOnError(err, "Invalid readRoutes")
+
 	tls, err := c.SA.TLS.Load()
 	cmd.FailOnError(err, "TLS config")
 	serverMetrics := bgrpc.NewServerMetrics(scope)
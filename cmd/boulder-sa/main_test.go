@@ -1 +1,28 @@
 package notmain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/sa"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestReadRoutes(t *testing.T) {
+	var c Config
+	c.SA.ReadRoutes = map[string]ReadRouteConfig{
+		"GetRegistration": {DB: "replica", MaxLag: cmd.ConfigDuration{Duration: time.Second}},
+		"CountOrders":     {DB: "primary"},
+	}
+	routes, err := c.readRoutes()
+	test.AssertNotError(t, err, "converting valid read routes")
+	test.AssertDeepEquals(t, routes, map[string]sa.ReadRoute{
+		"GetRegistration": {Replica: true, MaxLag: time.Second},
+		"CountOrders":     {},
+	})
+
+	c.SA.ReadRoutes = map[string]ReadRouteConfig{"CountOrders": {DB: "secondary"}}
+	_, err = c.readRoutes()
+	test.AssertError(t, err, "converting a route to an unknown db")
+}
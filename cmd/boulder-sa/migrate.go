@@ -0,0 +1,97 @@
+package notmain
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/sa"
+)
+
+const migrateUsage = `usage: boulder-sa migrate -config <path> [-db-connect-file <path>] <up|down|status>
+
+  up      apply every migration which hasn't been applied
+  down    roll back the most recently applied migration
+  status  list the embedded migrations and whether each is applied, and any
+          applied migrations which this binary doesn't know about
+
+The migrations are those selected by the SA's expectedSchema config for the
+database's engine, MySQL or SQLite. Applied versions are recorded in
+goose_db_version, as goose does. To create a SQLite database, set dbConnect to
+"sqlite:<path>" and run "up".
+`
+
+// migrateMain implements `boulder-sa migrate`, which applies the migrations
+// embedded in the binary to the SA's database.
+func migrateMain(args []string) {
+	flagSet := flag.NewFlagSet("migrate", flag.ExitOnError)
+	flagSet.Usage = func() { fmt.Fprint(os.Stderr, migrateUsage) }
+	configFile := flagSet.String("config", "", "File path to the configuration file for this service")
+	dbConnectFile := flagSet.String("db-connect-file", "", "File containing a DB URL to use instead of the configured one, e.g. for a user allowed to change the schema")
+	_ = flagSet.Parse(args)
+	if *configFile == "" || flagSet.NArg() != 1 {
+		flagSet.Usage()
+		os.Exit(1)
+	}
+
+	var c Config
+	err := cmd.ReadConfigFile(*configFile, &c)
+	cmd.FailOnError(err, "Reading JSON config file into config structure")
+	logger := cmd.NewLogger(c.Syslog)
+
+	dbConfig := c.SA.DB
+	if *dbConnectFile != "" {
+		dbConfig.DBConnectFile = *dbConnectFile
+	}
+	dbURL, err := dbConfig.URL()
+	cmd.FailOnError(err, "Couldn't load DB URL")
+	dbMap, err := sa.NewDbMap(dbURL, sa.DbSettings{})
+	cmd.FailOnError(err, "Couldn't connect to SA database")
+
+	migrations, err := c.migrations(dbMap.SQLDialect())
+	cmd.FailOnError(err, "Failed to load schema migrations")
+	if migrations == nil {
+		cmd.Fail("expectedSchema is \"none\": set it to the migrations to use")
+	}
+
+	switch flagSet.Arg(0) {
+	case "up":
+		n, err := sa.MigrateUp(dbMap, migrations, logger)
+		cmd.FailOnError(err, "Migrating up failed")
+		fmt.Printf("applied %d migrations\n", n)
+	case "down":
+		m, err := sa.MigrateDown(dbMap, migrations, logger)
+		cmd.FailOnError(err, "Migrating down failed")
+		fmt.Printf("rolled back %s\n", m.Name)
+	case "status":
+		applied, err := sa.AppliedMigrations(dbMap)
+		cmd.FailOnError(err, "Reading applied migrations failed")
+		isApplied := make(map[int64]bool)
+		for _, version := range applied {
+			isApplied[version] = true
+		}
+		known := make(map[int64]bool)
+		for _, m := range migrations {
+			known[m.Version] = true
+			state := "pending"
+			if isApplied[m.Version] {
+				state = "applied"
+			}
+			fmt.Printf("%-8s %s\n", state, m.Name)
+		}
+		for _, version := range applied {
+			if !known[version] {
+				fmt.Printf("%-8s %d\n", "unknown", version)
+			}
+		}
+		err = sa.CheckSchema(dbMap, migrations)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	default:
+		flagSet.Usage()
+		os.Exit(1)
+	}
+}
@@ -124,7 +124,22 @@ type Config struct {
 		// It should match the value configured in the RA.
 		PendingAuthorizationLifetimeDays int
 
+		// AccountCache, if set, caches accounts looked up by ID and by key
+		// in memory. Accounts the WFE changes are dropped from its cache
+		// immediately, but changes made through other WFEs, including key
+		// rollovers and deactivations, are only seen once the TTL passes.
+		// The TTL must be set, and can't be more than one minute.
 		AccountCache *CacheConfig
+
+		// UnpauseKey contains the path to a file containing the key which
+		// signs the links to the unpause page returned to accounts paused from
+		// ordering certificates for some identifiers. It must be at least 32
+		// bytes long. If it isn't set, no links are returned.
+		UnpauseKey cmd.PasswordConfig
+
+		// UnpauseLinkLifetime is how long the links to the unpause page are
+		// valid for. It defaults to 24 hours.
+		UnpauseLinkLifetime cmd.ConfigDuration
 	}
 
 	Syslog  cmd.SyslogConfig
@@ -418,6 +433,10 @@ func main() {
 
 	var accountGetter wfe2.AccountGetter
 	if c.WFE.AccountCache != nil {
+		ttl := c.WFE.AccountCache.TTL.Duration
+		if ttl <= 0 || ttl > wfe2.MaxAccountCacheTTL {
+			cmd.Fail(fmt.Sprintf("accountCache.ttl must be more than zero and at most %s", wfe2.MaxAccountCacheTTL))
+		}
 		accountGetter = wfe2.NewAccountCache(sac,
 			c.WFE.AccountCache.Size,
 			c.WFE.AccountCache.TTL.Duration,
@@ -450,6 +469,17 @@ func main() {
 	wfe.DirectoryWebsite = c.WFE.DirectoryWebsite
 	wfe.LegacyKeyIDPrefix = c.WFE.LegacyKeyIDPrefix
 
+	unpauseKey, err := c.WFE.UnpauseKey.Pass()
+	cmd.FailOnError(err, "Failed to read unpause key")
+	if unpauseKey != "" && len(unpauseKey) < 32 {
+		cmd.Fail("Unpause key must be at least 32 bytes long")
+	}
+	wfe.UnpauseKey = []byte(unpauseKey)
+	wfe.UnpauseLinkLifetime = c.WFE.UnpauseLinkLifetime.Duration
+	if wfe.UnpauseLinkLifetime == 0 {
+		wfe.UnpauseLinkLifetime = 24 * time.Hour
+	}
+
 	logger.Infof("WFE using key policy: %#v", kp)
 
 	logger.Infof("Server running, listening on %s....", c.WFE.ListenAddress)
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path"
 
+	_ "github.com/letsencrypt/boulder/cmd/admin"
 	_ "github.com/letsencrypt/boulder/cmd/admin-revoker"
 	_ "github.com/letsencrypt/boulder/cmd/akamai-purger"
 	_ "github.com/letsencrypt/boulder/cmd/bad-key-revoker"
@@ -19,6 +20,8 @@ import (
 	_ "github.com/letsencrypt/boulder/cmd/ceremony"
 	_ "github.com/letsencrypt/boulder/cmd/cert-checker"
 	_ "github.com/letsencrypt/boulder/cmd/contact-auditor"
+	_ "github.com/letsencrypt/boulder/cmd/ct-final-queue"
+	_ "github.com/letsencrypt/boulder/cmd/event-consumer"
 	_ "github.com/letsencrypt/boulder/cmd/expiration-mailer"
 	_ "github.com/letsencrypt/boulder/cmd/id-exporter"
 	_ "github.com/letsencrypt/boulder/cmd/log-validator"
@@ -27,6 +30,7 @@ import (
 	_ "github.com/letsencrypt/boulder/cmd/ocsp-responder"
 	_ "github.com/letsencrypt/boulder/cmd/ocsp-updater"
 	_ "github.com/letsencrypt/boulder/cmd/orphan-finder"
+	_ "github.com/letsencrypt/boulder/cmd/purger"
 	_ "github.com/letsencrypt/boulder/cmd/reversed-hostname-checker"
 	_ "github.com/letsencrypt/boulder/cmd/rocsp-tool"
 
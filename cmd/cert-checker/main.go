@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log/syslog"
 	"os"
 	"reflect"
@@ -15,21 +16,24 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/jmhodges/clock"
 	"github.com/prometheus/client_golang/prometheus"
 	zX509 "github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3"
 	"github.com/zmap/zlint/v3/lint"
+	"google.golang.org/grpc"
 
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/goodkey"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/identifier"
+	"github.com/letsencrypt/boulder/linter/lints/profile"
 	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/policy"
-	"github.com/letsencrypt/boulder/sa"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
 
 // For defense-in-depth in addition to using the PA & its hostnamePolicy to
@@ -76,37 +80,37 @@ type reportEntry struct {
 	Problems []string `json:"problems,omitempty"`
 }
 
-// certDB is an interface collecting the gorp.saDbMap functions that the various
-// parts of cert-checker rely on. Using this adapter shim allows tests to swap
-// out the saDbMap implementation.
-type certDB interface {
-	Select(i interface{}, query string, args ...interface{}) ([]interface{}, error)
-	SelectInt(query string, args ...interface{}) (int64, error)
+// certSource is the part of the SA's gRPC interface that cert-checker relies
+// on. Using this narrow interface allows tests to swap out the SA.
+type certSource interface {
+	StreamCertificates(ctx context.Context, req *sapb.StreamCertificatesRequest, opts ...grpc.CallOption) (sapb.StorageAuthority_StreamCertificatesClient, error)
 }
 
 type certChecker struct {
 	pa                          core.PolicyAuthority
 	kp                          goodkey.KeyPolicy
-	dbMap                       certDB
+	sac                         certSource
 	certs                       chan core.Certificate
 	clock                       clock.Clock
 	rMu                         *sync.Mutex
 	issuedReport                report
 	checkPeriod                 time.Duration
 	acceptableValidityDurations map[time.Duration]bool
+	profileLints                []*lint.Lint
 }
 
-func newChecker(saDbMap certDB, clk clock.Clock, pa core.PolicyAuthority, kp goodkey.KeyPolicy, period time.Duration, avd map[time.Duration]bool) certChecker {
+func newChecker(sac certSource, clk clock.Clock, pa core.PolicyAuthority, kp goodkey.KeyPolicy, period time.Duration, avd map[time.Duration]bool, profileLints []*lint.Lint) certChecker {
 	return certChecker{
 		pa:                          pa,
 		kp:                          kp,
-		dbMap:                       saDbMap,
+		sac:                         sac,
 		certs:                       make(chan core.Certificate, batchSize),
 		rMu:                         new(sync.Mutex),
 		clock:                       clk,
 		issuedReport:                report{Entries: make(map[string]reportEntry)},
 		checkPeriod:                 period,
 		acceptableValidityDurations: avd,
+		profileLints:                profileLints,
 	}
 }
 
@@ -114,48 +118,38 @@ func (c *certChecker) getCerts(unexpiredOnly bool) error {
 	c.issuedReport.end = c.clock.Now()
 	c.issuedReport.begin = c.issuedReport.end.Add(-c.checkPeriod)
 
-	args := map[string]interface{}{"issued": c.issuedReport.begin, "now": 0}
+	req := &sapb.StreamCertificatesRequest{IssuedAfter: c.issuedReport.begin.UnixNano()}
 	if unexpiredOnly {
-		args["now"] = c.clock.Now()
+		req.ExpiresAfter = c.clock.Now().UnixNano()
 	}
 
-	initialID, err := c.dbMap.SelectInt(
-		"SELECT MIN(id) FROM certificates WHERE issued >= :issued AND expires >= :now",
-		args,
-	)
+	// The SA streams the certificates a page at a time, and the certificate
+	// channel's buffer keeps us from eating unnecessary amounts of memory while
+	// the workers catch up.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := c.sac.StreamCertificates(ctx, req)
 	if err != nil {
 		return err
 	}
-	if initialID > 0 {
-		// decrement the initial ID so that we select below as we aren't using >=
-		initialID -= 1
-	}
-
-	// Retrieve certs in batches of 1000 (the size of the certificate channel)
-	// so that we don't eat unnecessary amounts of memory and avoid the 16MB MySQL
-	// packet limit.
-	args["limit"] = batchSize
-	args["id"] = initialID
 	for {
-		certs, err := sa.SelectCertificates(
-			c.dbMap,
-			"WHERE id > :id AND issued >= :issued AND expires >= :now ORDER BY id LIMIT :limit",
-			args,
-		)
+		certPB, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
 		if err != nil {
 			return err
 		}
-		for _, cert := range certs {
-			c.certs <- cert.Certificate
-		}
-		if len(certs) == 0 {
-			break
+		cert, err := bgrpc.PBToCert(certPB)
+		if err != nil {
+			return err
 		}
-		lastCert := certs[len(certs)-1]
-		args["id"] = lastCert.ID
-		if lastCert.Issued.After(c.issuedReport.end) {
+		if cert.Issued.After(c.issuedReport.end) {
+			// Certificates are streamed in the order they were added, so the
+			// rest were issued after the period being checked too.
 			break
 		}
+		c.certs <- cert
 	}
 
 	// Close channel so range operations won't block once the channel empties out
@@ -219,8 +213,11 @@ func (c *certChecker) checkCert(cert core.Certificate, ignoredLints map[string]b
 		problems = append(problems, fmt.Sprintf("Couldn't parse stored certificate: %s", err))
 	} else {
 		dnsNames = parsedCert.DNSNames
-		// Run zlint checks.
+		// Run zlint checks, including the Boulder profile lints.
 		results := zlint.LintCertificate(parsedCert)
+		for _, l := range c.profileLints {
+			results.Results[l.Name] = l.Execute(parsedCert)
+		}
 		for name, res := range results.Results {
 			if ignoredLints[name] || res.Status <= lint.Pass {
 				continue
@@ -324,9 +321,16 @@ func (c *certChecker) checkCert(cert core.Certificate, ignoredLints map[string]b
 
 type Config struct {
 	CertChecker struct {
+		// DB is DEPRECATED. Certificates are read from the SA, configured by
+		// TLS and SAService, and this is ignored.
 		DB cmd.DBConfig
 		cmd.HostnamePolicyConfig
 
+		// TLS configures the client certificate used to authenticate to the
+		// SA.
+		TLS       cmd.TLSConfig
+		SAService *cmd.GRPCClientConfig
+
 		Workers             int
 		ReportDirectoryPath string
 		UnexpiredOnly       bool
@@ -342,6 +346,12 @@ type Config struct {
 		// public keys in the certs it checks.
 		GoodKey goodkey.Config
 
+		// ProfileLints configures the Boulder-specific lints from the
+		// linter/lints/profile package. It should match the Lints of the CA's
+		// issuance profile, except that cert-checker can't know whether
+		// must-staple was requested, only whether it was allowed.
+		ProfileLints profile.Config
+
 		// IgnoredLints is a list of zlint names. Any lint results from a lint in
 		// the IgnoredLists list are ignored regardless of LintStatus level.
 		IgnoredLints []string
@@ -400,33 +410,14 @@ func main() {
 	kp, err := goodkey.NewKeyPolicy(&config.CertChecker.GoodKey, nil)
 	cmd.FailOnError(err, "Unable to create key policy")
 
-	saDbURL, err := config.CertChecker.DB.URL()
-	cmd.FailOnError(err, "Couldn't load DB URL")
-
-	dbSettings := sa.DbSettings{
-		MaxOpenConns:    config.CertChecker.DB.MaxOpenConns,
-		MaxIdleConns:    config.CertChecker.DB.MaxIdleConns,
-		ConnMaxLifetime: config.CertChecker.DB.ConnMaxLifetime.Duration,
-		ConnMaxIdleTime: config.CertChecker.DB.ConnMaxIdleTime.Duration,
-	}
+	tlsConfig, err := config.CertChecker.TLS.Load()
+	cmd.FailOnError(err, "TLS config")
 
-	conf, err := mysql.ParseDSN(saDbURL)
-	cmd.FailOnError(err, "Couldn't parse DB URL as DSN")
+	clientMetrics := bgrpc.NewClientMetrics(metrics.NoopRegisterer)
+	conn, err := bgrpc.ClientSetup(config.CertChecker.SAService, tlsConfig, clientMetrics, cmd.Clock())
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+	sac := sapb.NewStorageAuthorityClient(conn)
 
-	// Set transaction isolation level to READ UNCOMMITTED. This trades
-	// consistency for performance.
-	if len(conf.Params) == 0 {
-		conf.Params = make(map[string]string)
-	}
-	conf.Params["tx_isolation"] = "'READ-UNCOMMITTED'"
-	saDbURL = conf.FormatDSN()
-	saDbMap, err := sa.NewDbMap(saDbURL, dbSettings)
-	cmd.FailOnError(err, "Could not connect to database")
-
-	dbAddr, dbUser, err := config.CertChecker.DB.DSNAddressAndUser()
-	cmd.FailOnError(err, "Could not determine address or user of DB DSN")
-
-	sa.InitDBMetrics(saDbMap.Db, prometheus.DefaultRegisterer, dbSettings, dbAddr, dbUser)
 	checkerLatency := prometheus.NewHistogram(prometheus.HistogramOpts{
 		Name: "cert_checker_latency",
 		Help: "Histogram of latencies a cert-checker worker takes to complete a batch",
@@ -436,16 +427,20 @@ func main() {
 	pa, err := policy.New(config.PA.Challenges)
 	cmd.FailOnError(err, "Failed to create PA")
 
+	profilePolicy, err := profile.NewPolicy(config.CertChecker.ProfileLints)
+	cmd.FailOnError(err, "Failed to load ProfileLints")
+
 	err = pa.SetHostnamePolicyFile(config.CertChecker.HostnamePolicyFile)
 	cmd.FailOnError(err, "Failed to load HostnamePolicyFile")
 
 	checker := newChecker(
-		saDbMap,
+		sac,
 		cmd.Clock(),
 		pa,
 		kp,
 		config.CertChecker.CheckPeriod.Duration,
 		acceptableValidityDurations,
+		profilePolicy.Lints(nil),
 	)
 	fmt.Fprintf(os.Stderr, "# Getting certificates issued in the last %s\n", config.CertChecker.CheckPeriod)
 
@@ -26,8 +26,10 @@ import (
 
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/goodkey"
+	"github.com/letsencrypt/boulder/linter/lints/profile"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/mocks"
 	"github.com/letsencrypt/boulder/policy"
 	"github.com/letsencrypt/boulder/sa"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
@@ -61,7 +63,7 @@ func init() {
 }
 
 func BenchmarkCheckCert(b *testing.B) {
-	checker := newChecker(nil, clock.New(), pa, kp, time.Hour, testValidityDurations)
+	checker := newChecker(nil, clock.New(), pa, kp, time.Hour, testValidityDurations, nil)
 	testKey, _ := rsa.GenerateKey(rand.Reader, 1024)
 	expiry := time.Now().AddDate(0, 0, 1)
 	serial := big.NewInt(1337)
@@ -88,16 +90,9 @@ func BenchmarkCheckCert(b *testing.B) {
 }
 
 func TestCheckWildcardCert(t *testing.T) {
-	saDbMap, err := sa.NewDbMap(vars.DBConnSA, sa.DbSettings{})
-	test.AssertNotError(t, err, "Couldn't connect to database")
-	saCleanup := test.ResetSATestDatabase(t)
-	defer func() {
-		saCleanup()
-	}()
-
 	testKey, _ := rsa.GenerateKey(rand.Reader, 2048)
 	fc := clock.NewFake()
-	checker := newChecker(saDbMap, fc, pa, kp, time.Hour, testValidityDurations)
+	checker := newChecker(nil, fc, pa, kp, time.Hour, testValidityDurations, nil)
 	issued := checker.clock.Now().Add(-time.Minute)
 	goodExpiry := issued.Add(testValidityDuration - time.Second)
 	serial := big.NewInt(1337)
@@ -134,13 +129,7 @@ func TestCheckWildcardCert(t *testing.T) {
 }
 
 func TestCheckCertReturnsDNSNames(t *testing.T) {
-	saDbMap, err := sa.NewDbMap(vars.DBConnSA, sa.DbSettings{})
-	test.AssertNotError(t, err, "Couldn't connect to database")
-	saCleanup := test.ResetSATestDatabase(t)
-	defer func() {
-		saCleanup()
-	}()
-	checker := newChecker(saDbMap, clock.NewFake(), pa, kp, time.Hour, testValidityDurations)
+	checker := newChecker(nil, clock.NewFake(), pa, kp, time.Hour, testValidityDurations, nil)
 
 	certPEM, err := ioutil.ReadFile("testdata/quite_invalid.pem")
 	if err != nil {
@@ -183,13 +172,6 @@ func (*rsa2048Generator) genKey() (crypto.Signer, error) {
 }
 
 func TestCheckCert(t *testing.T) {
-	saDbMap, err := sa.NewDbMap(vars.DBConnSA, sa.DbSettings{})
-	test.AssertNotError(t, err, "Couldn't connect to database")
-	saCleanup := test.ResetSATestDatabase(t)
-	defer func() {
-		saCleanup()
-	}()
-
 	testCases := []struct {
 		name string
 		key  keyGen
@@ -207,7 +189,7 @@ func TestCheckCert(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			testKey, _ := tc.key.genKey()
 
-			checker := newChecker(saDbMap, clock.NewFake(), pa, kp, time.Hour, testValidityDurations)
+			checker := newChecker(nil, clock.NewFake(), pa, kp, time.Hour, testValidityDurations, nil)
 
 			// Create a RFC 7633 OCSP Must Staple Extension.
 			// OID 1.3.6.1.5.5.7.1.24
@@ -328,9 +310,9 @@ func TestGetAndProcessCerts(t *testing.T) {
 	fc := clock.NewFake()
 	fc.Set(fc.Now().Add(time.Hour))
 
-	checker := newChecker(saDbMap, fc, pa, kp, time.Hour, testValidityDurations)
 	sa, err := sa.NewSQLStorageAuthority(saDbMap, saDbMap, fc, blog.NewMock(), metrics.NoopRegisterer, 1)
 	test.AssertNotError(t, err, "Couldn't create SA to insert certificates")
+	checker := newChecker(isa.SA{Impl: sa}, fc, pa, kp, time.Hour, testValidityDurations, nil)
 	saCleanUp := test.ResetSATestDatabase(t)
 	defer func() {
 		saCleanUp()
@@ -361,7 +343,6 @@ func TestGetAndProcessCerts(t *testing.T) {
 		test.AssertNotError(t, err, "Couldn't add certificate")
 	}
 
-	batchSize = 2
 	err = checker.getCerts(false)
 	test.AssertNotError(t, err, "Failed to retrieve certificates")
 	test.AssertEquals(t, len(checker.certs), 5)
@@ -372,54 +353,14 @@ func TestGetAndProcessCerts(t *testing.T) {
 	test.AssertEquals(t, len(checker.issuedReport.Entries), 5)
 }
 
-// mismatchedCountDB is a certDB implementation for `getCerts` that returns one
-// high value when asked how many rows there are, and then returns nothing when
-// asked for the actual rows.
-type mismatchedCountDB struct{}
-
-// `getCerts` calls `SelectInt` first to determine how many rows there are
-// matching the `getCertsCountQuery` criteria. For this mock we return
-// a non-zero number
-func (db mismatchedCountDB) SelectInt(_ string, _ ...interface{}) (int64, error) {
-	return 99999, nil
-}
-
-// `getCerts` then calls `Select` to retrieve the Certificate rows. We pull
-// a dastardly switch-a-roo here and return an empty set
-func (db mismatchedCountDB) Select(output interface{}, _ string, _ ...interface{}) ([]interface{}, error) {
-	// But actually return nothing
-	outputPtr, _ := output.(*[]sa.CertWithID)
-	*outputPtr = []sa.CertWithID{}
-	return nil, nil
-}
-
-/*
- * In Boulder #2004[0] we identified that there is a race in `getCerts`
- * between the first call to `SelectOne` to identify how many rows there are,
- * and the subsequent call to `Select` to get the actual rows in batches. This
- * manifests in an index out of range panic where the cert checker thinks there
- * are more rows than there are and indexes into an empty set of certificates to
- * update the lastSerial field of the query `args`. This has been fixed by
- * adding a len() check in the inner `getCerts` loop that processes the certs
- * one batch at a time.
- *
- * TestGetCertsEmptyResults tests the fix remains in place by using a mock that
- * exploits this corner case deliberately. The `mismatchedCountDB` mock (defined
- * above) will return a high count for the `SelectOne` call, but an empty slice
- * for the `Select` call. Without the fix in place this reliably produced the
- * "index out of range" panic from #2004. With the fix in place the test passes.
- *
- * 0: https://github.com/letsencrypt/boulder/issues/2004
- */
 func TestGetCertsEmptyResults(t *testing.T) {
-	saDbMap, err := sa.NewDbMap(vars.DBConnSA, sa.DbSettings{})
-	test.AssertNotError(t, err, "Couldn't connect to database")
-	checker := newChecker(saDbMap, clock.NewFake(), pa, kp, time.Hour, testValidityDurations)
-	checker.dbMap = mismatchedCountDB{}
+	fc := clock.NewFake()
+	checker := newChecker(mocks.NewStorageAuthority(fc), fc, pa, kp, time.Hour, testValidityDurations, nil)
 
-	batchSize = 3
-	err = checker.getCerts(false)
+	err := checker.getCerts(false)
 	test.AssertNotError(t, err, "Failed to retrieve certificates")
+	_, ok := <-checker.certs
+	test.Assert(t, !ok, "certificate channel wasn't closed")
 }
 
 func TestSaveReport(t *testing.T) {
@@ -487,15 +428,8 @@ func TestIsForbiddenDomain(t *testing.T) {
 }
 
 func TestIgnoredLint(t *testing.T) {
-	saDbMap, err := sa.NewDbMap(vars.DBConnSA, sa.DbSettings{})
-	test.AssertNotError(t, err, "Couldn't connect to database")
-	saCleanup := test.ResetSATestDatabase(t)
-	defer func() {
-		saCleanup()
-	}()
-
 	testKey, _ := rsa.GenerateKey(rand.Reader, 2048)
-	checker := newChecker(saDbMap, clock.NewFake(), pa, kp, time.Hour, testValidityDurations)
+	checker := newChecker(nil, clock.NewFake(), pa, kp, time.Hour, testValidityDurations, nil)
 	serial := big.NewInt(1337)
 
 	template := &x509.Certificate{
@@ -568,3 +502,40 @@ func TestIgnoredLint(t *testing.T) {
 	})
 	test.AssertEquals(t, len(problems), 0)
 }
+
+func TestCheckCertProfileLints(t *testing.T) {
+	profilePolicy, err := profile.NewPolicy(profile.Config{MaxNames: 1})
+	test.AssertNotError(t, err, "NewPolicy failed")
+	checker := newChecker(nil, clock.NewFake(), pa, kp, time.Hour, testValidityDurations, profilePolicy.Lints(nil))
+
+	testKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	issued := checker.clock.Now().Add(-time.Minute)
+	serial := big.NewInt(1337)
+	rawCert := x509.Certificate{
+		Subject:               pkix.Name{CommonName: "example-a.com"},
+		NotBefore:             issued,
+		NotAfter:              issued.Add(testValidityDuration - time.Second),
+		DNSNames:              []string{"example-a.com", "example-b.com"},
+		SerialNumber:          serial,
+		BasicConstraintsValid: true,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		OCSPServer:            []string{"http://example.com/ocsp"},
+		IssuingCertificateURL: []string{"http://example.com/cert"},
+	}
+	certDer, err := x509.CreateCertificate(rand.Reader, &rawCert, &rawCert, testKey.Public(), testKey)
+	test.AssertNotError(t, err, "Couldn't create certificate")
+	cert := core.Certificate{
+		Serial:  core.SerialToString(serial),
+		Digest:  core.Fingerprint256(certDer),
+		DER:     certDer,
+		Issued:  issued,
+		Expires: rawCert.NotAfter,
+	}
+
+	_, problems := checker.checkCert(cert, nil)
+	test.AssertContains(t, strings.Join(problems, "\n"), "zlint error: e_boulder_too_many_names")
+
+	_, problems = checker.checkCert(cert, map[string]bool{"e_boulder_too_many_names": true})
+	test.AssertNotContains(t, strings.Join(problems, "\n"), "e_boulder_too_many_names")
+}
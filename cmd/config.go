@@ -17,6 +17,7 @@ import (
 	"github.com/go-sql-driver/mysql"
 	"github.com/honeycombio/beeline-go"
 	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/db"
 )
 
 // PasswordConfig contains a path to a file containing a password.
@@ -91,12 +92,17 @@ func (d *DBConfig) URL() (string, error) {
 }
 
 // DSNAddressAndUser returns the Address and User of the DBConnect DSN from
-// this object.
+// this object. For a SQLite DSN the address is the database file's path and
+// the user is empty.
 func (d *DBConfig) DSNAddressAndUser() (string, string, error) {
 	dsnStr, err := d.URL()
 	if err != nil {
 		return "", "", err
 	}
+	if db.IsSQLiteDSN(dsnStr) {
+		path := strings.TrimPrefix(dsnStr, db.SQLiteDSNPrefix)
+		return strings.SplitN(path, "?", 2)[0], "", nil
+	}
 	config, err := mysql.ParseDSN(dsnStr)
 	if err != nil {
 		return "", "", err
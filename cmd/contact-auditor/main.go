@@ -1,24 +1,34 @@
 package notmain
 
 import (
-	"database/sql"
+	"context"
 	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"strings"
 	"time"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/letsencrypt/boulder/cmd"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
 	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/policy"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/grpc"
 )
 
+// registrationStreamer is the part of the SA's gRPC interface that the
+// contact-auditor relies on.
+type registrationStreamer interface {
+	StreamRegistrationsWithContacts(ctx context.Context, req *sapb.StreamRegistrationsWithContactsRequest, opts ...grpc.CallOption) (sapb.StorageAuthority_StreamRegistrationsWithContactsClient, error)
+}
+
 type contactAuditor struct {
-	db            *sql.DB
+	sac           registrationStreamer
 	resultsFile   *os.File
 	writeToStdout bool
 	logger        blog.Logger
@@ -66,18 +76,10 @@ func validateContacts(id int64, createdAt string, contacts []string) error {
 	return nil
 }
 
-// beginAuditQuery executes the audit query and returns a cursor used to
-// stream the results.
-func (c contactAuditor) beginAuditQuery() (*sql.Rows, error) {
-	rows, err := c.db.Query(
-		`SELECT DISTINCT r.id, r.contact, r.createdAt
-		FROM registrations AS r
-			INNER JOIN certificates AS c on c.registrationID = r.id
-		WHERE r.contact NOT IN ('[]', 'null');`)
-	if err != nil {
-		return nil, err
-	}
-	return rows, nil
+// beginAuditQuery asks the SA to stream every registration which has contacts
+// and has been issued a certificate.
+func (c contactAuditor) beginAuditQuery() (sapb.StorageAuthority_StreamRegistrationsWithContactsClient, error) {
+	return c.sac.StreamRegistrationsWithContacts(context.Background(), &sapb.StreamRegistrationsWithContactsRequest{})
 }
 
 func (c contactAuditor) writeResults(result string) {
@@ -96,24 +98,28 @@ func (c contactAuditor) writeResults(result string) {
 	}
 }
 
-// run retrieves a cursor from `beginAuditQuery` and then audits the
-// `contact` column of all returned rows for abnormalities or policy
+// run retrieves a stream from `beginAuditQuery` and then audits the
+// contacts of all returned registrations for abnormalities or policy
 // violations.
 func (c contactAuditor) run(resChan chan *result) error {
-	c.logger.Infof("Beginning database query")
-	rows, err := c.beginAuditQuery()
+	c.logger.Infof("Beginning SA query")
+	stream, err := c.beginAuditQuery()
 	if err != nil {
 		return err
 	}
 
-	for rows.Next() {
-		var id int64
-		var contact []byte
-		var createdAt string
-		err := rows.Scan(&id, &contact, &createdAt)
+	for {
+		reg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
 		if err != nil {
+			// The stream was interrupted before it could complete.
 			return err
 		}
+		id := reg.Id
+		contact := reg.Contact
+		createdAt := time.Unix(0, reg.CreatedAt).UTC().Format("2006-01-02 15:04:05")
 
 		contacts, err := unmarshalContact(contact)
 		if err != nil {
@@ -130,13 +136,7 @@ func (c contactAuditor) run(resChan chan *result) error {
 			resChan <- &result{id, contacts, createdAt}
 		}
 	}
-	// Ensure the query wasn't interrupted before it could complete.
-	err = rows.Close()
-	if err != nil {
-		return err
-	} else {
-		c.logger.Info("Query completed successfully")
-	}
+	c.logger.Info("Query completed successfully")
 
 	// Only used for testing.
 	if resChan != nil {
@@ -146,23 +146,12 @@ func (c contactAuditor) run(resChan chan *result) error {
 	return nil
 }
 
-func makeDBConnection(dsn string) (*sql.DB, error) {
-	conf, err := mysql.ParseDSN(dsn)
-	if err != nil {
-		return nil, err
-	}
-
-	// Transaction isolation level READ UNCOMMITTED trades consistency
-	// for performance.
-	conf.Params = map[string]string{
-		"tx_isolation": "'READ-UNCOMMITTED'",
-	}
-	return sql.Open("mysql", conf.FormatDSN())
-}
-
 type Config struct {
 	ContactAuditor struct {
-		DB cmd.DBConfig
+		// TLS configures the client certificate used to authenticate to the
+		// SA.
+		TLS       cmd.TLSConfig
+		SAService *cmd.GRPCClientConfig
 	}
 }
 
@@ -182,17 +171,12 @@ func main() {
 	err = json.Unmarshal(configData, &cfg)
 	cmd.FailOnError(err, "Couldn't unmarshal config")
 
-	// Setup database client.
-	dbURL, err := cfg.ContactAuditor.DB.URL()
-	cmd.FailOnError(err, "Couldn't load dbURL")
-	db, err := makeDBConnection(dbURL)
-	cmd.FailOnError(err, "Couldn't setup database client")
-
-	// Apply database settings.
-	db.SetMaxOpenConns(cfg.ContactAuditor.DB.MaxOpenConns)
-	db.SetMaxIdleConns(cfg.ContactAuditor.DB.MaxIdleConns)
-	db.SetConnMaxLifetime(cfg.ContactAuditor.DB.ConnMaxLifetime.Duration)
-	db.SetConnMaxIdleTime(cfg.ContactAuditor.DB.ConnMaxIdleTime.Duration)
+	// Setup SA client.
+	tlsConfig, err := cfg.ContactAuditor.TLS.Load()
+	cmd.FailOnError(err, "TLS config")
+	clientMetrics := bgrpc.NewClientMetrics(metrics.NoopRegisterer)
+	conn, err := bgrpc.ClientSetup(cfg.ContactAuditor.SAService, tlsConfig, clientMetrics, cmd.Clock())
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
 
 	var resultsFile *os.File
 	if *writeToFile {
@@ -204,7 +188,7 @@ func main() {
 
 	// Setup and run contact-auditor.
 	auditor := contactAuditor{
-		db:            db,
+		sac:           sapb.NewStorageAuthorityClient(conn),
 		resultsFile:   resultsFile,
 		writeToStdout: *writeToStdout,
 		logger:        logger,
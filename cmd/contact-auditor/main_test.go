@@ -24,6 +24,7 @@ import (
 	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/sa"
 	"github.com/letsencrypt/boulder/test"
+	isa "github.com/letsencrypt/boulder/test/inmem/sa"
 	"github.com/letsencrypt/boulder/test/vars"
 )
 
@@ -345,11 +346,6 @@ func setup(t *testing.T) testCtx {
 		os.Remove(file.Name())
 	}
 
-	db, err := makeDBConnection(vars.DBConnSAMailer)
-	if err != nil {
-		t.Fatalf("Couldn't connect to the database: %s", err)
-	}
-
 	ssa, err := sa.NewSQLStorageAuthority(dbMap, dbMap, clock.New(), log, metrics.NoopRegisterer, 1)
 	if err != nil {
 		t.Fatalf("unable to create SQLStorageAuthority: %s", err)
@@ -357,7 +353,7 @@ func setup(t *testing.T) testCtx {
 
 	return testCtx{
 		c: contactAuditor{
-			db:          db,
+			sac:         isa.SA{Impl: ssa},
 			resultsFile: file,
 			logger:      blog.NewMock(),
 		},
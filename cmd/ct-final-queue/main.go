@@ -0,0 +1,140 @@
+package notmain
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/beeker1121/goque"
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/ctpolicy"
+	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+)
+
+var usageString = `
+name:
+  ct-final-queue - Inspects and replays the boulder-ra queues of final certificate CT submissions
+
+usage:
+  ct-final-queue list-pending --config <path>
+  ct-final-queue list-dead-letter --config <path>
+  ct-final-queue replay-dead-letter --config <path>
+
+command descriptions:
+  list-pending         Prints every submission waiting to be made, with its attempts and last error
+  list-dead-letter     Prints every submission which boulder-ra has given up on
+  replay-dead-letter   Moves every dead letter submission back to the pending queue, resetting its attempts
+
+The queues are LevelDB databases which can only be opened by one process at a
+time, so boulder-ra must be stopped while this tool runs.
+`
+
+// Config is the subset of the boulder-ra config needed to find its queues.
+type Config struct {
+	RA struct {
+		FinalCertQueue ctconfig.FinalCertQueueConfig
+	}
+}
+
+// list prints a line describing each submission in the queue, and returns the
+// number of submissions printed. It stops early if ctx is cancelled.
+func list(ctx context.Context, q *goque.Queue, w io.Writer) (int, error) {
+	length := q.Length()
+	for i := uint64(0); i < length; i++ {
+		if ctx.Err() != nil {
+			return int(i), ctx.Err()
+		}
+		item, err := q.PeekByOffset(i)
+		if err != nil {
+			return int(i), fmt.Errorf("failed to read queue item at offset %d: %s", i, err)
+		}
+		var sub ctpolicy.FinalCertSubmission
+		err = item.ToObject(&sub)
+		if err != nil {
+			fmt.Fprintf(w, "id=[%d] undecodable=[%s]\n", item.ID, err)
+			continue
+		}
+		fmt.Fprintf(w, "id=[%d] serial=[%s] log=[%s] attempts=[%d] nextAttempt=[%s] expiration=[%s] lastError=[%s]\n",
+			item.ID, sub.Serial, sub.LogURI, sub.Attempts, sub.NextAttempt.Format(time.RFC3339),
+			sub.Expiration.Format(time.RFC3339), sub.LastError)
+	}
+	return int(length), nil
+}
+
+func main() {
+	if len(os.Args) <= 2 {
+		fmt.Fprint(os.Stderr, usageString)
+		os.Exit(1)
+	}
+
+	command := os.Args[1]
+	flagSet := flag.NewFlagSet(command, flag.ContinueOnError)
+	configFile := flagSet.String("config", "", "File path to the boulder-ra configuration file")
+	err := flagSet.Parse(os.Args[2:])
+	cmd.FailOnError(err, "Error parsing flagset")
+
+	usage := func() {
+		fmt.Fprintf(os.Stderr, "%s\nargs:", usageString)
+		flagSet.PrintDefaults()
+		os.Exit(1)
+	}
+
+	if *configFile == "" {
+		usage()
+	}
+
+	var c Config
+	err = cmd.ReadConfigFile(*configFile, &c)
+	cmd.FailOnError(err, "Reading JSON config file into config structure")
+	qc := c.RA.FinalCertQueue
+	if qc.Dir == "" || qc.DeadLetterDir == "" {
+		cmd.Fail("Config does not configure a final certificate queue")
+	}
+
+	// On a signal, stop between queue items rather than exiting immediately,
+	// so that the queues are closed cleanly. Each command closes its queues
+	// before reporting an error, since cmd.FailOnError exits without running
+	// deferred calls.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP)
+	defer stop()
+
+	open := func(dir string) *goque.Queue {
+		q, err := goque.OpenQueue(dir)
+		cmd.FailOnError(err, fmt.Sprintf("Failed to open queue %q", dir))
+		return q
+	}
+
+	switch command {
+	case "list-pending":
+		q := open(qc.Dir)
+		n, err := list(ctx, q, os.Stdout)
+		_ = q.Close()
+		cmd.FailOnError(err, "Failed to list pending submissions")
+		fmt.Fprintf(os.Stderr, "%d pending submissions\n", n)
+	case "list-dead-letter":
+		q := open(qc.DeadLetterDir)
+		n, err := list(ctx, q, os.Stdout)
+		_ = q.Close()
+		cmd.FailOnError(err, "Failed to list dead letter submissions")
+		fmt.Fprintf(os.Stderr, "%d dead letter submissions\n", n)
+	case "replay-dead-letter":
+		pending := open(qc.Dir)
+		deadLetter := open(qc.DeadLetterDir)
+		n, err := ctpolicy.ReplayDeadLetters(ctx, pending, deadLetter, time.Now())
+		_ = pending.Close()
+		_ = deadLetter.Close()
+		fmt.Fprintf(os.Stderr, "Replayed %d dead letter submissions\n", n)
+		cmd.FailOnError(err, "Failed to replay dead letter submissions")
+	default:
+		usage()
+	}
+}
+
+func init() {
+	cmd.RegisterCommand("ct-final-queue", main)
+}
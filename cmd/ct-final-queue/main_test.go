@@ -0,0 +1,44 @@
+package notmain
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/beeker1121/goque"
+	"github.com/letsencrypt/boulder/ctpolicy"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestList(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "ct-final-queue")
+	test.AssertNotError(t, err, "Failed to create temp directory")
+	defer os.RemoveAll(tmpDir)
+	q, err := goque.OpenQueue(tmpDir)
+	test.AssertNotError(t, err, "Failed to open queue")
+	defer q.Close()
+
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = q.EnqueueObject(&ctpolicy.FinalCertSubmission{
+		Serial:      "00aa",
+		LogURI:      "http://log.example.com",
+		Attempts:    3,
+		NextAttempt: now,
+		Expiration:  now.Add(time.Hour),
+		LastError:   "timeout",
+	})
+	test.AssertNotError(t, err, "Failed to enqueue submission")
+	_, err = q.Enqueue([]byte("garbage"))
+	test.AssertNotError(t, err, "Failed to enqueue garbage")
+
+	var out bytes.Buffer
+	n, err := list(context.Background(), q, &out)
+	test.AssertNotError(t, err, "list failed")
+	test.AssertEquals(t, n, 2)
+	test.AssertContains(t, out.String(), "id=[1] serial=[00aa] log=[http://log.example.com] attempts=[3] nextAttempt=[2021-01-01T00:00:00Z] expiration=[2021-01-01T01:00:00Z] lastError=[timeout]\n")
+	test.AssertContains(t, out.String(), "id=[2] undecodable=")
+	test.AssertEquals(t, q.Length(), uint64(2))
+}
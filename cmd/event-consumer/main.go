@@ -0,0 +1,256 @@
+package notmain
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/boulder/cmd"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// Config holds the event consumer's configuration.
+type Config struct {
+	EventConsumer struct {
+		// TLS configures the client certificate used to authenticate to the
+		// SA.
+		TLS       cmd.TLSConfig
+		SAService *cmd.GRPCClientConfig
+
+		// CursorFile holds the ID of the last event written, so that the
+		// consumer resumes where it left off. It's created if it doesn't
+		// exist, in which case every event is written.
+		CursorFile string
+
+		// Types, if given, limits the events written to those types.
+		Types []string
+
+		// PollInterval is how long the consumer waits, when run with -follow,
+		// between catching up with the event stream and checking it again.
+		PollInterval cmd.ConfigDuration
+	}
+
+	Syslog cmd.SyslogConfig
+}
+
+// eventLine is an event as the consumer writes it: one JSON object per line.
+type eventLine struct {
+	ID             int64           `json:"id"`
+	Type           string          `json:"type"`
+	Created        time.Time       `json:"created"`
+	RegistrationID int64           `json:"registrationID"`
+	Serial         string          `json:"serial,omitempty"`
+	Details        json.RawMessage `json:"details"`
+}
+
+// consumer writes the SA's events as newline-delimited JSON. Events are
+// written at least once: the cursor is only saved after the events before it
+// have been flushed, so a consumer which is interrupted writes the events
+// since its last saved cursor again when it's restarted.
+//
+// The SA's event stream waits for an event whose transaction hasn't yet
+// committed rather than skipping it, but only for a minute, after which it
+// assumes that the transaction rolled back. An event which commits later than
+// that is never written. The SA counts such late commits in its
+// events_committed_late metric, and logs the IDs of the events concerned, so
+// that they can be recovered by rewinding the cursor file.
+type consumer struct {
+	sac        sapb.StorageAuthorityClient
+	out        *bufio.Writer
+	cursorFile string
+	types      []string
+	log        blog.Logger
+}
+
+// readCursor returns the ID of the last event written, or zero if the cursor
+// file doesn't exist yet.
+func (c *consumer) readCursor() (int64, error) {
+	contents, err := ioutil.ReadFile(c.cursorFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	cursor, err := strconv.ParseInt(strings.TrimSpace(string(contents)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing cursor file %q: %w", c.cursorFile, err)
+	}
+	return cursor, nil
+}
+
+// writeCursor saves the cursor by renaming a temporary file over the cursor
+// file, so that the cursor is never left partially written.
+func (c *consumer) writeCursor(cursor int64) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(c.cursorFile), filepath.Base(c.cursorFile)+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(tmp, "%d\n", cursor)
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), c.cursorFile)
+}
+
+// catchUp writes the events after the saved cursor until the SA's stream
+// ends, then saves the new cursor. It returns the number of events written.
+func (c *consumer) catchUp(ctx context.Context) (int, error) {
+	cursor, err := c.readCursor()
+	if err != nil {
+		return 0, err
+	}
+	stream, err := c.sac.StreamEvents(ctx, &sapb.StreamEventsRequest{AfterID: cursor, Types: c.types})
+	if err != nil {
+		return 0, err
+	}
+	written := 0
+	var streamErr error
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			// Save the cursor for the events already written before
+			// returning the error, so that they aren't written again.
+			streamErr = err
+			break
+		}
+		line, err := json.Marshal(eventLine{
+			ID:             event.Id,
+			Type:           event.Type,
+			Created:        time.Unix(0, event.Created).UTC(),
+			RegistrationID: event.RegistrationID,
+			Serial:         event.Serial,
+			Details:        json.RawMessage(event.Details),
+		})
+		if err != nil {
+			return written, err
+		}
+		_, err = c.out.Write(append(line, '\n'))
+		if err != nil {
+			return written, err
+		}
+		cursor = event.Id
+		written++
+	}
+	err = c.out.Flush()
+	if err != nil {
+		return written, err
+	}
+	if written > 0 {
+		err = c.writeCursor(cursor)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, streamErr
+}
+
+// follow catches up with the event stream every interval until the context
+// is canceled. Errors are logged rather than returned, so that a restarted or
+// unreachable SA only delays the events.
+func (c *consumer) follow(ctx context.Context, clk clock.Clock, interval time.Duration) {
+	for {
+		written, err := c.catchUp(ctx)
+		if err != nil {
+			c.log.Errf("Catching up with event stream: %s", err)
+		} else if written > 0 {
+			c.log.Infof("Wrote %d events", written)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-clk.After(interval):
+		}
+	}
+}
+
+func main() {
+	configPath := flag.String("config", "", "File path to the configuration file for this service")
+	outputPath := flag.String("output", "", "File to append events to, one JSON object per line. Defaults to stdout")
+	followStream := flag.Bool("follow", false, "Keep polling for new events rather than exiting once caught up")
+	flag.Parse()
+
+	if *configPath == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+	var config Config
+	err := cmd.ReadConfigFile(*configPath, &config)
+	cmd.FailOnError(err, "Failed reading config file")
+	if config.EventConsumer.CursorFile == "" {
+		cmd.Fail("cursorFile is required")
+	}
+
+	logger := cmd.NewLogger(config.Syslog)
+	defer logger.AuditPanic()
+	clk := cmd.Clock()
+
+	tlsConfig, err := config.EventConsumer.TLS.Load()
+	cmd.FailOnError(err, "TLS config")
+
+	clientMetrics := bgrpc.NewClientMetrics(metrics.NoopRegisterer)
+	conn, err := bgrpc.ClientSetup(config.EventConsumer.SAService, tlsConfig, clientMetrics, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+
+	out := os.Stdout
+	if *outputPath != "" {
+		out, err = os.OpenFile(*outputPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		cmd.FailOnError(err, "Failed to open output file")
+		defer out.Close()
+	}
+
+	c := &consumer{
+		sac:        sapb.NewStorageAuthorityClient(conn),
+		out:        bufio.NewWriter(out),
+		cursorFile: config.EventConsumer.CursorFile,
+		types:      config.EventConsumer.Types,
+		log:        logger,
+	}
+
+	if !*followStream {
+		_, err = c.catchUp(context.Background())
+		cmd.FailOnError(err, "Failed to catch up with event stream")
+		return
+	}
+
+	interval := config.EventConsumer.PollInterval.Duration
+	if interval == 0 {
+		interval = time.Minute
+	}
+	// On a signal, wait for the events being written to be flushed and the
+	// cursor saved before exiting.
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go cmd.CatchSignals(logger, func() {
+		cancel()
+		<-done
+	})
+	c.follow(ctx, clk, interval)
+	close(done)
+}
+
+func init() {
+	cmd.RegisterCommand("event-consumer", main)
+}
@@ -0,0 +1,142 @@
+package notmain
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/mocks"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+	"google.golang.org/grpc"
+)
+
+// mockSA streams the events after the request's cursor, failing with err
+// once they've all been sent if it's set.
+type mockSA struct {
+	mocks.StorageAuthority
+	events   []*sapb.Event
+	err      error
+	requests []*sapb.StreamEventsRequest
+}
+
+type failingEventStream struct {
+	mocks.EventStream
+	err error
+}
+
+func (s *failingEventStream) Recv() (*sapb.Event, error) {
+	event, err := s.EventStream.Recv()
+	if err != nil && s.err != nil {
+		return nil, s.err
+	}
+	return event, err
+}
+
+func (m *mockSA) StreamEvents(_ context.Context, req *sapb.StreamEventsRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamEventsClient, error) {
+	m.requests = append(m.requests, req)
+	var events []*sapb.Event
+	for _, e := range m.events {
+		if e.Id > req.AfterID {
+			events = append(events, e)
+		}
+	}
+	return &failingEventStream{mocks.EventStream{Events: events}, m.err}, nil
+}
+
+func setup(t *testing.T) (*consumer, *mockSA, *bytes.Buffer) {
+	msa := &mockSA{}
+	var out bytes.Buffer
+	c := &consumer{
+		sac:        msa,
+		out:        bufio.NewWriter(&out),
+		cursorFile: filepath.Join(t.TempDir(), "cursor"),
+		log:        blog.NewMock(),
+	}
+	return c, msa, &out
+}
+
+func readLines(t *testing.T, out *bytes.Buffer) []eventLine {
+	t.Helper()
+	var lines []eventLine
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var l eventLine
+		err := json.Unmarshal([]byte(line), &l)
+		test.AssertNotError(t, err, "output line wasn't JSON")
+		lines = append(lines, l)
+	}
+	out.Reset()
+	return lines
+}
+
+func TestCatchUp(t *testing.T) {
+	c, msa, out := setup(t)
+	c.types = []string{"certificateIssued"}
+	created := time.Date(2022, 2, 4, 0, 0, 0, 0, time.UTC)
+	msa.events = []*sapb.Event{
+		{Id: 1, Type: "certificateIssued", Created: created.UnixNano(), RegistrationID: 1, Serial: "01", Details: []byte(`{"names":["example.com"]}`)},
+		{Id: 3, Type: "certificateIssued", Created: created.UnixNano(), RegistrationID: 2, Serial: "02", Details: []byte(`{}`)},
+	}
+
+	written, err := c.catchUp(context.Background())
+	test.AssertNotError(t, err, "catchUp failed")
+	test.AssertEquals(t, written, 2)
+	test.AssertEquals(t, msa.requests[0].AfterID, int64(0))
+	test.AssertDeepEquals(t, msa.requests[0].Types, c.types)
+	lines := readLines(t, out)
+	test.AssertEquals(t, len(lines), 2)
+	test.AssertEquals(t, lines[0].Created, created)
+	test.AssertEquals(t, lines[0].Serial, "01")
+	test.AssertEquals(t, string(lines[0].Details), `{"names":["example.com"]}`)
+	cursor, err := ioutil.ReadFile(c.cursorFile)
+	test.AssertNotError(t, err, "reading cursor file")
+	test.AssertEquals(t, string(cursor), "3\n")
+
+	// The next catch up resumes from the cursor.
+	msa.events = append(msa.events, &sapb.Event{Id: 4, Type: "certificateIssued", Details: []byte(`{}`)})
+	written, err = c.catchUp(context.Background())
+	test.AssertNotError(t, err, "catchUp failed")
+	test.AssertEquals(t, written, 1)
+	test.AssertEquals(t, msa.requests[1].AfterID, int64(3))
+	test.AssertEquals(t, readLines(t, out)[0].ID, int64(4))
+
+	// Catching up with nothing new leaves the cursor alone.
+	written, err = c.catchUp(context.Background())
+	test.AssertNotError(t, err, "catchUp failed")
+	test.AssertEquals(t, written, 0)
+	cursor, err = ioutil.ReadFile(c.cursorFile)
+	test.AssertNotError(t, err, "reading cursor file")
+	test.AssertEquals(t, string(cursor), "4\n")
+}
+
+func TestCatchUpStreamError(t *testing.T) {
+	c, msa, out := setup(t)
+	msa.events = []*sapb.Event{{Id: 1, Type: "accountCreated", Details: []byte(`{}`)}}
+	msa.err = errors.New("stream broke")
+
+	// The events written before the error are flushed and the cursor saved,
+	// so they aren't written again.
+	written, err := c.catchUp(context.Background())
+	test.AssertError(t, err, "catchUp didn't return the stream's error")
+	test.AssertEquals(t, written, 1)
+	test.AssertEquals(t, len(readLines(t, out)), 1)
+	cursor, err := c.readCursor()
+	test.AssertNotError(t, err, "readCursor failed")
+	test.AssertEquals(t, cursor, int64(1))
+
+	err = ioutil.WriteFile(c.cursorFile, []byte("garbage"), 0644)
+	test.AssertNotError(t, err, "writing cursor file")
+	_, err = c.catchUp(context.Background())
+	test.AssertError(t, err, "caught up from an unparseable cursor")
+}
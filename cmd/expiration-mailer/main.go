@@ -8,6 +8,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math"
 	netmail "net/mail"
@@ -25,15 +26,15 @@ import (
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
 	corepb "github.com/letsencrypt/boulder/core/proto"
-	"github.com/letsencrypt/boulder/db"
+	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	blog "github.com/letsencrypt/boulder/log"
 	bmail "github.com/letsencrypt/boulder/mail"
 	"github.com/letsencrypt/boulder/metrics"
-	"github.com/letsencrypt/boulder/sa"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 const (
@@ -41,14 +42,18 @@ const (
 	defaultExpirationSubject = "Let's Encrypt certificate expiration notice for domain {{.ExpirationSubject}}"
 )
 
-type regStore interface {
+// mailerSA is the part of the SA's gRPC interface that the mailer relies on.
+type mailerSA interface {
 	GetRegistration(ctx context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*corepb.Registration, error)
+	GetCertificate(ctx context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error)
+	FQDNSetExists(ctx context.Context, req *sapb.FQDNSetExistsRequest, _ ...grpc.CallOption) (*sapb.Exists, error)
+	StreamExpiringSerials(ctx context.Context, req *sapb.StreamExpiringSerialsRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamExpiringSerialsClient, error)
+	MarkExpirationNagSent(ctx context.Context, req *sapb.MarkExpirationNagSentRequest, _ ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type mailer struct {
 	log             blog.Logger
-	dbMap           *db.WrappedMap
-	rs              regStore
+	sac             mailerSA
 	mailer          bmail.Mailer
 	emailTemplate   *template.Template
 	subjectTemplate *template.Template
@@ -173,27 +178,22 @@ func (m *mailer) sendNags(contacts []string, certs []*x509.Certificate) error {
 }
 
 func (m *mailer) updateCertStatus(serial string) error {
-	_, err := m.dbMap.Exec(
-		"UPDATE certificateStatus SET lastExpirationNagSent = ?  WHERE serial = ?",
-		m.clk.Now(), serial)
+	_, err := m.sac.MarkExpirationNagSent(context.Background(), &sapb.MarkExpirationNagSentRequest{
+		Serial: serial,
+		Sent:   m.clk.Now().UnixNano(),
+	})
 	return err
 }
 
 func (m *mailer) certIsRenewed(names []string, issued time.Time) (bool, error) {
-	namehash := sa.HashNames(names)
-
-	var present bool
-	err := m.dbMap.SelectOne(
-		&present,
-		// TODO(#5670): Remove this OR when the partitioning is fixed.
-		`SELECT EXISTS (SELECT id FROM fqdnSets WHERE setHash = ? AND issued > ? LIMIT 1)
-		OR EXISTS (SELECT id FROM fqdnSets_old WHERE setHash = ? AND issued > ? LIMIT 1)`,
-		namehash,
-		issued,
-		namehash,
-		issued,
-	)
-	return present, err
+	exists, err := m.sac.FQDNSetExists(context.Background(), &sapb.FQDNSetExistsRequest{
+		Domains:     names,
+		IssuedAfter: issued.UnixNano(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return exists.Exists, nil
 }
 
 func (m *mailer) processCerts(allCerts []core.Certificate) {
@@ -217,7 +217,7 @@ func (m *mailer) processCerts(allCerts []core.Certificate) {
 	}()
 
 	for regID, certs := range regIDToCerts {
-		reg, err := m.rs.GetRegistration(ctx, &sapb.RegistrationID{Id: regID})
+		reg, err := m.sac.GetRegistration(ctx, &sapb.RegistrationID{Id: regID})
 		if err != nil {
 			m.log.AuditErrf("Error fetching registration %d: %s", regID, err)
 			m.stats.errorCount.With(prometheus.Labels{"type": "GetRegistration"}).Inc()
@@ -291,29 +291,10 @@ func (m *mailer) findExpiringCertificates() error {
 		m.log.Infof("expiration-mailer: Searching for certificates that expire between %s and %s and had last nag >%s before expiry",
 			left.UTC(), right.UTC(), expiresIn)
 
-		// First we do a query on the certificateStatus table to find certificates
-		// nearing expiry meeting our criteria for email notification. We later
-		// sequentially fetch the certificate details. This avoids an expensive
-		// JOIN.
-		var serials []string
-		_, err := m.dbMap.Select(
-			&serials,
-			`SELECT
-				cs.serial
-				FROM certificateStatus AS cs
-				WHERE cs.notAfter > :cutoffA
-				AND cs.notAfter <= :cutoffB
-				AND cs.status != "revoked"
-				AND COALESCE(TIMESTAMPDIFF(SECOND, cs.lastExpirationNagSent, cs.notAfter) > :nagCutoff, 1)
-				ORDER BY cs.notAfter ASC
-				LIMIT :limit`,
-			map[string]interface{}{
-				"cutoffA":   left,
-				"cutoffB":   right,
-				"nagCutoff": expiresIn.Seconds(),
-				"limit":     m.limit,
-			},
-		)
+		// First we ask the SA for the serials of certificates nearing expiry
+		// meeting our criteria for email notification. We later sequentially
+		// fetch the certificate details. This avoids an expensive JOIN.
+		serials, err := m.expiringSerials(left, right, expiresIn)
 		if err != nil {
 			m.log.AuditErrf("expiration-mailer: Error loading certificate serials: %s", err)
 			return err
@@ -338,17 +319,21 @@ func (m *mailer) findExpiringCertificates() error {
 		// certificate status rows
 		var certs []core.Certificate
 		for _, serial := range serials {
-			var cert core.Certificate
-			cert, err := sa.SelectCertificate(m.dbMap, serial)
+			certPB, err := m.sac.GetCertificate(context.Background(), &sapb.Serial{Serial: serial})
 			if err != nil {
-				// We can get a NoRowsErr when processing a serial number corresponding
-				// to a precertificate with no final certificate. Since this certificate
-				// is not being used by a subscriber, we don't send expiration email about
-				// it.
-				if db.IsNoRows(err) {
+				// We can get a NotFound error when processing a serial number
+				// corresponding to a precertificate with no final certificate. Since
+				// this certificate is not being used by a subscriber, we don't send
+				// expiration email about it.
+				if errors.Is(err, berrors.NotFound) {
 					continue
 				}
-				m.log.AuditErrf("expiration-mailer: Error loading cert %q: %s", cert.Serial, err)
+				m.log.AuditErrf("expiration-mailer: Error loading cert %q: %s", serial, err)
+				return err
+			}
+			cert, err := bgrpc.PBToCert(certPB)
+			if err != nil {
+				m.log.AuditErrf("expiration-mailer: Error loading cert %q: %s", serial, err)
 				return err
 			}
 			certs = append(certs, cert)
@@ -371,6 +356,31 @@ func (m *mailer) findExpiringCertificates() error {
 	return nil
 }
 
+// expiringSerials returns the serials of up to m.limit unrevoked certificates
+// expiring after left and no later than right, which haven't been nagged about
+// within expiresIn of their expiry.
+func (m *mailer) expiringSerials(left, right time.Time, expiresIn time.Duration) ([]string, error) {
+	stream, err := m.sac.StreamExpiringSerials(context.Background(), &sapb.StreamExpiringSerialsRequest{
+		Expires:   &sapb.Range{Earliest: left.UnixNano(), Latest: right.UnixNano()},
+		NagWindow: expiresIn.Nanoseconds(),
+		Limit:     int64(m.limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var serials []string
+	for {
+		serial, err := stream.Recv()
+		if err == io.EOF {
+			return serials, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		serials = append(serials, serial.Serial)
+	}
+}
+
 type durationSlice []time.Duration
 
 func (ds durationSlice) Len() int {
@@ -388,6 +398,8 @@ func (ds durationSlice) Swap(a, b int) {
 type Config struct {
 	Mailer struct {
 		cmd.ServiceConfig
+		// DB is DEPRECATED. Certificates are read from and updated through
+		// the SA, configured by TLS and SAService, and this is ignored.
 		DB cmd.DBConfig
 		cmd.SMTPConfig
 
@@ -506,25 +518,6 @@ func main() {
 		c.Mailer.CertLimit = 100
 	}
 
-	// Configure DB
-	dbURL, err := c.Mailer.DB.URL()
-	cmd.FailOnError(err, "Couldn't load DB URL")
-	dbSettings := sa.DbSettings{
-		MaxOpenConns:    c.Mailer.DB.MaxOpenConns,
-		MaxIdleConns:    c.Mailer.DB.MaxIdleConns,
-		ConnMaxLifetime: c.Mailer.DB.ConnMaxLifetime.Duration,
-		ConnMaxIdleTime: c.Mailer.DB.ConnMaxIdleTime.Duration,
-	}
-	dbMap, err := sa.NewDbMap(dbURL, dbSettings)
-	cmd.FailOnError(err, "Could not connect to database")
-	sa.SetSQLDebug(dbMap, logger)
-
-	dbAddr, dbUser, err := c.Mailer.DB.DSNAddressAndUser()
-	cmd.FailOnError(err, "Could not determine address or user of DB DSN")
-
-	// Collect and periodically report DB metrics using the DBMap and prometheus scope.
-	sa.InitDBMetrics(dbMap.Db, scope, dbSettings, dbAddr, dbUser)
-
 	tlsConfig, err := c.Mailer.TLS.Load()
 	cmd.FailOnError(err, "TLS config")
 
@@ -599,8 +592,7 @@ func main() {
 
 	m := mailer{
 		log:             logger,
-		dbMap:           dbMap,
-		rs:              sac,
+		sac:             sac,
 		mailer:          mailClient,
 		subjectTemplate: subjTmpl,
 		emailTemplate:   tmpl,
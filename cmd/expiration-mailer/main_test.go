@@ -46,6 +46,7 @@ func intFromB64(b64 string) int {
 }
 
 type fakeRegStore struct {
+	sapb.StorageAuthorityClient
 	RegByID map[int64]*corepb.Registration
 }
 
@@ -114,7 +115,7 @@ func TestSendNags(t *testing.T) {
 		emailTemplate: tmpl,
 		// Explicitly override the default subject to use testEmailSubject
 		subjectTemplate: staticTmpl,
-		rs:              rs,
+		sac:             rs,
 		clk:             fc,
 		stats:           initStats(metrics.NoopRegisterer),
 	}
@@ -211,10 +212,16 @@ func TestProcessCerts(t *testing.T) {
 	// Test that the lastExpirationNagSent was updated for the certificate
 	// corresponding to serial4, which is set up as "already renewed" by
 	// addExpiringCerts.
-	if len(log.GetAllMatching("DEBUG: SQL:  UPDATE certificateStatus .*2006-01-02 15:04:05.999999999.*\"000000000000000000000000000000001339\"")) != 1 {
-		t.Errorf("Expected an update to certificateStatus, got these log lines:\n%s",
-			strings.Join(log.GetAllMatching(".*"), "\n"))
-	}
+	var lastNagSent time.Time
+	err := testCtx.dbMap.SelectOne(
+		&lastNagSent,
+		"SELECT lastExpirationNagSent FROM certificateStatus WHERE serial = ?",
+		serial4String,
+	)
+	test.AssertNotError(t, err, "Couldn't select certificateStatus")
+	// The column has a precision of one second.
+	sinceNag := testCtx.fc.Now().Sub(lastNagSent)
+	test.Assert(t, sinceNag > -time.Second && sinceNag < time.Second, "lastExpirationNagSent wasn't updated")
 }
 
 func TestFindExpiringCertificates(t *testing.T) {
@@ -819,8 +826,7 @@ func setup(t *testing.T, nagTimes []time.Duration) *testCtx {
 		mailer:          mc,
 		emailTemplate:   tmpl,
 		subjectTemplate: subjTmpl,
-		dbMap:           dbMap,
-		rs:              isa.SA{Impl: ssa},
+		sac:             isa.SA{Impl: ssa},
 		nagTimes:        offsetNags,
 		limit:           100,
 		clk:             fc,
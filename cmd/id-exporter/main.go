@@ -2,27 +2,37 @@ package notmain
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"strings"
 	"time"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/jmhodges/clock"
 	"github.com/letsencrypt/boulder/cmd"
-	"github.com/letsencrypt/boulder/db"
 	"github.com/letsencrypt/boulder/features"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
 	blog "github.com/letsencrypt/boulder/log"
-	"github.com/letsencrypt/boulder/sa"
+	"github.com/letsencrypt/boulder/metrics"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/grpc"
 )
 
+// registrationStreamer is the part of the SA's gRPC interface that the
+// id-exporter relies on.
+type registrationStreamer interface {
+	StreamRegistrationsWithContacts(ctx context.Context, req *sapb.StreamRegistrationsWithContactsRequest, opts ...grpc.CallOption) (sapb.StorageAuthority_StreamRegistrationsWithContactsClient, error)
+	StreamRegistrationsWithNames(ctx context.Context, req *sapb.StreamRegistrationsWithNamesRequest, opts ...grpc.CallOption) (sapb.StorageAuthority_StreamRegistrationsWithNamesClient, error)
+}
+
 type idExporter struct {
 	log   blog.Logger
-	dbMap *db.WrappedMap
+	sac   registrationStreamer
 	clk   clock.Clock
 	grace time.Duration
 }
@@ -38,14 +48,7 @@ type resultEntry struct {
 	Hostname string `json:"hostname,omitempty"`
 }
 
-// reverseHostname converts (reversed) names sourced from the
-// registrations table to standard hostnames.
-func (r *resultEntry) reverseHostname() {
-	r.Hostname = sa.ReverseName(r.Hostname)
-}
-
-// idExporterResults is passed as a selectable 'holder' for the results
-// of id-exporter database queries
+// idExporterResults holds the results of id-exporter queries
 type idExporterResults []*resultEntry
 
 // marshalToJSON returns JSON as bytes for all elements of the inner `id`
@@ -71,48 +74,59 @@ func (i *idExporterResults) writeToFile(outfile string) error {
 
 // findIDs gathers all registration IDs with unexpired certificates.
 func (c idExporter) findIDs() (idExporterResults, error) {
-	var holder idExporterResults
-	_, err := c.dbMap.Select(
-		&holder,
-		`SELECT DISTINCT r.id
-		FROM registrations AS r
-			INNER JOIN certificates AS c on c.registrationID = r.id
-		WHERE r.contact NOT IN ('[]', 'null')
-			AND c.expires >= :expireCutoff;`,
-		map[string]interface{}{
-			"expireCutoff": c.clk.Now().Add(-c.grace),
-		})
+	stream, err := c.sac.StreamRegistrationsWithContacts(context.Background(), &sapb.StreamRegistrationsWithContactsRequest{
+		ExpiresAfter: c.clk.Now().Add(-c.grace).UnixNano(),
+	})
 	if err != nil {
 		c.log.AuditErrf("Error finding IDs: %s", err)
 		return nil, err
 	}
-	return holder, nil
+	var holder idExporterResults
+	for {
+		reg, err := stream.Recv()
+		if err == io.EOF {
+			return holder, nil
+		}
+		if err != nil {
+			c.log.AuditErrf("Error finding IDs: %s", err)
+			return nil, err
+		}
+		holder = append(holder, &resultEntry{ID: reg.Id})
+	}
+}
+
+// findIDsWithNames gathers the registration IDs with unexpired certificates,
+// each with one of the names in their certificates. If hostname is given, only
+// certificates for it count.
+func (c idExporter) findIDsWithNames(hostname string) (idExporterResults, error) {
+	stream, err := c.sac.StreamRegistrationsWithNames(context.Background(), &sapb.StreamRegistrationsWithNamesRequest{
+		ExpiresAfter: c.clk.Now().Add(-c.grace).UnixNano(),
+		Name:         hostname,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var holder idExporterResults
+	for {
+		reg, err := stream.Recv()
+		if err == io.EOF {
+			return holder, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		holder = append(holder, &resultEntry{ID: reg.RegistrationID, Hostname: reg.Name})
+	}
 }
 
 // findIDsWithExampleHostnames gathers all registration IDs with
 // unexpired certificates and a corresponding example hostname.
 func (c idExporter) findIDsWithExampleHostnames() (idExporterResults, error) {
-	var holder idExporterResults
-	_, err := c.dbMap.Select(
-		&holder,
-		`SELECT SQL_BIG_RESULT
-			cert.registrationID AS id,
-			name.reversedName AS hostname
-		FROM certificates AS cert
-			INNER JOIN issuedNames AS name ON name.serial = cert.serial
-		WHERE cert.expires >= :expireCutoff
-		GROUP BY cert.registrationID;`,
-		map[string]interface{}{
-			"expireCutoff": c.clk.Now().Add(-c.grace),
-		})
+	holder, err := c.findIDsWithNames("")
 	if err != nil {
 		c.log.AuditErrf("Error finding IDs and example hostnames: %s", err)
 		return nil, err
 	}
-
-	for _, result := range holder {
-		result.reverseHostname()
-	}
 	return holder, nil
 }
 
@@ -121,27 +135,14 @@ func (c idExporter) findIDsWithExampleHostnames() (idExporterResults, error) {
 func (c idExporter) findIDsForHostnames(hostnames []string) (idExporterResults, error) {
 	var holder idExporterResults
 	for _, hostname := range hostnames {
-		// Pass the same list in each time, gorp will happily just append to the slice
-		// instead of overwriting it each time
-		// https://github.com/go-gorp/gorp/blob/2ae7d174a4cf270240c4561092402affba25da5e/select.go#L348-L355
-		_, err := c.dbMap.Select(
-			&holder,
-			`SELECT DISTINCT c.registrationID AS id
-			FROM certificates AS c
-				INNER JOIN issuedNames AS n ON c.serial = n.serial
-			WHERE c.expires >= :expireCutoff
-				AND n.reversedName = :reversedName;`,
-			map[string]interface{}{
-				"expireCutoff": c.clk.Now().Add(-c.grace),
-				"reversedName": sa.ReverseName(hostname),
-			},
-		)
+		results, err := c.findIDsWithNames(hostname)
 		if err != nil {
-			if db.IsNoRows(err) {
-				continue
-			}
 			return nil, err
 		}
+		// Only the IDs are exported for hostnames, as in findIDs.
+		for _, result := range results {
+			holder = append(holder, &resultEntry{ID: result.ID})
+		}
 	}
 
 	return holder, nil
@@ -224,7 +225,10 @@ func unmarshalHostnames(filePath string) ([]string, error) {
 
 type Config struct {
 	ContactExporter struct {
-		DB cmd.DBConfig
+		// TLS configures the client certificate used to authenticate to the
+		// SA.
+		TLS       cmd.TLSConfig
+		SAService *cmd.GRPCClientConfig
 		cmd.PasswordConfig
 		Features map[string]bool
 	}
@@ -237,8 +241,8 @@ func main() {
 		"hostnames", "", "Only include results with unexpired certificates that contain hostnames\nlisted (newline separated) in this file.")
 	withExampleHostnames := flag.Bool(
 		"with-example-hostnames", false, "Include an example hostname for each registration ID with an unexpired certificate.")
-	useDefaultIsolationLevel := flag.Bool(
-		"use-default-isolation-level", false, "Do not override database transaction isolation level to READ UNCOMMITTED")
+	_ = flag.Bool(
+		"use-default-isolation-level", false, "Deprecated and ignored. Registrations are read from the SA.")
 	configFile := flag.String("config", "", "File containing a JSON config.")
 
 	flag.Usage = func() {
@@ -268,36 +272,16 @@ func main() {
 	err = features.Set(cfg.ContactExporter.Features)
 	cmd.FailOnError(err, "Failed to set feature flags")
 
-	// Setup database client.
-	dbURL, err := cfg.ContactExporter.DB.URL()
-	cmd.FailOnError(err, "Couldn't load DB URL")
-
-	if !*useDefaultIsolationLevel {
-		conf, err := mysql.ParseDSN(dbURL)
-		cmd.FailOnError(err, "Couldn't parse DB URL as DSN")
-
-		// Transaction isolation level READ UNCOMMITTED trades consistency for
-		// performance.
-		if len(conf.Params) == 0 {
-			conf.Params = make(map[string]string)
-		}
-		conf.Params["tx_isolation"] = "'READ-UNCOMMITTED'"
-		dbURL = conf.FormatDSN()
-	}
-
-	dbSettings := sa.DbSettings{
-		MaxOpenConns:    cfg.ContactExporter.DB.MaxOpenConns,
-		MaxIdleConns:    cfg.ContactExporter.DB.MaxIdleConns,
-		ConnMaxLifetime: cfg.ContactExporter.DB.ConnMaxLifetime.Duration,
-		ConnMaxIdleTime: cfg.ContactExporter.DB.ConnMaxIdleTime.Duration,
-	}
-
-	dbMap, err := sa.NewDbMap(dbURL, dbSettings)
-	cmd.FailOnError(err, "Could not connect to database")
+	// Setup SA client.
+	tlsConfig, err := cfg.ContactExporter.TLS.Load()
+	cmd.FailOnError(err, "TLS config")
+	clientMetrics := bgrpc.NewClientMetrics(metrics.NoopRegisterer)
+	conn, err := bgrpc.ClientSetup(cfg.ContactExporter.SAService, tlsConfig, clientMetrics, cmd.Clock())
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
 
 	exporter := idExporter{
 		log:   log,
-		dbMap: dbMap,
+		sac:   sapb.NewStorageAuthorityClient(conn),
 		clk:   cmd.Clock(),
 		grace: *grace,
 	}
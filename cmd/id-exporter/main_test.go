@@ -18,6 +18,7 @@ import (
 	"github.com/jmhodges/clock"
 	"github.com/letsencrypt/boulder/core"
 	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/db"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/sa"
@@ -241,6 +242,7 @@ func Test_unmarshalHostnames(t *testing.T) {
 type testCtx struct {
 	c       idExporter
 	ssa     sapb.StorageAuthorityClient
+	dbMap   *db.WrappedMap
 	cleanUp func()
 }
 
@@ -353,9 +355,9 @@ func (tc testCtx) addCertificates(t *testing.T) {
 		Expires:        rawCertA.NotAfter,
 		DER:            certDerA,
 	}
-	err := tc.c.dbMap.Insert(certA)
+	err := tc.dbMap.Insert(certA)
 	test.AssertNotError(t, err, "Couldn't add certA")
-	_, err = tc.c.dbMap.Exec(
+	_, err = tc.dbMap.Exec(
 		"INSERT INTO issuedNames (reversedName, serial, notBefore) VALUES (?,?,0)",
 		"com.example-a",
 		serial1String,
@@ -378,9 +380,9 @@ func (tc testCtx) addCertificates(t *testing.T) {
 		Expires:        rawCertB.NotAfter,
 		DER:            certDerB,
 	}
-	err = tc.c.dbMap.Insert(certB)
+	err = tc.dbMap.Insert(certB)
 	test.AssertNotError(t, err, "Couldn't add certB")
-	_, err = tc.c.dbMap.Exec(
+	_, err = tc.dbMap.Exec(
 		"INSERT INTO issuedNames (reversedName, serial, notBefore) VALUES (?,?,0)",
 		"com.example-b",
 		serial2String,
@@ -403,9 +405,9 @@ func (tc testCtx) addCertificates(t *testing.T) {
 		Expires:        rawCertC.NotAfter,
 		DER:            certDerC,
 	}
-	err = tc.c.dbMap.Insert(certC)
+	err = tc.dbMap.Insert(certC)
 	test.AssertNotError(t, err, "Couldn't add certC")
-	_, err = tc.c.dbMap.Exec(
+	_, err = tc.dbMap.Exec(
 		"INSERT INTO issuedNames (reversedName, serial, notBefore) VALUES (?,?,0)",
 		"com.example-c",
 		serial3String,
@@ -428,9 +430,9 @@ func (tc testCtx) addCertificates(t *testing.T) {
 		Expires:        rawCertD.NotAfter,
 		DER:            certDerD,
 	}
-	err = tc.c.dbMap.Insert(certD)
+	err = tc.dbMap.Insert(certD)
 	test.AssertNotError(t, err, "Couldn't add certD")
-	_, err = tc.c.dbMap.Exec(
+	_, err = tc.dbMap.Exec(
 		"INSERT INTO issuedNames (reversedName, serial, notBefore) VALUES (?,?,0)",
 		"com.example-d",
 		serial4String,
@@ -456,11 +458,12 @@ func setup(t *testing.T) testCtx {
 
 	return testCtx{
 		c: idExporter{
-			dbMap: dbMap,
-			log:   log,
-			clk:   fc,
+			sac: isa.SA{Impl: ssa},
+			log: log,
+			clk: fc,
 		},
 		ssa:     isa.SA{Impl: ssa},
+		dbMap:   dbMap,
 		cleanUp: cleanUp,
 	}
 }
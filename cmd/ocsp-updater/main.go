@@ -12,6 +12,7 @@ import (
 
 	capb "github.com/letsencrypt/boulder/ca/proto"
 	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/db"
 	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/ocsp_updater"
@@ -34,6 +35,16 @@ func configureDb(dbConfig cmd.DBConfig) (*sql.DB, error) {
 		return nil, fmt.Errorf("while loading DSN from 'DBConnectFile': %s", err)
 	}
 
+	// A SQLite database has no isolation levels to relax, so it's opened the
+	// same way as the SA's.
+	if db.IsSQLiteDSN(dsn) {
+		dbMap, err := sa.NewDbMap(dsn, sa.NewDbSettingsFromDBConfig(dbConfig))
+		if err != nil {
+			return nil, fmt.Errorf("couldn't open SQLite database: %s", err)
+		}
+		return dbMap.Db, nil
+	}
+
 	conf, err := mysql.ParseDSN(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("while parsing DSN from 'DBConnectFile': %s", err)
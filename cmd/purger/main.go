@@ -0,0 +1,407 @@
+package notmain
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/honeycombio/beeline-go"
+	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/db"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/sa"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var rowsDeleted = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "purger_rows_deleted",
+	Help: "A counter of rows deleted by the purger, labelled by table",
+}, []string{"table"})
+
+var replicaLagPauses = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "purger_replica_lag_pauses",
+	Help: "A counter of the times the purger paused because the read-only database's lag was too high or unknown",
+})
+
+// target describes a table which can be purged. Rows are selected by the
+// expiry of the object they belong to, never by their creation time, so
+// nothing which is still unexpired, and therefore still needed for revocation
+// or OCSP, can be deleted.
+type target struct {
+	table string
+	// selectIDs selects the IDs of up to a batch of rows which expired before
+	// a cutoff. Its arguments are the cutoff, the ID to start after and the
+	// batch size.
+	selectIDs string
+	// count counts the rows which expired before the cutoff, its only
+	// argument.
+	count string
+	// children are the tables, and their column referring to the target's ID,
+	// whose rows are deleted along with the target's.
+	children [][2]string
+}
+
+// targets lists the tables which can be purged, by the name used for them in
+// the Retention config.
+var targets = map[string]target{
+	"authz2": {
+		table:     "authz2",
+		selectIDs: `SELECT id FROM authz2 WHERE expires < ? AND id > ? ORDER BY id LIMIT ?`,
+		count:     `SELECT COUNT(1) FROM authz2 WHERE expires < ?`,
+	},
+	"orders": {
+		table:     "orders",
+		selectIDs: `SELECT id FROM orders WHERE expires < ? AND id > ? ORDER BY id LIMIT ?`,
+		count:     `SELECT COUNT(1) FROM orders WHERE expires < ?`,
+		children: [][2]string{
+			{"orderToAuthz2", "orderID"},
+			{"orderFqdnSets", "orderID"},
+			{"requestedNames", "orderID"},
+			{"orderApprovals", "orderID"},
+		},
+	},
+	"certificates": {
+		table:     "certificates",
+		selectIDs: `SELECT id FROM certificates WHERE expires < ? AND id > ? ORDER BY id LIMIT ?`,
+		count:     `SELECT COUNT(1) FROM certificates WHERE expires < ?`,
+	},
+	"precertificates": {
+		table:     "precertificates",
+		selectIDs: `SELECT id FROM precertificates WHERE expires < ? AND id > ? ORDER BY id LIMIT ?`,
+		count:     `SELECT COUNT(1) FROM precertificates WHERE expires < ?`,
+	},
+	"fqdnSets": {
+		table:     "fqdnSets",
+		selectIDs: `SELECT id FROM fqdnSets WHERE expires < ? AND id > ? ORDER BY id LIMIT ?`,
+		count:     `SELECT COUNT(1) FROM fqdnSets WHERE expires < ?`,
+	},
+	"keyHashToSerial": {
+		table:     "keyHashToSerial",
+		selectIDs: `SELECT id FROM keyHashToSerial WHERE certNotAfter < ? AND id > ? ORDER BY id LIMIT ?`,
+		count:     `SELECT COUNT(1) FROM keyHashToSerial WHERE certNotAfter < ?`,
+	},
+	// issuedNames has no expiry of its own, so it uses that of the serial.
+	"issuedNames": {
+		table: "issuedNames",
+		selectIDs: `SELECT i.id FROM issuedNames AS i
+			JOIN serials AS s ON s.serial = i.serial
+			WHERE s.expires < ? AND i.id > ?
+			ORDER BY i.id LIMIT ?`,
+		count: `SELECT COUNT(1) FROM issuedNames AS i
+			JOIN serials AS s ON s.serial = i.serial
+			WHERE s.expires < ?`,
+	},
+}
+
+type Config struct {
+	Purger struct {
+		DB        cmd.DBConfig
+		DebugAddr string
+
+		// Retention maps the tables to purge to how long after their expiry
+		// rows are kept. Tables which aren't listed are never purged. The keys
+		// are the names in targets: purging orders also purges orderToAuthz2,
+		// orderFqdnSets, requestedNames and orderApprovals.
+		Retention map[string]cmd.ConfigDuration
+
+		// RateLimitWindow must be at least the longest rate limit window,
+		// including the automatic suspension window. Every retention period
+		// must be at least this long, so that rows which rate limits count are
+		// never deleted.
+		RateLimitWindow cmd.ConfigDuration
+
+		// BatchSize is the maximum number of rows deleted by each statement.
+		// Defaults to 1000.
+		BatchSize int
+		// BatchPause is how long to sleep after each batch, to give replicas
+		// time to catch up.
+		BatchPause cmd.ConfigDuration
+
+		// MaxReplicaLag, if set, pauses purging after each batch, for as long
+		// as the read-only database's replication lag isn't known to be below
+		// it. It requires ReadOnlyDB.
+		MaxReplicaLag cmd.ConfigDuration
+		// ReadOnlyDB is the replica whose lag is measured, using the
+		// replicationHeartbeats table, when MaxReplicaLag is set.
+		ReadOnlyDB cmd.DBConfig
+		// ReplicaLagInterval is how often the lag is measured. Defaults to
+		// one second.
+		ReplicaLagInterval cmd.ConfigDuration
+
+		// Interval is how long to sleep between passes over all of the tables.
+		// If zero the purger makes a single pass and exits.
+		Interval cmd.ConfigDuration
+	}
+
+	Syslog  cmd.SyslogConfig
+	Beeline cmd.BeelineConfig
+}
+
+// validateRetention returns an error if the retention config names an unknown
+// table, keeps rows for less than the rate limit window, or would purge
+// authorizations which orders that are kept still refer to.
+func validateRetention(retention map[string]cmd.ConfigDuration, rateLimitWindow time.Duration) error {
+	if rateLimitWindow <= 0 {
+		return fmt.Errorf("rateLimitWindow must be set")
+	}
+	for name, period := range retention {
+		if _, ok := targets[name]; !ok {
+			return fmt.Errorf("unknown table %q in retention", name)
+		}
+		if period.Duration < rateLimitWindow {
+			return fmt.Errorf("retention for %s (%s) is shorter than the rate limit window (%s)", name, period.Duration, rateLimitWindow)
+		}
+	}
+	if authzs, ok := retention["authz2"]; ok {
+		orders, ok := retention["orders"]
+		if !ok || orders.Duration > authzs.Duration {
+			return fmt.Errorf("orders must be retained for no longer than authz2")
+		}
+	}
+	return nil
+}
+
+// lagMonitor is the part of sa.ReplicaLagMonitor used by the purger.
+type lagMonitor interface {
+	Lag() (time.Duration, bool)
+}
+
+type purger struct {
+	dbMap      *db.WrappedMap
+	log        blog.Logger
+	clk        clock.Clock
+	batchSize  int
+	batchPause time.Duration
+
+	// replicaLag, if set, is checked after each batch, with purging paused
+	// while the lag isn't known to be below maxReplicaLag. It's checked every
+	// lagInterval while paused.
+	replicaLag    lagMonitor
+	maxReplicaLag time.Duration
+	lagInterval   time.Duration
+}
+
+// count returns the number of rows in the target which expired before the
+// cutoff.
+func (p *purger) count(ctx context.Context, t target, cutoff time.Time) (int64, error) {
+	return p.dbMap.WithContext(ctx).SelectInt(t.count, cutoff)
+}
+
+// waitForReplica returns once the replica's lag is known to be below
+// maxReplicaLag, or immediately if the lag isn't being monitored.
+func (p *purger) waitForReplica(ctx context.Context) error {
+	if p.replicaLag == nil {
+		return nil
+	}
+	paused := false
+	for {
+		lag, ok := p.replicaLag.Lag()
+		if ok && lag < p.maxReplicaLag {
+			return nil
+		}
+		if !paused {
+			paused = true
+			replicaLagPauses.Inc()
+			if ok {
+				p.log.Infof("Pausing while replica lag (%s) is at least %s", lag, p.maxReplicaLag)
+			} else {
+				p.log.Infof("Pausing while replica lag is unknown")
+			}
+		}
+		err := ctx.Err()
+		if err != nil {
+			return err
+		}
+		p.clk.Sleep(p.lagInterval)
+	}
+}
+
+// purge deletes all rows from the target, and their children, which expired
+// before the cutoff, in batches of batchSize, sleeping for batchPause after
+// each and then waiting for the replica to catch up. It returns the number of
+// rows deleted from the target.
+func (p *purger) purge(ctx context.Context, t target, cutoff time.Time) (int64, error) {
+	var total int64
+	var lastID int64
+	for {
+		var ids []int64
+		_, err := p.dbMap.WithContext(ctx).Select(&ids, t.selectIDs, cutoff, lastID, p.batchSize)
+		if err != nil {
+			return total, fmt.Errorf("selecting rows from %s: %w", t.table, err)
+		}
+		if len(ids) == 0 {
+			return total, nil
+		}
+		lastID = ids[len(ids)-1]
+
+		qmarks := make([]string, len(ids))
+		args := make([]interface{}, len(ids))
+		for i, id := range ids {
+			qmarks[i] = "?"
+			args[i] = id
+		}
+		in := strings.Join(qmarks, ",")
+		_, err = db.WithTransaction(ctx, p.dbMap, func(tx db.Executor) (interface{}, error) {
+			for _, child := range t.children {
+				res, err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s IN (%s)", child[0], child[1], in), args...)
+				if err != nil {
+					return nil, fmt.Errorf("deleting rows from %s: %w", child[0], err)
+				}
+				n, _ := res.RowsAffected()
+				rowsDeleted.WithLabelValues(child[0]).Add(float64(n))
+			}
+			res, err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE id IN (%s)", t.table, in), args...)
+			if err != nil {
+				return nil, fmt.Errorf("deleting rows from %s: %w", t.table, err)
+			}
+			n, _ := res.RowsAffected()
+			rowsDeleted.WithLabelValues(t.table).Add(float64(n))
+			total += n
+			return nil, nil
+		})
+		if err != nil {
+			return total, err
+		}
+		p.clk.Sleep(p.batchPause)
+		err = p.waitForReplica(ctx)
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// run makes a single pass over the tables in retention, purging each or, if
+// dryRun is set, only logging how many rows would be purged.
+func (p *purger) run(ctx context.Context, retention map[string]cmd.ConfigDuration, dryRun bool) error {
+	var names []string
+	for name := range retention {
+		names = append(names, name)
+	}
+	// Purge in a stable order, with orders before the authorizations they
+	// refer to.
+	sort.Slice(names, func(i, j int) bool {
+		if (names[i] == "orders") != (names[j] == "orders") {
+			return names[i] == "orders"
+		}
+		return names[i] < names[j]
+	})
+	for _, name := range names {
+		t := targets[name]
+		cutoff := p.clk.Now().Add(-retention[name].Duration)
+		if dryRun {
+			n, err := p.count(ctx, t, cutoff)
+			if err != nil {
+				return fmt.Errorf("counting rows in %s: %w", t.table, err)
+			}
+			p.log.Infof("Dry run: would purge %d rows from %s which expired before %s", n, t.table, cutoff)
+			continue
+		}
+		n, err := p.purge(ctx, t, cutoff)
+		if err != nil {
+			return err
+		}
+		p.log.Infof("Purged %d rows from %s which expired before %s", n, t.table, cutoff)
+	}
+	return nil
+}
+
+func main() {
+	configPath := flag.String("config", "", "File path to the configuration file for this service")
+	dryRun := flag.Bool("dry-run", false, "Log how many rows would be purged from each table, then exit")
+	flag.Parse()
+
+	if *configPath == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+	var config Config
+	err := cmd.ReadConfigFile(*configPath, &config)
+	cmd.FailOnError(err, "Failed reading config file")
+
+	err = validateRetention(config.Purger.Retention, config.Purger.RateLimitWindow.Duration)
+	cmd.FailOnError(err, "Invalid retention config")
+
+	bc, err := config.Beeline.Load()
+	cmd.FailOnError(err, "Failed to load Beeline config")
+	beeline.Init(bc)
+	defer beeline.Close()
+
+	scope, logger := cmd.StatsAndLogging(config.Syslog, config.Purger.DebugAddr)
+	defer logger.AuditPanic()
+	logger.Info(cmd.VersionString())
+	scope.MustRegister(rowsDeleted)
+	scope.MustRegister(replicaLagPauses)
+
+	dbURL, err := config.Purger.DB.URL()
+	cmd.FailOnError(err, "Couldn't load DB URL")
+	dbSettings := sa.DbSettings{
+		MaxOpenConns:    config.Purger.DB.MaxOpenConns,
+		MaxIdleConns:    config.Purger.DB.MaxIdleConns,
+		ConnMaxLifetime: config.Purger.DB.ConnMaxLifetime.Duration,
+		ConnMaxIdleTime: config.Purger.DB.ConnMaxIdleTime.Duration,
+	}
+	dbMap, err := sa.NewDbMap(dbURL, dbSettings)
+	cmd.FailOnError(err, "Could not connect to database")
+	sa.SetSQLDebug(dbMap, logger)
+
+	dbAddr, dbUser, err := config.Purger.DB.DSNAddressAndUser()
+	cmd.FailOnError(err, "Could not determine address or user of DB DSN")
+	sa.InitDBMetrics(dbMap.Db, scope, dbSettings, dbAddr, dbUser)
+
+	p := &purger{
+		dbMap:      dbMap,
+		log:        logger,
+		clk:        cmd.Clock(),
+		batchSize:  config.Purger.BatchSize,
+		batchPause: config.Purger.BatchPause.Duration,
+	}
+	if p.batchSize <= 0 {
+		p.batchSize = 1000
+	}
+
+	if config.Purger.MaxReplicaLag.Duration > 0 {
+		roURL, err := config.Purger.ReadOnlyDB.URL()
+		cmd.FailOnError(err, "Couldn't load read-only DB URL")
+		if roURL == "" {
+			cmd.Fail("maxReplicaLag requires readOnlyDB")
+		}
+		roSettings := sa.NewDbSettingsFromDBConfig(config.Purger.ReadOnlyDB)
+		roMap, err := sa.NewDbMap(roURL, roSettings)
+		cmd.FailOnError(err, "Could not connect to read-only database")
+		sa.SetSQLDebug(roMap, logger)
+
+		roAddr, roUser, err := config.Purger.ReadOnlyDB.DSNAddressAndUser()
+		cmd.FailOnError(err, "Could not determine address or user of read-only DB DSN")
+		sa.InitDBMetrics(roMap.Db, scope, roSettings, roAddr, roUser)
+
+		p.maxReplicaLag = config.Purger.MaxReplicaLag.Duration
+		p.lagInterval = config.Purger.ReplicaLagInterval.Duration
+		if p.lagInterval <= 0 {
+			p.lagInterval = time.Second
+		}
+		monitor := sa.NewReplicaLagMonitor(dbMap, roMap, p.lagInterval, p.clk, logger, scope)
+		go monitor.Run()
+		p.replicaLag = monitor
+	}
+
+	for {
+		err = p.run(context.Background(), config.Purger.Retention, *dryRun)
+		if *dryRun || config.Purger.Interval.Duration == 0 {
+			cmd.FailOnError(err, "Purging failed")
+			return
+		}
+		if err != nil {
+			logger.AuditErrf("Purging failed: %s", err)
+		}
+		p.clk.Sleep(config.Purger.Interval.Duration)
+	}
+}
+
+func init() {
+	cmd.RegisterCommand("purger", main)
+}
@@ -0,0 +1,189 @@
+package notmain
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/db"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/sa"
+	"github.com/letsencrypt/boulder/test"
+	"github.com/letsencrypt/boulder/test/vars"
+)
+
+func TestValidateRetention(t *testing.T) {
+	day := 24 * time.Hour
+	d := func(d time.Duration) cmd.ConfigDuration { return cmd.ConfigDuration{Duration: d} }
+
+	testCases := []struct {
+		name      string
+		retention map[string]cmd.ConfigDuration
+		window    time.Duration
+		expectErr bool
+	}{
+		{"valid", map[string]cmd.ConfigDuration{"certificates": d(90 * day), "orders": d(30 * day), "authz2": d(30 * day)}, 30 * day, false},
+		{"no window", map[string]cmd.ConfigDuration{"certificates": d(90 * day)}, 0, true},
+		{"unknown table", map[string]cmd.ConfigDuration{"registrations": d(90 * day)}, 30 * day, true},
+		{"child table", map[string]cmd.ConfigDuration{"orderToAuthz2": d(90 * day)}, 30 * day, true},
+		{"shorter than window", map[string]cmd.ConfigDuration{"fqdnSets": d(7 * day)}, 30 * day, true},
+		{"authzs without orders", map[string]cmd.ConfigDuration{"authz2": d(30 * day)}, 30 * day, true},
+		{"orders kept longer than authzs", map[string]cmd.ConfigDuration{"authz2": d(30 * day), "orders": d(60 * day)}, 30 * day, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateRetention(tc.retention, tc.window)
+			if tc.expectErr {
+				test.AssertError(t, err, "invalid retention config was accepted")
+			} else {
+				test.AssertNotError(t, err, "valid retention config was rejected")
+			}
+		})
+	}
+}
+
+func insertRegistration(t *testing.T, dbMap *db.WrappedMap, fc clock.Clock) int64 {
+	t.Helper()
+	jwkHash := make([]byte, 8)
+	_, err := rand.Read(jwkHash)
+	test.AssertNotError(t, err, "failed to read rand")
+	res, err := dbMap.Exec(
+		"INSERT INTO registrations (jwk, jwk_sha256, contact, agreement, initialIP, createdAt, status, LockCol) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		[]byte{}, fmt.Sprintf("%x", jwkHash), "[]", "yes", []byte{}, fc.Now(), "valid", 0,
+	)
+	test.AssertNotError(t, err, "failed to insert registration")
+	regID, err := res.LastInsertId()
+	test.AssertNotError(t, err, "failed to get registration ID")
+	return regID
+}
+
+// insertOrder inserts an order, one authorization for it and its requested
+// name, all expiring at expires, and returns the order's ID.
+func insertOrder(t *testing.T, dbMap *db.WrappedMap, fc clock.Clock, regID int64, expires time.Time) int64 {
+	t.Helper()
+	res, err := dbMap.Exec(
+		"INSERT INTO orders (registrationID, expires, created) VALUES (?, ?, ?)",
+		regID, expires, fc.Now(),
+	)
+	test.AssertNotError(t, err, "failed to insert order")
+	orderID, err := res.LastInsertId()
+	test.AssertNotError(t, err, "failed to get order ID")
+
+	token := make([]byte, 32)
+	_, err = rand.Read(token)
+	test.AssertNotError(t, err, "failed to read rand")
+	res, err = dbMap.Exec(
+		"INSERT INTO authz2 (identifierType, identifierValue, registrationID, status, expires, challenges, token) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		0, "example.com", regID, 1, expires, 1, token,
+	)
+	test.AssertNotError(t, err, "failed to insert authorization")
+	authzID, err := res.LastInsertId()
+	test.AssertNotError(t, err, "failed to get authorization ID")
+
+	_, err = dbMap.Exec("INSERT INTO orderToAuthz2 (orderID, authzID) VALUES (?, ?)", orderID, authzID)
+	test.AssertNotError(t, err, "failed to insert orderToAuthz2")
+	_, err = dbMap.Exec("INSERT INTO requestedNames (orderID, reversedName) VALUES (?, ?)", orderID, "com.example")
+	test.AssertNotError(t, err, "failed to insert requested name")
+	return orderID
+}
+
+func countRows(t *testing.T, dbMap *db.WrappedMap, table string) int64 {
+	t.Helper()
+	n, err := dbMap.SelectInt(fmt.Sprintf("SELECT COUNT(1) FROM %s", table))
+	test.AssertNotError(t, err, "failed to count rows")
+	return n
+}
+
+func TestPurge(t *testing.T) {
+	dbMap, err := sa.NewDbMap(vars.DBConnSAFullPerms, sa.DbSettings{})
+	test.AssertNotError(t, err, "failed setting up db client")
+	defer test.ResetSATestDatabase(t)()
+
+	fc := clock.NewFake()
+	fc.Set(time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC))
+	regID := insertRegistration(t, dbMap, fc)
+
+	// Two orders expired long ago, one recently and one is unexpired.
+	for _, expires := range []time.Time{
+		fc.Now().Add(-100 * 24 * time.Hour),
+		fc.Now().Add(-90 * 24 * time.Hour),
+		fc.Now().Add(-time.Hour),
+		fc.Now().Add(time.Hour),
+	} {
+		insertOrder(t, dbMap, fc, regID, expires)
+	}
+
+	p := &purger{
+		dbMap:      dbMap,
+		log:        blog.NewMock(),
+		clk:        fc,
+		batchSize:  1,
+		batchPause: time.Second,
+	}
+	retention := map[string]cmd.ConfigDuration{
+		"orders": {Duration: 30 * 24 * time.Hour},
+		"authz2": {Duration: 30 * 24 * time.Hour},
+	}
+
+	// A dry run only counts.
+	err = p.run(context.Background(), retention, true)
+	test.AssertNotError(t, err, "dry run failed")
+	test.AssertEquals(t, len(p.log.(*blog.Mock).GetAllMatching("Dry run: would purge 2 rows from orders")), 1)
+	test.AssertEquals(t, countRows(t, dbMap, "orders"), int64(4))
+
+	start := fc.Now()
+	err = p.run(context.Background(), retention, false)
+	test.AssertNotError(t, err, "purge failed")
+	for _, table := range []string{"orders", "authz2", "orderToAuthz2", "requestedNames"} {
+		test.AssertEquals(t, countRows(t, dbMap, table), int64(2))
+	}
+	// Each table was purged in two batches of one, with a pause after each.
+	test.AssertEquals(t, fc.Since(start), 4*time.Second)
+}
+
+// fakeLag returns each of its lags in turn, and then the last forever. A
+// negative lag is returned as unknown.
+type fakeLag struct {
+	lags []time.Duration
+}
+
+func (f *fakeLag) Lag() (time.Duration, bool) {
+	lag := f.lags[0]
+	if len(f.lags) > 1 {
+		f.lags = f.lags[1:]
+	}
+	return lag, lag >= 0
+}
+
+func TestWaitForReplica(t *testing.T) {
+	fc := clock.NewFake()
+	p := &purger{
+		log:           blog.NewMock(),
+		clk:           fc,
+		maxReplicaLag: 2 * time.Second,
+		lagInterval:   time.Second,
+	}
+
+	// Without a monitor there's no waiting.
+	err := p.waitForReplica(context.Background())
+	test.AssertNotError(t, err, "waiting without a monitor failed")
+
+	// The lag is unknown, then too high, then low enough.
+	p.replicaLag = &fakeLag{lags: []time.Duration{-1, 5 * time.Second, 2 * time.Second, time.Second}}
+	start := fc.Now()
+	err = p.waitForReplica(context.Background())
+	test.AssertNotError(t, err, "waiting for the replica failed")
+	test.AssertEquals(t, fc.Since(start), 3*time.Second)
+	test.AssertEquals(t, len(p.log.(*blog.Mock).GetAllMatching("Pausing while replica lag is unknown")), 1)
+
+	// Waiting stops when the context is done.
+	p.replicaLag = &fakeLag{lags: []time.Duration{-1}}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = p.waitForReplica(ctx)
+	test.AssertEquals(t, err, context.Canceled)
+}
@@ -64,6 +64,10 @@ type LogDescription struct {
 	URI             string
 	Key             string
 	SubmitFinalCert bool
+	// StaticCT indicates that the log (or every shard of the TemporalSet)
+	// implements the Static CT API (https://c2sp.org/static-ct-api) instead of
+	// RFC 6962. For such logs URI is the submission prefix.
+	StaticCT bool
 
 	*TemporalSet
 }
@@ -88,3 +92,26 @@ type CTGroup struct {
 	// the next.
 	Stagger cmd.ConfigDuration
 }
+
+// FinalCertQueueConfig configures the durable on-disk queue used to submit
+// final certificates to CT logs. Each (certificate, log) pair is written to the
+// queue before it is submitted, and is only removed once the log has accepted
+// it, so that submissions survive restarts and transient log outages.
+type FinalCertQueueConfig struct {
+	// Dir is the directory holding the queue of pending submissions. If it is
+	// empty, final certificates are submitted once and never retried.
+	Dir string
+	// DeadLetterDir is the directory holding submissions which failed
+	// MaxAttempts times. They are kept until replayed by an operator using the
+	// ct-final-queue command.
+	DeadLetterDir string
+	// MaxAttempts is the number of times a submission is tried before it is
+	// moved to the dead letter queue.
+	MaxAttempts int
+	// Backoff is the delay before the first retry of a failed submission.
+	// Subsequent retries back off exponentially, up to MaxBackoff.
+	Backoff    cmd.ConfigDuration
+	MaxBackoff cmd.ConfigDuration
+	// BatchSize is the number of submissions to attempt concurrently.
+	BatchSize int
+}
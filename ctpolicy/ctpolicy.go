@@ -2,14 +2,20 @@ package ctpolicy
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/x509"
 	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 
+	ct "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
 	"github.com/letsencrypt/boulder/canceled"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
 	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
 	pubpb "github.com/letsencrypt/boulder/publisher/proto"
 	"github.com/prometheus/client_golang/prometheus"
@@ -22,15 +28,23 @@ type CTPolicy struct {
 	groups        []ctconfig.CTGroup
 	informational []ctconfig.LogDescription
 	finalLogs     []ctconfig.LogDescription
+	issuers       map[issuance.IssuerNameID]*issuance.Certificate
+	finalQueue    *FinalCertQueue
 	log           blog.Logger
 
-	winnerCounter *prometheus.CounterVec
+	winnerCounter    *prometheus.CounterVec
+	sctVerifyCounter *prometheus.CounterVec
 }
 
-// New creates a new CTPolicy struct
+// New creates a new CTPolicy struct. The issuers are the certificates which
+// may have issued the precertificates passed to GetSCTs; they are needed to
+// verify the SCTs returned by each log. If finalQueue is non-nil, final
+// certificates are submitted through it and retried until they succeed.
 func New(pub pubpb.PublisherClient,
 	groups []ctconfig.CTGroup,
 	informational []ctconfig.LogDescription,
+	issuers []*issuance.Certificate,
+	finalQueue *FinalCertQueue,
 	log blog.Logger,
 	stats prometheus.Registerer,
 ) *CTPolicy {
@@ -57,14 +71,91 @@ func New(pub pubpb.PublisherClient,
 	)
 	stats.MustRegister(winnerCounter)
 
+	sctVerifyCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sct_verification",
+			Help: "Counter of SCT signature verifications by log and result.",
+		},
+		[]string{"log", "result"},
+	)
+	stats.MustRegister(sctVerifyCounter)
+
+	issuersByNameID := make(map[issuance.IssuerNameID]*issuance.Certificate, len(issuers))
+	for _, issuer := range issuers {
+		issuersByNameID[issuer.NameID()] = issuer
+	}
+
 	return &CTPolicy{
-		pub:           pub,
-		groups:        groups,
-		informational: informational,
-		finalLogs:     finalLogs,
-		log:           log,
-		winnerCounter: winnerCounter,
+		pub:              pub,
+		groups:           groups,
+		informational:    informational,
+		finalLogs:        finalLogs,
+		issuers:          issuersByNameID,
+		finalQueue:       finalQueue,
+		log:              log,
+		winnerCounter:    winnerCounter,
+		sctVerifyCounter: sctVerifyCounter,
+	}
+}
+
+// precertLeaf builds the Merkle tree leaf which a CT log signs when it issues
+// an SCT for the given precertificate: its TBSCertificate with the poison
+// extension removed, and the hash of its issuer's public key. The timestamp
+// and extensions of the leaf are left empty to be filled in from each SCT.
+func (ctp *CTPolicy) precertLeaf(precert core.CertDER) (*ct.MerkleTreeLeaf, error) {
+	parsed, err := x509.ParseCertificate(precert)
+	if err != nil {
+		return nil, fmt.Errorf("parsing precertificate: %w", err)
+	}
+	issuer, ok := ctp.issuers[issuance.GetIssuerNameID(parsed)]
+	if !ok {
+		return nil, fmt.Errorf("no issuer found for precertificate with issuer %q", parsed.Issuer)
+	}
+	chain := []ct.ASN1Cert{{Data: precert}, {Data: issuer.Raw}}
+	leaf, err := ct.MerkleTreeLeafFromRawChain(chain, ct.PrecertLogEntryType, 0)
+	if err != nil {
+		return nil, fmt.Errorf("building precertificate Merkle tree leaf: %w", err)
+	}
+	return leaf, nil
+}
+
+// verifySCT checks that sctBytes is a well formed SCT issued by the log with
+// the given base64 encoded public key, with a valid signature over the given
+// precertificate leaf.
+func verifySCT(sctBytes []byte, b64PK string, leaf ct.MerkleTreeLeaf) error {
+	var sct ct.SignedCertificateTimestamp
+	rest, err := cttls.Unmarshal(sctBytes, &sct)
+	if err != nil {
+		return fmt.Errorf("parsing SCT: %w", err)
+	}
+	if len(rest) > 0 {
+		return errors.New("trailing data after SCT")
+	}
+	if sct.SCTVersion != ct.V1 {
+		return fmt.Errorf("unsupported SCT version %d", sct.SCTVersion)
+	}
+	pk, err := ct.PublicKeyFromB64(b64PK)
+	if err != nil {
+		return fmt.Errorf("parsing log public key: %w", err)
+	}
+	pkDER, err := x509.MarshalPKIXPublicKey(pk)
+	if err != nil {
+		return fmt.Errorf("marshaling log public key: %w", err)
+	}
+	if sct.LogID.KeyID != sha256.Sum256(pkDER) {
+		return errors.New("SCT log ID does not match log public key")
+	}
+	verifier, err := ct.NewSignatureVerifier(pk)
+	if err != nil {
+		return fmt.Errorf("creating SCT verifier: %w", err)
 	}
+	// Copy the TimestampedEntry so that filling in this SCT's fields doesn't
+	// affect the leaf shared with the other logs' submissions.
+	entry := *leaf.TimestampedEntry
+	entry.Timestamp = sct.Timestamp
+	entry.Extensions = sct.Extensions
+	leaf.TimestampedEntry = &entry
+	return verifier.VerifySCTSignature(sct, ct.LogEntry{Leaf: leaf})
 }
 
 type result struct {
@@ -76,8 +167,13 @@ type result struct {
 // race submits an SCT to each log in a group and waits for the first response back,
 // once it has the first SCT it cancels all of the other submissions and returns.
 // It allows up to len(group)-1 of the submissions to fail as we only care about
-// getting a single SCT.
+// getting a single SCT. An SCT which doesn't verify against the log's public
+// key and the given precertificate leaf counts as a failure of that log.
 func (ctp *CTPolicy) race(ctx context.Context, cert core.CertDER, group ctconfig.CTGroup, expiration time.Time) ([]byte, error) {
+	leaf, err := ctp.precertLeaf(cert)
+	if err != nil {
+		return nil, err
+	}
 	results := make(chan result, len(group.Logs))
 	isPrecert := true
 	// Randomize the order in which we send requests to the logs in a group
@@ -103,6 +199,7 @@ func (ctp *CTPolicy) race(ctx context.Context, cert core.CertDER, group ctconfig
 				LogPublicKey: key,
 				Der:          cert,
 				Precert:      isPrecert,
+				StaticCT:     ld.StaticCT,
 			})
 			if err != nil {
 				// Only log the error if it is not a result of the context being canceled
@@ -112,6 +209,14 @@ func (ctp *CTPolicy) race(ctx context.Context, cert core.CertDER, group ctconfig
 				results <- result{err: err}
 				return
 			}
+			err = verifySCT(sct.Sct, key, *leaf)
+			if err != nil {
+				ctp.sctVerifyCounter.With(prometheus.Labels{"log": uri, "result": "invalid"}).Inc()
+				ctp.log.Errf("SCT from %q failed verification: %s", uri, err)
+				results <- result{err: err}
+				return
+			}
+			ctp.sctVerifyCounter.With(prometheus.Labels{"log": uri, "result": "valid"}).Inc()
 			results <- result{sct: sct.Sct, log: uri}
 		}(i, ld)
 	}
@@ -170,6 +275,7 @@ func (ctp *CTPolicy) GetSCTs(ctx context.Context, cert core.CertDER, expiration
 				LogPublicKey: key,
 				Der:          cert,
 				Precert:      isPrecert,
+				StaticCT:     l.StaticCT,
 			})
 			if err != nil {
 				ctp.log.Warningf("ct submission to informational log %q failed: %s", uri, err)
@@ -192,24 +298,15 @@ func (ctp *CTPolicy) GetSCTs(ctx context.Context, cert core.CertDER, expiration
 }
 
 // SubmitFinalCert submits finalized certificates created from precertificates
-// to any configured logs
+// to any configured logs. If a final certificate queue is configured the
+// submissions are written to it before SubmitFinalCert returns and made by
+// FinalCertLoop, otherwise each log is tried once in the background.
 func (ctp *CTPolicy) SubmitFinalCert(cert []byte, expiration time.Time) {
+	if ctp.finalQueue != nil {
+		ctp.queueFinalCert(cert, expiration)
+		return
+	}
 	for _, log := range ctp.finalLogs {
-		go func(l ctconfig.LogDescription) {
-			uri, key, err := l.Info(expiration)
-			if err != nil {
-				ctp.log.Errf("unable to get log info: %s", err)
-				return
-			}
-			_, err = ctp.pub.SubmitToSingleCTWithResult(context.Background(), &pubpb.Request{
-				LogURL:       uri,
-				LogPublicKey: key,
-				Der:          cert,
-				Precert:      false,
-			})
-			if err != nil {
-				ctp.log.Warningf("ct submission of final cert to log %q failed: %s", uri, err)
-			}
-		}(log)
+		go ctp.submitFinalCertOnce(cert, log, expiration)
 	}
 }
@@ -2,15 +2,26 @@ package ctpolicy
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
 	"errors"
+	"math/big"
 	"regexp"
 	"testing"
 	"time"
 
+	ct "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
 	"github.com/letsencrypt/boulder/cmd"
-	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
 	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
 	pubpb "github.com/letsencrypt/boulder/publisher/proto"
@@ -19,10 +30,122 @@ import (
 	"google.golang.org/grpc"
 )
 
+var (
+	// testIssuer is a throwaway issuer, and testPrecert a precertificate it
+	// issued, for which the mock publishers below sign SCTs.
+	testIssuer  *issuance.Certificate
+	testPrecert []byte
+	// keyA and keyB are the base64 public keys of two test logs, and logKeys
+	// maps them to the private keys that sign their SCTs.
+	keyA, keyB string
+	logKeys    = map[string]*ecdsa.PrivateKey{}
+)
+
+func init() {
+	issuerKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	issuerTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ctpolicy test issuer"},
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	issuerDER, err := x509.CreateCertificate(rand.Reader, issuerTmpl, issuerTmpl, issuerKey.Public(), issuerKey)
+	if err != nil {
+		panic(err)
+	}
+	issuerCert, err := x509.ParseCertificate(issuerDER)
+	if err != nil {
+		panic(err)
+	}
+	testIssuer, err = issuance.NewCertificate(issuerCert)
+	if err != nil {
+		panic(err)
+	}
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	testPrecert, err = x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		DNSNames:     []string{"example.com"},
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtraExtensions: []pkix.Extension{
+			{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3}, Critical: true, Value: []byte{0x05, 0x00}},
+		},
+	}, issuerCert, leafKey.Public(), issuerKey)
+	if err != nil {
+		panic(err)
+	}
+
+	newLogKey := func() string {
+		k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			panic(err)
+		}
+		der, err := x509.MarshalPKIXPublicKey(k.Public())
+		if err != nil {
+			panic(err)
+		}
+		b64 := base64.StdEncoding.EncodeToString(der)
+		logKeys[b64] = k
+		return b64
+	}
+	keyA = newLogKey()
+	keyB = newLogKey()
+}
+
+// signSCT returns a TLS encoded SCT for testPrecert, signed by the test log
+// whose base64 public key is b64PK.
+func signSCT(b64PK string) []byte {
+	k := logKeys[b64PK]
+	der, err := x509.MarshalPKIXPublicKey(k.Public())
+	if err != nil {
+		panic(err)
+	}
+	leaf, err := ct.MerkleTreeLeafFromRawChain(
+		[]ct.ASN1Cert{{Data: testPrecert}, {Data: testIssuer.Raw}},
+		ct.PrecertLogEntryType,
+		uint64(time.Now().UnixNano()/1e6))
+	if err != nil {
+		panic(err)
+	}
+	sct := ct.SignedCertificateTimestamp{
+		SCTVersion: ct.V1,
+		LogID:      ct.LogID{KeyID: sha256.Sum256(der)},
+		Timestamp:  leaf.TimestampedEntry.Timestamp,
+	}
+	input, err := ct.SerializeSCTSignatureInput(sct, ct.LogEntry{Leaf: *leaf})
+	if err != nil {
+		panic(err)
+	}
+	hashed := sha256.Sum256(input)
+	sig, err := ecdsa.SignASN1(rand.Reader, k, hashed[:])
+	if err != nil {
+		panic(err)
+	}
+	sct.Signature = ct.DigitallySigned{
+		Algorithm: cttls.SignatureAndHashAlgorithm{
+			Hash:      cttls.SHA256,
+			Signature: cttls.ECDSA,
+		},
+		Signature: sig,
+	}
+	sctBytes, err := cttls.Marshal(sct)
+	if err != nil {
+		panic(err)
+	}
+	return sctBytes
+}
+
 type mockPub struct{}
 
-func (mp *mockPub) SubmitToSingleCTWithResult(_ context.Context, _ *pubpb.Request, _ ...grpc.CallOption) (*pubpb.Result, error) {
-	return &pubpb.Result{Sct: []byte{0}}, nil
+func (mp *mockPub) SubmitToSingleCTWithResult(_ context.Context, req *pubpb.Request, _ ...grpc.CallOption) (*pubpb.Result, error) {
+	return &pubpb.Result{Sct: signSCT(req.LogPublicKey)}, nil
 }
 
 type alwaysFail struct{}
@@ -36,13 +159,13 @@ func TestGetSCTs(t *testing.T) {
 	defer cancel()
 	missingSCTErr := berrors.MissingSCTs
 	testCases := []struct {
-		name       string
-		mock       pubpb.PublisherClient
-		groups     []ctconfig.CTGroup
-		ctx        context.Context
-		result     core.SCTDERs
-		errRegexp  *regexp.Regexp
-		berrorType *berrors.ErrorType
+		name        string
+		mock        pubpb.PublisherClient
+		groups      []ctconfig.CTGroup
+		ctx         context.Context
+		resultCount int
+		errRegexp   *regexp.Regexp
+		berrorType  *berrors.ErrorType
 	}{
 		{
 			name: "basic success case",
@@ -51,20 +174,20 @@ func TestGetSCTs(t *testing.T) {
 				{
 					Name: "a",
 					Logs: []ctconfig.LogDescription{
-						{URI: "abc", Key: "def"},
-						{URI: "ghi", Key: "jkl"},
+						{URI: "abc", Key: keyA},
+						{URI: "ghi", Key: keyB},
 					},
 				},
 				{
 					Name: "b",
 					Logs: []ctconfig.LogDescription{
-						{URI: "abc", Key: "def"},
-						{URI: "ghi", Key: "jkl"},
+						{URI: "abc", Key: keyA},
+						{URI: "ghi", Key: keyB},
 					},
 				},
 			},
-			ctx:    context.Background(),
-			result: core.SCTDERs{[]byte{0}, []byte{0}},
+			ctx:         context.Background(),
+			resultCount: 2,
 		},
 		{
 			name: "basic failure case",
@@ -73,15 +196,15 @@ func TestGetSCTs(t *testing.T) {
 				{
 					Name: "a",
 					Logs: []ctconfig.LogDescription{
-						{URI: "abc", Key: "def"},
-						{URI: "ghi", Key: "jkl"},
+						{URI: "abc", Key: keyA},
+						{URI: "ghi", Key: keyB},
 					},
 				},
 				{
 					Name: "b",
 					Logs: []ctconfig.LogDescription{
-						{URI: "abc", Key: "def"},
-						{URI: "ghi", Key: "jkl"},
+						{URI: "abc", Key: keyA},
+						{URI: "ghi", Key: keyB},
 					},
 				},
 			},
@@ -96,15 +219,15 @@ func TestGetSCTs(t *testing.T) {
 				{
 					Name: "a",
 					Logs: []ctconfig.LogDescription{
-						{URI: "abc", Key: "def"},
-						{URI: "ghi", Key: "jkl"},
+						{URI: "abc", Key: keyA},
+						{URI: "ghi", Key: keyB},
 					},
 				},
 				{
 					Name: "b",
 					Logs: []ctconfig.LogDescription{
-						{URI: "abc", Key: "def"},
-						{URI: "ghi", Key: "jkl"},
+						{URI: "abc", Key: keyA},
+						{URI: "ghi", Key: keyB},
 					},
 				},
 			},
@@ -115,10 +238,11 @@ func TestGetSCTs(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			ctp := New(tc.mock, tc.groups, nil, blog.NewMock(), metrics.NoopRegisterer)
-			ret, err := ctp.GetSCTs(tc.ctx, []byte{0}, time.Time{})
-			if tc.result != nil {
-				test.AssertDeepEquals(t, ret, tc.result)
+			ctp := New(tc.mock, tc.groups, nil, []*issuance.Certificate{testIssuer}, nil, blog.NewMock(), metrics.NoopRegisterer)
+			ret, err := ctp.GetSCTs(tc.ctx, testPrecert, time.Time{})
+			if tc.resultCount != 0 {
+				test.AssertNotError(t, err, "GetSCTs failed")
+				test.AssertEquals(t, len(ret), tc.resultCount)
 			} else if tc.errRegexp != nil {
 				if !tc.errRegexp.MatchString(err.Error()) {
 					t.Errorf("Error %q did not match expected regexp %q", err, tc.errRegexp)
@@ -139,14 +263,14 @@ func (mp *failOne) SubmitToSingleCTWithResult(_ context.Context, req *pubpb.Requ
 	if req.LogURL == mp.badURL {
 		return nil, errors.New("BAD")
 	}
-	return &pubpb.Result{Sct: []byte{0}}, nil
+	return &pubpb.Result{Sct: signSCT(req.LogPublicKey)}, nil
 }
 
 type slowPublisher struct{}
 
 func (sp *slowPublisher) SubmitToSingleCTWithResult(_ context.Context, req *pubpb.Request, _ ...grpc.CallOption) (*pubpb.Result, error) {
 	time.Sleep(time.Second)
-	return &pubpb.Result{Sct: []byte{0}}, nil
+	return &pubpb.Result{Sct: signSCT(req.LogPublicKey)}, nil
 }
 
 func TestGetSCTsMetrics(t *testing.T) {
@@ -154,19 +278,19 @@ func TestGetSCTsMetrics(t *testing.T) {
 		{
 			Name: "a",
 			Logs: []ctconfig.LogDescription{
-				{URI: "abc", Key: "def"},
-				{URI: "ghi", Key: "jkl"},
+				{URI: "abc", Key: keyA},
+				{URI: "ghi", Key: keyB},
 			},
 		},
 		{
 			Name: "b",
 			Logs: []ctconfig.LogDescription{
-				{URI: "abc", Key: "def"},
-				{URI: "ghi", Key: "jkl"},
+				{URI: "abc", Key: keyA},
+				{URI: "ghi", Key: keyB},
 			},
 		},
-	}, nil, blog.NewMock(), metrics.NoopRegisterer)
-	_, err := ctp.GetSCTs(context.Background(), []byte{0}, time.Time{})
+	}, nil, []*issuance.Certificate{testIssuer}, nil, blog.NewMock(), metrics.NoopRegisterer)
+	_, err := ctp.GetSCTs(context.Background(), testPrecert, time.Time{})
 	test.AssertNotError(t, err, "GetSCTs failed")
 	test.AssertMetricWithLabelsEquals(t, ctp.winnerCounter, prometheus.Labels{"log": "ghi", "group": "a"}, 1)
 	test.AssertMetricWithLabelsEquals(t, ctp.winnerCounter, prometheus.Labels{"log": "ghi", "group": "b"}, 1)
@@ -179,11 +303,11 @@ func TestGetSCTsFailMetrics(t *testing.T) {
 		{
 			Name: "a",
 			Logs: []ctconfig.LogDescription{
-				{URI: "abc", Key: "def"},
+				{URI: "abc", Key: keyA},
 			},
 		},
-	}, nil, blog.NewMock(), metrics.NoopRegisterer)
-	_, err := ctp.GetSCTs(context.Background(), []byte{0}, time.Time{})
+	}, nil, []*issuance.Certificate{testIssuer}, nil, blog.NewMock(), metrics.NoopRegisterer)
+	_, err := ctp.GetSCTs(context.Background(), testPrecert, time.Time{})
 	if err == nil {
 		t.Fatal("GetSCTs should have failed")
 	}
@@ -197,11 +321,11 @@ func TestGetSCTsFailMetrics(t *testing.T) {
 		{
 			Name: "a",
 			Logs: []ctconfig.LogDescription{
-				{URI: "abc", Key: "def"},
+				{URI: "abc", Key: keyA},
 			},
 		},
-	}, nil, blog.NewMock(), metrics.NoopRegisterer)
-	_, err = ctp.GetSCTs(ctx, []byte{0}, time.Time{})
+	}, nil, []*issuance.Certificate{testIssuer}, nil, blog.NewMock(), metrics.NoopRegisterer)
+	_, err = ctp.GetSCTs(ctx, testPrecert, time.Time{})
 	if err == nil {
 		t.Fatal("GetSCTs should have failed")
 	}
@@ -213,9 +337,9 @@ type countEm struct {
 	count int
 }
 
-func (ce *countEm) SubmitToSingleCTWithResult(_ context.Context, _ *pubpb.Request, _ ...grpc.CallOption) (*pubpb.Result, error) {
+func (ce *countEm) SubmitToSingleCTWithResult(_ context.Context, req *pubpb.Request, _ ...grpc.CallOption) (*pubpb.Result, error) {
 	ce.count++
-	return &pubpb.Result{Sct: []byte{0}}, nil
+	return &pubpb.Result{Sct: signSCT(req.LogPublicKey)}, nil
 }
 
 func TestStagger(t *testing.T) {
@@ -225,14 +349,170 @@ func TestStagger(t *testing.T) {
 			Name:    "a",
 			Stagger: cmd.ConfigDuration{Duration: 500 * time.Millisecond},
 			Logs: []ctconfig.LogDescription{
-				{URI: "abc", Key: "def"},
-				{URI: "ghi", Key: "jkl"},
+				{URI: "abc", Key: keyA},
+				{URI: "ghi", Key: keyB},
 			},
 		},
-	}, nil, blog.NewMock(), metrics.NoopRegisterer)
-	_, err := ctp.GetSCTs(context.Background(), []byte{0}, time.Time{})
+	}, nil, []*issuance.Certificate{testIssuer}, nil, blog.NewMock(), metrics.NoopRegisterer)
+	_, err := ctp.GetSCTs(context.Background(), testPrecert, time.Time{})
 	test.AssertNotError(t, err, "GetSCTs failed")
 	if countingPub.count != 1 {
 		t.Errorf("wrong number of requests to publisher. got %d, expected 1", countingPub.count)
 	}
 }
+
+// A mock publisher that only returns SCTs for submissions flagged as being
+// destined for a Static CT API log.
+type staticOnly struct{}
+
+func (so *staticOnly) SubmitToSingleCTWithResult(_ context.Context, req *pubpb.Request, _ ...grpc.CallOption) (*pubpb.Result, error) {
+	if !req.StaticCT {
+		return nil, errors.New("not a static log")
+	}
+	return &pubpb.Result{Sct: signSCT(req.LogPublicKey)}, nil
+}
+
+func TestGetSCTsStaticCT(t *testing.T) {
+	ctp := New(&staticOnly{}, []ctconfig.CTGroup{
+		{
+			Name: "a",
+			Logs: []ctconfig.LogDescription{
+				{URI: "abc", Key: keyA, StaticCT: true},
+			},
+		},
+	}, nil, []*issuance.Certificate{testIssuer}, nil, blog.NewMock(), metrics.NoopRegisterer)
+	_, err := ctp.GetSCTs(context.Background(), testPrecert, time.Time{})
+	test.AssertNotError(t, err, "GetSCTs failed for a static CT log")
+
+	ctp = New(&staticOnly{}, []ctconfig.CTGroup{
+		{
+			Name: "a",
+			Logs: []ctconfig.LogDescription{
+				{URI: "abc", Key: keyA},
+			},
+		},
+	}, nil, []*issuance.Certificate{testIssuer}, nil, blog.NewMock(), metrics.NoopRegisterer)
+	_, err = ctp.GetSCTs(context.Background(), testPrecert, time.Time{})
+	test.AssertError(t, err, "GetSCTs marked an RFC 6962 log as static")
+}
+
+// A mock publisher whose SCTs from the log with the given key are signed by
+// the wrong key.
+type wrongSigner struct {
+	badKey string
+}
+
+func (ws *wrongSigner) SubmitToSingleCTWithResult(_ context.Context, req *pubpb.Request, _ ...grpc.CallOption) (*pubpb.Result, error) {
+	if req.LogPublicKey == ws.badKey {
+		for k := range logKeys {
+			if k != ws.badKey {
+				return &pubpb.Result{Sct: signSCT(k)}, nil
+			}
+		}
+	}
+	return &pubpb.Result{Sct: signSCT(req.LogPublicKey)}, nil
+}
+
+// A mock publisher that returns SCTs which don't parse.
+type garbageSCTs struct{}
+
+func (gs *garbageSCTs) SubmitToSingleCTWithResult(_ context.Context, _ *pubpb.Request, _ ...grpc.CallOption) (*pubpb.Result, error) {
+	return &pubpb.Result{Sct: []byte{0}}, nil
+}
+
+func TestGetSCTsVerification(t *testing.T) {
+	// An SCT signed by the wrong log counts as a failure of that log, but the
+	// race can still be won by the other log in the group.
+	ctp := New(&wrongSigner{badKey: keyA}, []ctconfig.CTGroup{
+		{
+			Name: "a",
+			Logs: []ctconfig.LogDescription{
+				{URI: "abc", Key: keyA},
+				{URI: "ghi", Key: keyB},
+			},
+		},
+	}, nil, []*issuance.Certificate{testIssuer}, nil, blog.NewMock(), metrics.NoopRegisterer)
+	scts, err := ctp.GetSCTs(context.Background(), testPrecert, time.Time{})
+	test.AssertNotError(t, err, "GetSCTs failed")
+	test.AssertEquals(t, len(scts), 1)
+	test.AssertNotError(t, verifySCT(scts[0], keyB, mustLeaf(t, ctp)), "returned SCT didn't verify")
+	test.AssertMetricWithLabelsEquals(t, ctp.winnerCounter, prometheus.Labels{"log": "ghi", "group": "a"}, 1)
+	test.AssertMetricWithLabelsEquals(t, ctp.sctVerifyCounter, prometheus.Labels{"log": "ghi", "result": "valid"}, 1)
+
+	// If the only log in a group returns a bad SCT, the group fails.
+	ctp = New(&wrongSigner{badKey: keyA}, []ctconfig.CTGroup{
+		{
+			Name: "a",
+			Logs: []ctconfig.LogDescription{
+				{URI: "abc", Key: keyA},
+			},
+		},
+	}, nil, []*issuance.Certificate{testIssuer}, nil, blog.NewMock(), metrics.NoopRegisterer)
+	_, err = ctp.GetSCTs(context.Background(), testPrecert, time.Time{})
+	test.AssertError(t, err, "GetSCTs accepted an SCT signed by the wrong log")
+	test.AssertErrorIs(t, err, berrors.MissingSCTs)
+	test.AssertMetricWithLabelsEquals(t, ctp.sctVerifyCounter, prometheus.Labels{"log": "abc", "result": "invalid"}, 1)
+	test.AssertMetricWithLabelsEquals(t, ctp.winnerCounter, prometheus.Labels{"log": "all_failed", "group": "a"}, 1)
+
+	ctp = New(&garbageSCTs{}, []ctconfig.CTGroup{
+		{
+			Name: "a",
+			Logs: []ctconfig.LogDescription{
+				{URI: "abc", Key: keyA},
+			},
+		},
+	}, nil, []*issuance.Certificate{testIssuer}, nil, blog.NewMock(), metrics.NoopRegisterer)
+	_, err = ctp.GetSCTs(context.Background(), testPrecert, time.Time{})
+	test.AssertError(t, err, "GetSCTs accepted a malformed SCT")
+	test.AssertMetricWithLabelsEquals(t, ctp.sctVerifyCounter, prometheus.Labels{"log": "abc", "result": "invalid"}, 1)
+
+	// Without the precertificate's issuer, SCTs can't be verified at all.
+	ctp = New(&mockPub{}, []ctconfig.CTGroup{
+		{
+			Name: "a",
+			Logs: []ctconfig.LogDescription{
+				{URI: "abc", Key: keyA},
+			},
+		},
+	}, nil, nil, nil, blog.NewMock(), metrics.NoopRegisterer)
+	_, err = ctp.GetSCTs(context.Background(), testPrecert, time.Time{})
+	test.AssertError(t, err, "GetSCTs succeeded without the precertificate's issuer")
+	test.AssertContains(t, err.Error(), "no issuer found")
+}
+
+func TestVerifySCT(t *testing.T) {
+	ctp := New(&mockPub{}, nil, nil, []*issuance.Certificate{testIssuer}, nil, blog.NewMock(), metrics.NoopRegisterer)
+	leaf := mustLeaf(t, ctp)
+
+	sct := signSCT(keyA)
+	test.AssertNotError(t, verifySCT(sct, keyA, leaf), "valid SCT failed verification")
+
+	err := verifySCT(sct, keyB, leaf)
+	test.AssertError(t, err, "SCT verified against the wrong log key")
+	test.AssertContains(t, err.Error(), "log ID does not match")
+
+	err = verifySCT(append(sct, 0), keyA, leaf)
+	test.AssertError(t, err, "SCT with trailing data verified")
+
+	// Flip a bit in the signature.
+	corrupt := make([]byte, len(sct))
+	copy(corrupt, sct)
+	corrupt[len(corrupt)-1] ^= 1
+	test.AssertError(t, verifySCT(corrupt, keyA, leaf), "SCT with corrupt signature verified")
+
+	// An SCT over a different precertificate must not verify.
+	otherLeaf := leaf
+	otherEntry := *leaf.TimestampedEntry
+	otherPrecert := *otherEntry.PrecertEntry
+	otherPrecert.IssuerKeyHash[0] ^= 1
+	otherEntry.PrecertEntry = &otherPrecert
+	otherLeaf.TimestampedEntry = &otherEntry
+	test.AssertError(t, verifySCT(sct, keyA, otherLeaf), "SCT verified for the wrong issuer key hash")
+}
+
+func mustLeaf(t *testing.T, ctp *CTPolicy) ct.MerkleTreeLeaf {
+	t.Helper()
+	leaf, err := ctp.precertLeaf(testPrecert)
+	test.AssertNotError(t, err, "building precert leaf")
+	return *leaf
+}
@@ -0,0 +1,330 @@
+package ctpolicy
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/beeker1121/goque"
+	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	pubpb "github.com/letsencrypt/boulder/publisher/proto"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// FinalCertSubmission is a single submission of a final certificate to a CT
+// log, as stored in a FinalCertQueue. It is exported so that the
+// ct-final-queue command can inspect and replay queued submissions.
+type FinalCertSubmission struct {
+	Serial      string
+	DER         []byte
+	Expiration  time.Time
+	LogURI      string
+	LogKey      string
+	StaticCT    bool
+	Attempts    int
+	NextAttempt time.Time
+	LastError   string
+}
+
+// FinalCertQueue holds the on-disk queues used to durably submit final
+// certificates to CT logs. Submissions wait in the pending queue until a log
+// accepts them, and are moved to the dead letter queue once they have failed
+// too many times.
+type FinalCertQueue struct {
+	pending     *goque.Queue
+	deadLetter  *goque.Queue
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	batchSize   int
+	clk         clock.Clock
+
+	submissions *prometheus.CounterVec
+}
+
+// NewFinalCertQueue creates a FinalCertQueue from already opened pending and
+// dead letter queues.
+func NewFinalCertQueue(
+	pending *goque.Queue,
+	deadLetter *goque.Queue,
+	maxAttempts int,
+	backoff time.Duration,
+	maxBackoff time.Duration,
+	batchSize int,
+	clk clock.Clock,
+	stats prometheus.Registerer,
+) *FinalCertQueue {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	submissions := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "final_cert_submissions",
+			Help: "Number of queued final certificate submission attempts, labelled by log and result (success, retry, dead_letter)",
+		},
+		[]string{"log", "result"},
+	)
+	stats.MustRegister(submissions)
+
+	stats.MustRegister(prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "final_cert_queue_pending",
+			Help: "Number of final certificate submissions waiting in the pending queue",
+		},
+		func() float64 { return float64(pending.Length()) },
+	))
+	stats.MustRegister(prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "final_cert_queue_dead_letter",
+			Help: "Number of final certificate submissions waiting in the dead letter queue",
+		},
+		func() float64 { return float64(deadLetter.Length()) },
+	))
+
+	return &FinalCertQueue{
+		pending:     pending,
+		deadLetter:  deadLetter,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		maxBackoff:  maxBackoff,
+		batchSize:   batchSize,
+		clk:         clk,
+		submissions: submissions,
+	}
+}
+
+// queueFinalCert durably records a submission of the given final certificate
+// to each log which wants final certificates. If a submission can't be written
+// to the queue it is attempted once immediately instead, as it would have been
+// without a queue.
+func (ctp *CTPolicy) queueFinalCert(cert []byte, expiration time.Time) {
+	var serial string
+	parsed, err := x509.ParseCertificate(cert)
+	if err != nil {
+		ctp.log.Errf("failed to parse final certificate for CT submission: %s", err)
+	} else {
+		serial = core.SerialToString(parsed.SerialNumber)
+	}
+	for _, log := range ctp.finalLogs {
+		uri, key, err := log.Info(expiration)
+		if err != nil {
+			ctp.log.Errf("unable to get log info: %s", err)
+			continue
+		}
+		_, err = ctp.finalQueue.pending.EnqueueObject(&FinalCertSubmission{
+			Serial:      serial,
+			DER:         cert,
+			Expiration:  expiration,
+			LogURI:      uri,
+			LogKey:      key,
+			StaticCT:    log.StaticCT,
+			NextAttempt: ctp.finalQueue.clk.Now(),
+		})
+		if err != nil {
+			ctp.log.AuditErrf("failed to queue final certificate for CT submission: serial=[%s] log=[%s] err=[%s]",
+				serial, uri, err)
+			go ctp.submitFinalCertOnce(cert, log, expiration)
+		}
+	}
+}
+
+// FinalCertLoop runs a loop processing batches of queued final certificate
+// submissions, waiting a second whenever there is nothing ready to submit. It
+// is called directly by boulder-ra when a final certificate queue is
+// configured. It returns once ctx is cancelled, after finishing any batch in
+// progress, at which point the caller may close the queues.
+func (ctp *CTPolicy) FinalCertLoop(ctx context.Context) {
+	for {
+		attempted, err := ctp.processFinalCertBatch()
+		if err != nil && err != goque.ErrEmpty {
+			ctp.log.AuditErrf("failed to process queued final certificate submissions: %s", err)
+		}
+		wait := time.Duration(0)
+		if attempted == 0 {
+			wait = time.Second
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// processFinalCertBatch submits every submission at the head of the pending
+// queue whose next attempt is due, concurrently. Submissions which failed are
+// re-queued with a later next attempt, or moved to the dead letter queue if
+// they have run out of attempts, and submissions which aren't yet due are
+// moved to the back of the queue. Items are only dequeued once their
+// replacements have been written, so a restart in the middle of a batch can
+// cause a certificate to be submitted twice but never to be dropped. It
+// returns the number of submissions attempted.
+func (ctp *CTPolicy) processFinalCertBatch() (int, error) {
+	q := ctp.finalQueue
+	n := q.pending.Length()
+	if n == 0 {
+		return 0, goque.ErrEmpty
+	}
+	if n > uint64(q.batchSize) {
+		n = uint64(q.batchSize)
+	}
+
+	subs := make([]*FinalCertSubmission, n)
+	for i := range subs {
+		item, err := q.pending.PeekByOffset(uint64(i))
+		if err != nil {
+			return 0, fmt.Errorf("failed to peek into final cert queue: %s", err)
+		}
+		var sub FinalCertSubmission
+		err = item.ToObject(&sub)
+		if err != nil {
+			// Keep the undecodable item around for inspection rather than
+			// letting it block the queue.
+			ctp.log.AuditErrf("failed to unmarshal queued final cert submission, moving to dead letter queue: item=[%s] err=[%s]",
+				hex.EncodeToString(item.Value), err)
+			_, err = q.deadLetter.Enqueue(item.Value)
+			if err != nil {
+				return 0, fmt.Errorf("failed to move final cert submission to dead letter queue: %s", err)
+			}
+			continue
+		}
+		subs[i] = &sub
+	}
+
+	now := q.clk.Now()
+	attempted := 0
+	succeeded := make([]bool, len(subs))
+	var wg sync.WaitGroup
+	for i, sub := range subs {
+		if sub == nil || sub.NextAttempt.After(now) {
+			continue
+		}
+		attempted++
+		if now.After(sub.Expiration) {
+			// Logs reject expired certificates, so there's no point trying.
+			sub.Attempts++
+			sub.LastError = "certificate expired before it could be submitted"
+			continue
+		}
+		wg.Add(1)
+		go func(i int, sub *FinalCertSubmission) {
+			defer wg.Done()
+			sub.Attempts++
+			_, err := ctp.pub.SubmitToSingleCTWithResult(context.Background(), &pubpb.Request{
+				LogURL:       sub.LogURI,
+				LogPublicKey: sub.LogKey,
+				Der:          sub.DER,
+				Precert:      false,
+				StaticCT:     sub.StaticCT,
+			})
+			if err != nil {
+				sub.LastError = err.Error()
+				return
+			}
+			succeeded[i] = true
+		}(i, sub)
+	}
+	wg.Wait()
+
+	for i, sub := range subs {
+		switch {
+		case sub == nil:
+		case succeeded[i]:
+			q.submissions.With(prometheus.Labels{"log": sub.LogURI, "result": "success"}).Inc()
+			ctp.log.AuditInfof("Submitted final certificate to CT log: serial=[%s] log=[%s] attempts=[%d]",
+				sub.Serial, sub.LogURI, sub.Attempts)
+		case sub.NextAttempt.After(now):
+			_, err := q.pending.EnqueueObject(sub)
+			if err != nil {
+				return attempted, fmt.Errorf("failed to requeue final cert submission: %s", err)
+			}
+		case sub.Attempts >= q.maxAttempts || now.After(sub.Expiration):
+			_, err := q.deadLetter.EnqueueObject(sub)
+			if err != nil {
+				return attempted, fmt.Errorf("failed to move final cert submission to dead letter queue: %s", err)
+			}
+			q.submissions.With(prometheus.Labels{"log": sub.LogURI, "result": "dead_letter"}).Inc()
+			ctp.log.AuditErrf("Giving up on CT submission of final certificate, moved to dead letter queue: serial=[%s] log=[%s] attempts=[%d] err=[%s]",
+				sub.Serial, sub.LogURI, sub.Attempts, sub.LastError)
+		default:
+			sub.NextAttempt = now.Add(core.RetryBackoff(sub.Attempts, q.backoff, q.maxBackoff, 2))
+			_, err := q.pending.EnqueueObject(sub)
+			if err != nil {
+				return attempted, fmt.Errorf("failed to requeue final cert submission: %s", err)
+			}
+			q.submissions.With(prometheus.Labels{"log": sub.LogURI, "result": "retry"}).Inc()
+			ctp.log.Warningf("ct submission of final cert to log %q failed, will retry at %s: %s",
+				sub.LogURI, sub.NextAttempt, sub.LastError)
+		}
+	}
+
+	for range subs {
+		_, err := q.pending.Dequeue()
+		if err != nil {
+			return attempted, fmt.Errorf("failed to dequeue final cert submission: %s", err)
+		}
+	}
+	return attempted, nil
+}
+
+// ReplayDeadLetters moves every submission in the dead letter queue back to the
+// pending queue with its attempt count reset, so that it will be retried. It
+// stops early, between submissions, if ctx is cancelled. It returns the number
+// of submissions moved.
+func ReplayDeadLetters(ctx context.Context, pending, deadLetter *goque.Queue, now time.Time) (int, error) {
+	replayed := 0
+	for {
+		if ctx.Err() != nil {
+			return replayed, ctx.Err()
+		}
+		item, err := deadLetter.Peek()
+		if err == goque.ErrEmpty {
+			return replayed, nil
+		}
+		if err != nil {
+			return replayed, fmt.Errorf("failed to peek into dead letter queue: %s", err)
+		}
+		var sub FinalCertSubmission
+		err = item.ToObject(&sub)
+		if err != nil {
+			return replayed, fmt.Errorf("failed to unmarshal dead letter item %d: %s", item.ID, err)
+		}
+		sub.Attempts = 0
+		sub.NextAttempt = now
+		_, err = pending.EnqueueObject(&sub)
+		if err != nil {
+			return replayed, fmt.Errorf("failed to requeue dead letter item %d: %s", item.ID, err)
+		}
+		_, err = deadLetter.Dequeue()
+		if err != nil {
+			return replayed, fmt.Errorf("failed to dequeue dead letter item %d: %s", item.ID, err)
+		}
+		replayed++
+	}
+}
+
+// submitFinalCertOnce makes a single attempt to submit a final certificate to
+// the given log, logging but otherwise ignoring any failure.
+func (ctp *CTPolicy) submitFinalCertOnce(cert []byte, l ctconfig.LogDescription, expiration time.Time) {
+	uri, key, err := l.Info(expiration)
+	if err != nil {
+		ctp.log.Errf("unable to get log info: %s", err)
+		return
+	}
+	_, err = ctp.pub.SubmitToSingleCTWithResult(context.Background(), &pubpb.Request{
+		LogURL:       uri,
+		LogPublicKey: key,
+		Der:          cert,
+		Precert:      false,
+		StaticCT:     l.StaticCT,
+	})
+	if err != nil {
+		ctp.log.Warningf("ct submission of final cert to log %q failed: %s", uri, err)
+	}
+}
@@ -0,0 +1,165 @@
+package ctpolicy
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/beeker1121/goque"
+	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	"github.com/letsencrypt/boulder/issuance"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	pubpb "github.com/letsencrypt/boulder/publisher/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// setupFinalQueue returns a CTPolicy with a final certificate queue, stored in
+// temporary directories, which submits to two logs using the given publisher.
+// The returned function closes and removes the queues.
+func setupFinalQueue(t *testing.T, pub pubpb.PublisherClient, maxAttempts int) (*CTPolicy, *goque.Queue, *goque.Queue, clock.FakeClock, *blog.Mock, func()) {
+	t.Helper()
+	var cleanups []func()
+	open := func() *goque.Queue {
+		dir, err := ioutil.TempDir("", "ctpolicy-final-queue")
+		test.AssertNotError(t, err, "Failed to create temp directory")
+		q, err := goque.OpenQueue(dir)
+		test.AssertNotError(t, err, "Failed to open queue")
+		cleanups = append(cleanups, func() {
+			_ = q.Close()
+			_ = os.RemoveAll(dir)
+		})
+		return q
+	}
+	pending, deadLetter := open(), open()
+	cleanup := func() {
+		for _, c := range cleanups {
+			c()
+		}
+	}
+	fc := clock.NewFake()
+	fc.Set(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	log := blog.NewMock()
+	fq := NewFinalCertQueue(pending, deadLetter, maxAttempts, time.Minute, time.Hour, 10, fc, metrics.NoopRegisterer)
+	ctp := New(pub, nil, []ctconfig.LogDescription{
+		{URI: "abc", Key: keyA, SubmitFinalCert: true},
+		{URI: "def", Key: keyB, SubmitFinalCert: true},
+	}, []*issuance.Certificate{testIssuer}, fq, log, metrics.NoopRegisterer)
+	return ctp, pending, deadLetter, fc, log, cleanup
+}
+
+func TestFinalCertQueueSuccess(t *testing.T) {
+	ctp, pending, deadLetter, fc, log, cleanup := setupFinalQueue(t, &mockPub{}, 3)
+	defer cleanup()
+
+	ctp.SubmitFinalCert(testPrecert, fc.Now().Add(time.Hour))
+	test.AssertEquals(t, pending.Length(), uint64(2))
+
+	attempted, err := ctp.processFinalCertBatch()
+	test.AssertNotError(t, err, "processFinalCertBatch failed")
+	test.AssertEquals(t, attempted, 2)
+	test.AssertEquals(t, pending.Length(), uint64(0))
+	test.AssertEquals(t, deadLetter.Length(), uint64(0))
+	test.AssertEquals(t, len(log.GetAllMatching(`Submitted final certificate to CT log: serial=\[[0-9a-f]+\] log=\[(abc|def)\] attempts=\[1\]`)), 2)
+
+	_, err = ctp.processFinalCertBatch()
+	test.AssertEquals(t, err, goque.ErrEmpty)
+}
+
+func TestFinalCertQueueRetry(t *testing.T) {
+	ctp, pending, deadLetter, fc, log, cleanup := setupFinalQueue(t, &alwaysFail{}, 2)
+	defer cleanup()
+
+	ctp.SubmitFinalCert(testPrecert, fc.Now().Add(24*time.Hour))
+	attempted, err := ctp.processFinalCertBatch()
+	test.AssertNotError(t, err, "processFinalCertBatch failed")
+	test.AssertEquals(t, attempted, 2)
+	test.AssertEquals(t, pending.Length(), uint64(2))
+
+	item, err := pending.Peek()
+	test.AssertNotError(t, err, "Failed to peek into queue")
+	var sub FinalCertSubmission
+	test.AssertNotError(t, item.ToObject(&sub), "Failed to unmarshal submission")
+	test.AssertEquals(t, sub.Attempts, 1)
+	test.AssertEquals(t, sub.LastError, "BAD")
+	test.Assert(t, sub.NextAttempt.After(fc.Now()), "Failed submission should be retried later")
+
+	// Nothing is due yet, so nothing should be attempted, but the queue must
+	// still hold both submissions.
+	attempted, err = ctp.processFinalCertBatch()
+	test.AssertNotError(t, err, "processFinalCertBatch failed")
+	test.AssertEquals(t, attempted, 0)
+	test.AssertEquals(t, pending.Length(), uint64(2))
+
+	// After the backoff both submissions fail for the last time and are moved
+	// to the dead letter queue.
+	fc.Add(2 * time.Minute)
+	attempted, err = ctp.processFinalCertBatch()
+	test.AssertNotError(t, err, "processFinalCertBatch failed")
+	test.AssertEquals(t, attempted, 2)
+	test.AssertEquals(t, pending.Length(), uint64(0))
+	test.AssertEquals(t, deadLetter.Length(), uint64(2))
+	test.AssertEquals(t, len(log.GetAllMatching("Giving up on CT submission of final certificate")), 2)
+
+	// A cancelled replay leaves the dead letter queue alone.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	replayed, err := ReplayDeadLetters(ctx, pending, deadLetter, fc.Now())
+	test.AssertErrorIs(t, err, context.Canceled)
+	test.AssertEquals(t, replayed, 0)
+	test.AssertEquals(t, deadLetter.Length(), uint64(2))
+
+	replayed, err = ReplayDeadLetters(context.Background(), pending, deadLetter, fc.Now())
+	test.AssertNotError(t, err, "ReplayDeadLetters failed")
+	test.AssertEquals(t, replayed, 2)
+	test.AssertEquals(t, deadLetter.Length(), uint64(0))
+	test.AssertEquals(t, pending.Length(), uint64(2))
+	item, err = pending.Peek()
+	test.AssertNotError(t, err, "Failed to peek into queue")
+	sub = FinalCertSubmission{}
+	test.AssertNotError(t, item.ToObject(&sub), "Failed to unmarshal submission")
+	test.AssertEquals(t, sub.Attempts, 0)
+}
+
+func TestFinalCertLoopStops(t *testing.T) {
+	ctp, pending, _, fc, _, cleanup := setupFinalQueue(t, &mockPub{}, 3)
+	defer cleanup()
+
+	ctp.SubmitFinalCert(testPrecert, fc.Now().Add(time.Hour))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// With its context already cancelled the loop finishes the batch it
+	// started and returns.
+	ctp.FinalCertLoop(ctx)
+	test.AssertEquals(t, pending.Length(), uint64(0))
+}
+
+func TestFinalCertQueueExpired(t *testing.T) {
+	ctp, pending, deadLetter, fc, _, cleanup := setupFinalQueue(t, &mockPub{}, 5)
+	defer cleanup()
+
+	ctp.SubmitFinalCert(testPrecert, fc.Now().Add(time.Minute))
+	fc.Add(time.Hour)
+	_, err := ctp.processFinalCertBatch()
+	test.AssertNotError(t, err, "processFinalCertBatch failed")
+	test.AssertEquals(t, pending.Length(), uint64(0))
+	test.AssertEquals(t, deadLetter.Length(), uint64(2))
+}
+
+func TestFinalCertQueueUndecodable(t *testing.T) {
+	ctp, pending, deadLetter, fc, _, cleanup := setupFinalQueue(t, &mockPub{}, 5)
+	defer cleanup()
+
+	_, err := pending.Enqueue([]byte("not a gob"))
+	test.AssertNotError(t, err, "Failed to enqueue garbage")
+	ctp.SubmitFinalCert(testPrecert, fc.Now().Add(time.Hour))
+
+	attempted, err := ctp.processFinalCertBatch()
+	test.AssertNotError(t, err, "processFinalCertBatch failed")
+	test.AssertEquals(t, attempted, 2)
+	test.AssertEquals(t, pending.Length(), uint64(0))
+	test.AssertEquals(t, deadLetter.Length(), uint64(1))
+}
@@ -0,0 +1,123 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	gorp "github.com/go-gorp/gorp/v3"
+)
+
+// Dialect abstracts the SQL which differs between the database engines
+// Boulder supports: MariaDB, which is used in production, and SQLite, for
+// development and small deployments.
+type Dialect interface {
+	// UseIndex returns a hint, to follow a table name in a SELECT, that the
+	// named index should be used, or "" if the engine has no such hints.
+	UseIndex(index string) string
+	// Inserted returns an expression, for use in an OnDuplicateKeyUpdate
+	// assignment, referring to the value of column in the row which was
+	// being inserted.
+	Inserted(column string) string
+	// OnDuplicateKeyUpdate returns a clause to follow the VALUES of an INSERT
+	// which makes the assignments instead if the row conflicts with an
+	// existing row on the key made up of keyColumns.
+	OnDuplicateKeyUpdate(keyColumns []string, assignments string) string
+	// SecondsBetween returns an expression for the number of whole seconds
+	// from the time start to the time end, which is NULL if either is NULL.
+	SecondsBetween(start, end string) string
+	// LikeEscape returns a clause, to follow the pattern of a LIKE, which
+	// makes backslash the pattern's escape character.
+	LikeEscape() string
+	// isDuplicate returns true if err is the engine's error for inserting a
+	// row which conflicts with a primary or unique key.
+	isDuplicate(err error) bool
+}
+
+// SQLiteDSNPrefix marks a DSN as naming a SQLite database file, as in
+// "sqlite:/var/lib/boulder/boulder.db", rather than a MySQL server.
+const SQLiteDSNPrefix = "sqlite:"
+
+// IsSQLiteDSN returns true if the DSN names a SQLite database file.
+func IsSQLiteDSN(dsn string) bool {
+	return strings.HasPrefix(dsn, SQLiteDSNPrefix)
+}
+
+// MySQL is the Dialect for MariaDB and MySQL.
+var MySQL Dialect = mysqlDialect{}
+
+// SQLite is the Dialect for SQLite.
+var SQLite Dialect = sqliteDialect{}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) UseIndex(index string) string {
+	return fmt.Sprintf("USE INDEX (%s)", index)
+}
+
+func (mysqlDialect) Inserted(column string) string {
+	return fmt.Sprintf("VALUES(%s)", column)
+}
+
+func (mysqlDialect) OnDuplicateKeyUpdate(_ []string, assignments string) string {
+	return "ON DUPLICATE KEY UPDATE " + assignments
+}
+
+func (mysqlDialect) SecondsBetween(start, end string) string {
+	return fmt.Sprintf("TIMESTAMPDIFF(SECOND, %s, %s)", start, end)
+}
+
+// LikeEscape doubles the backslash, which escapes characters in MariaDB's
+// string literals.
+func (mysqlDialect) LikeEscape() string {
+	return `ESCAPE '\\'`
+}
+
+// isDuplicate returns true when the error has a message with a prefix matching
+// "Error 1062: Duplicate entry". This is the error prefix returned by MariaDB
+// when a duplicate row is to be inserted.
+func (mysqlDialect) isDuplicate(err error) bool {
+	return strings.HasPrefix(err.Error(), "Error 1062: Duplicate entry")
+}
+
+type sqliteDialect struct{}
+
+// UseIndex returns "": SQLite's INDEXED BY fails the query, rather than
+// falling back, if the index can't be used.
+func (sqliteDialect) UseIndex(string) string {
+	return ""
+}
+
+func (sqliteDialect) Inserted(column string) string {
+	return "excluded." + column
+}
+
+func (sqliteDialect) OnDuplicateKeyUpdate(keyColumns []string, assignments string) string {
+	return fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(keyColumns, ", "), assignments)
+}
+
+// SecondsBetween uses strftime's Unix timestamps, since SQLite has no
+// timestamp type for the difference of two times.
+func (sqliteDialect) SecondsBetween(start, end string) string {
+	return fmt.Sprintf("(CAST(strftime('%%s', %s) AS INTEGER) - CAST(strftime('%%s', %s) AS INTEGER))", end, start)
+}
+
+// LikeEscape is needed because, unlike MariaDB, SQLite's LIKE has no escape
+// character by default. Backslash isn't special in SQLite's string literals.
+func (sqliteDialect) LikeEscape() string {
+	return `ESCAPE '\'`
+}
+
+// isDuplicate returns true for SQLite's error for a primary or unique key
+// conflict, which is "UNIQUE constraint failed" for both.
+func (sqliteDialect) isDuplicate(err error) bool {
+	return strings.HasPrefix(err.Error(), "UNIQUE constraint failed")
+}
+
+// SQLDialect returns the Dialect of the database engine the map is connected
+// to.
+func (m *WrappedMap) SQLDialect() Dialect {
+	if _, ok := m.DbMap.Dialect.(gorp.SqliteDialect); ok {
+		return SQLite
+	}
+	return MySQL
+}
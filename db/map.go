@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
-	"strings"
 
 	gorp "github.com/go-gorp/gorp/v3"
 )
@@ -26,13 +25,10 @@ func (e ErrDatabaseOp) noRows() bool {
 	return e.Err == sql.ErrNoRows
 }
 
-// duplicate returns true when the underlying error has a message with a prefix
-// matching "Error 1062: Duplicate entry". This is the error prefixed returned
-// by MariaDB when a duplicate row is to be inserted.
+// duplicate returns true when the underlying error is the error returned by
+// either supported database engine when a duplicate row is to be inserted.
 func (e ErrDatabaseOp) duplicate() bool {
-	return strings.HasPrefix(
-		e.Err.Error(),
-		"Error 1062: Duplicate entry")
+	return MySQL.isDuplicate(e.Err) || SQLite.isDuplicate(e.Err)
 }
 
 // Error for an ErrDatabaseOp composes a message with context about the
@@ -96,6 +96,15 @@ func TestErrDatabaseOpDuplicate(t *testing.T) {
 			},
 			expectDuplicate: true,
 		},
+		{
+			name: "underlying err has SQLite duplicate prefix",
+			err: ErrDatabaseOp{
+				Op:    "test",
+				Table: "testTable",
+				Err:   errors.New("UNIQUE constraint failed: testTable.id"),
+			},
+			expectDuplicate: true,
+		},
 		{
 			name: "underlying err doesn't have duplicate prefix",
 			err: ErrDatabaseOp{
@@ -74,7 +74,15 @@ func (mi *MultiInserter) query() (string, []interface{}) {
 // which is assumed to already have a context attached. If a non-empty retCol
 // was provided, then it returns the list of values from that column returned
 // by the query.
-func (mi *MultiInserter) Insert(exec Executor) ([]int64, error) {
+//
+// SQLite can't return a column from an INSERT, and limits the number of
+// arguments to a query, so with the SQLite dialect each row is inserted
+// separately. retCol must then be the table's integer primary key.
+func (mi *MultiInserter) Insert(exec Executor, dialect Dialect) ([]int64, error) {
+	if dialect == SQLite {
+		return mi.insertEach(exec)
+	}
+
 	query, queryArgs := mi.query()
 	rows, err := exec.Query(query, queryArgs...)
 	if err != nil {
@@ -101,3 +109,26 @@ func (mi *MultiInserter) Insert(exec Executor) ([]int64, error) {
 
 	return ids, nil
 }
+
+// insertEach inserts the rows one at a time, returning the IDs assigned to
+// them if a non-empty retCol was provided.
+func (mi *MultiInserter) insertEach(exec Executor) ([]int64, error) {
+	questionsRow := strings.TrimRight(strings.Repeat("?,", mi.numFields), ",")
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);", mi.table, mi.fields, questionsRow)
+
+	ids := make([]int64, 0, len(mi.values))
+	for _, row := range mi.values {
+		res, err := exec.Exec(query, row...)
+		if err != nil {
+			return nil, err
+		}
+		if mi.retCol != "" {
+			id, err := res.LastInsertId()
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
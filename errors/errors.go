@@ -2,6 +2,7 @@ package errors
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/letsencrypt/boulder/identifier"
 )
@@ -30,6 +31,7 @@ const (
 	DNS
 	BadPublicKey
 	BadCSR
+	RestrictedIdentifier
 )
 
 func (ErrorType) Error() string {
@@ -41,6 +43,26 @@ type BoulderError struct {
 	Type      ErrorType
 	Detail    string
 	SubErrors []SubBoulderError
+
+	// RateLimitDetails describes the exceeded limit of a RateLimit error. It
+	// is optional, even for RateLimit errors.
+	RateLimitDetails *RateLimitDetails `json:",omitempty"`
+}
+
+// RateLimitDetails describes the rate limit which caused a RateLimit error, so
+// that clients can be told when it is worth retrying.
+type RateLimitDetails struct {
+	// Limit is the name of the limit in the rate limit policy file, e.g.
+	// "certificatesPerName".
+	Limit string
+	// Key is what the limit counts on, e.g. a registered domain. It is empty
+	// for per-account limits.
+	Key       string `json:",omitempty"`
+	Count     int64
+	Threshold int64
+	// RetryAfter is how long until the request might succeed. It is zero if
+	// that isn't known.
+	RetryAfter time.Duration
 }
 
 // SubBoulderError represents sub-errors specific to an identifier that are
@@ -62,9 +84,10 @@ func (be *BoulderError) Unwrap() error {
 // provided subErrs to the existing BoulderError.
 func (be *BoulderError) WithSubErrors(subErrs []SubBoulderError) *BoulderError {
 	return &BoulderError{
-		Type:      be.Type,
-		Detail:    be.Detail,
-		SubErrors: append(be.SubErrors, subErrs...),
+		Type:             be.Type,
+		Detail:           be.Detail,
+		SubErrors:        append(be.SubErrors, subErrs...),
+		RateLimitDetails: be.RateLimitDetails,
 	}
 }
 
@@ -99,6 +122,16 @@ func RateLimitError(msg string, args ...interface{}) error {
 	}
 }
 
+// RateLimitErrorWithDetails is like RateLimitError, but also describes the
+// exceeded limit so that it can be returned to the client.
+func RateLimitErrorWithDetails(details RateLimitDetails, msg string, args ...interface{}) error {
+	return &BoulderError{
+		Type:             RateLimit,
+		Detail:           fmt.Sprintf(msg+": see https://letsencrypt.org/docs/rate-limits/", args...),
+		RateLimitDetails: &details,
+	}
+}
+
 func RejectedIdentifierError(msg string, args ...interface{}) error {
 	return New(RejectedIdentifier, msg, args...)
 }
@@ -138,3 +171,7 @@ func BadPublicKeyError(msg string, args ...interface{}) error {
 func BadCSRError(msg string, args ...interface{}) error {
 	return New(BadCSR, msg, args...)
 }
+
+func RestrictedIdentifierError(msg string, args ...interface{}) error {
+	return New(RestrictedIdentifier, msg, args...)
+}
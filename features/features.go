@@ -51,14 +51,31 @@ const (
 	// GET requests. WARNING: This feature is a draft and highly unstable.
 	ServeRenewalInfo
 	// GetAuthzReadOnly causes the SA to use its read-only database connection
-	// (which is generally pointed at a replica rather than the primary db) when
-	// querying the authz2 table.
+	// (which is generally pointed at a replica rather than the primary db) for
+	// GetAuthorizations2, unless the SA's readRoutes configure that method.
+	// Prefer configuring a read route, which can also take replica lag into
+	// account.
 	GetAuthzReadOnly
 	// GetAuthzUseIndex causes the SA to use to add a USE INDEX hint when it
 	// queries the authz2 table.
 	GetAuthzUseIndex
 	// Check the failed authorization limit before doing authz reuse.
 	CheckFailedAuthorizationsFirst
+	// AccountIdentifierPolicies causes the RA to restrict the identifiers in
+	// orders from accounts which have an identifier policy in the SA.
+	AccountIdentifierPolicies
+	// AccountSuspensions causes the RA to refuse new orders, finalization and
+	// validation for accounts which are suspended in the SA, and to
+	// automatically suspend accounts which repeatedly fail validation when
+	// configured to.
+	AccountSuspensions
+	// PauseIdentifiers causes the RA to pause accounts from ordering
+	// certificates for identifiers which they have failed to validate too many
+	// times in a row, and the WFE to refuse new orders for paused identifiers.
+	PauseIdentifiers
+	// BlockedNames causes the RA to refuse orders and finalization for names
+	// which have been blocked in the SA using the admin tool.
+	BlockedNames
 )
 
 // List of features and their default value, protected by fMu
@@ -84,6 +101,10 @@ var features = map[FeatureFlag]bool{
 	GetAuthzReadOnly:               false,
 	GetAuthzUseIndex:               false,
 	CheckFailedAuthorizationsFirst: false,
+	AccountIdentifierPolicies:      false,
+	AccountSuspensions:             false,
+	PauseIdentifiers:               false,
+	BlockedNames:                   false,
 }
 
 var fMu = new(sync.RWMutex)
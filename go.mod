@@ -1,6 +1,6 @@
 module github.com/letsencrypt/boulder
 
-go 1.12
+go 1.16
 
 require (
 	github.com/beeker1121/goque v1.0.3-0.20191103205551-d618510128af
@@ -17,6 +17,7 @@ require (
 	github.com/jmhodges/clock v0.0.0-20160418191101-880ee4c33548
 	github.com/letsencrypt/challtestsrv v1.2.0
 	github.com/letsencrypt/pkcs11key/v4 v4.0.0
+	github.com/mattn/go-sqlite3 v1.14.6
 	github.com/miekg/dns v1.1.30
 	github.com/miekg/pkcs11 v1.0.3
 	github.com/prometheus/client_golang v1.7.1
@@ -38,3 +39,8 @@ require (
 // that we do not rely upon. It appears to introduce performance regressions
 // for us.
 exclude github.com/go-sql-driver/mysql v1.6.0
+
+// This version is required by parts of the gobuffalo packages that we do not
+// rely upon. It is an old, retracted tag which sorts above the v1 releases and
+// predates the driver version we vendor.
+exclude github.com/mattn/go-sqlite3 v2.0.3+incompatible
@@ -50,6 +50,10 @@ func ClientSetup(c *cmd.GRPCClientConfig, tlsConfig *tls.Config, metrics clientM
 		grpc.WithBalancerName("round_robin"),
 		grpc.WithTransportCredentials(creds),
 		grpc.WithChainUnaryInterceptor(allInterceptors...),
+		grpc.WithChainStreamInterceptor(
+			ci.interceptStream,
+			ci.metrics.grpcMetrics.StreamClientInterceptor(),
+		),
 	)
 }
 
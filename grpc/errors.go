@@ -45,6 +45,17 @@ func wrapError(ctx context.Context, err error) error {
 			pairs = append(pairs, string(jsonSubErrs))
 		}
 
+		// Likewise, rate limit details are passed as JSON.
+		if berr.RateLimitDetails != nil {
+			jsonDetails, err := json.Marshal(berr.RateLimitDetails)
+			if err != nil {
+				return berrors.InternalServerError(
+					"error marshaling json RateLimitDetails, orig error %q",
+					err)
+			}
+			pairs = append(pairs, "ratelimitdetails", string(jsonDetails))
+		}
+
 		// Ignoring the error return here is safe because if setting the metadata
 		// fails, we'll still return an error, but it will be interpreted on the
 		// other side as an InternalServerError instead of a more specific one.
@@ -86,6 +97,26 @@ func unwrapError(err error, md metadata.MD) error {
 	}
 	outErr := berrors.New(berrors.ErrorType(errType), unwrappedErr)
 
+	detailsJSON, ok := md["ratelimitdetails"]
+	if ok {
+		if len(detailsJSON) != 1 {
+			return berrors.InternalServerError(
+				"multiple ratelimitdetails metadata, wrapped error %q",
+				unwrappedErr,
+			)
+		}
+		var details berrors.RateLimitDetails
+		err := json.Unmarshal([]byte(detailsJSON[0]), &details)
+		if err != nil {
+			return berrors.InternalServerError(
+				"error unmarshaling ratelimitdetails JSON %q, wrapped error %q",
+				detailsJSON[0],
+				unwrappedErr,
+			)
+		}
+		outErr.(*berrors.BoulderError).RateLimitDetails = &details
+	}
+
 	subErrsJSON, ok := md["suberrors"]
 	if !ok {
 		return outErr
@@ -96,3 +96,48 @@ func TestSubErrorWrapping(t *testing.T) {
 	test.Assert(t, err != nil, fmt.Sprintf("nil error returned, expected: %s", err))
 	test.AssertDeepEquals(t, err, es.err)
 }
+
+// TestRateLimitDetailsWrapping tests that the details of a rate limit error,
+// including those of its suberrors, are preserved across the RPC layer.
+func TestRateLimitDetailsWrapping(t *testing.T) {
+	serverMetrics := NewServerMetrics(metrics.NoopRegisterer)
+	si := newServerInterceptor(serverMetrics, clock.NewFake())
+	ci := clientInterceptor{time.Second, NewClientMetrics(metrics.NoopRegisterer), clock.NewFake()}
+	srv := grpc.NewServer(grpc.UnaryInterceptor(si.intercept))
+	es := &errorServer{}
+	test_proto.RegisterChillerServer(srv, es)
+	lis, err := net.Listen("tcp", "127.0.0.1:")
+	test.AssertNotError(t, err, "Failed to create listener")
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	conn, err := grpc.Dial(
+		lis.Addr().String(),
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(ci.intercept),
+	)
+	test.AssertNotError(t, err, "Failed to dial grpc test server")
+	client := test_proto.NewChillerClient(conn)
+
+	details := berrors.RateLimitDetails{
+		Limit:      "certificatesPerName",
+		Key:        "chillserver.com",
+		Count:      50,
+		Threshold:  50,
+		RetryAfter: 90 * time.Minute,
+	}
+	es.err = berrors.RateLimitErrorWithDetails(details, "too much chill")
+	_, err = client.Chill(context.Background(), &test_proto.Time{})
+	test.Assert(t, err != nil, fmt.Sprintf("nil error returned, expected: %s", err))
+	test.AssertDeepEquals(t, err, es.err)
+
+	es.err = es.err.(*berrors.BoulderError).WithSubErrors([]berrors.SubBoulderError{
+		{
+			Identifier:   identifier.DNSIdentifier("chillserver.com"),
+			BoulderError: berrors.RateLimitErrorWithDetails(details, "too much chill").(*berrors.BoulderError),
+		},
+	})
+	_, err = client.Chill(context.Background(), &test_proto.Time{})
+	test.Assert(t, err != nil, fmt.Sprintf("nil error returned, expected: %s", err))
+	test.AssertDeepEquals(t, err, es.err)
+}
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"time"
@@ -101,6 +102,28 @@ func (si *serverInterceptor) intercept(ctx context.Context, req interface{}, inf
 	return resp, err
 }
 
+// interceptStream is the streaming counterpart of intercept. It observes the
+// RPC's latency and wraps Boulder errors in the same way, but doesn't shave
+// the deadline, since a stream's deadline, if it has one, covers all of its
+// messages rather than a single response.
+func (si *serverInterceptor) interceptStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if info == nil {
+		return berrors.InternalServerError("passed nil *grpc.StreamServerInfo")
+	}
+
+	if md, ok := metadata.FromIncomingContext(ss.Context()); ok && len(md[clientRequestTimeKey]) > 0 {
+		if err := si.observeLatency(md[clientRequestTimeKey][0]); err != nil {
+			return err
+		}
+	}
+
+	err := handler(srv, ss)
+	if err != nil {
+		err = wrapError(ss.Context(), err)
+	}
+	return err
+}
+
 // splitMethodName is borrowed directly from
 // `grpc-ecosystem/go-grpc-prometheus/util.go` and is used to extract the
 // service and method name from the `method` argument to
@@ -213,6 +236,48 @@ func (ci *clientInterceptor) intercept(
 	return err
 }
 
+// interceptStream is the streaming counterpart of intercept. It tags the
+// request with the time it was sent, disables FailFast and unwraps Boulder
+// errors received from the server. The client's timeout isn't applied, since
+// a stream may legitimately last much longer than a unary RPC: callers should
+// set a deadline on ctx instead. Streams aren't counted in inFlightRPCs,
+// since a stream the caller stops reading from would never be seen to end.
+func (ci *clientInterceptor) interceptStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	cc *grpc.ClientConn,
+	fullMethod string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	opts = append(opts, grpc.WaitForReady(true))
+
+	nowTS := strconv.FormatInt(ci.clk.Now().UnixNano(), 10)
+	reqMD := metadata.New(map[string]string{clientRequestTimeKey: nowTS})
+	ctx = metadata.NewOutgoingContext(ctx, reqMD)
+
+	cs, err := streamer(ctx, desc, cc, fullMethod, opts...)
+	if err != nil {
+		return nil, unwrapError(err, nil)
+	}
+	return errUnwrappingStream{cs}, nil
+}
+
+// errUnwrappingStream is a grpc.ClientStream which unwraps the errors it
+// receives, using the trailer the server wrapped them in.
+type errUnwrappingStream struct {
+	grpc.ClientStream
+}
+
+// RecvMsg returns io.EOF unchanged, since it marks the end of the stream
+// rather than an error.
+func (s errUnwrappingStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil || err == io.EOF {
+		return err
+	}
+	return unwrapError(err, s.ClientStream.Trailer())
+}
+
 // CancelTo408Interceptor calls the underlying invoker, checks to see if the
 // resulting error was a gRPC Canceled error (because this client cancelled
 // the request, likely because the ACME client itself canceled the HTTP
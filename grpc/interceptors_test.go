@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"errors"
+	"io"
 	"log"
 	"net"
 	"net/http"
@@ -19,6 +20,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/grpc/test_proto"
 	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/probs"
@@ -79,6 +81,97 @@ func TestClientInterceptor(t *testing.T) {
 	test.AssertError(t, err, "ci.intercept didn't fail when handler returned a error")
 }
 
+// testServerStream is a grpc.ServerStream which only has a context.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestServerStreamInterceptor(t *testing.T) {
+	serverMetrics := NewServerMetrics(metrics.NoopRegisterer)
+	si := newServerInterceptor(serverMetrics, clock.NewFake())
+
+	md := metadata.New(map[string]string{clientRequestTimeKey: "0"})
+	ss := testServerStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+	info := &grpc.StreamServerInfo{FullMethod: "-service-test", IsServerStream: true}
+	handler := func(srv interface{}, _ grpc.ServerStream) error {
+		if srv != nil {
+			return berrors.NotFoundError("nothing here")
+		}
+		return nil
+	}
+
+	err := si.interceptStream(nil, ss, nil, handler)
+	test.AssertError(t, err, "si.interceptStream didn't fail with a nil grpc.StreamServerInfo")
+
+	err = si.interceptStream(nil, ss, info, handler)
+	test.AssertNotError(t, err, "si.interceptStream failed with a non-nil grpc.StreamServerInfo")
+
+	err = si.interceptStream(0, ss, info, handler)
+	test.AssertError(t, err, "si.interceptStream didn't fail when handler returned a error")
+	test.AssertEquals(t, status.Code(err), codes.Unknown)
+
+	// A stream without a request time isn't rejected.
+	err = si.interceptStream(nil, testServerStream{ctx: context.Background()}, info, handler)
+	test.AssertNotError(t, err, "si.interceptStream failed with a context missing metadata")
+}
+
+// testClientStream is a grpc.ClientStream whose RecvMsg returns recvErr, with
+// trailer as the stream's trailer.
+type testClientStream struct {
+	grpc.ClientStream
+	recvErr error
+	trailer metadata.MD
+}
+
+func (s testClientStream) RecvMsg(interface{}) error {
+	return s.recvErr
+}
+
+func (s testClientStream) Trailer() metadata.MD {
+	return s.trailer
+}
+
+func TestClientStreamInterceptor(t *testing.T) {
+	ci := clientInterceptor{
+		timeout: time.Second,
+		metrics: NewClientMetrics(metrics.NoopRegisterer),
+		clk:     clock.NewFake(),
+	}
+	var recvErr error
+	streamer := func(ctx context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, method string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if !ok || len(md[clientRequestTimeKey]) == 0 {
+			return nil, errors.New("request time missing")
+		}
+		if method == "-service-brokeTest" {
+			return nil, status.Error(codes.Unavailable, "broken")
+		}
+		return testClientStream{
+			recvErr: recvErr,
+			trailer: metadata.Pairs("errortype", strconv.Itoa(int(berrors.NotFound))),
+		}, nil
+	}
+
+	_, err := ci.interceptStream(context.Background(), nil, nil, "-service-brokeTest", streamer)
+	test.AssertError(t, err, "ci.interceptStream didn't fail when the streamer failed")
+
+	recvErr = io.EOF
+	cs, err := ci.interceptStream(context.Background(), nil, nil, "-service-test", streamer)
+	test.AssertNotError(t, err, "ci.interceptStream failed")
+	test.AssertEquals(t, cs.RecvMsg(nil), io.EOF)
+
+	recvErr = status.Error(codes.Unknown, "nothing here")
+	cs, err = ci.interceptStream(context.Background(), nil, nil, "-service-test", streamer)
+	test.AssertNotError(t, err, "ci.interceptStream failed")
+	err = cs.RecvMsg(nil)
+	test.Assert(t, errors.Is(err, berrors.NotFound), "RecvMsg's error wasn't unwrapped from the trailer")
+}
+
 func TestCancelTo408Interceptor(t *testing.T) {
 	err := CancelTo408Interceptor(context.Background(), "-service-test", nil, nil, nil, testInvoker)
 	test.AssertNotError(t, err, "CancelTo408Interceptor returned an error when it shouldn't")
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math/big"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/linter"
+	"github.com/letsencrypt/boulder/linter/lints/profile"
 	"github.com/letsencrypt/boulder/policyasn1"
 	"github.com/letsencrypt/boulder/privatekey"
 	"github.com/letsencrypt/pkcs11key/v4"
@@ -43,6 +45,12 @@ type ProfileConfig struct {
 	Policies            []PolicyInformation
 	MaxValidityPeriod   cmd.ConfigDuration
 	MaxValidityBackdate cmd.ConfigDuration
+
+	// Lints configures the Boulder-specific lints which every certificate
+	// issued under this profile must pass. Its AllowMustStaple is always taken
+	// from this profile, and if its URLHosts are empty they are taken from the
+	// issuer's URLs.
+	Lints profile.Config
 }
 
 // PolicyInformation describes a policy
@@ -169,6 +177,8 @@ type Profile struct {
 
 	maxBackdate time.Duration
 	maxValidity time.Duration
+
+	lints *profile.Policy
 }
 
 func parseOID(oidStr string) (asn1.ObjectIdentifier, error) {
@@ -212,6 +222,25 @@ func NewProfile(profileConfig ProfileConfig, issuerConfig IssuerConfig) (*Profil
 		maxBackdate:       profileConfig.MaxValidityBackdate.Duration,
 		maxValidity:       profileConfig.MaxValidityPeriod.Duration,
 	}
+	lintConfig := profileConfig.Lints
+	lintConfig.AllowMustStaple = profileConfig.AllowMustStaple
+	if len(lintConfig.URLHosts) == 0 {
+		for _, u := range []string{issuerConfig.IssuerURL, issuerConfig.OCSPURL, issuerConfig.CRLURL} {
+			if u == "" {
+				continue
+			}
+			parsed, err := url.Parse(u)
+			if err != nil {
+				return nil, fmt.Errorf("failed parsing issuer URL %q: %s", u, err)
+			}
+			lintConfig.URLHosts = append(lintConfig.URLHosts, parsed.Host)
+		}
+	}
+	lints, err := profile.NewPolicy(lintConfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lint config: %s", err)
+	}
+	sp.lints = lints
 	if len(profileConfig.Policies) > 0 {
 		var policies []policyasn1.PolicyInformation
 		for _, policyConfig := range profileConfig.Policies {
@@ -638,8 +667,9 @@ func (i *Issuer) Issue(req *IssuanceRequest) ([]byte, error) {
 	}
 
 	// check that the tbsCertificate is properly formed by signing it
-	// with a throwaway key and then linting it using zlint
-	err = i.Linter.Check(template, req.PublicKey)
+	// with a throwaway key and then linting it using zlint and the
+	// profile's own lints
+	err = i.Linter.Check(template, req.PublicKey, i.Profile.lints.Lints(&profile.Request{MustStaple: req.IncludeMustStaple})...)
 	if err != nil {
 		return nil, fmt.Errorf("tbsCertificate linting failed: %w", err)
 	}
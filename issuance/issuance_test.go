@@ -22,6 +22,7 @@ import (
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/linter"
+	"github.com/letsencrypt/boulder/linter/lints/profile"
 	"github.com/letsencrypt/boulder/policyasn1"
 	"github.com/letsencrypt/boulder/test"
 )
@@ -89,6 +90,11 @@ func TestNewProfilePolicies(t *testing.T) {
 			},
 		},
 	})
+	lints, err := profile.NewPolicy(profile.Config{
+		AllowMustStaple: true,
+		URLHosts:        []string{"issuer-url", "ocsp-url"},
+	})
+	test.AssertNotError(t, err, "NewPolicy failed")
 	profile, err := NewProfile(config, defaultIssuerConfig())
 	test.AssertNotError(t, err, "NewProfile failed")
 	test.AssertDeepEquals(t, *profile, Profile{
@@ -106,6 +112,7 @@ func TestNewProfilePolicies(t *testing.T) {
 		},
 		maxBackdate: time.Hour,
 		maxValidity: time.Hour,
+		lints:       lints,
 	})
 	var policies []policyasn1.PolicyInformation
 	_, err = asn1.Unmarshal(profile.policies.Value, &policies)
@@ -719,6 +726,55 @@ func TestIssueBadLint(t *testing.T) {
 	test.AssertEquals(t, err.Error(), "tbsCertificate linting failed: failed lints: w_ct_sct_policy_count_unsatisfied")
 }
 
+func TestIssueProfileLints(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+	linter, err := linter.New(
+		issuerCert.Certificate,
+		issuerSigner,
+		[]string{"w_ct_sct_policy_count_unsatisfied"},
+	)
+	test.AssertNotError(t, err, "failed to create linter")
+	config := defaultProfileConfig()
+	config.Lints.MaxNames = 1
+	p, err := NewProfile(config, defaultIssuerConfig())
+	test.AssertNotError(t, err, "NewProfile failed")
+	signer, err := NewIssuer(issuerCert, issuerSigner, p, linter, fc)
+	test.AssertNotError(t, err, "NewIssuer failed")
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+	_, err = signer.Issue(&IssuanceRequest{
+		PublicKey: pk.Public(),
+		Serial:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		DNSNames:  []string{"example.com", "www.example.com"},
+		NotBefore: fc.Now(),
+		NotAfter:  fc.Now().Add(time.Hour - time.Second),
+	})
+	test.AssertError(t, err, "Issue didn't fail")
+	test.AssertEquals(t, err.Error(), "tbsCertificate linting failed: failed lints: e_boulder_too_many_names")
+
+	config = defaultProfileConfig()
+	config.Lints.URLHosts = []string{"elsewhere"}
+	p, err = NewProfile(config, defaultIssuerConfig())
+	test.AssertNotError(t, err, "NewProfile failed")
+	signer, err = NewIssuer(issuerCert, issuerSigner, p, linter, fc)
+	test.AssertNotError(t, err, "NewIssuer failed")
+	_, err = signer.Issue(&IssuanceRequest{
+		PublicKey: pk.Public(),
+		Serial:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		DNSNames:  []string{"example.com"},
+		NotBefore: fc.Now(),
+		NotAfter:  fc.Now().Add(time.Hour - time.Second),
+	})
+	test.AssertError(t, err, "Issue didn't fail")
+	test.AssertEquals(t, err.Error(), "tbsCertificate linting failed: failed lints: e_boulder_unexpected_url_host")
+
+	config = defaultProfileConfig()
+	config.Lints.ExtKeyUsages = []string{"bogus"}
+	_, err = NewProfile(config, defaultIssuerConfig())
+	test.AssertError(t, err, "NewProfile accepted an unknown extended key usage")
+}
+
 func TestLoadChain_Valid(t *testing.T) {
 	chain, err := LoadChain([]string{
 		"../test/test-ca-cross.pem",
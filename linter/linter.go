@@ -66,13 +66,15 @@ func New(realIssuer *x509.Certificate, realSigner crypto.Signer, skipLints []str
 
 // Check signs the given TBS certificate using the Linter's fake issuer cert and
 // private key, then runs the resulting certificate through all non-filtered
-// lints. It returns an error if any lint fails.
-func (l Linter) Check(tbs *x509.Certificate, subjectPubKey crypto.PublicKey) error {
+// lints, plus any extra lints given, such as those from the lints/profile
+// package which depend on the issuance profile. It returns an error if any
+// lint fails.
+func (l Linter) Check(tbs *x509.Certificate, subjectPubKey crypto.PublicKey, extraLints ...*lint.Lint) error {
 	cert, err := makeLintCert(tbs, subjectPubKey, l.issuer, l.signer)
 	if err != nil {
 		return err
 	}
-	return check(cert, l.registry)
+	return check(cert, l.registry, extraLints)
 }
 
 func makeSigner(realSigner crypto.Signer) (crypto.Signer, error) {
@@ -174,15 +176,22 @@ func makeLintCert(tbs *x509.Certificate, subjectPubKey crypto.PublicKey, issuer
 	return lintCert, nil
 }
 
-func check(lintCert *zlintx509.Certificate, lints lint.Registry) error {
+func check(lintCert *zlintx509.Certificate, lints lint.Registry, extraLints []*lint.Lint) error {
 	lintRes := zlint.LintCertificateEx(lintCert, lints)
+	var failedLints []string
 	if lintRes.NoticesPresent || lintRes.WarningsPresent || lintRes.ErrorsPresent || lintRes.FatalsPresent {
-		var failedLints []string
 		for lintName, result := range lintRes.Results {
 			if result.Status > lint.Pass {
 				failedLints = append(failedLints, lintName)
 			}
 		}
+	}
+	for _, extra := range extraLints {
+		if extra.Execute(lintCert).Status > lint.Pass {
+			failedLints = append(failedLints, extra.Name)
+		}
+	}
+	if len(failedLints) > 0 {
 		return fmt.Errorf("failed lints: %s", strings.Join(failedLints, ", "))
 	}
 	return nil
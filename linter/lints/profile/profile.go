@@ -0,0 +1,333 @@
+// Package profile contains lints which check subscriber certificates against
+// Boulder's own issuance policy. Unlike the other lints under linter/lints,
+// which are registered with zlint's global registry, these lints depend on how
+// a particular issuance profile is configured, so they are constructed from a
+// Policy and passed explicitly to linter.Linter.Check or run by cert-checker.
+package profile
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+
+	"github.com/letsencrypt/boulder/linter/lints"
+)
+
+// maxCNLength is the maximum length of a CommonName, per the ub-common-name
+// upper bound in RFC 5280 Appendix A.1.
+const maxCNLength = 64
+
+var (
+	// oidTLSFeature is the TLS Feature extension defined in RFC 7633.
+	oidTLSFeature = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 24}
+	// mustStapleValue is a TLS Feature extension value containing only the
+	// status_request feature, which is the only one Boulder ever includes.
+	mustStapleValue = []byte{0x30, 0x03, 0x02, 0x01, 0x05}
+)
+
+// Config describes the issuance policy enforced by the profile lints. It is
+// embedded in issuance.ProfileConfig and in the cert-checker config.
+type Config struct {
+	// MaxNames is the maximum number of SANs a certificate may contain. If it
+	// is zero, the number of SANs is not checked.
+	MaxNames int
+	// AllowMustStaple is whether certificates may contain the must-staple
+	// extension.
+	AllowMustStaple bool
+	// RSAKeyUsages and ECDSAKeyUsages are the exact key usages which
+	// certificates for RSA and ECDSA keys must have, using the names from
+	// RFC 5280 Section 4.2.1.3, e.g. "digitalSignature". If empty they default
+	// to the key usages Boulder includes.
+	RSAKeyUsages   []string
+	ECDSAKeyUsages []string
+	// ExtKeyUsages are the exact extended key usages which certificates must
+	// have, either "serverAuth" or "clientAuth". If empty they default to the
+	// extended key usages Boulder includes.
+	ExtKeyUsages []string
+	// URLHosts are the hosts which the AIA OCSP and CA Issuers URLs and the
+	// CRL distribution point URLs may use. If empty, URLs are not checked.
+	URLHosts []string
+}
+
+// Request describes what was asked for when a certificate was requested, for
+// the lints which check that a certificate contains only what was requested.
+type Request struct {
+	MustStaple bool
+}
+
+// Policy is a parsed Config, from which the profile lints are constructed.
+type Policy struct {
+	maxNames        int
+	allowMustStaple bool
+	keyUsages       map[x509.PublicKeyAlgorithm]x509.KeyUsage
+	extKeyUsages    []x509.ExtKeyUsage
+	urlHosts        map[string]bool
+}
+
+var keyUsagesByName = map[string]x509.KeyUsage{
+	"digitalSignature":  x509.KeyUsageDigitalSignature,
+	"contentCommitment": x509.KeyUsageContentCommitment,
+	"keyEncipherment":   x509.KeyUsageKeyEncipherment,
+	"dataEncipherment":  x509.KeyUsageDataEncipherment,
+	"keyAgreement":      x509.KeyUsageKeyAgreement,
+}
+
+var extKeyUsagesByName = map[string]x509.ExtKeyUsage{
+	"serverAuth": x509.ExtKeyUsageServerAuth,
+	"clientAuth": x509.ExtKeyUsageClientAuth,
+}
+
+func parseKeyUsages(names []string, def x509.KeyUsage) (x509.KeyUsage, error) {
+	if len(names) == 0 {
+		return def, nil
+	}
+	var ku x509.KeyUsage
+	for _, name := range names {
+		u, ok := keyUsagesByName[name]
+		if !ok {
+			return 0, fmt.Errorf("unknown key usage %q", name)
+		}
+		ku |= u
+	}
+	return ku, nil
+}
+
+// NewPolicy parses a Config into a Policy.
+func NewPolicy(c Config) (*Policy, error) {
+	if c.MaxNames < 0 {
+		return nil, fmt.Errorf("MaxNames must not be negative, got %d", c.MaxNames)
+	}
+	rsaKU, err := parseKeyUsages(c.RSAKeyUsages, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment)
+	if err != nil {
+		return nil, err
+	}
+	ecdsaKU, err := parseKeyUsages(c.ECDSAKeyUsages, x509.KeyUsageDigitalSignature)
+	if err != nil {
+		return nil, err
+	}
+	ekus := []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	if len(c.ExtKeyUsages) > 0 {
+		ekus = nil
+		for _, name := range c.ExtKeyUsages {
+			eku, ok := extKeyUsagesByName[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown extended key usage %q", name)
+			}
+			ekus = append(ekus, eku)
+		}
+	}
+	sort.Slice(ekus, func(i, j int) bool { return ekus[i] < ekus[j] })
+	var hosts map[string]bool
+	if len(c.URLHosts) > 0 {
+		hosts = make(map[string]bool, len(c.URLHosts))
+		for _, host := range c.URLHosts {
+			hosts[host] = true
+		}
+	}
+	return &Policy{
+		maxNames:        c.MaxNames,
+		allowMustStaple: c.AllowMustStaple,
+		keyUsages: map[x509.PublicKeyAlgorithm]x509.KeyUsage{
+			x509.RSA:   rsaKU,
+			x509.ECDSA: ecdsaKU,
+		},
+		extKeyUsages: ekus,
+		urlHosts:     hosts,
+	}, nil
+}
+
+// Lints returns the profile lints for a certificate issued in response to the
+// given request. If req is nil, as in cert-checker which doesn't know what was
+// requested, the lints which depend on the request only check the certificate
+// against the policy. A nil Policy has no lints.
+func (p *Policy) Lints(req *Request) []*lint.Lint {
+	if p == nil {
+		return nil
+	}
+	newLint := func(name, description string, l lint.LintInterface) *lint.Lint {
+		return &lint.Lint{
+			Name:        name,
+			Description: description,
+			Citation:    "Boulder issuance profile",
+			Source:      lints.LetsEncryptProfile,
+			Lint:        func() lint.LintInterface { return l },
+		}
+	}
+	ret := []*lint.Lint{
+		newLint("e_boulder_common_name_too_long",
+			"Subscriber certificate CommonNames must not be longer than 64 bytes",
+			&cnTooLong{}),
+		newLint("e_boulder_key_usage_mismatch",
+			"Subscriber certificates must have exactly the key usages configured for their key type",
+			&keyUsageMismatch{expected: p.keyUsages}),
+		newLint("e_boulder_ext_key_usage_mismatch",
+			"Subscriber certificates must have exactly the configured extended key usages",
+			&extKeyUsageMismatch{expected: p.extKeyUsages}),
+		newLint("e_boulder_must_staple_not_allowed",
+			"Subscriber certificates must contain the must-staple extension only when allowed and requested",
+			&mustStaple{allowed: p.allowMustStaple, req: req}),
+	}
+	if p.maxNames > 0 {
+		ret = append(ret, newLint("e_boulder_too_many_names",
+			"Subscriber certificates must not contain more SANs than the configured maximum",
+			&tooManyNames{max: p.maxNames}))
+	}
+	if p.urlHosts != nil {
+		ret = append(ret, newLint("e_boulder_unexpected_url_host",
+			"Subscriber certificate AIA and CRL distribution point URLs must use the configured hosts",
+			&unexpectedURLHost{hosts: p.urlHosts}))
+	}
+	return ret
+}
+
+// subscriberCert is the CheckApplies method shared by the profile lints.
+func subscriberCert(c *x509.Certificate) bool {
+	return util.IsServerAuthCert(c) && !c.IsCA
+}
+
+type tooManyNames struct {
+	max int
+}
+
+func (l *tooManyNames) CheckApplies(c *x509.Certificate) bool {
+	return subscriberCert(c)
+}
+
+func (l *tooManyNames) Execute(c *x509.Certificate) *lint.LintResult {
+	if len(c.DNSNames)+len(c.IPAddresses) > l.max {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("certificate has %d SANs, more than the maximum of %d", len(c.DNSNames)+len(c.IPAddresses), l.max),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+type cnTooLong struct{}
+
+func (l *cnTooLong) CheckApplies(c *x509.Certificate) bool {
+	return subscriberCert(c)
+}
+
+func (l *cnTooLong) Execute(c *x509.Certificate) *lint.LintResult {
+	for _, cn := range c.Subject.CommonNames {
+		if len(cn) > maxCNLength {
+			return &lint.LintResult{
+				Status:  lint.Error,
+				Details: fmt.Sprintf("CommonName is %d bytes long", len(cn)),
+			}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+type keyUsageMismatch struct {
+	expected map[x509.PublicKeyAlgorithm]x509.KeyUsage
+}
+
+func (l *keyUsageMismatch) CheckApplies(c *x509.Certificate) bool {
+	_, ok := l.expected[c.PublicKeyAlgorithm]
+	return subscriberCert(c) && ok
+}
+
+func (l *keyUsageMismatch) Execute(c *x509.Certificate) *lint.LintResult {
+	expected := l.expected[c.PublicKeyAlgorithm]
+	if c.KeyUsage != expected {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("key usage is %#x, want %#x", c.KeyUsage, expected),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+type extKeyUsageMismatch struct {
+	expected []x509.ExtKeyUsage
+}
+
+func (l *extKeyUsageMismatch) CheckApplies(c *x509.Certificate) bool {
+	return !c.IsCA
+}
+
+func (l *extKeyUsageMismatch) Execute(c *x509.Certificate) *lint.LintResult {
+	if len(c.UnknownExtKeyUsage) > 0 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("unexpected extended key usages %v", c.UnknownExtKeyUsage),
+		}
+	}
+	got := make([]x509.ExtKeyUsage, len(c.ExtKeyUsage))
+	copy(got, c.ExtKeyUsage)
+	sort.Slice(got, func(i, j int) bool { return got[i] < got[j] })
+	mismatch := len(got) != len(l.expected)
+	for i := 0; !mismatch && i < len(got); i++ {
+		mismatch = got[i] != l.expected[i]
+	}
+	if mismatch {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("extended key usages are %v, want %v", c.ExtKeyUsage, l.expected),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+type unexpectedURLHost struct {
+	hosts map[string]bool
+}
+
+func (l *unexpectedURLHost) CheckApplies(c *x509.Certificate) bool {
+	return subscriberCert(c)
+}
+
+func (l *unexpectedURLHost) Execute(c *x509.Certificate) *lint.LintResult {
+	var urls []string
+	urls = append(urls, c.OCSPServer...)
+	urls = append(urls, c.IssuingCertificateURL...)
+	urls = append(urls, c.CRLDistributionPoints...)
+	for _, u := range urls {
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("unparseable URL %q", u)}
+		}
+		if !l.hosts[parsed.Host] {
+			return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("URL %q has unexpected host", u)}
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+type mustStaple struct {
+	allowed bool
+	req     *Request
+}
+
+func (l *mustStaple) CheckApplies(c *x509.Certificate) bool {
+	return subscriberCert(c)
+}
+
+func (l *mustStaple) Execute(c *x509.Certificate) *lint.LintResult {
+	ext := util.GetExtFromCert(c, oidTLSFeature)
+	if ext == nil {
+		if l.req != nil && l.req.MustStaple {
+			return &lint.LintResult{Status: lint.Error, Details: "must-staple was requested but is missing"}
+		}
+		return &lint.LintResult{Status: lint.Pass}
+	}
+	if !l.allowed {
+		return &lint.LintResult{Status: lint.Error, Details: "must-staple is not allowed by this profile"}
+	}
+	if l.req != nil && !l.req.MustStaple {
+		return &lint.LintResult{Status: lint.Error, Details: "must-staple is present but was not requested"}
+	}
+	if !bytes.Equal(ext.Value, mustStapleValue) {
+		return &lint.LintResult{Status: lint.Error, Details: "TLS Feature extension contains features other than status_request"}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
@@ -0,0 +1,195 @@
+package profile
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	zx509 "github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+var mustStapleExt = pkix.Extension{
+	Id:    []int{1, 3, 6, 1, 5, 5, 7, 1, 24},
+	Value: mustStapleValue,
+}
+
+func makeCert(t *testing.T, modify func(*x509.Certificate)) *zx509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate key")
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "example.com"},
+		DNSNames:              []string{"example.com"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		OCSPServer:            []string{"http://ocsp.example.net"},
+		IssuingCertificateURL: []string{"http://issuer.example.net/cert"},
+	}
+	modify(template)
+	parent := &x509.Certificate{Subject: pkix.Name{CommonName: "issuer"}}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), key)
+	test.AssertNotError(t, err, "failed to create certificate")
+	cert, err := zx509.ParseCertificate(der)
+	test.AssertNotError(t, err, "failed to parse certificate")
+	return cert
+}
+
+// failed runs the lints against the certificate and returns the names of the
+// ones which didn't pass.
+func failed(lints []*lint.Lint, cert *zx509.Certificate) []string {
+	var ret []string
+	for _, l := range lints {
+		if l.Execute(cert).Status > lint.Pass {
+			ret = append(ret, l.Name)
+		}
+	}
+	return ret
+}
+
+func TestProfileLints(t *testing.T) {
+	policy, err := NewPolicy(Config{
+		MaxNames:        2,
+		AllowMustStaple: true,
+		URLHosts:        []string{"ocsp.example.net", "issuer.example.net"},
+	})
+	test.AssertNotError(t, err, "NewPolicy failed")
+	noStaple, err := NewPolicy(Config{})
+	test.AssertNotError(t, err, "NewPolicy failed")
+
+	testCases := []struct {
+		name     string
+		policy   *Policy
+		req      *Request
+		modify   func(*x509.Certificate)
+		expected string
+	}{
+		{
+			name:   "valid",
+			policy: policy,
+			req:    &Request{},
+			modify: func(*x509.Certificate) {},
+		},
+		{
+			name:   "too many names",
+			policy: policy,
+			modify: func(c *x509.Certificate) {
+				c.DNSNames = []string{"a.example.com", "b.example.com", "c.example.com"}
+			},
+			expected: "e_boulder_too_many_names",
+		},
+		{
+			name:   "long common name",
+			policy: policy,
+			modify: func(c *x509.Certificate) {
+				c.Subject.CommonName = strings.Repeat("a", 61) + ".com"
+			},
+			expected: "e_boulder_common_name_too_long",
+		},
+		{
+			name:   "extra key usage",
+			policy: policy,
+			modify: func(c *x509.Certificate) {
+				c.KeyUsage |= x509.KeyUsageKeyEncipherment
+			},
+			expected: "e_boulder_key_usage_mismatch",
+		},
+		{
+			name:   "missing clientAuth",
+			policy: policy,
+			modify: func(c *x509.Certificate) {
+				c.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+			},
+			expected: "e_boulder_ext_key_usage_mismatch",
+		},
+		{
+			name:   "unexpected CRL host",
+			policy: policy,
+			modify: func(c *x509.Certificate) {
+				c.CRLDistributionPoints = []string{"http://crl.example.org/1.crl"}
+			},
+			expected: "e_boulder_unexpected_url_host",
+		},
+		{
+			name:   "requested must-staple",
+			policy: policy,
+			req:    &Request{MustStaple: true},
+			modify: func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{mustStapleExt}
+			},
+		},
+		{
+			name:   "unknown request must-staple",
+			policy: policy,
+			modify: func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{mustStapleExt}
+			},
+		},
+		{
+			name:   "unrequested must-staple",
+			policy: policy,
+			req:    &Request{},
+			modify: func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{mustStapleExt}
+			},
+			expected: "e_boulder_must_staple_not_allowed",
+		},
+		{
+			name:     "missing must-staple",
+			policy:   policy,
+			req:      &Request{MustStaple: true},
+			modify:   func(*x509.Certificate) {},
+			expected: "e_boulder_must_staple_not_allowed",
+		},
+		{
+			name:   "disallowed must-staple",
+			policy: noStaple,
+			modify: func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{mustStapleExt}
+			},
+			expected: "e_boulder_must_staple_not_allowed",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := failed(tc.policy.Lints(tc.req), makeCert(t, tc.modify))
+			if tc.expected == "" {
+				test.AssertEquals(t, len(got), 0)
+			} else {
+				test.AssertDeepEquals(t, got, []string{tc.expected})
+			}
+		})
+	}
+}
+
+func TestNewPolicy(t *testing.T) {
+	_, err := NewPolicy(Config{MaxNames: -1})
+	test.AssertError(t, err, "NewPolicy accepted negative MaxNames")
+	_, err = NewPolicy(Config{RSAKeyUsages: []string{"certSign"}})
+	test.AssertError(t, err, "NewPolicy accepted an unknown key usage")
+	_, err = NewPolicy(Config{ExtKeyUsages: []string{"codeSigning"}})
+	test.AssertError(t, err, "NewPolicy accepted an unknown extended key usage")
+
+	p, err := NewPolicy(Config{
+		RSAKeyUsages: []string{"digitalSignature"},
+		ExtKeyUsages: []string{"serverAuth"},
+	})
+	test.AssertNotError(t, err, "NewPolicy failed")
+	test.AssertEquals(t, p.keyUsages[zx509.RSA], zx509.KeyUsageDigitalSignature)
+	test.AssertDeepEquals(t, p.extKeyUsages, []zx509.ExtKeyUsage{zx509.ExtKeyUsageServerAuth})
+
+	var nilPolicy *Policy
+	test.AssertEquals(t, len(nilPolicy.Lints(nil)), 0)
+}
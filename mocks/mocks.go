@@ -7,6 +7,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math/rand"
 	"net"
@@ -531,6 +532,341 @@ func (sa *StorageAuthority) KeyBlocked(ctx context.Context, req *sapb.KeyBlocked
 	return &sapb.Exists{Exists: false}, nil
 }
 
+// GetRateLimitOverrides is a mock
+func (sa *StorageAuthority) GetRateLimitOverrides(ctx context.Context, req *sapb.GetRateLimitOverridesRequest, _ ...grpc.CallOption) (*sapb.RateLimitOverrides, error) {
+	return &sapb.RateLimitOverrides{}, nil
+}
+
+// AddRateLimitOverride is a mock
+func (sa *StorageAuthority) AddRateLimitOverride(ctx context.Context, req *sapb.RateLimitOverride, _ ...grpc.CallOption) (*sapb.RateLimitOverride, error) {
+	return req, nil
+}
+
+// ExpireRateLimitOverride is a mock
+func (sa *StorageAuthority) ExpireRateLimitOverride(ctx context.Context, req *sapb.ExpireRateLimitOverrideRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// GetAccountIdentifierPolicy is a mock which reports that no account has an
+// identifier policy
+func (sa *StorageAuthority) GetAccountIdentifierPolicy(ctx context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*sapb.AccountIdentifierPolicy, error) {
+	return nil, berrors.NotFoundError("no identifier policy for registration ID %d", req.Id)
+}
+
+// SetAccountIdentifierPolicy is a mock
+func (sa *StorageAuthority) SetAccountIdentifierPolicy(ctx context.Context, req *sapb.AccountIdentifierPolicy, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveAccountIdentifierPolicy is a mock
+func (sa *StorageAuthority) RemoveAccountIdentifierPolicy(ctx context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// GetAccountSuspension is a mock which reports that no account is suspended
+func (sa *StorageAuthority) GetAccountSuspension(ctx context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*sapb.AccountSuspension, error) {
+	return nil, berrors.NotFoundError("registration ID %d is not suspended", req.Id)
+}
+
+// SuspendAccount is a mock
+func (sa *StorageAuthority) SuspendAccount(ctx context.Context, req *sapb.AccountSuspension, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// UnsuspendAccount is a mock
+func (sa *StorageAuthority) UnsuspendAccount(ctx context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// CheckIdentifiersPaused is a mock which reports that no identifiers are paused
+func (sa *StorageAuthority) CheckIdentifiersPaused(ctx context.Context, req *sapb.CheckIdentifiersPausedRequest, _ ...grpc.CallOption) (*sapb.Identifiers, error) {
+	return &sapb.Identifiers{}, nil
+}
+
+// RecordValidationFailure is a mock
+func (sa *StorageAuthority) RecordValidationFailure(ctx context.Context, req *sapb.AccountIdentifier, _ ...grpc.CallOption) (*sapb.Count, error) {
+	return &sapb.Count{Count: 1}, nil
+}
+
+// ResetValidationFailures is a mock
+func (sa *StorageAuthority) ResetValidationFailures(ctx context.Context, req *sapb.AccountIdentifier, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// PauseIdentifier is a mock
+func (sa *StorageAuthority) PauseIdentifier(ctx context.Context, req *sapb.AccountIdentifier, _ ...grpc.CallOption) (*sapb.Exists, error) {
+	return &sapb.Exists{Exists: true}, nil
+}
+
+// UnpauseAccount is a mock
+func (sa *StorageAuthority) UnpauseAccount(ctx context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*sapb.Count, error) {
+	return &sapb.Count{}, nil
+}
+
+// GetOrderApproval is a mock which reports that no order is held for approval
+func (sa *StorageAuthority) GetOrderApproval(ctx context.Context, req *sapb.OrderRequest, _ ...grpc.CallOption) (*sapb.OrderApproval, error) {
+	return nil, berrors.NotFoundError("order ID %d is not held for approval", req.Id)
+}
+
+// GetPendingOrderApprovals is a mock
+func (sa *StorageAuthority) GetPendingOrderApprovals(ctx context.Context, req *emptypb.Empty, _ ...grpc.CallOption) (*sapb.OrderApprovals, error) {
+	return &sapb.OrderApprovals{}, nil
+}
+
+// HoldOrderForApproval is a mock
+func (sa *StorageAuthority) HoldOrderForApproval(ctx context.Context, req *sapb.OrderApproval, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// DecideOrderApproval is a mock
+func (sa *StorageAuthority) DecideOrderApproval(ctx context.Context, req *sapb.DecideOrderApprovalRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// GetSerialsByKey is a mock which streams no serials
+func (sa *StorageAuthority) GetSerialsByKey(ctx context.Context, req *sapb.SPKIHash, _ ...grpc.CallOption) (sapb.StorageAuthority_GetSerialsByKeyClient, error) {
+	return &SerialStream{}, nil
+}
+
+// GetSerialsByAccount is a mock which streams no serials
+func (sa *StorageAuthority) GetSerialsByAccount(ctx context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (sapb.StorageAuthority_GetSerialsByAccountClient, error) {
+	return &SerialStream{}, nil
+}
+
+// GetSerialsByName is a mock which streams no serials
+func (sa *StorageAuthority) GetSerialsByName(ctx context.Context, req *sapb.GetSerialsByNameRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_GetSerialsByNameClient, error) {
+	return &SerialStream{}, nil
+}
+
+// SearchCertificatesByName is a mock which finds no certificates
+func (sa *StorageAuthority) SearchCertificatesByName(ctx context.Context, req *sapb.SearchCertificatesByNameRequest, _ ...grpc.CallOption) (*sapb.CertificateSearchResults, error) {
+	return &sapb.CertificateSearchResults{}, nil
+}
+
+// GetAccountKeys is a mock which finds no key history
+func (sa *StorageAuthority) GetAccountKeys(ctx context.Context, req *sapb.GetAccountKeysRequest, _ ...grpc.CallOption) (*sapb.AccountKeys, error) {
+	return &sapb.AccountKeys{}, nil
+}
+
+// CheckNamesBlocked is a mock which reports that no names are blocked
+func (sa *StorageAuthority) CheckNamesBlocked(ctx context.Context, req *sapb.Identifiers, _ ...grpc.CallOption) (*sapb.Identifiers, error) {
+	return &sapb.Identifiers{}, nil
+}
+
+// AddBlockedName is a mock
+func (sa *StorageAuthority) AddBlockedName(ctx context.Context, req *sapb.AddBlockedNameRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// GetIncidents is a mock which returns no incidents
+func (sa *StorageAuthority) GetIncidents(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*sapb.Incidents, error) {
+	return &sapb.Incidents{}, nil
+}
+
+// IncidentsForSerial is a mock which returns no incidents
+func (sa *StorageAuthority) IncidentsForSerial(ctx context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*sapb.Incidents, error) {
+	return &sapb.Incidents{}, nil
+}
+
+// SerialsForIncident is a mock which streams no serials
+func (sa *StorageAuthority) SerialsForIncident(ctx context.Context, req *sapb.SerialsForIncidentRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_SerialsForIncidentClient, error) {
+	return &IncidentSerialStream{}, nil
+}
+
+// IncidentSerialStream is a mock StorageAuthority_SerialsForIncidentClient
+// which streams Serials
+type IncidentSerialStream struct {
+	grpc.ClientStream
+	Serials []*sapb.IncidentSerial
+}
+
+// Recv returns the next of the stream's serials, or io.EOF once they have all
+// been returned
+func (s *IncidentSerialStream) Recv() (*sapb.IncidentSerial, error) {
+	if len(s.Serials) == 0 {
+		return nil, io.EOF
+	}
+	next := s.Serials[0]
+	s.Serials = s.Serials[1:]
+	return next, nil
+}
+
+// StreamEvents is a mock which streams no events
+func (sa *StorageAuthority) StreamEvents(ctx context.Context, req *sapb.StreamEventsRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamEventsClient, error) {
+	return &EventStream{}, nil
+}
+
+// EventStream is a mock StorageAuthority_StreamEventsClient which streams
+// Events
+type EventStream struct {
+	grpc.ClientStream
+	Events []*sapb.Event
+}
+
+// Recv returns the next of the stream's events, or io.EOF once they have all
+// been returned
+func (s *EventStream) Recv() (*sapb.Event, error) {
+	if len(s.Events) == 0 {
+		return nil, io.EOF
+	}
+	next := s.Events[0]
+	s.Events = s.Events[1:]
+	return next, nil
+}
+
+// StreamCertificates is a mock which streams no certificates
+func (sa *StorageAuthority) StreamCertificates(ctx context.Context, req *sapb.StreamCertificatesRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamCertificatesClient, error) {
+	return &CertificateStream{}, nil
+}
+
+// CertificateStream is a mock StorageAuthority_StreamCertificatesClient and
+// StorageAuthority_StreamUnrevokedCertificatesByKeyClient which streams
+// Certificates
+type CertificateStream struct {
+	grpc.ClientStream
+	Certificates []*corepb.Certificate
+}
+
+// Recv returns the next of the stream's certificates, or io.EOF once they
+// have all been returned
+func (s *CertificateStream) Recv() (*corepb.Certificate, error) {
+	if len(s.Certificates) == 0 {
+		return nil, io.EOF
+	}
+	next := s.Certificates[0]
+	s.Certificates = s.Certificates[1:]
+	return next, nil
+}
+
+// StreamExpiringSerials is a mock which streams no serials
+func (sa *StorageAuthority) StreamExpiringSerials(ctx context.Context, req *sapb.StreamExpiringSerialsRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamExpiringSerialsClient, error) {
+	return &SerialStream{}, nil
+}
+
+// SerialStream is a mock client for the StorageAuthority methods which stream
+// Serials
+type SerialStream struct {
+	grpc.ClientStream
+	Serials []*sapb.Serial
+}
+
+// Recv returns the next of the stream's serials, or io.EOF once they have all
+// been returned
+func (s *SerialStream) Recv() (*sapb.Serial, error) {
+	if len(s.Serials) == 0 {
+		return nil, io.EOF
+	}
+	next := s.Serials[0]
+	s.Serials = s.Serials[1:]
+	return next, nil
+}
+
+// StreamRegistrationsWithContacts is a mock which streams no registrations
+func (sa *StorageAuthority) StreamRegistrationsWithContacts(ctx context.Context, req *sapb.StreamRegistrationsWithContactsRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamRegistrationsWithContactsClient, error) {
+	return &RegistrationContactsStream{}, nil
+}
+
+// RegistrationContactsStream is a mock
+// StorageAuthority_StreamRegistrationsWithContactsClient which streams
+// RegistrationContacts
+type RegistrationContactsStream struct {
+	grpc.ClientStream
+	Registrations []*sapb.RegistrationContacts
+}
+
+// Recv returns the next of the stream's registrations, or io.EOF once they
+// have all been returned
+func (s *RegistrationContactsStream) Recv() (*sapb.RegistrationContacts, error) {
+	if len(s.Registrations) == 0 {
+		return nil, io.EOF
+	}
+	next := s.Registrations[0]
+	s.Registrations = s.Registrations[1:]
+	return next, nil
+}
+
+// StreamRegistrationsWithNames is a mock which streams no registrations
+func (sa *StorageAuthority) StreamRegistrationsWithNames(ctx context.Context, req *sapb.StreamRegistrationsWithNamesRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamRegistrationsWithNamesClient, error) {
+	return &RegistrationNameStream{}, nil
+}
+
+// RegistrationNameStream is a mock
+// StorageAuthority_StreamRegistrationsWithNamesClient which streams
+// RegistrationNames
+type RegistrationNameStream struct {
+	grpc.ClientStream
+	Registrations []*sapb.RegistrationName
+}
+
+// Recv returns the next of the stream's registrations, or io.EOF once they
+// have all been returned
+func (s *RegistrationNameStream) Recv() (*sapb.RegistrationName, error) {
+	if len(s.Registrations) == 0 {
+		return nil, io.EOF
+	}
+	next := s.Registrations[0]
+	s.Registrations = s.Registrations[1:]
+	return next, nil
+}
+
+// StreamUncheckedBlockedKeys is a mock which streams no keys
+func (sa *StorageAuthority) StreamUncheckedBlockedKeys(ctx context.Context, req *emptypb.Empty, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamUncheckedBlockedKeysClient, error) {
+	return &BlockedKeyStream{}, nil
+}
+
+// BlockedKeyStream is a mock StorageAuthority_StreamUncheckedBlockedKeysClient
+// which streams BlockedKeys
+type BlockedKeyStream struct {
+	grpc.ClientStream
+	Keys []*sapb.BlockedKey
+}
+
+// Recv returns the next of the stream's keys, or io.EOF once they have all
+// been returned
+func (s *BlockedKeyStream) Recv() (*sapb.BlockedKey, error) {
+	if len(s.Keys) == 0 {
+		return nil, io.EOF
+	}
+	next := s.Keys[0]
+	s.Keys = s.Keys[1:]
+	return next, nil
+}
+
+// StreamUnrevokedCertificatesByKey is a mock which streams no certificates
+func (sa *StorageAuthority) StreamUnrevokedCertificatesByKey(ctx context.Context, req *sapb.SPKIHash, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamUnrevokedCertificatesByKeyClient, error) {
+	return &CertificateStream{}, nil
+}
+
+// AddIncident is a mock
+func (sa *StorageAuthority) AddIncident(ctx context.Context, req *sapb.Incident, _ ...grpc.CallOption) (*sapb.Incident, error) {
+	return req, nil
+}
+
+// AddIncidentSerials is a mock
+func (sa *StorageAuthority) AddIncidentSerials(ctx context.Context, req *sapb.AddIncidentSerialsRequest, _ ...grpc.CallOption) (*sapb.AddIncidentSerialsResponse, error) {
+	return &sapb.AddIncidentSerialsResponse{Added: int64(len(req.Serials))}, nil
+}
+
+// AddIncidentSerialsIssuedBetween is a mock
+func (sa *StorageAuthority) AddIncidentSerialsIssuedBetween(ctx context.Context, req *sapb.AddIncidentSerialsIssuedBetweenRequest, _ ...grpc.CallOption) (*sapb.AddIncidentSerialsResponse, error) {
+	return &sapb.AddIncidentSerialsResponse{}, nil
+}
+
+// MarkIncidentSerialsNotified is a mock
+func (sa *StorageAuthority) MarkIncidentSerialsNotified(ctx context.Context, req *sapb.MarkIncidentSerialsNotifiedRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// MarkBlockedKeyChecked is a mock
+func (sa *StorageAuthority) MarkBlockedKeyChecked(ctx context.Context, req *sapb.SPKIHash, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+// MarkExpirationNagSent is a mock
+func (sa *StorageAuthority) MarkExpirationNagSent(ctx context.Context, req *sapb.MarkExpirationNagSentRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
 // Publisher is a mock
 type PublisherClient struct {
 	// empty
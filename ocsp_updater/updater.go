@@ -139,7 +139,9 @@ func New(
 	var queryBody strings.Builder
 	queryBody.WriteString("WHERE ocspLastUpdated < ? AND NOT isExpired ")
 	if len(serialSuffixes) > 0 {
-		fmt.Fprintf(&queryBody, "AND RIGHT(serial, 1) IN ( %s ) ",
+		// SUBSTR with a negative start, rather than MySQL's RIGHT, so that the
+		// query works with SQLite too.
+		fmt.Fprintf(&queryBody, "AND SUBSTR(serial, -1) IN ( %s ) ",
 			getQuestionsForShardList(len(serialSuffixes)),
 		)
 	}
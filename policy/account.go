@@ -0,0 +1,141 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/identifier"
+)
+
+// AccountPolicy restricts the identifiers a single account may request
+// certificates for. An identifier is within the account's scope if it is
+// equal to one of the ExactNames, or if it is equal to or a subdomain of one of
+// the Suffixes. Wildcard identifiers are only permitted when AllowWildcards is
+// set, and only when their base domain is covered by one of the Suffixes;
+// ExactNames never cover wildcards.
+type AccountPolicy struct {
+	Suffixes       []string
+	ExactNames     []string
+	AllowWildcards bool
+}
+
+var (
+	errAccountPolicyEmpty       = errors.New("account identifier policy must contain at least one suffix or exact name")
+	errOutsideAccountScope      = berrors.RestrictedIdentifierError("This account is not permitted to issue for this identifier")
+	errAccountWildcardForbidden = berrors.RestrictedIdentifierError("This account is not permitted to issue for wildcard identifiers")
+)
+
+// Validate checks that the policy is well formed: it must contain at least one
+// entry, and every entry must be a lowercase domain name without a wildcard or
+// a trailing dot.
+func (ap AccountPolicy) Validate() error {
+	if len(ap.Suffixes) == 0 && len(ap.ExactNames) == 0 {
+		return errAccountPolicyEmpty
+	}
+	for _, entries := range [][]string{ap.Suffixes, ap.ExactNames} {
+		for _, entry := range entries {
+			if entry == "" {
+				return errors.New("account identifier policy contains an empty entry")
+			}
+			if entry != strings.ToLower(entry) {
+				return fmt.Errorf("account identifier policy entry %q must be lowercase", entry)
+			}
+			if strings.Contains(entry, "*") {
+				return fmt.Errorf("account identifier policy entry %q must not contain a wildcard", entry)
+			}
+			if strings.HasPrefix(entry, ".") || strings.HasSuffix(entry, ".") {
+				return fmt.Errorf("account identifier policy entry %q must not begin or end with a dot", entry)
+			}
+		}
+	}
+	return nil
+}
+
+// coveredBySuffix returns true if name is equal to, or a subdomain of, one of
+// the policy's Suffixes. Matching is done on label boundaries so that a suffix
+// of "example.com" does not match "badexample.com".
+func (ap AccountPolicy) coveredBySuffix(name string) bool {
+	for _, suffix := range ap.Suffixes {
+		if name == suffix || strings.HasSuffix(name, "."+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// willingToIssue checks a single identifier against the policy. It is used by
+// the plural WillingToIssue when evaluating a list of identifiers.
+func (ap AccountPolicy) willingToIssue(ident identifier.ACMEIdentifier) error {
+	if ident.Type != identifier.DNS {
+		return errInvalidIdentifier
+	}
+	name := strings.ToLower(ident.Value)
+
+	if strings.HasPrefix(name, "*.") {
+		if !ap.AllowWildcards {
+			return errAccountWildcardForbidden
+		}
+		if !ap.coveredBySuffix(strings.TrimPrefix(name, "*.")) {
+			return errOutsideAccountScope
+		}
+		return nil
+	}
+
+	for _, exact := range ap.ExactNames {
+		if name == exact {
+			return nil
+		}
+	}
+	if ap.coveredBySuffix(name) {
+		return nil
+	}
+	return errOutsideAccountScope
+}
+
+// WillingToIssue determines whether every one of the provided identifiers is
+// within the scope of the account policy. If one or more identifiers are out
+// of scope a RestrictedIdentifier error is returned, with a sub-error for each
+// offending identifier when there is more than one.
+func (ap AccountPolicy) WillingToIssue(idents []identifier.ACMEIdentifier) error {
+	var subErrors []berrors.SubBoulderError
+	for _, ident := range idents {
+		if err := ap.willingToIssue(ident); err != nil {
+			var bErr *berrors.BoulderError
+			if errors.As(err, &bErr) {
+				subErrors = append(subErrors, berrors.SubBoulderError{
+					Identifier:   ident,
+					BoulderError: bErr})
+			} else {
+				subErrors = append(subErrors, berrors.SubBoulderError{
+					Identifier: ident,
+					BoulderError: &berrors.BoulderError{
+						Type:   berrors.RestrictedIdentifier,
+						Detail: err.Error(),
+					}})
+			}
+		}
+	}
+	if len(subErrors) > 0 {
+		if len(subErrors) == 1 {
+			return berrors.RestrictedIdentifierError(
+				"Cannot issue for %q: %s",
+				subErrors[0].Identifier.Value,
+				subErrors[0].BoulderError.Detail,
+			)
+		}
+
+		detail := fmt.Sprintf(
+			"Cannot issue for %q: %s (and %d more problems. Refer to sub-problems for more information.)",
+			subErrors[0].Identifier.Value,
+			subErrors[0].BoulderError.Detail,
+			len(subErrors)-1,
+		)
+		return (&berrors.BoulderError{
+			Type:   berrors.RestrictedIdentifier,
+			Detail: detail,
+		}).WithSubErrors(subErrors)
+	}
+	return nil
+}
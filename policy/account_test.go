@@ -0,0 +1,97 @@
+package policy
+
+import (
+	"testing"
+
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/identifier"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestAccountPolicyValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		policy  AccountPolicy
+		wantErr bool
+	}{
+		{"empty", AccountPolicy{}, true},
+		{"wildcards only", AccountPolicy{AllowWildcards: true}, true},
+		{"suffix", AccountPolicy{Suffixes: []string{"example.com"}}, false},
+		{"exact", AccountPolicy{ExactNames: []string{"www.example.com"}}, false},
+		{"empty entry", AccountPolicy{Suffixes: []string{""}}, true},
+		{"uppercase", AccountPolicy{Suffixes: []string{"Example.com"}}, true},
+		{"wildcard entry", AccountPolicy{ExactNames: []string{"*.example.com"}}, true},
+		{"leading dot", AccountPolicy{Suffixes: []string{".example.com"}}, true},
+		{"trailing dot", AccountPolicy{Suffixes: []string{"example.com."}}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.wantErr {
+				test.AssertError(t, err, "expected Validate to fail")
+			} else {
+				test.AssertNotError(t, err, "expected Validate to succeed")
+			}
+		})
+	}
+}
+
+func TestAccountPolicyWillingToIssue(t *testing.T) {
+	ap := AccountPolicy{
+		Suffixes:   []string{"example.com"},
+		ExactNames: []string{"www.zombo.com"},
+	}
+	wildcardAP := ap
+	wildcardAP.AllowWildcards = true
+
+	testCases := []struct {
+		name    string
+		policy  AccountPolicy
+		ident   identifier.ACMEIdentifier
+		allowed bool
+	}{
+		{"suffix itself", ap, identifier.DNSIdentifier("example.com"), true},
+		{"subdomain of suffix", ap, identifier.DNSIdentifier("a.b.example.com"), true},
+		{"mixed case", ap, identifier.DNSIdentifier("WWW.Example.com"), true},
+		{"not on label boundary", ap, identifier.DNSIdentifier("badexample.com"), false},
+		{"exact name", ap, identifier.DNSIdentifier("www.zombo.com"), true},
+		{"parent of exact name", ap, identifier.DNSIdentifier("zombo.com"), false},
+		{"child of exact name", ap, identifier.DNSIdentifier("a.www.zombo.com"), false},
+		{"unrelated", ap, identifier.DNSIdentifier("letsencrypt.org"), false},
+		{"wildcard not allowed", ap, identifier.DNSIdentifier("*.example.com"), false},
+		{"wildcard under suffix", wildcardAP, identifier.DNSIdentifier("*.example.com"), true},
+		{"wildcard under exact name", wildcardAP, identifier.DNSIdentifier("*.www.zombo.com"), false},
+		{"wildcard out of scope", wildcardAP, identifier.DNSIdentifier("*.letsencrypt.org"), false},
+		{"non-DNS identifier", ap, identifier.ACMEIdentifier{Type: "ip", Value: "10.0.0.1"}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.WillingToIssue([]identifier.ACMEIdentifier{tc.ident})
+			if tc.allowed {
+				test.AssertNotError(t, err, "expected identifier to be allowed")
+			} else {
+				test.AssertError(t, err, "expected identifier to be refused")
+				test.AssertErrorIs(t, err, berrors.RestrictedIdentifier)
+			}
+		})
+	}
+}
+
+func TestAccountPolicyWillingToIssueSubErrors(t *testing.T) {
+	ap := AccountPolicy{Suffixes: []string{"example.com"}}
+
+	err := ap.WillingToIssue([]identifier.ACMEIdentifier{
+		identifier.DNSIdentifier("example.com"),
+		identifier.DNSIdentifier("letsencrypt.org"),
+		identifier.DNSIdentifier("*.example.com"),
+	})
+	test.AssertError(t, err, "expected err from WillingToIssue")
+
+	var berr *berrors.BoulderError
+	test.AssertErrorWraps(t, err, &berr)
+	test.AssertEquals(t, berr.Type, berrors.RestrictedIdentifier)
+	test.AssertEquals(t, len(berr.SubErrors), 2)
+	test.AssertEquals(t, berr.Error(), "Cannot issue for \"letsencrypt.org\": This account is not permitted to issue for this identifier (and 1 more problems. Refer to sub-problems for more information.)")
+	test.AssertEquals(t, berr.SubErrors[1].Identifier.Value, "*.example.com")
+	test.AssertEquals(t, berr.SubErrors[1].Type, berrors.RestrictedIdentifier)
+}
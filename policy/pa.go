@@ -31,6 +31,7 @@ type AuthorityImpl struct {
 	blocklist              map[string]bool
 	exactBlocklist         map[string]bool
 	wildcardExactBlocklist map[string]bool
+	approvalRequired       map[string]bool
 	blocklistMu            sync.RWMutex
 
 	enabledChallenges map[core.AcmeChallenge]bool
@@ -71,6 +72,11 @@ type blockedNamesPolicy struct {
 	// time above and beyond the high-risk domains. Managing these entries separately
 	// from HighRiskBlockedNames makes it easier to vet changes accurately.
 	AdminBlockedNames []string `yaml:"AdminBlockedNames"`
+
+	// ApprovalRequiredNames is a list of domain names for which, along with
+	// their subdomains, issuance is permitted but only once an administrator
+	// has approved the finalized order. Unlike the other lists it may be empty.
+	ApprovalRequiredNames []string `yaml:"ApprovalRequiredNames"`
 }
 
 // SetHostnamePolicyFile will load the given policy file, returning error if it
@@ -139,10 +145,15 @@ func (pa *AuthorityImpl) processHostnamePolicy(policy blockedNamesPolicy) error
 		// wildcardNameMap to block issuance for `*.`+parts[1]
 		wildcardNameMap[parts[1]] = true
 	}
+	approvalMap := make(map[string]bool)
+	for _, v := range policy.ApprovalRequiredNames {
+		approvalMap[v] = true
+	}
 	pa.blocklistMu.Lock()
 	pa.blocklist = nameMap
 	pa.exactBlocklist = exactNameMap
 	pa.wildcardExactBlocklist = wildcardNameMap
+	pa.approvalRequired = approvalMap
 	pa.blocklistMu.Unlock()
 	return nil
 }
@@ -540,6 +551,27 @@ func (pa *AuthorityImpl) checkHostLists(domain string) error {
 	return nil
 }
 
+// NamesRequiringApproval returns those of the given names which are, or are
+// subdomains of, an ApprovalRequiredNames entry in the hostname policy.
+// Issuance for them must be approved by an administrator after the order is
+// finalized. Wildcard names are matched by their base domain.
+func (pa *AuthorityImpl) NamesRequiringApproval(names []string) []string {
+	pa.blocklistMu.RLock()
+	defer pa.blocklistMu.RUnlock()
+
+	var matched []string
+	for _, name := range names {
+		labels := strings.Split(strings.TrimPrefix(name, "*."), ".")
+		for i := range labels {
+			if pa.approvalRequired[strings.Join(labels[i:], ".")] {
+				matched = append(matched, name)
+				break
+			}
+		}
+	}
+	return matched
+}
+
 // ChallengesFor makes a decision of what challenges are acceptable for
 // the given identifier.
 func (pa *AuthorityImpl) ChallengesFor(identifier identifier.ACMEIdentifier) ([]core.Challenge, error) {
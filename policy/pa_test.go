@@ -459,6 +459,33 @@ func TestMalformedExactBlocklist(t *testing.T) {
 	test.AssertEquals(t, err.Error(), "Malformed ExactBlockedNames entry, only one label: \"com\"")
 }
 
+func TestNamesRequiringApproval(t *testing.T) {
+	pa := paImpl(t)
+
+	err := pa.processHostnamePolicy(blockedNamesPolicy{
+		HighRiskBlockedNames:  []string{"blocked.com"},
+		ExactBlockedNames:     []string{"exact.blocked.com"},
+		ApprovalRequiredNames: []string{"bank.com", "login.example.net"},
+	})
+	test.AssertNotError(t, err, "Couldn't process hostname policy")
+
+	names := []string{
+		"bank.com",
+		"www.bank.com",
+		"*.bank.com",
+		"notbank.com",
+		"login.example.net",
+		"example.net",
+		"*.example.net",
+	}
+	test.AssertDeepEquals(t, pa.NamesRequiringApproval(names), []string{"bank.com", "www.bank.com", "*.bank.com", "login.example.net"})
+	test.AssertEquals(t, len(pa.NamesRequiringApproval([]string{"example.com"})), 0)
+
+	// Names requiring approval are still acceptable for issuance.
+	err = pa.WillingToIssueWildcards([]identifier.ACMEIdentifier{identifier.DNSIdentifier("*.bank.com")})
+	test.AssertNotError(t, err, "Names requiring approval should not be blocked")
+}
+
 func TestValidEmailError(t *testing.T) {
 	err := ValidEmail("(๑•́ ω •̀๑)")
 	test.AssertEquals(t, err.Error(), "\"(๑•́ ω •̀๑)\" is not a valid e-mail address")
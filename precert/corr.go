@@ -0,0 +1,194 @@
+// Package precert checks that a final certificate corresponds to the
+// precertificate it was issued from, as required by RFC 6962.
+package precert
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/cryptobyte"
+	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+var (
+	// oidCTPoison is defined in RFC 6962 Section 3.1.
+	oidCTPoison = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3}
+	// oidSCTList is defined in RFC 6962 Section 3.3.
+	oidSCTList = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 2}
+)
+
+// Correspond returns nil if the final certificate corresponds to the
+// precertificate, and an error describing the first difference otherwise. The
+// order of the arguments matters.
+//
+// Per RFC 6962 Section 3.1, a precertificate is the final certificate's
+// TBSCertificate plus a critical poison extension, signed by the same issuer.
+// Boulder doesn't use precertificate signing certificates, so the two
+// TBSCertificates must be byte for byte identical in every field, and have the
+// same extensions in the same order, except that the precertificate must have
+// exactly one poison extension which the final certificate lacks, and the final
+// certificate may have one SCT list extension which the precertificate lacks.
+func Correspond(precertDER, finalDER []byte) error {
+	preTBS, err := tbsFromCertDER(precertDER)
+	if err != nil {
+		return fmt.Errorf("parsing precertificate: %w", err)
+	}
+	finalTBS, err := tbsFromCertDER(finalDER)
+	if err != nil {
+		return fmt.Errorf("parsing final certificate: %w", err)
+	}
+
+	fields := []struct {
+		name     string
+		tag      cryptobyte_asn1.Tag
+		optional bool
+	}{
+		{"version", cryptobyte_asn1.Tag(0).Constructed().ContextSpecific(), true},
+		{"serialNumber", cryptobyte_asn1.INTEGER, false},
+		{"signature", cryptobyte_asn1.SEQUENCE, false},
+		{"issuer", cryptobyte_asn1.SEQUENCE, false},
+		{"validity", cryptobyte_asn1.SEQUENCE, false},
+		{"subject", cryptobyte_asn1.SEQUENCE, false},
+		{"subjectPublicKeyInfo", cryptobyte_asn1.SEQUENCE, false},
+		{"issuerUniqueID", cryptobyte_asn1.Tag(1).ContextSpecific(), true},
+		{"subjectUniqueID", cryptobyte_asn1.Tag(2).ContextSpecific(), true},
+	}
+	for _, f := range fields {
+		preField, err := readField(&preTBS, f.tag, f.optional)
+		if err != nil {
+			return fmt.Errorf("reading precertificate %s: %w", f.name, err)
+		}
+		finalField, err := readField(&finalTBS, f.tag, f.optional)
+		if err != nil {
+			return fmt.Errorf("reading final certificate %s: %w", f.name, err)
+		}
+		if !bytes.Equal(preField, finalField) {
+			return fmt.Errorf("%s differs: precertificate %x, final certificate %x", f.name, preField, finalField)
+		}
+	}
+
+	preExts, err := readExtensions(&preTBS)
+	if err != nil {
+		return fmt.Errorf("reading precertificate extensions: %w", err)
+	}
+	finalExts, err := readExtensions(&finalTBS)
+	if err != nil {
+		return fmt.Errorf("reading final certificate extensions: %w", err)
+	}
+	if !preTBS.Empty() {
+		return errors.New("trailing data after precertificate extensions")
+	}
+	if !finalTBS.Empty() {
+		return errors.New("trailing data after final certificate extensions")
+	}
+
+	preExts, poisons := without(preExts, oidCTPoison)
+	if poisons != 1 {
+		return fmt.Errorf("precertificate has %d poison extensions, want 1", poisons)
+	}
+	finalExts, scts := without(finalExts, oidSCTList)
+	if scts > 1 {
+		return fmt.Errorf("final certificate has %d SCT list extensions, want at most 1", scts)
+	}
+	if _, sctsInPrecert := without(preExts, oidSCTList); sctsInPrecert != 0 {
+		return errors.New("precertificate has an SCT list extension")
+	}
+	if _, poisonsInFinal := without(finalExts, oidCTPoison); poisonsInFinal != 0 {
+		return errors.New("final certificate has a poison extension")
+	}
+
+	if len(preExts) != len(finalExts) {
+		return fmt.Errorf("precertificate has %d extensions besides the poison, final certificate has %d besides the SCT list",
+			len(preExts), len(finalExts))
+	}
+	for i := range preExts {
+		if !bytes.Equal(preExts[i].raw, finalExts[i].raw) {
+			return fmt.Errorf("extension %d differs: precertificate %s (%x), final certificate %s (%x)",
+				i, preExts[i].oid, preExts[i].raw, finalExts[i].oid, finalExts[i].raw)
+		}
+	}
+	return nil
+}
+
+// tbsFromCertDER returns the contents of the TBSCertificate of a DER encoded
+// certificate.
+func tbsFromCertDER(certDER []byte) (cryptobyte.String, error) {
+	input := cryptobyte.String(certDER)
+	var cert, tbs cryptobyte.String
+	if !input.ReadASN1(&cert, cryptobyte_asn1.SEQUENCE) {
+		return nil, errors.New("malformed certificate")
+	}
+	if !input.Empty() {
+		return nil, errors.New("trailing data after certificate")
+	}
+	if !cert.ReadASN1(&tbs, cryptobyte_asn1.SEQUENCE) {
+		return nil, errors.New("malformed TBSCertificate")
+	}
+	return tbs, nil
+}
+
+// readField reads the next element of a TBSCertificate, including its tag and
+// length. An absent optional field is returned as nil.
+func readField(tbs *cryptobyte.String, tag cryptobyte_asn1.Tag, optional bool) (cryptobyte.String, error) {
+	if optional && !tbs.PeekASN1Tag(tag) {
+		return nil, nil
+	}
+	var field cryptobyte.String
+	if !tbs.ReadASN1Element(&field, tag) {
+		return nil, errors.New("malformed or missing field")
+	}
+	return field, nil
+}
+
+// extension is a single raw extension and its OID.
+type extension struct {
+	oid asn1.ObjectIdentifier
+	raw cryptobyte.String
+}
+
+// readExtensions reads the optional extensions field of a TBSCertificate.
+func readExtensions(tbs *cryptobyte.String) ([]extension, error) {
+	var exts cryptobyte.String
+	var present bool
+	if !tbs.ReadOptionalASN1(&exts, &present, cryptobyte_asn1.Tag(3).Constructed().ContextSpecific()) {
+		return nil, errors.New("malformed extensions")
+	}
+	if !present {
+		return nil, nil
+	}
+	var seq cryptobyte.String
+	if !exts.ReadASN1(&seq, cryptobyte_asn1.SEQUENCE) || !exts.Empty() {
+		return nil, errors.New("malformed extensions")
+	}
+	var ret []extension
+	for !seq.Empty() {
+		var raw, ext cryptobyte.String
+		if !seq.ReadASN1Element(&raw, cryptobyte_asn1.SEQUENCE) {
+			return nil, errors.New("malformed extension")
+		}
+		ext = raw
+		var oid asn1.ObjectIdentifier
+		if !ext.ReadASN1(&ext, cryptobyte_asn1.SEQUENCE) || !ext.ReadASN1ObjectIdentifier(&oid) {
+			return nil, errors.New("malformed extension")
+		}
+		ret = append(ret, extension{oid: oid, raw: raw})
+	}
+	return ret, nil
+}
+
+// without returns the extensions which don't have the given OID, along with
+// the number that did.
+func without(exts []extension, oid asn1.ObjectIdentifier) ([]extension, int) {
+	var ret []extension
+	removed := 0
+	for _, ext := range exts {
+		if ext.oid.Equal(oid) {
+			removed++
+			continue
+		}
+		ret = append(ret, ext)
+	}
+	return ret, removed
+}
@@ -0,0 +1,174 @@
+package precert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+var (
+	poison  = pkix.Extension{Id: oidCTPoison, Critical: true, Value: []byte{0x05, 0x00}}
+	sctList = pkix.Extension{Id: oidSCTList, Value: []byte{0x04, 0x02, 0x00, 0x00}}
+	other   = pkix.Extension{Id: []int{1, 2, 3, 4}, Value: []byte{0x05, 0x00}}
+)
+
+// issue signs a certificate based on a fixed template, after letting modify
+// change it.
+func issue(t *testing.T, key *ecdsa.PrivateKey, modify func(*x509.Certificate)) []byte {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1337),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com", "www.example.com"},
+		NotBefore:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	modify(template)
+	parent := &x509.Certificate{Subject: pkix.Name{CommonName: "issuer"}}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), key)
+	test.AssertNotError(t, err, "Failed to create certificate")
+	return der
+}
+
+func TestCorrespond(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "Failed to generate key")
+
+	precert := issue(t, key, func(c *x509.Certificate) {
+		c.ExtraExtensions = []pkix.Extension{other, poison}
+	})
+
+	testCases := []struct {
+		name      string
+		precert   []byte
+		final     func(*x509.Certificate)
+		expectErr string
+	}{
+		{
+			name: "valid with SCTs",
+			final: func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{other, sctList}
+			},
+		},
+		{
+			name: "valid without SCTs",
+			final: func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{other}
+			},
+		},
+		{
+			name: "serial differs",
+			final: func(c *x509.Certificate) {
+				c.SerialNumber = big.NewInt(1338)
+				c.ExtraExtensions = []pkix.Extension{other, sctList}
+			},
+			expectErr: "serialNumber differs",
+		},
+		{
+			name: "validity differs",
+			final: func(c *x509.Certificate) {
+				c.NotAfter = c.NotAfter.Add(time.Second)
+				c.ExtraExtensions = []pkix.Extension{other, sctList}
+			},
+			expectErr: "validity differs",
+		},
+		{
+			name: "subject differs",
+			final: func(c *x509.Certificate) {
+				c.Subject.CommonName = "www.example.com"
+				c.ExtraExtensions = []pkix.Extension{other, sctList}
+			},
+			expectErr: "subject differs",
+		},
+		{
+			name: "SAN differs",
+			final: func(c *x509.Certificate) {
+				c.DNSNames = []string{"example.com"}
+				c.ExtraExtensions = []pkix.Extension{other, sctList}
+			},
+			expectErr: "extension 2 differs",
+		},
+		{
+			name: "extension missing",
+			final: func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{sctList}
+			},
+			expectErr: "precertificate has 4 extensions besides the poison, final certificate has 3",
+		},
+		{
+			name: "final has poison",
+			final: func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{other, poison}
+			},
+			expectErr: "final certificate has a poison extension",
+		},
+		{
+			name: "two SCT lists",
+			final: func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{other, sctList, sctList}
+			},
+			expectErr: "final certificate has 2 SCT list extensions",
+		},
+		{
+			name: "precert without poison",
+			precert: issue(t, key, func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{other}
+			}),
+			final: func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{other}
+			},
+			expectErr: "precertificate has 0 poison extensions",
+		},
+		{
+			name: "precert with SCT list",
+			precert: issue(t, key, func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{other, poison, sctList}
+			}),
+			final: func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{other, sctList}
+			},
+			expectErr: "precertificate has an SCT list extension",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pre := precert
+			if tc.precert != nil {
+				pre = tc.precert
+			}
+			err := Correspond(pre, issue(t, key, tc.final))
+			if tc.expectErr == "" {
+				test.AssertNotError(t, err, "Correspond failed for a valid pair")
+			} else {
+				test.AssertError(t, err, "Correspond succeeded for a mismatched pair")
+				test.Assert(t, strings.Contains(err.Error(), tc.expectErr),
+					"Expected error containing "+tc.expectErr+", got "+err.Error())
+			}
+		})
+	}
+}
+
+func TestCorrespondMalformed(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "Failed to generate key")
+	precert := issue(t, key, func(c *x509.Certificate) {
+		c.ExtraExtensions = []pkix.Extension{poison}
+	})
+
+	err = Correspond(precert, []byte{0x30, 0x00})
+	test.AssertError(t, err, "Correspond accepted a malformed final certificate")
+	err = Correspond([]byte("not a certificate"), precert)
+	test.AssertError(t, err, "Correspond accepted a malformed precertificate")
+	err = Correspond(precert, append(precert, 0x00))
+	test.AssertError(t, err, "Correspond accepted trailing data")
+}
@@ -3,6 +3,7 @@ package probs
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/letsencrypt/boulder/identifier"
 )
@@ -27,6 +28,7 @@ const (
 	BadPublicKeyProblem          = ProblemType("badPublicKey")
 	BadRevocationReasonProblem   = ProblemType("badRevocationReason")
 	BadCSRProblem                = ProblemType("badCSR")
+	RestrictedIdentifierProblem  = ProblemType("restrictedIdentifier")
 
 	V1ErrorNS = "urn:acme:error:"
 	V2ErrorNS = "urn:ietf:params:acme:error:"
@@ -46,6 +48,26 @@ type ProblemDetails struct {
 	// SubProblems are optional additional per-identifier problems. See
 	// RFC 8555 Section 6.7.1: https://tools.ietf.org/html/rfc8555#section-6.7.1
 	SubProblems []SubProblemDetails `json:"subproblems,omitempty"`
+	// RateLimit is an optional extension describing the exceeded rate limit
+	// of a RateLimitedProblem.
+	RateLimit *RateLimitDetails `json:"rateLimit,omitempty"`
+	// RetryAfter, if non-zero, is sent to the client in a Retry-After header.
+	RetryAfter time.Duration `json:"-"`
+}
+
+// RateLimitDetails is a problem document extension describing which rate limit
+// was exceeded, so that clients can act on it without parsing the detail.
+type RateLimitDetails struct {
+	// Limit is the name of the limit, e.g. "certificatesPerName".
+	Limit string `json:"limit"`
+	// Key is what the limit counts on, e.g. a registered domain. It is
+	// omitted for per-account limits.
+	Key       string `json:"key,omitempty"`
+	Count     int64  `json:"count"`
+	Threshold int64  `json:"threshold"`
+	// RetryAfter is the number of seconds until the request might succeed. It
+	// is omitted if that isn't known.
+	RetryAfter int64 `json:"retryAfter,omitempty"`
 }
 
 // SubProblemDetails represents sub-problems specific to an identifier that are
@@ -68,6 +90,8 @@ func (pd *ProblemDetails) WithSubProblems(subProbs []SubProblemDetails) *Problem
 		Detail:      pd.Detail,
 		HTTPStatus:  pd.HTTPStatus,
 		SubProblems: append(pd.SubProblems, subProbs...),
+		RateLimit:   pd.RateLimit,
+		RetryAfter:  pd.RetryAfter,
 	}
 }
 
@@ -99,7 +123,8 @@ func ProblemDetailsToStatusCode(prob *ProblemDetails) int {
 		return http.StatusInternalServerError
 	case
 		UnauthorizedProblem,
-		CAAProblem:
+		CAAProblem,
+		RestrictedIdentifierProblem:
 		return http.StatusForbidden
 	case RateLimitedProblem:
 		return statusTooManyRequests
@@ -339,6 +364,17 @@ func BadRevocationReason(detail string, a ...interface{}) *ProblemDetails {
 	}
 }
 
+// RestrictedIdentifier returns a ProblemDetails with a
+// RestrictedIdentifierProblem and a 403 Forbidden status code. It is used when
+// an identifier is acceptable in general, but not for the requesting account.
+func RestrictedIdentifier(detail string) *ProblemDetails {
+	return &ProblemDetails{
+		Type:       RestrictedIdentifierProblem,
+		Detail:     detail,
+		HTTPStatus: http.StatusForbidden,
+	}
+}
+
 // BadCSR returns a ProblemDetails representing a BadCSRProblem.
 func BadCSR(detail string, a ...interface{}) *ProblemDetails {
 	return &ProblemDetails{
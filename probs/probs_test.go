@@ -36,6 +36,7 @@ func TestProblemDetailsToStatusCode(t *testing.T) {
 		{&ProblemDetails{Type: ConnectionProblem, HTTPStatus: 200}, 200},
 		{&ProblemDetails{Type: AccountDoesNotExistProblem}, http.StatusBadRequest},
 		{&ProblemDetails{Type: BadRevocationReasonProblem}, http.StatusBadRequest},
+		{&ProblemDetails{Type: RestrictedIdentifierProblem}, http.StatusForbidden},
 	}
 
 	for _, c := range testCases {
@@ -62,6 +63,7 @@ func TestProblemDetailsConvenience(t *testing.T) {
 		{BadNonce("bad nonce detail"), BadNonceProblem, http.StatusBadRequest, "bad nonce detail"},
 		{TLSError("TLS error detail"), TLSProblem, http.StatusBadRequest, "TLS error detail"},
 		{RejectedIdentifier("rejected identifier detail"), RejectedIdentifierProblem, http.StatusBadRequest, "rejected identifier detail"},
+		{RestrictedIdentifier("restricted identifier detail"), RestrictedIdentifierProblem, http.StatusForbidden, "restricted identifier detail"},
 		{AccountDoesNotExist("no account detail"), AccountDoesNotExistProblem, http.StatusBadRequest, "no account detail"},
 		{BadRevocationReason("only reason xxx is supported"), BadRevocationReasonProblem, http.StatusBadRequest, "only reason xxx is supported"},
 	}
@@ -37,7 +37,8 @@ import (
 type Log struct {
 	logID  string
 	uri    string
-	client *ctClient.LogClient
+	static bool
+	client submitter
 }
 
 // logCache contains a cache of *Log's that are constructed as required by
@@ -48,8 +49,9 @@ type logCache struct {
 }
 
 // AddLog adds a *Log to the cache by constructing the statName, client and
-// verifier for the given uri & base64 public key.
-func (c *logCache) AddLog(uri, b64PK, userAgent string, logger blog.Logger) (*Log, error) {
+// verifier for the given uri & base64 public key. If static is true the log
+// is expected to implement the Static CT API rather than RFC 6962.
+func (c *logCache) AddLog(uri, b64PK, userAgent string, static bool, logger blog.Logger) (*Log, error) {
 	// Lock the mutex for reading to check the cache
 	c.RLock()
 	log, present := c.logs[b64PK]
@@ -65,7 +67,7 @@ func (c *logCache) AddLog(uri, b64PK, userAgent string, logger blog.Logger) (*Lo
 	defer c.Unlock()
 
 	// Construct a Log, add it to the cache, and return it to the caller
-	log, err := NewLog(uri, b64PK, userAgent, logger)
+	log, err := NewLog(uri, b64PK, userAgent, static, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -88,8 +90,9 @@ func (la logAdaptor) Printf(s string, args ...interface{}) {
 	la.Logger.Infof(s, args...)
 }
 
-// NewLog returns an initialized Log struct
-func NewLog(uri, b64PK, userAgent string, logger blog.Logger) (*Log, error) {
+// NewLog returns an initialized Log struct. For a Static CT API log, uri is
+// the log's submission prefix.
+func NewLog(uri, b64PK, userAgent string, static bool, logger blog.Logger) (*Log, error) {
 	url, err := url.Parse(uri)
 	if err != nil {
 		return nil, err
@@ -141,10 +144,16 @@ func NewLog(uri, b64PK, userAgent string, logger blog.Logger) (*Log, error) {
 		return nil, fmt.Errorf("making CT client: %s", err)
 	}
 
+	var sub submitter = client
+	if static {
+		sub = staticClient{client}
+	}
+
 	return &Log{
 		logID:  b64PK,
 		uri:    url.String(),
-		client: client,
+		static: static,
+		client: sub,
 	}, nil
 }
 
@@ -241,7 +250,7 @@ func (pub *Impl) SubmitToSingleCTWithResult(ctx context.Context, req *pubpb.Requ
 	// Add a log URL/pubkey to the cache, if already present the
 	// existing *Log will be returned, otherwise one will be constructed, added
 	// and returned.
-	ctLog, err := pub.ctLogsCache.AddLog(req.LogURL, req.LogPublicKey, pub.userAgent, pub.log)
+	ctLog, err := pub.ctLogsCache.AddLog(req.LogURL, req.LogPublicKey, pub.userAgent, req.StaticCT, pub.log)
 	if err != nil {
 		pub.log.AuditErrf("Making Log: %s", err)
 		return nil, err
@@ -336,6 +345,13 @@ func (pub *Impl) singleLogSubmit(
 // CreateTestingSignedSCT is used by both the publisher tests and ct-test-serv, which is
 // why it is exported. It creates a signed SCT based on the provided chain.
 func CreateTestingSignedSCT(req []string, k *ecdsa.PrivateKey, precert bool, timestamp time.Time) []byte {
+	return CreateTestingSignedSCTWithExtensions(req, k, precert, timestamp, nil)
+}
+
+// CreateTestingSignedSCTWithExtensions is like CreateTestingSignedSCT, but
+// includes the given CtExtensions in the signed SCT. It is used to emulate
+// Static CT API logs, whose SCTs carry a leaf_index extension.
+func CreateTestingSignedSCTWithExtensions(req []string, k *ecdsa.PrivateKey, precert bool, timestamp time.Time, exts ct.CTExtensions) []byte {
 	chain := make([]ct.ASN1Cert, len(req))
 	for i, str := range req {
 		b, err := base64.StdEncoding.DecodeString(str)
@@ -363,6 +379,7 @@ func CreateTestingSignedSCT(req []string, k *ecdsa.PrivateKey, precert bool, tim
 		SCTVersion: ct.V1,
 		LogID:      ct.LogID{KeyID: logID},
 		Timestamp:  timestampMillis,
+		Extensions: exts,
 	}, ct.LogEntry{Leaf: *leaf})
 	hashed := sha256.Sum256(serialized)
 	var ecdsaSig struct {
@@ -384,6 +401,7 @@ func CreateTestingSignedSCT(req []string, k *ecdsa.PrivateKey, precert bool, tim
 	jsonSCTObj.SCTVersion = ct.V1
 	jsonSCTObj.ID = base64.StdEncoding.EncodeToString(logID[:])
 	jsonSCTObj.Timestamp = timestampMillis
+	jsonSCTObj.Extensions = base64.StdEncoding.EncodeToString(exts)
 	ds := ct.DigitallySigned{
 		Algorithm: cttls.SignatureAndHashAlgorithm{
 			Hash:      cttls.SHA256,
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	ct "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/letsencrypt/boulder/core"
@@ -106,6 +107,32 @@ func lyingLogSrv(k *ecdsa.PrivateKey, timestamp time.Time) *testLogSrv {
 	return testLog
 }
 
+// staticLogSrv emulates a Static CT API log, which includes a leaf_index
+// extension in each SCT it returns.
+func staticLogSrv(k *ecdsa.PrivateKey) *testLogSrv {
+	testLog := &testLogSrv{}
+	m := http.NewServeMux()
+	m.HandleFunc("/ct/", func(w http.ResponseWriter, r *http.Request) {
+		decoder := json.NewDecoder(r.Body)
+		var jsonReq ctSubmissionRequest
+		err := decoder.Decode(&jsonReq)
+		if err != nil {
+			return
+		}
+		precert := false
+		if r.URL.Path == "/ct/v1/add-pre-chain" {
+			precert = true
+		}
+		index := atomic.AddInt64(&testLog.submissions, 1) - 1
+		sct := CreateTestingSignedSCTWithExtensions(jsonReq.Chain, k, precert, time.Now(), MarshalLeafIndex(uint64(index)))
+		fmt.Fprint(w, string(sct))
+	})
+
+	testLog.Server = httptest.NewUnstartedServer(m)
+	testLog.Server.Start()
+	return testLog
+}
+
 func errorBodyLogSrv() *httptest.Server {
 	m := http.NewServeMux()
 	m.HandleFunc("/ct/", func(w http.ResponseWriter, r *http.Request) {
@@ -166,7 +193,7 @@ func addLog(t *testing.T, pub *Impl, port int, pubKey *ecdsa.PublicKey) *Log {
 	uri := fmt.Sprintf("http://localhost:%d", port)
 	der, err := x509.MarshalPKIXPublicKey(pubKey)
 	test.AssertNotError(t, err, "Failed to marshal key")
-	newLog, err := NewLog(uri, base64.StdEncoding.EncodeToString(der), "test-user-agent/1.0", log)
+	newLog, err := NewLog(uri, base64.StdEncoding.EncodeToString(der), "test-user-agent/1.0", false, log)
 	test.AssertNotError(t, err, "Couldn't create log")
 	test.AssertEquals(t, newLog.uri, fmt.Sprintf("http://localhost:%d", port))
 	return newLog
@@ -263,11 +290,11 @@ func TestLogCache(t *testing.T) {
 	}
 
 	// Adding a log with an invalid base64 public key should error
-	_, err := cache.AddLog("www.test.com", "1234", "test-user-agent/1.0", log)
+	_, err := cache.AddLog("www.test.com", "1234", "test-user-agent/1.0", false, log)
 	test.AssertError(t, err, "AddLog() with invalid base64 pk didn't error")
 
 	// Adding a log with an invalid URI should error
-	_, err = cache.AddLog(":", "", "test-user-agent/1.0", log)
+	_, err = cache.AddLog(":", "", "test-user-agent/1.0", false, log)
 	test.AssertError(t, err, "AddLog() with an invalid log URI didn't error")
 
 	// Create one keypair & base 64 public key
@@ -285,21 +312,21 @@ func TestLogCache(t *testing.T) {
 	k2b64 := base64.StdEncoding.EncodeToString(der2)
 
 	// Adding the first log should not produce an error
-	l1, err := cache.AddLog("http://log.one.example.com", k1b64, "test-user-agent/1.0", log)
+	l1, err := cache.AddLog("http://log.one.example.com", k1b64, "test-user-agent/1.0", false, log)
 	test.AssertNotError(t, err, "cache.AddLog() failed for log 1")
 	test.AssertEquals(t, cache.Len(), 1)
 	test.AssertEquals(t, l1.uri, "http://log.one.example.com")
 	test.AssertEquals(t, l1.logID, k1b64)
 
 	// Adding it again should not produce any errors, or increase the Len()
-	l1, err = cache.AddLog("http://log.one.example.com", k1b64, "test-user-agent/1.0", log)
+	l1, err = cache.AddLog("http://log.one.example.com", k1b64, "test-user-agent/1.0", false, log)
 	test.AssertNotError(t, err, "cache.AddLog() failed for second add of log 1")
 	test.AssertEquals(t, cache.Len(), 1)
 	test.AssertEquals(t, l1.uri, "http://log.one.example.com")
 	test.AssertEquals(t, l1.logID, k1b64)
 
 	// Adding a second log should not error and should increase the Len()
-	l2, err := cache.AddLog("http://log.two.example.com", k2b64, "test-user-agent/1.0", log)
+	l2, err := cache.AddLog("http://log.two.example.com", k2b64, "test-user-agent/1.0", false, log)
 	test.AssertNotError(t, err, "cache.AddLog() failed for log 2")
 	test.AssertEquals(t, cache.Len(), 2)
 	test.AssertEquals(t, l2.uri, "http://log.two.example.com")
@@ -374,6 +401,80 @@ func TestHTTPStatusMetric(t *testing.T) {
 		"http_status": "",
 	}, 1)
 }
+
+func TestStaticCTSubmission(t *testing.T) {
+	pub, leaf, k := setup(t)
+	pkDER, err := x509.MarshalPKIXPublicKey(&k.PublicKey)
+	test.AssertNotError(t, err, "Failed to marshal key")
+	pkB64 := base64.StdEncoding.EncodeToString(pkDER)
+
+	staticSrv := staticLogSrv(k)
+	defer staticSrv.Close()
+	port, err := getPort(staticSrv.URL)
+	test.AssertNotError(t, err, "Failed to get test server port")
+	staticURI := fmt.Sprintf("http://localhost:%d", port)
+
+	for i := uint64(0); i < 2; i++ {
+		res, err := pub.SubmitToSingleCTWithResult(ctx, &pubpb.Request{
+			LogURL:       staticURI,
+			LogPublicKey: pkB64,
+			Der:          leaf.Raw,
+			StaticCT:     true,
+		})
+		test.AssertNotError(t, err, "SubmitToSingleCTWithResult failed for static log")
+		var sct ct.SignedCertificateTimestamp
+		_, err = cttls.Unmarshal(res.Sct, &sct)
+		test.AssertNotError(t, err, "Failed to unmarshal SCT")
+		index, err := LeafIndex(sct.Extensions)
+		test.AssertNotError(t, err, "SCT had no usable leaf_index")
+		test.AssertEquals(t, index, i)
+	}
+
+	// An RFC 6962 log doesn't include a leaf_index, so submitting to it as if
+	// it were a static log should fail.
+	pub, leaf, k = setup(t)
+	pkDER, err = x509.MarshalPKIXPublicKey(&k.PublicKey)
+	test.AssertNotError(t, err, "Failed to marshal key")
+	pkB64 = base64.StdEncoding.EncodeToString(pkDER)
+	rfc6962Srv := logSrv(k)
+	defer rfc6962Srv.Close()
+	port, err = getPort(rfc6962Srv.URL)
+	test.AssertNotError(t, err, "Failed to get test server port")
+
+	_, err = pub.SubmitToSingleCTWithResult(ctx, &pubpb.Request{
+		LogURL:       fmt.Sprintf("http://localhost:%d", port),
+		LogPublicKey: pkB64,
+		Der:          leaf.Raw,
+		StaticCT:     true,
+	})
+	test.AssertError(t, err, "SubmitToSingleCTWithResult accepted an SCT without a leaf_index")
+	test.AssertContains(t, err.Error(), "missing the leaf_index extension")
+}
+
+func TestLeafIndex(t *testing.T) {
+	index, err := LeafIndex(MarshalLeafIndex(1<<33 + 7))
+	test.AssertNotError(t, err, "LeafIndex failed on a valid extension")
+	test.AssertEquals(t, index, uint64(1<<33+7))
+
+	// Unknown extensions are skipped.
+	exts := append(ct.CTExtensions{9, 0, 2, 0xaa, 0xbb}, MarshalLeafIndex(42)...)
+	index, err = LeafIndex(exts)
+	test.AssertNotError(t, err, "LeafIndex failed with an unknown extension present")
+	test.AssertEquals(t, index, uint64(42))
+
+	_, err = LeafIndex(nil)
+	test.AssertError(t, err, "LeafIndex succeeded with no extensions")
+
+	_, err = LeafIndex(append(MarshalLeafIndex(1), MarshalLeafIndex(2)...))
+	test.AssertError(t, err, "LeafIndex succeeded with two leaf_index extensions")
+
+	_, err = LeafIndex(ct.CTExtensions{0, 0, 4, 0, 0, 0, 1})
+	test.AssertError(t, err, "LeafIndex succeeded with a short leaf_index")
+
+	_, err = LeafIndex(ct.CTExtensions{0, 0, 5, 0, 0})
+	test.AssertError(t, err, "LeafIndex succeeded with truncated data")
+}
+
 func Test_GetCTBundleForChain(t *testing.T) {
 	chain, err := issuance.LoadChain([]string{
 		"../test/hierarchy/int-r3.cert.pem",
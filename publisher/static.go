@@ -0,0 +1,107 @@
+package publisher
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ct "github.com/google/certificate-transparency-go"
+	ctClient "github.com/google/certificate-transparency-go/client"
+)
+
+// leafIndexExtensionType is the CtExtension type of the leaf_index extension
+// defined by the Static CT API: https://c2sp.org/static-ct-api
+const leafIndexExtensionType = 0
+
+// submitter is the subset of a CT log client used to submit chains.
+type submitter interface {
+	AddChain(context.Context, []ct.ASN1Cert) (*ct.SignedCertificateTimestamp, error)
+	AddPreChain(context.Context, []ct.ASN1Cert) (*ct.SignedCertificateTimestamp, error)
+}
+
+// staticClient submits chains to a log implementing the Static CT API. These
+// logs accept submissions on the same add-chain and add-pre-chain endpoints as
+// RFC 6962 logs, but they serve their tree as static tiles and checkpoints
+// rather than through get-proof-by-hash. Because of this every SCT they issue
+// must carry a leaf_index extension locating the entry in the log, and an SCT
+// without one can never be audited.
+type staticClient struct {
+	*ctClient.LogClient
+}
+
+// AddChain submits a final certificate chain and checks that the returned SCT
+// carries a leaf_index extension.
+func (c staticClient) AddChain(ctx context.Context, chain []ct.ASN1Cert) (*ct.SignedCertificateTimestamp, error) {
+	sct, err := c.LogClient.AddChain(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+	_, err = LeafIndex(sct.Extensions)
+	if err != nil {
+		return nil, err
+	}
+	return sct, nil
+}
+
+// AddPreChain submits a precertificate chain and checks that the returned SCT
+// carries a leaf_index extension.
+func (c staticClient) AddPreChain(ctx context.Context, chain []ct.ASN1Cert) (*ct.SignedCertificateTimestamp, error) {
+	sct, err := c.LogClient.AddPreChain(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+	_, err = LeafIndex(sct.Extensions)
+	if err != nil {
+		return nil, err
+	}
+	return sct, nil
+}
+
+// LeafIndex parses the CtExtensions of an SCT issued by a Static CT API log and
+// returns the value of its single leaf_index extension. It returns an error if
+// the extensions are malformed, or if there is not exactly one leaf_index.
+func LeafIndex(exts ct.CTExtensions) (uint64, error) {
+	var index uint64
+	found := false
+	for len(exts) > 0 {
+		if len(exts) < 3 {
+			return 0, errors.New("truncated SCT extension header")
+		}
+		extType := exts[0]
+		extLen := int(binary.BigEndian.Uint16(exts[1:3]))
+		exts = exts[3:]
+		if len(exts) < extLen {
+			return 0, errors.New("truncated SCT extension data")
+		}
+		data := exts[:extLen]
+		exts = exts[extLen:]
+		if extType != leafIndexExtensionType {
+			continue
+		}
+		if found {
+			return 0, errors.New("SCT contains more than one leaf_index extension")
+		}
+		// The leaf_index is a uint40.
+		if len(data) != 5 {
+			return 0, fmt.Errorf("leaf_index extension has wrong length %d", len(data))
+		}
+		for _, b := range data {
+			index = index<<8 | uint64(b)
+		}
+		found = true
+	}
+	if !found {
+		return 0, errors.New("SCT from static CT log is missing the leaf_index extension")
+	}
+	return index, nil
+}
+
+// MarshalLeafIndex returns the CtExtensions encoding of a leaf_index extension
+// with the given value. It is exported for use by ct-test-srv.
+func MarshalLeafIndex(index uint64) ct.CTExtensions {
+	return ct.CTExtensions{
+		leafIndexExtensionType, 0, 5,
+		byte(index >> 32), byte(index >> 24), byte(index >> 16), byte(index >> 8), byte(index),
+	}
+}
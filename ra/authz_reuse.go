@@ -0,0 +1,118 @@
+package ra
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+)
+
+// AuthzReusePolicy decides whether NewOrder may reuse an existing
+// authorization for a name rather than creating a new pending one.
+//
+// Authorizations are never reused across accounts. Pending authorizations are
+// always eligible for reuse, as long as they offer only challenges which could
+// authorize the name. Valid authorizations are eligible if ReuseValid is set and
+// they were validated recently enough for the challenge type used, and, for
+// wildcard names, only if they were validated by DNS-01.
+type AuthzReusePolicy struct {
+	ReuseValid bool
+	// MaxAge limits, by challenge type, how long after validation a valid
+	// authorization may be reused. Authorizations validated by challenge types
+	// which aren't listed may be reused until they expire. A zero MaxAge
+	// prevents reuse of authorizations validated by that challenge type.
+	MaxAge map[core.AcmeChallenge]time.Duration
+}
+
+// DefaultProfile is the name of the profile used by orders which don't select
+// one. Orders can't select a profile yet, so it is the only one in use.
+const DefaultProfile = "default"
+
+// AuthzReuseProfile is the part of the AuthzReusePolicy which may differ
+// between profiles. Whether valid authorizations are reused at all is
+// configured for the whole RA.
+type AuthzReuseProfile struct {
+	// MaxAge is used as the AuthzReusePolicy's MaxAge.
+	MaxAge map[core.AcmeChallenge]time.Duration
+}
+
+// validChallenge returns the challenge which made the authorization valid, or
+// nil if there isn't one.
+func validChallenge(authz *corepb.Authorization) *corepb.Challenge {
+	for _, chall := range authz.Challenges {
+		if chall.Status == string(core.StatusValid) {
+			return chall
+		}
+	}
+	return nil
+}
+
+// Reusable returns whether authz may be reused for name in a new order for the
+// account regID, along with the reason why or why not for logging.
+func (p AuthzReusePolicy) Reusable(authz *corepb.Authorization, name string, regID int64, now time.Time) (bool, string) {
+	if authz.RegistrationID != regID {
+		return false, "belongs to a different account"
+	}
+	wildcard := strings.HasPrefix(name, "*.")
+
+	switch core.AcmeStatus(authz.Status) {
+	case core.StatusPending:
+		// A wildcard name can only be authorized by DNS-01, so a pending
+		// authorization offering anything else can't be used for one. In
+		// theory the SA never returns such an authorization, but we check
+		// again to be safe.
+		if wildcard && (len(authz.Challenges) != 1 || core.AcmeChallenge(authz.Challenges[0].Type) != core.ChallengeTypeDNS01) {
+			return false, "pending wildcard authorization offers challenges other than dns-01"
+		}
+		return true, "pending"
+
+	case core.StatusValid:
+		if !p.ReuseValid {
+			return false, "reuse of valid authorizations is disabled"
+		}
+		chall := validChallenge(authz)
+		if chall == nil {
+			return false, "valid authorization has no valid challenge"
+		}
+		challType := core.AcmeChallenge(chall.Type)
+		if wildcard && challType != core.ChallengeTypeDNS01 {
+			return false, fmt.Sprintf("wildcard validated by %s", challType)
+		}
+		maxAge, limited := p.MaxAge[challType]
+		if !limited {
+			return true, fmt.Sprintf("validated by %s", challType)
+		}
+		if chall.Validated == 0 {
+			return false, fmt.Sprintf("validated by %s at an unknown time", challType)
+		}
+		age := now.Sub(time.Unix(0, chall.Validated))
+		if age >= maxAge {
+			return false, fmt.Sprintf("validated by %s %s ago, older than the maximum of %s", challType, age, maxAge)
+		}
+		return true, fmt.Sprintf("validated by %s %s ago, within the maximum of %s", challType, age, maxAge)
+
+	default:
+		return false, fmt.Sprintf("status is %s", authz.Status)
+	}
+}
+
+// ReusableUntil returns when the authorization, having been reused, stops being
+// eligible for reuse according to MaxAge, or the zero time if MaxAge doesn't
+// apply to it. An order reusing the authorization must expire no later than
+// this.
+func (p AuthzReusePolicy) ReusableUntil(authz *corepb.Authorization) time.Time {
+	if authz.Status != string(core.StatusValid) {
+		return time.Time{}
+	}
+	chall := validChallenge(authz)
+	if chall == nil || chall.Validated == 0 {
+		return time.Time{}
+	}
+	maxAge, limited := p.MaxAge[core.AcmeChallenge(chall.Type)]
+	if !limited {
+		return time.Time{}
+	}
+	return time.Unix(0, chall.Validated).Add(maxAge)
+}
@@ -0,0 +1,175 @@
+package ra
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestAuthzReusePolicy(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	validAuthz := func(regID int64, challType core.AcmeChallenge, age time.Duration) *corepb.Authorization {
+		return &corepb.Authorization{
+			Id:             "1",
+			RegistrationID: regID,
+			Status:         string(core.StatusValid),
+			Challenges: []*corepb.Challenge{
+				{Type: string(core.ChallengeTypeHTTP01), Status: string(core.StatusPending)},
+				{Type: string(challType), Status: string(core.StatusValid), Validated: now.Add(-age).UnixNano()},
+			},
+		}
+	}
+	pendingAuthz := func(challTypes ...core.AcmeChallenge) *corepb.Authorization {
+		authz := &corepb.Authorization{
+			Id:             "1",
+			RegistrationID: 1,
+			Status:         string(core.StatusPending),
+		}
+		for _, challType := range challTypes {
+			authz.Challenges = append(authz.Challenges, &corepb.Challenge{Type: string(challType), Status: string(core.StatusPending)})
+		}
+		return authz
+	}
+
+	policy := AuthzReusePolicy{
+		ReuseValid: true,
+		MaxAge: map[core.AcmeChallenge]time.Duration{
+			core.ChallengeTypeHTTP01:    24 * time.Hour,
+			core.ChallengeTypeTLSALPN01: 0,
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		policy AuthzReusePolicy
+		authz  *corepb.Authorization
+		domain string
+		reuse  bool
+		until  time.Time
+	}{
+		{
+			name:   "pending",
+			policy: policy,
+			authz:  pendingAuthz(core.ChallengeTypeHTTP01, core.ChallengeTypeDNS01),
+			domain: "example.com",
+			reuse:  true,
+		},
+		{
+			name:   "pending wildcard with only dns-01",
+			policy: policy,
+			authz:  pendingAuthz(core.ChallengeTypeDNS01),
+			domain: "*.example.com",
+			reuse:  true,
+		},
+		{
+			name:   "pending wildcard with other challenges",
+			policy: policy,
+			authz:  pendingAuthz(core.ChallengeTypeHTTP01, core.ChallengeTypeDNS01),
+			domain: "*.example.com",
+			reuse:  false,
+		},
+		{
+			name:   "other account",
+			policy: policy,
+			authz:  validAuthz(2, core.ChallengeTypeDNS01, time.Hour),
+			domain: "example.com",
+			reuse:  false,
+		},
+		{
+			name:   "valid reuse disabled",
+			policy: AuthzReusePolicy{},
+			authz:  validAuthz(1, core.ChallengeTypeDNS01, time.Hour),
+			domain: "example.com",
+			reuse:  false,
+		},
+		{
+			name:   "dns-01 without a max age",
+			policy: policy,
+			authz:  validAuthz(1, core.ChallengeTypeDNS01, 300*24*time.Hour),
+			domain: "example.com",
+			reuse:  true,
+		},
+		{
+			name:   "http-01 within max age",
+			policy: policy,
+			authz:  validAuthz(1, core.ChallengeTypeHTTP01, time.Hour),
+			domain: "example.com",
+			reuse:  true,
+			until:  now.Add(23 * time.Hour),
+		},
+		{
+			name:   "http-01 older than max age",
+			policy: policy,
+			authz:  validAuthz(1, core.ChallengeTypeHTTP01, 25*time.Hour),
+			domain: "example.com",
+			reuse:  false,
+			until:  now.Add(-time.Hour),
+		},
+		{
+			name:   "tls-alpn-01 never reused",
+			policy: policy,
+			authz:  validAuthz(1, core.ChallengeTypeTLSALPN01, time.Second),
+			domain: "example.com",
+			reuse:  false,
+			until:  now.Add(-time.Second),
+		},
+		{
+			name:   "wildcard validated by dns-01",
+			policy: policy,
+			authz:  validAuthz(1, core.ChallengeTypeDNS01, time.Hour),
+			domain: "*.example.com",
+			reuse:  true,
+		},
+		{
+			name:   "wildcard validated by http-01",
+			policy: policy,
+			authz:  validAuthz(1, core.ChallengeTypeHTTP01, time.Hour),
+			domain: "*.example.com",
+			reuse:  false,
+			until:  now.Add(23 * time.Hour),
+		},
+		{
+			name:   "invalid",
+			policy: policy,
+			authz:  &corepb.Authorization{Id: "1", RegistrationID: 1, Status: string(core.StatusInvalid)},
+			domain: "example.com",
+			reuse:  false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reuse, reason := tc.policy.Reusable(tc.authz, tc.domain, 1, now)
+			test.AssertEquals(t, reuse, tc.reuse)
+			test.Assert(t, reason != "", "no reason given")
+			until := tc.policy.ReusableUntil(tc.authz)
+			test.Assert(t, until.Equal(tc.until), fmt.Sprintf("ReusableUntil returned %s, expected %s", until, tc.until))
+		})
+	}
+}
+
+func TestAuthzReuseProfiles(t *testing.T) {
+	ra := &RegistrationAuthorityImpl{reuseValidAuthz: true}
+
+	// Without any profiles, valid authorizations are reused until they expire.
+	policy := ra.authzReusePolicy(DefaultProfile)
+	test.AssertEquals(t, policy.ReuseValid, true)
+	test.AssertEquals(t, len(policy.MaxAge), 0)
+
+	ra.SetAuthzReuseProfiles(map[string]AuthzReuseProfile{
+		DefaultProfile: {MaxAge: map[core.AcmeChallenge]time.Duration{core.ChallengeTypeHTTP01: time.Hour}},
+		"shortlived":   {MaxAge: map[core.AcmeChallenge]time.Duration{core.ChallengeTypeHTTP01: time.Minute}},
+	})
+	policy = ra.authzReusePolicy(DefaultProfile)
+	test.AssertEquals(t, policy.MaxAge[core.ChallengeTypeHTTP01], time.Hour)
+	policy = ra.authzReusePolicy("shortlived")
+	test.AssertEquals(t, policy.MaxAge[core.ChallengeTypeHTTP01], time.Minute)
+
+	// Whether valid authorizations are reused at all applies to every profile.
+	ra.reuseValidAuthz = false
+	test.AssertEquals(t, ra.authzReusePolicy("shortlived").ReuseValid, false)
+}
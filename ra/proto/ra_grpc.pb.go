@@ -29,6 +29,12 @@ type RegistrationAuthorityClient interface {
 	AdministrativelyRevokeCertificate(ctx context.Context, in *AdministrativelyRevokeCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	NewOrder(ctx context.Context, in *NewOrderRequest, opts ...grpc.CallOption) (*proto.Order, error)
 	FinalizeOrder(ctx context.Context, in *FinalizeOrderRequest, opts ...grpc.CallOption) (*proto.Order, error)
+	GetRateLimitStatus(ctx context.Context, in *GetRateLimitStatusRequest, opts ...grpc.CallOption) (*RateLimitStatus, error)
+	SuspendAccount(ctx context.Context, in *SuspendAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnsuspendAccount(ctx context.Context, in *UnsuspendAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnpauseAccount(ctx context.Context, in *UnpauseAccountRequest, opts ...grpc.CallOption) (*UnpauseAccountResponse, error)
+	ApproveOrder(ctx context.Context, in *OrderApprovalRequest, opts ...grpc.CallOption) (*proto.Order, error)
+	RejectOrder(ctx context.Context, in *OrderApprovalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type registrationAuthorityClient struct {
@@ -120,6 +126,60 @@ func (c *registrationAuthorityClient) FinalizeOrder(ctx context.Context, in *Fin
 	return out, nil
 }
 
+func (c *registrationAuthorityClient) GetRateLimitStatus(ctx context.Context, in *GetRateLimitStatusRequest, opts ...grpc.CallOption) (*RateLimitStatus, error) {
+	out := new(RateLimitStatus)
+	err := c.cc.Invoke(ctx, "/ra.RegistrationAuthority/GetRateLimitStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationAuthorityClient) SuspendAccount(ctx context.Context, in *SuspendAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ra.RegistrationAuthority/SuspendAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationAuthorityClient) UnsuspendAccount(ctx context.Context, in *UnsuspendAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ra.RegistrationAuthority/UnsuspendAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationAuthorityClient) UnpauseAccount(ctx context.Context, in *UnpauseAccountRequest, opts ...grpc.CallOption) (*UnpauseAccountResponse, error) {
+	out := new(UnpauseAccountResponse)
+	err := c.cc.Invoke(ctx, "/ra.RegistrationAuthority/UnpauseAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationAuthorityClient) ApproveOrder(ctx context.Context, in *OrderApprovalRequest, opts ...grpc.CallOption) (*proto.Order, error) {
+	out := new(proto.Order)
+	err := c.cc.Invoke(ctx, "/ra.RegistrationAuthority/ApproveOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationAuthorityClient) RejectOrder(ctx context.Context, in *OrderApprovalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ra.RegistrationAuthority/RejectOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RegistrationAuthorityServer is the server API for RegistrationAuthority service.
 // All implementations must embed UnimplementedRegistrationAuthorityServer
 // for forward compatibility
@@ -133,6 +193,12 @@ type RegistrationAuthorityServer interface {
 	AdministrativelyRevokeCertificate(context.Context, *AdministrativelyRevokeCertificateRequest) (*emptypb.Empty, error)
 	NewOrder(context.Context, *NewOrderRequest) (*proto.Order, error)
 	FinalizeOrder(context.Context, *FinalizeOrderRequest) (*proto.Order, error)
+	GetRateLimitStatus(context.Context, *GetRateLimitStatusRequest) (*RateLimitStatus, error)
+	SuspendAccount(context.Context, *SuspendAccountRequest) (*emptypb.Empty, error)
+	UnsuspendAccount(context.Context, *UnsuspendAccountRequest) (*emptypb.Empty, error)
+	UnpauseAccount(context.Context, *UnpauseAccountRequest) (*UnpauseAccountResponse, error)
+	ApproveOrder(context.Context, *OrderApprovalRequest) (*proto.Order, error)
+	RejectOrder(context.Context, *OrderApprovalRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedRegistrationAuthorityServer()
 }
 
@@ -167,6 +233,24 @@ func (UnimplementedRegistrationAuthorityServer) NewOrder(context.Context, *NewOr
 func (UnimplementedRegistrationAuthorityServer) FinalizeOrder(context.Context, *FinalizeOrderRequest) (*proto.Order, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizeOrder not implemented")
 }
+func (UnimplementedRegistrationAuthorityServer) GetRateLimitStatus(context.Context, *GetRateLimitStatusRequest) (*RateLimitStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateLimitStatus not implemented")
+}
+func (UnimplementedRegistrationAuthorityServer) SuspendAccount(context.Context, *SuspendAccountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendAccount not implemented")
+}
+func (UnimplementedRegistrationAuthorityServer) UnsuspendAccount(context.Context, *UnsuspendAccountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendAccount not implemented")
+}
+func (UnimplementedRegistrationAuthorityServer) UnpauseAccount(context.Context, *UnpauseAccountRequest) (*UnpauseAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseAccount not implemented")
+}
+func (UnimplementedRegistrationAuthorityServer) ApproveOrder(context.Context, *OrderApprovalRequest) (*proto.Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveOrder not implemented")
+}
+func (UnimplementedRegistrationAuthorityServer) RejectOrder(context.Context, *OrderApprovalRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectOrder not implemented")
+}
 func (UnimplementedRegistrationAuthorityServer) mustEmbedUnimplementedRegistrationAuthorityServer() {}
 
 // UnsafeRegistrationAuthorityServer may be embedded to opt out of forward compatibility for this service.
@@ -342,6 +426,114 @@ func _RegistrationAuthority_FinalizeOrder_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RegistrationAuthority_GetRateLimitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateLimitStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationAuthorityServer).GetRateLimitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ra.RegistrationAuthority/GetRateLimitStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationAuthorityServer).GetRateLimitStatus(ctx, req.(*GetRateLimitStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationAuthority_SuspendAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationAuthorityServer).SuspendAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ra.RegistrationAuthority/SuspendAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationAuthorityServer).SuspendAccount(ctx, req.(*SuspendAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationAuthority_UnsuspendAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsuspendAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationAuthorityServer).UnsuspendAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ra.RegistrationAuthority/UnsuspendAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationAuthorityServer).UnsuspendAccount(ctx, req.(*UnsuspendAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationAuthority_UnpauseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationAuthorityServer).UnpauseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ra.RegistrationAuthority/UnpauseAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationAuthorityServer).UnpauseAccount(ctx, req.(*UnpauseAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationAuthority_ApproveOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationAuthorityServer).ApproveOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ra.RegistrationAuthority/ApproveOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationAuthorityServer).ApproveOrder(ctx, req.(*OrderApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistrationAuthority_RejectOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationAuthorityServer).RejectOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ra.RegistrationAuthority/RejectOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationAuthorityServer).RejectOrder(ctx, req.(*OrderApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RegistrationAuthority_ServiceDesc is the grpc.ServiceDesc for RegistrationAuthority service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -385,6 +577,30 @@ var RegistrationAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizeOrder",
 			Handler:    _RegistrationAuthority_FinalizeOrder_Handler,
 		},
+		{
+			MethodName: "GetRateLimitStatus",
+			Handler:    _RegistrationAuthority_GetRateLimitStatus_Handler,
+		},
+		{
+			MethodName: "SuspendAccount",
+			Handler:    _RegistrationAuthority_SuspendAccount_Handler,
+		},
+		{
+			MethodName: "UnsuspendAccount",
+			Handler:    _RegistrationAuthority_UnsuspendAccount_Handler,
+		},
+		{
+			MethodName: "UnpauseAccount",
+			Handler:    _RegistrationAuthority_UnpauseAccount_Handler,
+		},
+		{
+			MethodName: "ApproveOrder",
+			Handler:    _RegistrationAuthority_ApproveOrder_Handler,
+		},
+		{
+			MethodName: "RejectOrder",
+			Handler:    _RegistrationAuthority_RejectOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ra.proto",
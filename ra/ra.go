@@ -12,6 +12,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/honeycombio/beeline-go"
@@ -36,6 +37,7 @@ import (
 	pubpb "github.com/letsencrypt/boulder/publisher/proto"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
 	"github.com/letsencrypt/boulder/ratelimit"
+	"github.com/letsencrypt/boulder/ratelimits"
 	"github.com/letsencrypt/boulder/reloader"
 	"github.com/letsencrypt/boulder/revocation"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
@@ -85,6 +87,7 @@ type RegistrationAuthorityImpl struct {
 	maxContactsPerReg            int
 	maxNames                     int
 	reuseValidAuthz              bool
+	authzReuseProfiles           map[string]AuthzReuseProfile
 	orderLifetime                time.Duration
 
 	issuersByNameID map[issuance.IssuerNameID]*issuance.Certificate
@@ -93,8 +96,26 @@ type RegistrationAuthorityImpl struct {
 
 	ctpolicy *ctpolicy.CTPolicy
 
+	// overridesMu protects overrides, the rate limit overrides most recently
+	// loaded from the SA by ID.
+	overridesMu sync.Mutex
+	overrides   map[int64]*sapb.RateLimitOverride
+
+	// limiter, if set, is a token bucket rate limiter which runs in shadow
+	// mode beside the rlPolicies limits. It is never enforced; disagreements
+	// between the two are logged and counted.
+	limiter *ratelimits.Limiter
+
+	autoSuspend AutoSuspendConfig
+
+	// maxConsecutiveFailures is the number of validations for an identifier an
+	// account may fail in a row before it is paused from ordering certificates
+	// for that identifier. Zero disables pausing.
+	maxConsecutiveFailures int64
+
 	ctpolicyResults             *prometheus.HistogramVec
 	rateLimitCounter            *prometheus.CounterVec
+	shadowRateLimitCounter      *prometheus.CounterVec
 	revocationReasonCounter     *prometheus.CounterVec
 	namesPerCert                *prometheus.HistogramVec
 	newRegCounter               prometheus.Counter
@@ -102,6 +123,7 @@ type RegistrationAuthorityImpl struct {
 	recheckCAACounter           prometheus.Counter
 	newCertCounter              prometheus.Counter
 	recheckCAAUsedAuthzLifetime prometheus.Counter
+	pausedIdentifiersCounter    *prometheus.CounterVec
 }
 
 // NewRegistrationAuthorityImpl constructs a new RA object.
@@ -151,6 +173,12 @@ func NewRegistrationAuthorityImpl(
 	}, []string{"limit", "result"})
 	stats.MustRegister(rateLimitCounter)
 
+	shadowRateLimitCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ra_shadow_ratelimits",
+		Help: "A counter of shadow token bucket rate limit checks labelled by limit and result (agree, disagree, error)",
+	}, []string{"limit", "result"})
+	stats.MustRegister(shadowRateLimitCounter)
+
 	newRegCounter := prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "new_registrations",
 		Help: "A counter of new registrations",
@@ -187,6 +215,12 @@ func NewRegistrationAuthorityImpl(
 	}, []string{"reason"})
 	stats.MustRegister(revocationReasonCounter)
 
+	pausedIdentifiersCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "paused_identifiers",
+		Help: "A counter of account and identifier pairs paused and unpaused, by action",
+	}, []string{"action"})
+	stats.MustRegister(pausedIdentifiersCounter)
+
 	issuersByNameID := make(map[issuance.IssuerNameID]*issuance.Certificate)
 	issuersByID := make(map[issuance.IssuerID]*issuance.Certificate)
 	for _, issuer := range issuers {
@@ -214,12 +248,14 @@ func NewRegistrationAuthorityImpl(
 		issuersByID:                  issuersByID,
 		namesPerCert:                 namesPerCert,
 		rateLimitCounter:             rateLimitCounter,
+		shadowRateLimitCounter:       shadowRateLimitCounter,
 		newRegCounter:                newRegCounter,
 		reusedValidAuthzCounter:      reusedValidAuthzCounter,
 		recheckCAACounter:            recheckCAACounter,
 		newCertCounter:               newCertCounter,
 		revocationReasonCounter:      revocationReasonCounter,
 		recheckCAAUsedAuthzLifetime:  recheckCAAUsedAuthzLifetime,
+		pausedIdentifiersCounter:     pausedIdentifiersCounter,
 	}
 	return ra
 }
@@ -237,6 +273,133 @@ func (ra *RegistrationAuthorityImpl) rateLimitPoliciesLoadError(err error) {
 	ra.log.Errf("error reloading rate limit policy: %s", err)
 }
 
+// RefreshRateLimitOverrides loads the unexpired rate limit overrides from the
+// SA and applies them on top of the rate limit policy file, audit logging each
+// override which was added or removed since the last refresh.
+func (ra *RegistrationAuthorityImpl) RefreshRateLimitOverrides(ctx context.Context) error {
+	resp, err := ra.SA.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{})
+	if err != nil {
+		return fmt.Errorf("getting rate limit overrides: %w", err)
+	}
+
+	loaded := make(map[int64]*sapb.RateLimitOverride, len(resp.Overrides))
+	overrides := make([]ratelimit.Override, 0, len(resp.Overrides))
+	for _, o := range resp.Overrides {
+		loaded[o.Id] = o
+		overrides = append(overrides, ratelimit.Override{
+			ID:             o.Id,
+			Limit:          o.Limit,
+			Key:            o.Key,
+			RegistrationID: o.RegistrationID,
+			Threshold:      o.Threshold,
+		})
+	}
+	err = ra.rlPolicies.LoadOverrides(overrides)
+	if err != nil {
+		return fmt.Errorf("loading rate limit overrides: %w", err)
+	}
+
+	ra.overridesMu.Lock()
+	defer ra.overridesMu.Unlock()
+	for id, o := range loaded {
+		if _, ok := ra.overrides[id]; !ok {
+			ra.log.AuditInfof("Rate limit override loaded: id=[%d] limit=[%s] key=[%s] regID=[%d] threshold=[%d] owner=[%s] ticket=[%s] reason=[%s] expires=[%s]",
+				id, o.Limit, o.Key, o.RegistrationID, o.Threshold, o.Owner, o.Ticket, o.Reason, time.Unix(0, o.Expires).UTC())
+		}
+	}
+	for id, o := range ra.overrides {
+		if _, ok := loaded[id]; !ok {
+			ra.log.AuditInfof("Rate limit override removed: id=[%d] limit=[%s] key=[%s] regID=[%d] ticket=[%s]",
+				id, o.Limit, o.Key, o.RegistrationID, o.Ticket)
+		}
+	}
+	ra.overrides = loaded
+	return nil
+}
+
+// RateLimitOverridesLoop calls RefreshRateLimitOverrides every interval, so
+// that overrides added or expired in the database take effect without a
+// restart. It is called directly by boulder-ra.
+func (ra *RegistrationAuthorityImpl) RateLimitOverridesLoop(interval time.Duration) {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		err := ra.RefreshRateLimitOverrides(ctx)
+		cancel()
+		if err != nil {
+			ra.log.Errf("error refreshing rate limit overrides: %s", err)
+		}
+		ra.clk.Sleep(interval)
+	}
+}
+
+// getThreshold returns the threshold of the given limit for key and regID,
+// audit logging the use of any override from the database.
+func (ra *RegistrationAuthorityImpl) getThreshold(limit ratelimit.RateLimitPolicy, key string, regID int64) int64 {
+	threshold, overrideID := limit.GetThresholdWithOverride(key, regID)
+	if overrideID == 0 {
+		return threshold
+	}
+	ra.overridesMu.Lock()
+	o, ok := ra.overrides[overrideID]
+	ra.overridesMu.Unlock()
+	if !ok {
+		o = &sapb.RateLimitOverride{}
+	}
+	ra.log.AuditInfof("Rate limit override applied: id=[%d] limit=[%s] key=[%s] regID=[%d] threshold=[%d] ticket=[%s]",
+		overrideID, o.Limit, key, regID, threshold, o.Ticket)
+	return threshold
+}
+
+// AutoSuspendConfig configures the automatic suspension of accounts which fail
+// validation for the same name over and over again.
+type AutoSuspendConfig struct {
+	// FailureThreshold is the number of failed authorizations for a single name
+	// within Window after which the account is suspended. Zero disables
+	// automatic suspension.
+	FailureThreshold int64
+	Window           time.Duration
+	// Duration is how long an automatic suspension lasts.
+	Duration time.Duration
+}
+
+// SetAutoSuspend configures the automatic suspension of accounts which
+// repeatedly fail validation. It has no effect unless the AccountSuspensions
+// feature is enabled.
+func (ra *RegistrationAuthorityImpl) SetAutoSuspend(conf AutoSuspendConfig) {
+	ra.autoSuspend = conf
+}
+
+// SetAuthzReuseProfiles configures, by profile name, how NewOrder decides
+// which existing valid authorizations it may reuse. Profiles which aren't
+// configured, including the DefaultProfile, reuse valid authorizations until
+// they expire, if the reuseValidAuthz constructor argument allows it.
+func (ra *RegistrationAuthorityImpl) SetAuthzReuseProfiles(profiles map[string]AuthzReuseProfile) {
+	ra.authzReuseProfiles = profiles
+}
+
+// authzReusePolicy returns the AuthzReusePolicy for orders using the named
+// profile.
+func (ra *RegistrationAuthorityImpl) authzReusePolicy(profile string) AuthzReusePolicy {
+	return AuthzReusePolicy{
+		ReuseValid: ra.reuseValidAuthz,
+		MaxAge:     ra.authzReuseProfiles[profile].MaxAge,
+	}
+}
+
+// SetMaxConsecutiveFailures configures the number of validations for an
+// identifier an account may fail in a row before it is paused from ordering
+// certificates for that identifier. It has no effect unless the
+// PauseIdentifiers feature is enabled.
+func (ra *RegistrationAuthorityImpl) SetMaxConsecutiveFailures(max int64) {
+	ra.maxConsecutiveFailures = max
+}
+
+// SetShadowLimiter configures a token bucket rate limiter to run in shadow mode
+// beside the rate limits enforced by counting rows in the SA.
+func (ra *RegistrationAuthorityImpl) SetShadowLimiter(limiter *ratelimits.Limiter) {
+	ra.limiter = limiter
+}
+
 // certificateRequestAuthz is a struct for holding information about a valid
 // authz referenced during a certificateRequestEvent. It holds both the
 // authorization ID and the challenge type that made the authorization valid. We
@@ -290,8 +453,9 @@ type registrationCounter func(context.Context, *sapb.CountRegistrationsByIPReque
 
 // checkRegistrationIPLimit checks a specific registraton limit by using the
 // provided registrationCounter function to determine if the limit has been
-// exceeded for a given IP or IP range
-func (ra *RegistrationAuthorityImpl) checkRegistrationIPLimit(ctx context.Context, limit ratelimit.RateLimitPolicy, ip net.IP, counter registrationCounter) error {
+// exceeded for a given IP or IP range. The name of the limit is included in
+// any rate limit error.
+func (ra *RegistrationAuthorityImpl) checkRegistrationIPLimit(ctx context.Context, name string, limit ratelimit.RateLimitPolicy, ip net.IP, counter registrationCounter, msg string) error {
 	if !limit.Enabled() {
 		return nil
 	}
@@ -308,8 +472,14 @@ func (ra *RegistrationAuthorityImpl) checkRegistrationIPLimit(ctx context.Contex
 		return err
 	}
 
-	if count.Count >= limit.GetThreshold(ip.String(), noRegistrationID) {
-		return berrors.RateLimitError("too many registrations for this IP")
+	threshold := ra.getThreshold(limit, ip.String(), noRegistrationID)
+	if count.Count >= threshold {
+		return berrors.RateLimitErrorWithDetails(berrors.RateLimitDetails{
+			Limit:      name,
+			Count:      count.Count,
+			Threshold:  threshold,
+			RetryAfter: limit.Window.Duration,
+		}, msg)
 	}
 
 	return nil
@@ -321,7 +491,8 @@ func (ra *RegistrationAuthorityImpl) checkRegistrationLimits(ctx context.Context
 	// Check the registrations per IP limit using the CountRegistrationsByIP SA
 	// function that matches IP addresses exactly
 	exactRegLimit := ra.rlPolicies.RegistrationsPerIP()
-	err := ra.checkRegistrationIPLimit(ctx, exactRegLimit, ip, ra.SA.CountRegistrationsByIP)
+	err := ra.checkRegistrationIPLimit(ctx, "registrationsPerIP", exactRegLimit, ip, ra.SA.CountRegistrationsByIP,
+		"too many registrations for this IP")
 	if err != nil {
 		ra.rateLimitCounter.WithLabelValues("registrations_by_ip", "exceeded").Inc()
 		ra.log.Infof("Rate limit exceeded, RegistrationsByIP, IP: %s", ip)
@@ -340,13 +511,14 @@ func (ra *RegistrationAuthorityImpl) checkRegistrationLimits(ctx context.Context
 	// CountRegistrationsByIPRange SA function that fuzzy-matches IPv6 addresses
 	// within a larger address range
 	fuzzyRegLimit := ra.rlPolicies.RegistrationsPerIPRange()
-	err = ra.checkRegistrationIPLimit(ctx, fuzzyRegLimit, ip, ra.SA.CountRegistrationsByIPRange)
+	// For the fuzzyRegLimit we use a new error message that specifically
+	// mentions that the limit being exceeded is applied to a *range* of IPs
+	err = ra.checkRegistrationIPLimit(ctx, "registrationsPerIPRange", fuzzyRegLimit, ip, ra.SA.CountRegistrationsByIPRange,
+		"too many registrations for this IP range")
 	if err != nil {
 		ra.rateLimitCounter.WithLabelValues("registrations_by_ip_range", "exceeded").Inc()
 		ra.log.Infof("Rate limit exceeded, RegistrationsByIPRange, IP: %s", ip)
-		// For the fuzzyRegLimit we use a new error message that specifically
-		// mentions that the limit being exceeded is applied to a *range* of IPs
-		return berrors.RateLimitError("too many registrations for this IP range")
+		return err
 	}
 	ra.rateLimitCounter.WithLabelValues("registrations_by_ip_range", "pass").Inc()
 
@@ -374,7 +546,10 @@ func (ra *RegistrationAuthorityImpl) NewRegistration(ctx context.Context, reques
 	if err := ipAddr.UnmarshalText(request.InitialIP); err != nil {
 		return nil, berrors.InternalServerError("failed to unmarshal ip address: %s", err.Error())
 	}
-	if err := ra.checkRegistrationLimits(ctx, ipAddr); err != nil {
+	err := ra.checkRegistrationLimits(ctx, ipAddr)
+	shadowTxns := ra.registrationShadowTxns(ipAddr)
+	ra.checkShadowLimits(ctx, "registrations", shadowTxns, err, false)
+	if err != nil {
 		return nil, err
 	}
 
@@ -401,6 +576,7 @@ func (ra *RegistrationAuthorityImpl) NewRegistration(ctx context.Context, reques
 	if err != nil {
 		return nil, err
 	}
+	ra.spendShadowLimits(ctx, "registrations", shadowTxns)
 
 	ra.newRegCounter.Inc()
 	return res, nil
@@ -483,10 +659,17 @@ func (ra *RegistrationAuthorityImpl) checkPendingAuthorizationLimit(ctx context.
 		// Most rate limits have a key for overrides, but there is no meaningful key
 		// here.
 		noKey := ""
-		if countPB.Count >= limit.GetThreshold(noKey, regID) {
+		threshold := ra.getThreshold(limit, noKey, regID)
+		if countPB.Count >= threshold {
 			ra.rateLimitCounter.WithLabelValues("pending_authorizations_by_registration_id", "exceeded").Inc()
 			ra.log.Infof("Rate limit exceeded, PendingAuthorizationsByRegID, regID: %d", regID)
-			return berrors.RateLimitError("too many currently pending authorizations")
+			// Pending authorizations aren't counted over a window, so there's
+			// no way to know when one will be completed or expire.
+			return berrors.RateLimitErrorWithDetails(berrors.RateLimitDetails{
+				Limit:     "pendingAuthorizationsPerAccount",
+				Count:     countPB.Count,
+				Threshold: threshold,
+			}, "too many currently pending authorizations")
 		}
 		ra.rateLimitCounter.WithLabelValues("pending_authorizations_by_registration_id", "pass").Inc()
 	}
@@ -535,9 +718,16 @@ func (ra *RegistrationAuthorityImpl) checkInvalidAuthorizationLimit(ctx context.
 	// Most rate limits have a key for overrides, but there is no meaningful key
 	// here.
 	noKey := ""
-	if count.Count >= int64(limit.GetThreshold(noKey, regID)) {
+	threshold := ra.getThreshold(limit, noKey, regID)
+	if count.Count >= threshold {
 		ra.log.Infof("Rate limit exceeded, InvalidAuthorizationsByRegID, regID: %d", regID)
-		return berrors.RateLimitError("too many failed authorizations recently")
+		return berrors.RateLimitErrorWithDetails(berrors.RateLimitDetails{
+			Limit:      "invalidAuthorizationsPerAccount",
+			Key:        hostname,
+			Count:      count.Count,
+			Threshold:  threshold,
+			RetryAfter: limit.Window.Duration,
+		}, "too many failed authorizations recently")
 	}
 	return nil
 }
@@ -563,9 +753,15 @@ func (ra *RegistrationAuthorityImpl) checkNewOrdersPerAccountLimit(ctx context.C
 	}
 	// There is no meaningful override key to use for this rate limit
 	noKey := ""
-	if count.Count >= limit.GetThreshold(noKey, acctID) {
+	threshold := ra.getThreshold(limit, noKey, acctID)
+	if count.Count >= threshold {
 		ra.rateLimitCounter.WithLabelValues("new_order_by_registration_id", "exceeded").Inc()
-		return berrors.RateLimitError("too many new orders recently")
+		return berrors.RateLimitErrorWithDetails(berrors.RateLimitDetails{
+			Limit:      "newOrdersPerAccount",
+			Count:      count.Count,
+			Threshold:  threshold,
+			RetryAfter: limit.Window.Duration,
+		}, "too many new orders recently")
 	}
 	ra.rateLimitCounter.WithLabelValues("new_order_by_registration_id", "pass").Inc()
 	return nil
@@ -875,7 +1071,9 @@ func (ra *RegistrationAuthorityImpl) failOrder(
 // returned. Similarly we vet that all of the names in the order are acceptable
 // based on current policy and return an error if the order can't be fulfilled.
 // If successful the order will be returned in processing status for the client
-// to poll while awaiting finalization to occur.
+// to poll while awaiting finalization to occur. Orders for names requiring
+// approval stay in processing status until ApproveOrder or RejectOrder is
+// called for them.
 func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rapb.FinalizeOrderRequest) (*corepb.Order, error) {
 	if req == nil || req.Order == nil {
 		return nil, errIncompleteGRPCRequest
@@ -896,6 +1094,10 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 		return nil, berrors.InternalServerError("Order has no associated names")
 	}
 
+	if err := ra.checkAccountSuspended(ctx, order.RegistrationID); err != nil {
+		return nil, err
+	}
+
 	// Parse the CSR from the request
 	csrOb, err := x509.ParseCertificateRequest(req.Csr)
 	if err != nil {
@@ -925,6 +1127,17 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 		}
 	}
 
+	// The account's identifier policy may have changed since the order was
+	// created, so check it again before issuing.
+	if err := ra.checkAccountIdentifierPolicy(ctx, order.RegistrationID, orderNames); err != nil {
+		return nil, err
+	}
+
+	// Likewise, any of the names may have been blocked since.
+	if err := ra.checkNamesBlocked(ctx, orderNames); err != nil {
+		return nil, err
+	}
+
 	// Update the order to be status processing - we issue synchronously at the
 	// present time so this is somewhat artificial/unnecessary but allows planning
 	// for the future.
@@ -942,10 +1155,38 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 		return nil, err
 	}
 
+	// Orders for names which the hostname policy says require approval are
+	// held, remaining in processing status, until an administrator approves
+	// or rejects them.
+	approvalNames := ra.PA.NamesRequiringApproval(orderNames)
+	if len(approvalNames) > 0 {
+		_, err = ra.SA.HoldOrderForApproval(ctx, &sapb.OrderApproval{
+			OrderID:        order.Id,
+			RegistrationID: order.RegistrationID,
+			Names:          approvalNames,
+			Csr:            req.Csr,
+		})
+		if err != nil {
+			ra.failOrder(ctx, order, probs.ServerInternal("Error holding order for approval"))
+			return nil, err
+		}
+		ra.log.AuditInfof("Order held for approval: orderID=[%d] regID=[%d] names=[%s]",
+			order.Id, order.RegistrationID, strings.Join(approvalNames, ","))
+		order.Status = string(core.StatusProcessing)
+		return order, nil
+	}
+
+	return ra.issueCertificateForOrder(ctx, order, req.Csr, csrOb)
+}
+
+// issueCertificateForOrder issues a certificate for an order which is in
+// processing status, using the CSR it was finalized with, and records the
+// certificate's serial in the order. Any error fails the order.
+func (ra *RegistrationAuthorityImpl) issueCertificateForOrder(ctx context.Context, order *corepb.Order, csr []byte, csrOb *x509.CertificateRequest) (*corepb.Order, error) {
 	// Attempt issuance for the order. If the order isn't fully authorized this
 	// will return an error.
 	issueReq := core.CertificateRequest{
-		Bytes: req.Csr,
+		Bytes: csr,
 		CSR:   csrOb,
 	}
 	// We use IssuerNameID 0 here because (as of now) only the v1 flow sets this
@@ -1053,7 +1294,7 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 	acctID accountID,
 	oID orderID,
 	issuerNameID issuance.IssuerNameID,
-	logEvent *certificateRequestEvent) (core.Certificate, error) {
+	logEvent *certificateRequestEvent) (_ core.Certificate, err error) {
 	emptyCert := core.Certificate{}
 	if acctID <= 0 {
 		return emptyCert, berrors.MalformedError("invalid account ID: %d", acctID)
@@ -1090,9 +1331,19 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 	// issue a cert due to rate limiting, we don't want to tell them to go get the
 	// necessary authorizations, only to later fail the rate limit check.
 	err = ra.checkLimits(ctx, names, account.ID)
+	shadowTxns, spent := ra.checkCertificateShadowLimits(ctx, names, account.ID, err, true)
 	if err != nil {
 		return emptyCert, err
 	}
+	if spent {
+		defer func() {
+			if err != nil {
+				// The certificate wasn't issued, so it shouldn't count
+				// against the shadow limits either.
+				ra.refundShadowLimits(ctx, "certificates", shadowTxns)
+			}
+		}()
+	}
 
 	// Check that this specific order is fully authorized and associated with
 	// the expected account ID
@@ -1172,8 +1423,11 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 		return emptyCert, berrors.InternalServerError("failed to parse certificate: %s", err.Error())
 	}
 
-	// Asynchronously submit the final certificate to any configured logs
-	go ra.ctpolicy.SubmitFinalCert(cert.Der, parsedCertificate.NotAfter)
+	// Submit the final certificate to any configured logs. With a final
+	// certificate queue this durably queues the submissions before returning,
+	// so that they're written before the RPC completes and can't be lost when
+	// the RA shuts down. Without one the submissions are made asynchronously.
+	ra.ctpolicy.SubmitFinalCert(cert.Der, parsedCertificate.NotAfter)
 
 	err = ra.MatchesCSR(parsedCertificate, csr)
 	if err != nil {
@@ -1242,9 +1496,9 @@ func domainsForRateLimiting(names []string) ([]string, error) {
 
 // enforceNameCounts uses the provided count RPC to find a count of certificates
 // for each of the names. If the count for any of the names exceeds the limit
-// for the given registration then the names out of policy are returned to be
-// used for a rate limit error.
-func (ra *RegistrationAuthorityImpl) enforceNameCounts(ctx context.Context, names []string, limit ratelimit.RateLimitPolicy, regID int64) ([]string, error) {
+// for the given registration then details of the names out of policy are
+// returned to be used for a rate limit error.
+func (ra *RegistrationAuthorityImpl) enforceNameCounts(ctx context.Context, names []string, limit ratelimit.RateLimitPolicy, regID int64) ([]berrors.RateLimitDetails, error) {
 	now := ra.clk.Now()
 	req := &sapb.CountCertificatesByNamesRequest{
 		Names: names,
@@ -1263,18 +1517,39 @@ func (ra *RegistrationAuthorityImpl) enforceNameCounts(ctx context.Context, name
 		return nil, errIncompleteGRPCResponse
 	}
 
-	var badNames []string
+	var badNames []berrors.RateLimitDetails
 	// Find the names that have counts at or over the threshold. Range
 	// over the names slice input to ensure the order of badNames will
 	// return the badNames in the same order they were input.
 	for _, name := range names {
-		if response.Counts[name] >= limit.GetThreshold(name, regID) {
-			badNames = append(badNames, name)
+		threshold := ra.getThreshold(limit, name, regID)
+		if response.Counts[name] >= threshold {
+			badNames = append(badNames, berrors.RateLimitDetails{
+				Limit:      "certificatesPerName",
+				Key:        name,
+				Count:      response.Counts[name],
+				Threshold:  threshold,
+				RetryAfter: ra.certificatesPerNameRetryAfter(limit, response.Earliest[name], now),
+			})
 		}
 	}
 	return badNames, nil
 }
 
+// certificatesPerNameRetryAfter returns how long until the earliest issuance
+// counted against a name leaves the window of the given limit. If the SA didn't
+// say when that was, the whole window is assumed.
+func (ra *RegistrationAuthorityImpl) certificatesPerNameRetryAfter(limit ratelimit.RateLimitPolicy, earliest int64, now time.Time) time.Duration {
+	if earliest == 0 {
+		return limit.Window.Duration
+	}
+	retryAfter := time.Unix(0, earliest).Add(limit.Window.Duration).Sub(now)
+	if retryAfter < 0 {
+		return 0
+	}
+	return retryAfter
+}
+
 func (ra *RegistrationAuthorityImpl) checkCertificatesPerNameLimit(ctx context.Context, names []string, limit ratelimit.RateLimitPolicy, regID int64) error {
 	// check if there is already an existing certificate for
 	// the exact name set we are issuing for. If so bypass the
@@ -1312,26 +1587,38 @@ func (ra *RegistrationAuthorityImpl) checkCertificatesPerNameLimit(ctx context.C
 			return nil
 		}
 
-		ra.log.Infof("Rate limit exceeded, CertificatesForDomain, regID: %d, domains: %s", regID, strings.Join(namesOutOfLimit, ", "))
+		var domains []string
+		for _, details := range namesOutOfLimit {
+			domains = append(domains, details.Key)
+		}
+		ra.log.Infof("Rate limit exceeded, CertificatesForDomain, regID: %d, domains: %s", regID, strings.Join(domains, ", "))
 		ra.rateLimitCounter.WithLabelValues("certificates_for_domain", "exceeded").Inc()
+		// The request can't succeed until every name is under the limit, so
+		// the top-level error describes the name which will take the longest.
+		slowest := namesOutOfLimit[0]
+		for _, details := range namesOutOfLimit {
+			if details.RetryAfter > slowest.RetryAfter {
+				slowest = details
+			}
+		}
 		if len(namesOutOfLimit) > 1 {
 			var subErrors []berrors.SubBoulderError
-			for _, name := range namesOutOfLimit {
+			for _, details := range namesOutOfLimit {
 				subErrors = append(subErrors, berrors.SubBoulderError{
-					Identifier:   identifier.DNSIdentifier(name),
-					BoulderError: berrors.RateLimitError("too many certificates already issued").(*berrors.BoulderError),
+					Identifier:   identifier.DNSIdentifier(details.Key),
+					BoulderError: berrors.RateLimitErrorWithDetails(details, "too many certificates already issued").(*berrors.BoulderError),
 				})
 			}
-			return berrors.RateLimitError("too many certificates already issued for multiple names (%s and %d others)", namesOutOfLimit[0], len(namesOutOfLimit)).(*berrors.BoulderError).WithSubErrors(subErrors)
+			return berrors.RateLimitErrorWithDetails(slowest, "too many certificates already issued for multiple names (%s and %d others)", domains[0], len(domains)).(*berrors.BoulderError).WithSubErrors(subErrors)
 		}
-		return berrors.RateLimitError("too many certificates already issued for: %s", namesOutOfLimit[0])
+		return berrors.RateLimitErrorWithDetails(slowest, "too many certificates already issued for: %s", domains[0])
 	}
 	ra.rateLimitCounter.WithLabelValues("certificates_for_domain", "pass").Inc()
 
 	return nil
 }
 
-func (ra *RegistrationAuthorityImpl) checkCertificatesPerFQDNSetLimit(ctx context.Context, names []string, limit ratelimit.RateLimitPolicy, regID int64) error {
+func (ra *RegistrationAuthorityImpl) checkCertificatesPerFQDNSetLimit(ctx context.Context, name string, names []string, limit ratelimit.RateLimitPolicy, regID int64) error {
 	count, err := ra.SA.CountFQDNSets(ctx, &sapb.CountFQDNSetsRequest{
 		Domains: names,
 		Window:  limit.Window.Duration.Nanoseconds(),
@@ -1340,9 +1627,15 @@ func (ra *RegistrationAuthorityImpl) checkCertificatesPerFQDNSetLimit(ctx contex
 		return fmt.Errorf("checking duplicate certificate limit for %q: %s", names, err)
 	}
 	names = core.UniqueLowerNames(names)
-	threshold := limit.GetThreshold(strings.Join(names, ","), regID)
+	threshold := ra.getThreshold(limit, strings.Join(names, ","), regID)
 	if count.Count >= threshold {
-		return berrors.RateLimitError(
+		return berrors.RateLimitErrorWithDetails(berrors.RateLimitDetails{
+			Limit:      name,
+			Key:        strings.Join(names, ","),
+			Count:      count.Count,
+			Threshold:  threshold,
+			RetryAfter: limit.Window.Duration,
+		},
 			"too many certificates (%d) already issued for this exact set of domains in the last %.0f hours: %s",
 			threshold, limit.Window.Duration.Hours(), strings.Join(names, ","),
 		)
@@ -1361,7 +1654,7 @@ func (ra *RegistrationAuthorityImpl) checkLimits(ctx context.Context, names []st
 
 	fqdnFastLimits := ra.rlPolicies.CertificatesPerFQDNSetFast()
 	if fqdnFastLimits.Enabled() {
-		err := ra.checkCertificatesPerFQDNSetLimit(ctx, names, fqdnFastLimits, regID)
+		err := ra.checkCertificatesPerFQDNSetLimit(ctx, "certificatesPerFQDNSetFast", names, fqdnFastLimits, regID)
 		if err != nil {
 			return err
 		}
@@ -1369,7 +1662,7 @@ func (ra *RegistrationAuthorityImpl) checkLimits(ctx context.Context, names []st
 
 	fqdnLimits := ra.rlPolicies.CertificatesPerFQDNSet()
 	if fqdnLimits.Enabled() {
-		err := ra.checkCertificatesPerFQDNSetLimit(ctx, names, fqdnLimits, regID)
+		err := ra.checkCertificatesPerFQDNSetLimit(ctx, "certificatesPerFQDNSet", names, fqdnLimits, regID)
 		if err != nil {
 			return err
 		}
@@ -1377,6 +1670,315 @@ func (ra *RegistrationAuthorityImpl) checkLimits(ctx context.Context, names []st
 	return nil
 }
 
+// GetRateLimitStatus reports the current usage of each enabled rate limit by
+// the given account, and, if names are provided, by a certificate for those
+// names. Thresholds include any overrides. The registration limits aren't
+// reported, since they only apply before an account exists, and neither is the
+// deprecated pendingOrdersPerAccount limit.
+func (ra *RegistrationAuthorityImpl) GetRateLimitStatus(ctx context.Context, req *rapb.GetRateLimitStatusRequest) (*rapb.RateLimitStatus, error) {
+	if req == nil || req.RegistrationID == 0 {
+		return nil, errIncompleteGRPCRequest
+	}
+	regID := req.RegistrationID
+	names := core.UniqueLowerNames(req.Names)
+	if len(names) > ra.maxNames {
+		return nil, berrors.MalformedError("cannot report rate limits for more than %d names", ra.maxNames)
+	}
+	if len(names) > 0 {
+		err := ra.checkOrderNames(names)
+		if err != nil {
+			return nil, err
+		}
+	}
+	now := ra.clk.Now()
+	noKey := ""
+	var limits []*rapb.RateLimitUsage
+	usage := func(name, key string, limit ratelimit.RateLimitPolicy, count int64, reset time.Time) {
+		u := &rapb.RateLimitUsage{
+			Limit:     name,
+			Key:       key,
+			Count:     count,
+			Threshold: limit.GetThreshold(key, regID),
+			Window:    limit.Window.Duration.Nanoseconds(),
+		}
+		if count > 0 && !reset.IsZero() {
+			u.ResetTime = reset.UnixNano()
+		}
+		limits = append(limits, u)
+	}
+
+	limit := ra.rlPolicies.PendingAuthorizationsPerAccount()
+	if limit.Enabled() {
+		count, err := ra.SA.CountPendingAuthorizations2(ctx, &sapb.RegistrationID{Id: regID})
+		if err != nil {
+			return nil, err
+		}
+		usage("pendingAuthorizationsPerAccount", noKey, limit, count.Count, time.Time{})
+	}
+
+	limit = ra.rlPolicies.NewOrdersPerAccount()
+	if limit.Enabled() {
+		count, err := ra.SA.CountOrders(ctx, &sapb.CountOrdersRequest{
+			AccountID: regID,
+			Range: &sapb.Range{
+				Earliest: now.Add(-limit.Window.Duration).UnixNano(),
+				Latest:   now.UnixNano(),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		// The SA doesn't say when the oldest order counted was created, so
+		// the reset time is when all of them will have left the window.
+		usage("newOrdersPerAccount", noKey, limit, count.Count, now.Add(limit.Window.Duration))
+	}
+
+	if len(names) == 0 {
+		return &rapb.RateLimitStatus{Limits: limits}, nil
+	}
+
+	limit = ra.rlPolicies.CertificatesPerName()
+	if limit.Enabled() {
+		tldNames, err := domainsForRateLimiting(names)
+		if err != nil {
+			return nil, berrors.MalformedError("%s", err)
+		}
+		counts, err := ra.SA.CountCertificatesByNames(ctx, &sapb.CountCertificatesByNamesRequest{
+			Names: tldNames,
+			Range: &sapb.Range{
+				Earliest: limit.WindowBegin(now).UnixNano(),
+				Latest:   now.UnixNano(),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range tldNames {
+			retryAfter := ra.certificatesPerNameRetryAfter(limit, counts.Earliest[name], now)
+			usage("certificatesPerName", name, limit, counts.Counts[name], now.Add(retryAfter))
+		}
+	}
+
+	limit = ra.rlPolicies.InvalidAuthorizationsPerAccount()
+	if limit.Enabled() {
+		latest := now.Add(ra.pendingAuthorizationLifetime)
+		for _, name := range names {
+			count, err := ra.SA.CountInvalidAuthorizations2(ctx, &sapb.CountInvalidAuthorizationsRequest{
+				RegistrationID: regID,
+				Hostname:       name,
+				Range: &sapb.Range{
+					Earliest: latest.Add(-limit.Window.Duration).UnixNano(),
+					Latest:   latest.UnixNano(),
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			u := &rapb.RateLimitUsage{
+				Limit:     "invalidAuthorizationsPerAccount",
+				Key:       name,
+				Count:     count.Count,
+				Threshold: limit.GetThreshold(noKey, regID),
+				Window:    limit.Window.Duration.Nanoseconds(),
+			}
+			if count.Count > 0 {
+				u.ResetTime = now.Add(limit.Window.Duration).UnixNano()
+			}
+			limits = append(limits, u)
+		}
+	}
+
+	for _, fqdnSetLimit := range []struct {
+		name  string
+		limit ratelimit.RateLimitPolicy
+	}{
+		{"certificatesPerFQDNSetFast", ra.rlPolicies.CertificatesPerFQDNSetFast()},
+		{"certificatesPerFQDNSet", ra.rlPolicies.CertificatesPerFQDNSet()},
+	} {
+		if !fqdnSetLimit.limit.Enabled() {
+			continue
+		}
+		count, err := ra.SA.CountFQDNSets(ctx, &sapb.CountFQDNSetsRequest{
+			Domains: names,
+			Window:  fqdnSetLimit.limit.Window.Duration.Nanoseconds(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		usage(fqdnSetLimit.name, strings.Join(names, ","), fqdnSetLimit.limit, count.Count, now.Add(fqdnSetLimit.limit.Window.Duration))
+	}
+
+	return &rapb.RateLimitStatus{Limits: limits}, nil
+}
+
+// shadowLimit converts the threshold of a legacy rate limit policy for the
+// given key and registration into a token bucket which allows the same number
+// of requests per window. It returns false if the policy is disabled or the
+// threshold is zero, since a bucket can't be empty by design.
+func shadowLimit(policy ratelimit.RateLimitPolicy, key string, regID int64) (ratelimits.Limit, bool) {
+	if !policy.Enabled() {
+		return ratelimits.Limit{}, false
+	}
+	threshold := policy.GetThreshold(key, regID)
+	if threshold <= 0 {
+		return ratelimits.Limit{}, false
+	}
+	return ratelimits.Limit{Burst: threshold, Count: threshold, Period: policy.Window.Duration}, true
+}
+
+// appendShadowTxn appends a transaction costing one token from the named
+// limit's bucket for id, if the legacy policy translates into a limit.
+func (ra *RegistrationAuthorityImpl) appendShadowTxn(txns []ratelimits.Transaction, name ratelimits.Name, id string, policy ratelimit.RateLimitPolicy, key string, regID int64) []ratelimits.Transaction {
+	limit, ok := shadowLimit(policy, key, regID)
+	if !ok {
+		return txns
+	}
+	txn, err := ratelimits.NewTransaction(name, id, limit, 1)
+	if err != nil {
+		ra.log.Warningf("building shadow rate limit transaction for %s %q: %s", name, id, err)
+		return txns
+	}
+	return append(txns, txn)
+}
+
+// registrationShadowTxns returns the shadow rate limit transactions
+// corresponding to the RegistrationsPerIP and RegistrationsPerIPRange limits.
+func (ra *RegistrationAuthorityImpl) registrationShadowTxns(ip net.IP) []ratelimits.Transaction {
+	if ra.limiter == nil {
+		return nil
+	}
+	var txns []ratelimits.Transaction
+	txns = ra.appendShadowTxn(txns, ratelimits.NewRegistrationsPerIPAddress, ip.String(),
+		ra.rlPolicies.RegistrationsPerIP(), ip.String(), noRegistrationID)
+	if ip.To4() == nil {
+		ipRange := &net.IPNet{IP: ip.Mask(net.CIDRMask(48, 128)), Mask: net.CIDRMask(48, 128)}
+		txns = ra.appendShadowTxn(txns, ratelimits.NewRegistrationsPerIPv6Range, ipRange.String(),
+			ra.rlPolicies.RegistrationsPerIPRange(), ip.String(), noRegistrationID)
+	}
+	return txns
+}
+
+// newOrderShadowTxns returns the shadow rate limit transactions corresponding
+// to the NewOrdersPerAccount limit.
+func (ra *RegistrationAuthorityImpl) newOrderShadowTxns(acctID int64) []ratelimits.Transaction {
+	if ra.limiter == nil {
+		return nil
+	}
+	return ra.appendShadowTxn(nil, ratelimits.NewOrdersPerAccount, strconv.FormatInt(acctID, 10),
+		ra.rlPolicies.NewOrdersPerAccount(), "", acctID)
+}
+
+// certificateShadowTxns returns the shadow rate limit transactions
+// corresponding to the CertificatesPerName and CertificatesPerFQDNSet limits.
+// As with CertificatesPerName, renewals of an existing set of names are exempt
+// from the per domain buckets.
+func (ra *RegistrationAuthorityImpl) certificateShadowTxns(ctx context.Context, names []string, regID int64) ([]ratelimits.Transaction, error) {
+	if ra.limiter == nil {
+		return nil, nil
+	}
+	var txns []ratelimits.Transaction
+	exists, err := ra.SA.FQDNSetExists(ctx, &sapb.FQDNSetExistsRequest{Domains: names})
+	if err != nil {
+		return nil, fmt.Errorf("checking renewal exemption for %q: %s", names, err)
+	}
+	if !exists.Exists {
+		domains, err := domainsForRateLimiting(names)
+		if err != nil {
+			return nil, err
+		}
+		for _, domain := range domains {
+			txns = ra.appendShadowTxn(txns, ratelimits.CertificatesPerDomain, domain,
+				ra.rlPolicies.CertificatesPerName(), domain, regID)
+		}
+	}
+	fqdnSet := strings.Join(core.UniqueLowerNames(names), ",")
+	txns = ra.appendShadowTxn(txns, ratelimits.CertificatesPerFQDNSet, fqdnSet,
+		ra.rlPolicies.CertificatesPerFQDNSet(), fqdnSet, regID)
+	return txns, nil
+}
+
+// checkShadowLimits compares the decision of a legacy rate limit check, given
+// by its error, with the decision of the shadow limiter for the same request.
+// If spend is true and both allowed the request, the tokens are spent and true
+// is returned. Disagreements are logged and counted but never returned; the shadow limiter
+// is not enforced. Errors other than rate limit errors from the legacy check
+// are ignored, since there's no decision to compare against.
+func (ra *RegistrationAuthorityImpl) checkShadowLimits(ctx context.Context, limit string, txns []ratelimits.Transaction, legacyErr error, spend bool) bool {
+	if ra.limiter == nil || len(txns) == 0 {
+		return false
+	}
+	if legacyErr != nil && !errors.Is(legacyErr, berrors.RateLimit) {
+		return false
+	}
+	legacyAllowed := legacyErr == nil
+
+	var d *ratelimits.Decision
+	var err error
+	if spend && legacyAllowed {
+		d, err = ra.limiter.BatchSpend(ctx, txns)
+	} else {
+		d, err = ra.limiter.BatchCheck(ctx, txns)
+	}
+	if err != nil {
+		ra.shadowRateLimitCounter.WithLabelValues(limit, "error").Inc()
+		ra.log.Warningf("checking shadow rate limit %s: %s", limit, err)
+		return false
+	}
+	spent := spend && legacyAllowed && d.Allowed
+	if d.Allowed == legacyAllowed {
+		ra.shadowRateLimitCounter.WithLabelValues(limit, "agree").Inc()
+		return spent
+	}
+	ra.shadowRateLimitCounter.WithLabelValues(limit, "disagree").Inc()
+	buckets := make([]string, len(txns))
+	for i, txn := range txns {
+		buckets[i] = txn.BucketKey()
+	}
+	ra.log.Infof("Shadow rate limit disagreement: limit=[%s] legacyAllowed=[%t] shadowAllowed=[%t] remaining=[%d] retryIn=[%s] buckets=[%s]",
+		limit, legacyAllowed, d.Allowed, d.Remaining, d.RetryIn, strings.Join(buckets, ", "))
+	return spent
+}
+
+// checkCertificateShadowLimits builds the shadow rate limit transactions for a
+// certificate for the given names and passes them to checkShadowLimits. It
+// returns the transactions and whether their tokens were spent.
+func (ra *RegistrationAuthorityImpl) checkCertificateShadowLimits(ctx context.Context, names []string, regID int64, legacyErr error, spend bool) ([]ratelimits.Transaction, bool) {
+	txns, err := ra.certificateShadowTxns(ctx, names, regID)
+	if err != nil {
+		ra.shadowRateLimitCounter.WithLabelValues("certificates", "error").Inc()
+		ra.log.Warningf("building shadow rate limit transactions for certificate: %s", err)
+		return nil, false
+	}
+	return txns, ra.checkShadowLimits(ctx, "certificates", txns, legacyErr, spend)
+}
+
+// spendShadowLimits spends from the shadow limiter's buckets for an event
+// which the legacy limits count once it has happened, such as a new
+// registration being stored.
+func (ra *RegistrationAuthorityImpl) spendShadowLimits(ctx context.Context, limit string, txns []ratelimits.Transaction) {
+	if ra.limiter == nil || len(txns) == 0 {
+		return
+	}
+	_, err := ra.limiter.BatchSpend(ctx, txns)
+	if err != nil {
+		ra.shadowRateLimitCounter.WithLabelValues(limit, "error").Inc()
+		ra.log.Warningf("spending shadow rate limit %s: %s", limit, err)
+	}
+}
+
+// refundShadowLimits returns tokens spent by checkShadowLimits when the request
+// they were spent on failed.
+func (ra *RegistrationAuthorityImpl) refundShadowLimits(ctx context.Context, limit string, txns []ratelimits.Transaction) {
+	if ra.limiter == nil || len(txns) == 0 {
+		return
+	}
+	_, err := ra.limiter.BatchRefund(ctx, txns)
+	if err != nil {
+		ra.shadowRateLimitCounter.WithLabelValues(limit, "error").Inc()
+		ra.log.Warningf("refunding shadow rate limit %s: %s", limit, err)
+	}
+}
+
 // UpdateRegistration updates an existing Registration with new values. Caller
 // is responsible for making sure that update.Key is only different from base.Key
 // if it is being called from the WFE key change endpoint.
@@ -1578,6 +2180,10 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 		return nil, berrors.MalformedError("authorization must be pending")
 	}
 
+	if err := ra.checkAccountSuspended(ctx, authz.RegistrationID); err != nil {
+		return nil, err
+	}
+
 	// Look up the account key for this authorization
 	regPB, err := ra.SA.GetRegistration(ctx, &sapb.RegistrationID{Id: authz.RegistrationID})
 	if err != nil {
@@ -1670,6 +2276,19 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 		if err := ra.recordValidation(vaCtx, authz.ID, authz.Expires, challenge); err != nil {
 			ra.log.AuditErrf("Could not record updated validation: err=[%s] regID=[%d] authzID=[%s]",
 				err, authz.RegistrationID, authz.ID)
+		} else {
+			err := ra.trackConsecutiveFailures(vaCtx, authz.RegistrationID, authz.Identifier.Value, challenge.Status)
+			if err != nil {
+				ra.log.AuditErrf("Could not track consecutive validation failures: err=[%s] regID=[%d] name=[%s]",
+					err, authz.RegistrationID, authz.Identifier.Value)
+			}
+			if challenge.Status == core.StatusInvalid {
+				err := ra.maybeAutoSuspend(vaCtx, authz.RegistrationID, authz.Identifier.Value)
+				if err != nil {
+					ra.log.AuditErrf("Could not check for automatic suspension: err=[%s] regID=[%d] name=[%s]",
+						err, authz.RegistrationID, authz.Identifier.Value)
+				}
+			}
 		}
 	}(authz)
 	return bgrpc.AuthzToPB(authz)
@@ -1930,17 +2549,339 @@ func (ra *RegistrationAuthorityImpl) checkOrderNames(names []string) error {
 	return nil
 }
 
+// checkAccountIdentifierPolicy validates that each of the names is within the
+// scope of the account's identifier policy, if it has one. Accounts without a
+// policy are unrestricted. If any of the names are out of scope a
+// restrictedIdentifier error with suberrors for each out of scope identifier is
+// returned.
+func (ra *RegistrationAuthorityImpl) checkAccountIdentifierPolicy(ctx context.Context, regID int64, names []string) error {
+	if !features.Enabled(features.AccountIdentifierPolicies) {
+		return nil
+	}
+	pb, err := ra.SA.GetAccountIdentifierPolicy(ctx, &sapb.RegistrationID{Id: regID})
+	if err != nil {
+		if errors.Is(err, berrors.NotFound) {
+			return nil
+		}
+		return err
+	}
+	accountPolicy := policy.AccountPolicy{
+		Suffixes:       pb.Suffixes,
+		ExactNames:     pb.ExactNames,
+		AllowWildcards: pb.AllowWildcards,
+	}
+	idents := make([]identifier.ACMEIdentifier, len(names))
+	for i, name := range names {
+		idents[i] = identifier.DNSIdentifier(name)
+	}
+	return accountPolicy.WillingToIssue(idents)
+}
+
+// checkNamesBlocked returns a rejectedIdentifier error, with suberrors for each
+// blocked identifier, if any of the names have been blocked in the SA.
+func (ra *RegistrationAuthorityImpl) checkNamesBlocked(ctx context.Context, names []string) error {
+	if !features.Enabled(features.BlockedNames) {
+		return nil
+	}
+	blocked, err := ra.SA.CheckNamesBlocked(ctx, &sapb.Identifiers{Identifiers: names})
+	if err != nil {
+		return err
+	}
+	if len(blocked.Identifiers) == 0 {
+		return nil
+	}
+	const detail = "The ACME server refuses to issue a certificate for this domain name, because it is forbidden by policy"
+	if len(blocked.Identifiers) == 1 {
+		return berrors.RejectedIdentifierError("Cannot issue for %q: %s", blocked.Identifiers[0], detail)
+	}
+	subErrors := make([]berrors.SubBoulderError, len(blocked.Identifiers))
+	for i, name := range blocked.Identifiers {
+		subErrors[i] = berrors.SubBoulderError{
+			Identifier: identifier.DNSIdentifier(name),
+			BoulderError: &berrors.BoulderError{
+				Type:   berrors.RejectedIdentifier,
+				Detail: detail,
+			},
+		}
+	}
+	return (&berrors.BoulderError{
+		Type: berrors.RejectedIdentifier,
+		Detail: fmt.Sprintf(
+			"Cannot issue for %q: %s (and %d more problems. Refer to sub-problems for more information.)",
+			blocked.Identifiers[0], detail, len(blocked.Identifiers)-1),
+	}).WithSubErrors(subErrors)
+}
+
+// checkAccountSuspended returns an unauthorized error, including the reason and
+// expiry of the suspension, if the account is suspended.
+func (ra *RegistrationAuthorityImpl) checkAccountSuspended(ctx context.Context, regID int64) error {
+	if !features.Enabled(features.AccountSuspensions) {
+		return nil
+	}
+	suspension, err := ra.SA.GetAccountSuspension(ctx, &sapb.RegistrationID{Id: regID})
+	if err != nil {
+		if errors.Is(err, berrors.NotFound) {
+			return nil
+		}
+		return err
+	}
+	return berrors.UnauthorizedError(
+		"Account is suspended until %s: %s",
+		time.Unix(0, suspension.Expires).UTC().Format(time.RFC3339),
+		suspension.Reason,
+	)
+}
+
+// checkIdentifiersPaused returns a rate limit error, naming the paused
+// identifiers, if the account is paused from ordering certificates for any of
+// the names.
+func (ra *RegistrationAuthorityImpl) checkIdentifiersPaused(ctx context.Context, regID int64, names []string) error {
+	if !features.Enabled(features.PauseIdentifiers) {
+		return nil
+	}
+	paused, err := ra.SA.CheckIdentifiersPaused(ctx, &sapb.CheckIdentifiersPausedRequest{
+		RegistrationID: regID,
+		Identifiers:    names,
+	})
+	if err != nil {
+		return err
+	}
+	if len(paused.Identifiers) == 0 {
+		return nil
+	}
+	return berrors.RateLimitErrorWithDetails(berrors.RateLimitDetails{
+		Limit:     ratelimit.PausedIdentifiers,
+		Count:     ra.maxConsecutiveFailures,
+		Threshold: ra.maxConsecutiveFailures,
+	}, "Your account is paused from requesting certificates for %s after too many failed validations in a row",
+		strings.Join(paused.Identifiers, ", "))
+}
+
+// maybeAutoSuspend suspends the account if it has failed validation for the
+// name at least the configured number of times within the configured window,
+// and it isn't already suspended.
+func (ra *RegistrationAuthorityImpl) maybeAutoSuspend(ctx context.Context, regID int64, name string) error {
+	if !features.Enabled(features.AccountSuspensions) || ra.autoSuspend.FailureThreshold == 0 {
+		return nil
+	}
+	// Failed authorizations keep the expiry they had while pending, so count
+	// those that expire within the window ending one pending authorization
+	// lifetime from now.
+	latest := ra.clk.Now().Add(ra.pendingAuthorizationLifetime)
+	earliest := latest.Add(-ra.autoSuspend.Window)
+	count, err := ra.SA.CountInvalidAuthorizations2(ctx, &sapb.CountInvalidAuthorizationsRequest{
+		RegistrationID: regID,
+		Hostname:       name,
+		Range: &sapb.Range{
+			Earliest: earliest.UnixNano(),
+			Latest:   latest.UnixNano(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if count.Count < ra.autoSuspend.FailureThreshold {
+		return nil
+	}
+
+	_, err = ra.SA.GetAccountSuspension(ctx, &sapb.RegistrationID{Id: regID})
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, berrors.NotFound) {
+		return err
+	}
+
+	expires := ra.clk.Now().Add(ra.autoSuspend.Duration)
+	_, err = ra.SA.SuspendAccount(ctx, &sapb.AccountSuspension{
+		RegistrationID: regID,
+		Reason:         fmt.Sprintf("validation for %q failed %d times in %s", name, count.Count, ra.autoSuspend.Window),
+		SuspendedBy:    "automatic",
+		Expires:        expires.UnixNano(),
+	})
+	if err != nil {
+		return err
+	}
+	ra.log.AuditInfof("Automatically suspended account: regID=[%d] name=[%s] failures=[%d] expires=[%s]",
+		regID, name, count.Count, expires.UTC())
+	return nil
+}
+
+// trackConsecutiveFailures records the result of a validation for the account
+// and name, pausing the account from ordering certificates for the name once
+// it has failed too many validations for it in a row.
+func (ra *RegistrationAuthorityImpl) trackConsecutiveFailures(ctx context.Context, regID int64, name string, status core.AcmeStatus) error {
+	if !features.Enabled(features.PauseIdentifiers) || ra.maxConsecutiveFailures == 0 {
+		return nil
+	}
+	ai := &sapb.AccountIdentifier{RegistrationID: regID, Identifier: name}
+	if status == core.StatusValid {
+		_, err := ra.SA.ResetValidationFailures(ctx, ai)
+		return err
+	}
+
+	count, err := ra.SA.RecordValidationFailure(ctx, ai)
+	if err != nil {
+		return err
+	}
+	if count.Count < ra.maxConsecutiveFailures {
+		return nil
+	}
+	newlyPaused, err := ra.SA.PauseIdentifier(ctx, ai)
+	if err != nil {
+		return err
+	}
+	if newlyPaused.Exists {
+		ra.pausedIdentifiersCounter.WithLabelValues("paused").Inc()
+		ra.log.AuditInfof("Paused identifier: regID=[%d] name=[%s] consecutiveFailures=[%d]",
+			regID, name, count.Count)
+	}
+	return nil
+}
+
+// UnpauseAccount lifts all of an account's paused identifiers. It is called
+// by the WFE once the subscriber has proven control of the account key.
+func (ra *RegistrationAuthorityImpl) UnpauseAccount(ctx context.Context, req *rapb.UnpauseAccountRequest) (*rapb.UnpauseAccountResponse, error) {
+	if req == nil || req.RegistrationID == 0 {
+		return nil, errIncompleteGRPCRequest
+	}
+	count, err := ra.SA.UnpauseAccount(ctx, &sapb.RegistrationID{Id: req.RegistrationID})
+	if err != nil {
+		return nil, err
+	}
+	ra.pausedIdentifiersCounter.WithLabelValues("unpaused").Add(float64(count.Count))
+	ra.log.AuditInfof("Unpaused account: regID=[%d] identifiers=[%d]", req.RegistrationID, count.Count)
+	return &rapb.UnpauseAccountResponse{Count: count.Count}, nil
+}
+
+// SuspendAccount suspends an account until the requested expiry. A suspended
+// account may not create or finalize orders or perform validation, but may
+// still fetch and revoke its certificates.
+func (ra *RegistrationAuthorityImpl) SuspendAccount(ctx context.Context, req *rapb.SuspendAccountRequest) (*emptypb.Empty, error) {
+	if req == nil || core.IsAnyNilOrZero(req.RegistrationID, req.Reason, req.AdminName, req.Expires) {
+		return nil, errIncompleteGRPCRequest
+	}
+	reg, err := ra.SA.GetRegistration(ctx, &sapb.RegistrationID{Id: req.RegistrationID})
+	if err != nil {
+		return nil, err
+	}
+	if reg.Status != string(core.StatusValid) {
+		return nil, berrors.MalformedError("only valid accounts can be suspended, account status is %q", reg.Status)
+	}
+	_, err = ra.SA.SuspendAccount(ctx, &sapb.AccountSuspension{
+		RegistrationID: req.RegistrationID,
+		Reason:         req.Reason,
+		SuspendedBy:    req.AdminName,
+		Expires:        req.Expires,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ra.log.AuditInfof("Suspended account: regID=[%d] reason=[%s] admin=[%s] expires=[%s]",
+		req.RegistrationID, req.Reason, req.AdminName, time.Unix(0, req.Expires).UTC())
+	return &emptypb.Empty{}, nil
+}
+
+// UnsuspendAccount lifts an account's suspension before it expires.
+func (ra *RegistrationAuthorityImpl) UnsuspendAccount(ctx context.Context, req *rapb.UnsuspendAccountRequest) (*emptypb.Empty, error) {
+	if req == nil || core.IsAnyNilOrZero(req.RegistrationID, req.AdminName) {
+		return nil, errIncompleteGRPCRequest
+	}
+	_, err := ra.SA.UnsuspendAccount(ctx, &sapb.RegistrationID{Id: req.RegistrationID})
+	if err != nil {
+		return nil, err
+	}
+	ra.log.AuditInfof("Unsuspended account: regID=[%d] admin=[%s]", req.RegistrationID, req.AdminName)
+	return &emptypb.Empty{}, nil
+}
+
+// ApproveOrder approves issuance for an order held for approval by
+// FinalizeOrder, and issues its certificate.
+func (ra *RegistrationAuthorityImpl) ApproveOrder(ctx context.Context, req *rapb.OrderApprovalRequest) (*corepb.Order, error) {
+	if req == nil || core.IsAnyNilOrZero(req.OrderID, req.AdminName, req.Reason) {
+		return nil, errIncompleteGRPCRequest
+	}
+	approval, err := ra.SA.GetOrderApproval(ctx, &sapb.OrderRequest{Id: req.OrderID})
+	if err != nil {
+		return nil, err
+	}
+	order, err := ra.SA.GetOrder(ctx, &sapb.OrderRequest{Id: req.OrderID})
+	if err != nil {
+		return nil, err
+	}
+	// The order's authorizations may have expired while it awaited approval,
+	// making it invalid.
+	if order.Status != string(core.StatusProcessing) {
+		return nil, berrors.OrderNotReadyError("order's status (%q) is not acceptable for approval", order.Status)
+	}
+	csrOb, err := x509.ParseCertificateRequest(approval.Csr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recording the decision first ensures that an order can't be approved,
+	// and so issued for, more than once.
+	_, err = ra.SA.DecideOrderApproval(ctx, &sapb.DecideOrderApprovalRequest{
+		OrderID:   req.OrderID,
+		Approved:  true,
+		DecidedBy: req.AdminName,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ra.log.AuditInfof("Order approved: orderID=[%d] regID=[%d] names=[%s] admin=[%s] reason=[%s]",
+		req.OrderID, approval.RegistrationID, strings.Join(approval.Names, ","), req.AdminName, req.Reason)
+
+	return ra.issueCertificateForOrder(ctx, order, approval.Csr, csrOb)
+}
+
+// RejectOrder rejects an order held for approval by FinalizeOrder, making it
+// invalid. The reason is shown to the subscriber in the order's error.
+func (ra *RegistrationAuthorityImpl) RejectOrder(ctx context.Context, req *rapb.OrderApprovalRequest) (*emptypb.Empty, error) {
+	if req == nil || core.IsAnyNilOrZero(req.OrderID, req.AdminName, req.Reason) {
+		return nil, errIncompleteGRPCRequest
+	}
+	approval, err := ra.SA.GetOrderApproval(ctx, &sapb.OrderRequest{Id: req.OrderID})
+	if err != nil {
+		return nil, err
+	}
+	_, err = ra.SA.DecideOrderApproval(ctx, &sapb.DecideOrderApprovalRequest{
+		OrderID:   req.OrderID,
+		Approved:  false,
+		DecidedBy: req.AdminName,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ra.log.AuditInfof("Order rejected: orderID=[%d] regID=[%d] names=[%s] admin=[%s] reason=[%s]",
+		req.OrderID, approval.RegistrationID, strings.Join(approval.Names, ","), req.AdminName, req.Reason)
+
+	ra.failOrder(ctx, &corepb.Order{Id: req.OrderID}, probs.RejectedIdentifier(
+		fmt.Sprintf("Issuance for %s was not approved: %s", strings.Join(approval.Names, ", "), req.Reason)))
+	return &emptypb.Empty{}, nil
+}
+
 // NewOrder creates a new order object
 func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.NewOrderRequest) (*corepb.Order, error) {
 	if req == nil || req.RegistrationID == 0 {
 		return nil, errIncompleteGRPCRequest
 	}
 
+	if err := ra.checkAccountSuspended(ctx, req.RegistrationID); err != nil {
+		return nil, err
+	}
+
 	newOrder := &sapb.NewOrderRequest{
 		RegistrationID: req.RegistrationID,
 		Names:          core.UniqueLowerNames(req.Names),
 	}
 
+	if err := ra.checkIdentifiersPaused(ctx, newOrder.RegistrationID, newOrder.Names); err != nil {
+		return nil, err
+	}
+
 	if len(newOrder.Names) > ra.maxNames {
 		return nil, berrors.MalformedError(
 			"Order cannot contain more than %d DNS names", ra.maxNames)
@@ -1951,6 +2892,16 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 		return nil, err
 	}
 
+	// Validate that none of the names have been blocked by an administrator
+	if err := ra.checkNamesBlocked(ctx, newOrder.Names); err != nil {
+		return nil, err
+	}
+
+	// Validate that the account is permitted to issue for each of the names
+	if err := ra.checkAccountIdentifierPolicy(ctx, newOrder.RegistrationID, newOrder.Names); err != nil {
+		return nil, err
+	}
+
 	if err := wildcardOverlap(newOrder.Names); err != nil {
 		return nil, err
 	}
@@ -1978,13 +2929,18 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	}
 
 	// Check if there is rate limit space for a new order within the current window
-	if err := ra.checkNewOrdersPerAccountLimit(ctx, newOrder.RegistrationID); err != nil {
+	err = ra.checkNewOrdersPerAccountLimit(ctx, newOrder.RegistrationID)
+	shadowOrderTxns := ra.newOrderShadowTxns(newOrder.RegistrationID)
+	ra.checkShadowLimits(ctx, "new_orders", shadowOrderTxns, err, false)
+	if err != nil {
 		return nil, err
 	}
 	// Check if there is rate limit space for issuing a certificate for the new
 	// order's names. If there isn't then it doesn't make sense to allow creating
 	// an order - it will just fail when finalization checks the same limits.
-	if err := ra.checkLimits(ctx, newOrder.Names, newOrder.RegistrationID); err != nil {
+	err = ra.checkLimits(ctx, newOrder.Names, newOrder.RegistrationID)
+	ra.checkCertificateShadowLimits(ctx, newOrder.Names, newOrder.RegistrationID, err, false)
+	if err != nil {
 		return nil, err
 	}
 	if features.Enabled(features.CheckFailedAuthorizationsFirst) {
@@ -2013,13 +2969,20 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 		return nil, err
 	}
 
-	// Collect up the authorizations we found into a map keyed by the domains the
-	// authorizations correspond to
+	// Collect up the authorizations we found which the reuse policy allows us
+	// to reuse into a map keyed by the domains the authorizations correspond
+	// to. The SA returns at most one authorization per domain, preferring a
+	// valid one, so if the policy refuses a valid authorization a new pending
+	// one is created even if an older pending one exists. Orders can't select
+	// a profile yet, so they all use the default one.
+	authzReuse := ra.authzReusePolicy(DefaultProfile)
+	now := ra.clk.Now()
 	nameToExistingAuthz := make(map[string]*corepb.Authorization, len(newOrder.Names))
 	for _, v := range existingAuthz.Authz {
-		// Don't reuse a valid authorization if the reuseValidAuthz flag is
-		// disabled.
-		if v.Authz.Status == string(core.StatusValid) && !ra.reuseValidAuthz {
+		reuse, reason := authzReuse.Reusable(v.Authz, v.Domain, newOrder.RegistrationID, now)
+		ra.log.Infof("Authz reuse: regID=[%d] name=[%s] authzID=[%s] status=[%s] reused=[%t] reason=[%s]",
+			newOrder.RegistrationID, v.Domain, v.Authz.Id, v.Authz.Status, reuse, reason)
+		if !reuse {
 			continue
 		}
 		nameToExistingAuthz[v.Domain] = v.Authz
@@ -2030,40 +2993,16 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	// that there is a missing authz for that name.
 	var missingAuthzNames []string
 	for _, name := range newOrder.Names {
-		// If there isn't an existing authz, note that its missing and continue
-		if _, exists := nameToExistingAuthz[name]; !exists {
+		authz, exists := nameToExistingAuthz[name]
+		if !exists {
 			missingAuthzNames = append(missingAuthzNames, name)
 			continue
 		}
-		authz := nameToExistingAuthz[name]
-		// If the identifier is a wildcard and the existing authz only has one
-		// DNS-01 type challenge we can reuse it. In theory we will
-		// never get back an authorization for a domain with a wildcard prefix
-		// that doesn't meet this criteria from SA.GetAuthorizations but we verify
-		// again to be safe.
-		if strings.HasPrefix(name, "*.") &&
-			len(authz.Challenges) == 1 && core.AcmeChallenge(authz.Challenges[0].Type) == core.ChallengeTypeDNS01 {
-			authzID, err := strconv.ParseInt(authz.Id, 10, 64)
-			if err != nil {
-				return nil, err
-			}
-			newOrder.V2Authorizations = append(newOrder.V2Authorizations, authzID)
-			continue
-		} else if !strings.HasPrefix(name, "*.") {
-			// If the identifier isn't a wildcard, we can reuse any authz
-			authzID, err := strconv.ParseInt(authz.Id, 10, 64)
-			if err != nil {
-				return nil, err
-			}
-			newOrder.V2Authorizations = append(newOrder.V2Authorizations, authzID)
-			continue
+		authzID, err := strconv.ParseInt(authz.Id, 10, 64)
+		if err != nil {
+			return nil, err
 		}
-
-		// Delete the authz from the nameToExistingAuthz map since we are not reusing it.
-		delete(nameToExistingAuthz, name)
-		// If we reached this point then the existing authz was not acceptable for
-		// reuse and we need to mark the name as requiring a new pending authz
-		missingAuthzNames = append(missingAuthzNames, name)
+		newOrder.V2Authorizations = append(newOrder.V2Authorizations, authzID)
 	}
 
 	// If the order isn't fully authorized we need to check that the client has
@@ -2114,6 +3053,12 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 		if authzExpiry.Before(minExpiry) {
 			minExpiry = authzExpiry
 		}
+		// Likewise if the reuse policy would stop allowing the authorization
+		// to be reused before then, the order must expire first.
+		reusableUntil := authzReuse.ReusableUntil(authz)
+		if !reusableUntil.IsZero() && reusableUntil.Before(minExpiry) {
+			minExpiry = reusableUntil
+		}
 	}
 	// If the newly created pending authz's have an expiry closer than the
 	// minExpiry the minExpiry is the pending authz expiry.
@@ -2138,6 +3083,7 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	if storedOrder.Id == 0 || storedOrder.Created == 0 || storedOrder.Status == "" || storedOrder.RegistrationID == 0 || storedOrder.Expires == 0 || len(storedOrder.Names) == 0 {
 		return nil, errIncompleteGRPCResponse
 	}
+	ra.spendShadowLimits(ctx, "new_orders", shadowOrderTxns)
 
 	// Note how many names are being requested in this certificate order.
 	ra.namesPerCert.With(prometheus.Labels{"type": "requested"}).Observe(float64(len(storedOrder.Names)))
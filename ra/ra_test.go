@@ -49,6 +49,7 @@ import (
 	pubpb "github.com/letsencrypt/boulder/publisher/proto"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
 	"github.com/letsencrypt/boulder/ratelimit"
+	"github.com/letsencrypt/boulder/ratelimits"
 	"github.com/letsencrypt/boulder/sa"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/test"
@@ -273,6 +274,10 @@ func (r *dummyRateLimitConfig) LoadPolicies(contents []byte) error {
 	return nil // NOP - unrequired behaviour for this mock
 }
 
+func (r *dummyRateLimitConfig) LoadOverrides(overrides []ratelimit.Override) error {
+	return nil // NOP - unrequired behaviour for this mock
+}
+
 func parseAndMarshalIP(t *testing.T, ip string) []byte {
 	ipBytes, err := net.ParseIP(ip).MarshalText()
 	test.AssertNotError(t, err, "failed to marshal ip")
@@ -346,7 +351,7 @@ func initAuthorities(t *testing.T) (*DummyValidationAuthority, sapb.StorageAutho
 		Status:    string(core.StatusValid),
 	})
 
-	ctp := ctpolicy.New(&mocks.PublisherClient{}, nil, nil, log, metrics.NoopRegisterer)
+	ctp := ctpolicy.New(&mocks.PublisherClient{}, nil, nil, nil, nil, log, metrics.NoopRegisterer)
 
 	ra := NewRegistrationAuthorityImpl(fc,
 		log,
@@ -1125,12 +1130,16 @@ func (m mockSAWithNameCounts) CountCertificatesByNames(ctx context.Context, req
 		m.t.Errorf("incorrect earliest: got '%d', expected '%d'", req.Range.Earliest, expectedEarliest)
 	}
 	counts := make(map[string]int64)
+	earliest := make(map[string]int64)
 	for _, name := range req.Names {
 		if count, ok := m.nameCounts.Counts[name]; ok {
 			counts[name] = count
 		}
+		if e, ok := m.nameCounts.Earliest[name]; ok {
+			earliest[name] = e
+		}
 	}
-	return &sapb.CountByNames{Counts: counts}, nil
+	return &sapb.CountByNames{Counts: counts, Earliest: earliest}, nil
 }
 
 func TestCheckCertificatesPerNameLimit(t *testing.T) {
@@ -1169,6 +1178,14 @@ func TestCheckCertificatesPerNameLimit(t *testing.T) {
 	var bErr *berrors.BoulderError
 	test.AssertErrorWraps(t, err, &bErr)
 	test.AssertEquals(t, len(bErr.SubErrors), 0)
+	// Without the earliest issuance, the whole window must be waited out.
+	test.AssertDeepEquals(t, bErr.RateLimitDetails, &berrors.RateLimitDetails{
+		Limit:      "certificatesPerName",
+		Key:        "example.com",
+		Count:      10,
+		Threshold:  3,
+		RetryAfter: 23 * time.Hour,
+	})
 
 	// Three base domains, two above threshold, one below
 	mockSA.nameCounts.Counts["example.com"] = 10
@@ -1182,6 +1199,19 @@ func TestCheckCertificatesPerNameLimit(t *testing.T) {
 	test.AssertErrorWraps(t, err, &bErr)
 	test.AssertEquals(t, len(bErr.SubErrors), 2)
 
+	// With the earliest issuances, each name's retry time is when its earliest
+	// issuance leaves the window, and the top-level error has the latest.
+	mockSA.nameCounts.Earliest = map[string]int64{
+		"example.com":       fc.Now().Add(-22 * time.Hour).UnixNano(),
+		"other-example.com": fc.Now().Add(-20 * time.Hour).UnixNano(),
+	}
+	err = ra.checkCertificatesPerNameLimit(ctx, []string{"example.com", "other-example.com", "good-example.com"}, rlp, 99)
+	test.AssertErrorWraps(t, err, &bErr)
+	test.AssertEquals(t, bErr.RateLimitDetails.Key, "other-example.com")
+	test.AssertEquals(t, bErr.RateLimitDetails.RetryAfter, 3*time.Hour)
+	test.AssertEquals(t, bErr.SubErrors[0].RateLimitDetails.RetryAfter, time.Hour)
+	mockSA.nameCounts.Earliest = nil
+
 	// SA misbehaved and didn't send back a count for every input name
 	err = ra.checkCertificatesPerNameLimit(ctx, []string{"zombo.com", "www.example.com", "example.com"}, rlp, 99)
 	test.AssertError(t, err, "incorrectly failed to error on misbehaving SA")
@@ -1258,7 +1288,7 @@ func TestCheckExactCertificateLimit(t *testing.T) {
 	// as we expect
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			result := ra.checkCertificatesPerFQDNSetLimit(ctx, []string{tc.Domain}, rlp, 0)
+			result := ra.checkCertificatesPerFQDNSetLimit(ctx, "certificatesPerFQDNSet", []string{tc.Domain}, rlp, 0)
 			if tc.ExpectedErr == nil {
 				test.AssertNotError(t, result, fmt.Sprintf("Expected no error for %q", tc.Domain))
 			} else {
@@ -2822,6 +2852,102 @@ func TestFinalizeOrderWithMixedSANAndCN(t *testing.T) {
 	test.AssertEquals(t, updatedOrder.Status, "valid")
 }
 
+func TestFinalizeOrderApproval(t *testing.T) {
+	_, sa, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+	ctx := context.Background()
+
+	exp := ra.clk.Now().Add(365 * 24 * time.Hour)
+	testKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "error generating test key")
+
+	// www.approval-required.letsencrypt.org is covered by the
+	// ApprovalRequiredNames in the test hostname policy.
+	names := []string{"not-example.com", "www.approval-required.letsencrypt.org"}
+	newHeldOrder := func() *corepb.Order {
+		var authzIDs []int64
+		for _, name := range names {
+			authzIDs = append(authzIDs, createFinalizedAuthorization(t, sa, name, exp, "valid", ra.clk.Now()))
+		}
+		order, err := sa.NewOrder(ctx, &sapb.NewOrderRequest{
+			RegistrationID:   Registration.Id,
+			Expires:          exp.UnixNano(),
+			Names:            names,
+			V2Authorizations: authzIDs,
+		})
+		test.AssertNotError(t, err, "Could not add test order")
+		csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+			PublicKey:          testKey.PublicKey,
+			SignatureAlgorithm: x509.SHA256WithRSA,
+			DNSNames:           names,
+		}, testKey)
+		test.AssertNotError(t, err, "Could not create CSR")
+
+		finalized, err := ra.FinalizeOrder(ctx, &rapb.FinalizeOrderRequest{Order: order, Csr: csr})
+		test.AssertNotError(t, err, "FinalizeOrder failed")
+		test.AssertEquals(t, finalized.Status, string(core.StatusProcessing))
+
+		held, err := sa.GetOrder(ctx, &sapb.OrderRequest{Id: order.Id})
+		test.AssertNotError(t, err, "Error getting held order")
+		test.AssertEquals(t, held.Status, string(core.StatusProcessing))
+		test.AssertEquals(t, held.CertificateSerial, "")
+
+		approval, err := sa.GetOrderApproval(ctx, &sapb.OrderRequest{Id: order.Id})
+		test.AssertNotError(t, err, "Order wasn't held for approval")
+		test.AssertDeepEquals(t, approval.Names, []string{"www.approval-required.letsencrypt.org"})
+		test.AssertEquals(t, approval.Status, "pending")
+		return held
+	}
+
+	// Approval requires an admin and a reason.
+	order := newHeldOrder()
+	_, err = ra.ApproveOrder(ctx, &rapb.OrderApprovalRequest{OrderID: order.Id, AdminName: "admin"})
+	test.AssertError(t, err, "ApproveOrder succeeded without a reason")
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(13),
+		DNSNames:              names,
+		NotBefore:             time.Now(),
+		BasicConstraintsValid: true,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, testKey.Public(), testKey)
+	test.AssertNotError(t, err, "Failed to create cert")
+	ra.CA = &mocks.MockCA{PEM: pem.EncodeToMemory(&pem.Block{Bytes: cert})}
+
+	approved, err := ra.ApproveOrder(ctx, &rapb.OrderApprovalRequest{OrderID: order.Id, AdminName: "admin", Reason: "known subscriber"})
+	test.AssertNotError(t, err, "ApproveOrder failed")
+	test.AssertEquals(t, approved.Status, string(core.StatusValid))
+	updatedOrder, err := sa.GetOrder(ctx, &sapb.OrderRequest{Id: order.Id})
+	test.AssertNotError(t, err, "Error getting approved order")
+	test.AssertEquals(t, updatedOrder.Status, string(core.StatusValid))
+	test.AssertNotEquals(t, updatedOrder.CertificateSerial, "")
+	approval, err := sa.GetOrderApproval(ctx, &sapb.OrderRequest{Id: order.Id})
+	test.AssertNotError(t, err, "GetOrderApproval failed")
+	test.AssertEquals(t, approval.Status, "approved")
+	test.AssertEquals(t, approval.DecidedBy, "admin")
+
+	// An order can only be decided once.
+	_, err = ra.ApproveOrder(ctx, &rapb.OrderApprovalRequest{OrderID: order.Id, AdminName: "admin", Reason: "again"})
+	test.AssertError(t, err, "ApproveOrder succeeded for an order which isn't held")
+	_, err = ra.RejectOrder(ctx, &rapb.OrderApprovalRequest{OrderID: order.Id, AdminName: "admin", Reason: "again"})
+	test.AssertError(t, err, "RejectOrder succeeded for an approved order")
+
+	// A rejected order becomes invalid, with the reason in its error.
+	order = newHeldOrder()
+	_, err = ra.RejectOrder(ctx, &rapb.OrderApprovalRequest{OrderID: order.Id, AdminName: "admin", Reason: "suspected phishing"})
+	test.AssertNotError(t, err, "RejectOrder failed")
+	updatedOrder, err = sa.GetOrder(ctx, &sapb.OrderRequest{Id: order.Id})
+	test.AssertNotError(t, err, "Error getting rejected order")
+	test.AssertEquals(t, updatedOrder.Status, string(core.StatusInvalid))
+	test.AssertContains(t, updatedOrder.Error.Detail, "suspected phishing")
+	approval, err = sa.GetOrderApproval(ctx, &sapb.OrderRequest{Id: order.Id})
+	test.AssertNotError(t, err, "GetOrderApproval failed")
+	test.AssertEquals(t, approval.Status, "rejected")
+	_, err = ra.ApproveOrder(ctx, &rapb.OrderApprovalRequest{OrderID: order.Id, AdminName: "admin", Reason: "oops"})
+	test.AssertError(t, err, "ApproveOrder succeeded for a rejected order")
+}
+
 func TestFinalizeOrderWildcard(t *testing.T) {
 	_, sa, ra, _, cleanUp := initAuthorities(t)
 	defer cleanUp()
@@ -3160,7 +3286,7 @@ func TestCTPolicyMeasurements(t *testing.T) {
 	_, ssa, ra, _, cleanup := initAuthorities(t)
 	defer cleanup()
 
-	ctp := ctpolicy.New(&timeoutPub{}, []ctconfig.CTGroup{{}}, nil, log, metrics.NoopRegisterer)
+	ctp := ctpolicy.New(&timeoutPub{}, []ctconfig.CTGroup{{}}, nil, nil, nil, log, metrics.NoopRegisterer)
 	ra.ctpolicy = ctp
 
 	// Create valid authorizations for not-example.com and www.not-example.com
@@ -3688,3 +3814,609 @@ func TestAdministrativelyRevokeCertificate(t *testing.T) {
 	})
 	test.AssertError(t, err, "AdministrativelyRevokeCertificate should have failed with just serial for keyCompromise")
 }
+
+func TestShadowLimits(t *testing.T) {
+	fc := clock.NewFake()
+	log := blog.NewMock()
+	ra := NewRegistrationAuthorityImpl(fc, log, metrics.NoopRegisterer,
+		1, testKeyPolicy, 100, true, 300*24*time.Hour, 7*24*time.Hour, nil, noopCAA{}, 0, nil, nil, nil)
+	ra.SA = mocks.NewStorageAuthority(fc)
+	err := ra.rlPolicies.LoadPolicies([]byte(`
+registrationsPerIP:
+  window: 1h
+  threshold: 2
+certificatesPerName:
+  window: 1h
+  threshold: 1
+  overrides:
+    blocked.com: 0
+`))
+	test.AssertNotError(t, err, "loading rate limit policies")
+	ctx := context.Background()
+	ip := net.ParseIP("10.0.0.1")
+
+	// Without a limiter, nothing happens.
+	test.AssertEquals(t, len(ra.registrationShadowTxns(ip)), 0)
+	test.Assert(t, !ra.checkShadowLimits(ctx, "registrations", nil, nil, true), "spent without a limiter")
+
+	ra.SetShadowLimiter(ratelimits.NewLimiter(fc, ratelimits.NewInmemSource(), metrics.NoopRegisterer))
+	txns := ra.registrationShadowTxns(ip)
+	test.AssertEquals(t, len(txns), 1)
+
+	// The legacy limit allows the first two registrations, and so does the
+	// shadow limiter once they have been spent.
+	for i := 0; i < 2; i++ {
+		ra.checkShadowLimits(ctx, "registrations", txns, nil, false)
+		ra.spendShadowLimits(ctx, "registrations", txns)
+	}
+	test.AssertMetricWithLabelsEquals(t, ra.shadowRateLimitCounter, prometheus.Labels{"limit": "registrations", "result": "agree"}, 2)
+
+	// The third is denied by both.
+	ra.checkShadowLimits(ctx, "registrations", txns, berrors.RateLimitError("too many registrations for this IP"), false)
+	test.AssertMetricWithLabelsEquals(t, ra.shadowRateLimitCounter, prometheus.Labels{"limit": "registrations", "result": "agree"}, 3)
+
+	// If the legacy limit allows it anyway, that's a disagreement.
+	ra.checkShadowLimits(ctx, "registrations", txns, nil, false)
+	test.AssertMetricWithLabelsEquals(t, ra.shadowRateLimitCounter, prometheus.Labels{"limit": "registrations", "result": "disagree"}, 1)
+	test.AssertEquals(t, len(log.GetAllMatching("Shadow rate limit disagreement: limit=\\[registrations\\] legacyAllowed=\\[true\\] shadowAllowed=\\[false\\]")), 1)
+
+	// Errors which aren't rate limit errors aren't compared.
+	ra.checkShadowLimits(ctx, "registrations", txns, berrors.InternalServerError("oops"), false)
+	test.AssertMetricWithLabelsEquals(t, ra.shadowRateLimitCounter, prometheus.Labels{"limit": "registrations", "result": "disagree"}, 1)
+
+	// Certificates are checked per registered domain and for the set of names,
+	// skipping domains whose threshold is zero.
+	names := []string{"www.example.com", "example.com", "blocked.com"}
+	certTxns, spent := ra.checkCertificateShadowLimits(ctx, names, 1, nil, true)
+	test.Assert(t, spent, "didn't spend from a full bucket")
+	test.AssertEquals(t, len(certTxns), 1)
+	test.AssertEquals(t, certTxns[0].BucketKey(), "4:example.com")
+	_, spent = ra.checkCertificateShadowLimits(ctx, names, 1, nil, true)
+	test.Assert(t, !spent, "spent from an empty bucket")
+
+	// Spending at finalization can be refunded if issuance fails.
+	ra.refundShadowLimits(ctx, "certificates", certTxns)
+	_, spent = ra.checkCertificateShadowLimits(ctx, names, 1, nil, true)
+	test.Assert(t, spent, "didn't spend from a refunded bucket")
+}
+
+type mockSAWithOverrides struct {
+	mocks.StorageAuthority
+	overrides []*sapb.RateLimitOverride
+}
+
+func (sa *mockSAWithOverrides) GetRateLimitOverrides(_ context.Context, _ *sapb.GetRateLimitOverridesRequest, _ ...grpc.CallOption) (*sapb.RateLimitOverrides, error) {
+	return &sapb.RateLimitOverrides{Overrides: sa.overrides}, nil
+}
+
+func TestRefreshRateLimitOverrides(t *testing.T) {
+	fc := clock.NewFake()
+	log := blog.NewMock()
+	ra := NewRegistrationAuthorityImpl(fc, log, metrics.NoopRegisterer,
+		1, testKeyPolicy, 100, true, 300*24*time.Hour, 7*24*time.Hour, nil, noopCAA{}, 0, nil, nil, nil)
+	err := ra.rlPolicies.LoadPolicies([]byte(`
+newOrdersPerAccount:
+  window: 3h
+  threshold: 10
+`))
+	test.AssertNotError(t, err, "loading rate limit policies")
+	mockSA := &mockSAWithOverrides{
+		overrides: []*sapb.RateLimitOverride{{
+			Id:             7,
+			Limit:          "newOrdersPerAccount",
+			RegistrationID: 1,
+			Threshold:      100,
+			Owner:          "admin",
+			Reason:         "large hosting provider",
+			Ticket:         "T-1",
+			Expires:        fc.Now().Add(time.Hour).UnixNano(),
+		}},
+	}
+	ra.SA = mockSA
+
+	err = ra.RefreshRateLimitOverrides(context.Background())
+	test.AssertNotError(t, err, "refreshing rate limit overrides")
+	test.AssertEquals(t, len(log.GetAllMatching(`Rate limit override loaded: id=\[7\] limit=\[newOrdersPerAccount\]`)), 1)
+
+	// Using the override is audit logged, using the policy file's threshold
+	// isn't.
+	test.AssertEquals(t, ra.getThreshold(ra.rlPolicies.NewOrdersPerAccount(), "", 1), int64(100))
+	test.AssertEquals(t, len(log.GetAllMatching(`Rate limit override applied: id=\[7\] limit=\[newOrdersPerAccount\] key=\[\] regID=\[1\] threshold=\[100\] ticket=\[T-1\]`)), 1)
+	test.AssertEquals(t, ra.getThreshold(ra.rlPolicies.NewOrdersPerAccount(), "", 2), int64(10))
+	test.AssertEquals(t, len(log.GetAllMatching("Rate limit override applied")), 1)
+
+	// Refreshing again with no changes logs nothing new.
+	err = ra.RefreshRateLimitOverrides(context.Background())
+	test.AssertNotError(t, err, "refreshing rate limit overrides")
+	test.AssertEquals(t, len(log.GetAllMatching("Rate limit override loaded")), 1)
+
+	// Once the override is gone from the SA, it no longer applies.
+	mockSA.overrides = nil
+	err = ra.RefreshRateLimitOverrides(context.Background())
+	test.AssertNotError(t, err, "refreshing rate limit overrides")
+	test.AssertEquals(t, len(log.GetAllMatching(`Rate limit override removed: id=\[7\]`)), 1)
+	test.AssertEquals(t, ra.getThreshold(ra.rlPolicies.NewOrdersPerAccount(), "", 1), int64(10))
+}
+
+type mockSAWithRateLimitCounts struct {
+	mocks.StorageAuthority
+	clk clock.Clock
+}
+
+func (m *mockSAWithRateLimitCounts) CountPendingAuthorizations2(_ context.Context, _ *sapb.RegistrationID, _ ...grpc.CallOption) (*sapb.Count, error) {
+	return &sapb.Count{Count: 3}, nil
+}
+
+func (m *mockSAWithRateLimitCounts) CountOrders(_ context.Context, _ *sapb.CountOrdersRequest, _ ...grpc.CallOption) (*sapb.Count, error) {
+	return &sapb.Count{Count: 5}, nil
+}
+
+func (m *mockSAWithRateLimitCounts) CountCertificatesByNames(_ context.Context, req *sapb.CountCertificatesByNamesRequest, _ ...grpc.CallOption) (*sapb.CountByNames, error) {
+	counts := &sapb.CountByNames{Counts: map[string]int64{}, Earliest: map[string]int64{}}
+	for _, name := range req.Names {
+		counts.Counts[name] = 0
+		if name == "example.com" {
+			counts.Counts[name] = 2
+			counts.Earliest[name] = m.clk.Now().Add(-time.Hour).UnixNano()
+		}
+	}
+	return counts, nil
+}
+
+func (m *mockSAWithRateLimitCounts) CountInvalidAuthorizations2(_ context.Context, req *sapb.CountInvalidAuthorizationsRequest, _ ...grpc.CallOption) (*sapb.Count, error) {
+	switch req.Hostname {
+	case "www.example.com":
+		return &sapb.Count{Count: 1}, nil
+	case "*.example.com":
+		return &sapb.Count{Count: 4}, nil
+	}
+	return &sapb.Count{}, nil
+}
+
+func TestGetRateLimitStatus(t *testing.T) {
+	fc := clock.NewFake()
+	ra := NewRegistrationAuthorityImpl(fc, blog.NewMock(), metrics.NoopRegisterer,
+		1, testKeyPolicy, 100, true, 300*24*time.Hour, 7*24*time.Hour, nil, noopCAA{}, 0, nil, nil, nil)
+	ra.SA = &mockSAWithRateLimitCounts{clk: fc}
+	pa, err := policy.New(map[core.AcmeChallenge]bool{})
+	test.AssertNotError(t, err, "Couldn't create PA")
+	err = pa.SetHostnamePolicyFile("../test/hostname-policy.yaml")
+	test.AssertNotError(t, err, "Couldn't set hostname policy")
+	ra.PA = pa
+	err = ra.rlPolicies.LoadPolicies([]byte(`
+pendingAuthorizationsPerAccount:
+  window: 168h
+  threshold: 100
+newOrdersPerAccount:
+  window: 3h
+  threshold: 300
+  registrationOverrides:
+    1: 1000
+certificatesPerName:
+  window: 168h
+  threshold: 50
+  overrides:
+    zombo.com: 100
+invalidAuthorizationsPerAccount:
+  window: 1h
+  threshold: 5
+certificatesPerFQDNSet:
+  window: 168h
+  threshold: 5
+`))
+	test.AssertNotError(t, err, "loading rate limit policies")
+	now := fc.Now()
+
+	_, err = ra.GetRateLimitStatus(ctx, &rapb.GetRateLimitStatusRequest{})
+	test.AssertEquals(t, err, errIncompleteGRPCRequest)
+
+	// Without names, only the per-account limits are reported.
+	status, err := ra.GetRateLimitStatus(ctx, &rapb.GetRateLimitStatusRequest{RegistrationID: 1})
+	test.AssertNotError(t, err, "GetRateLimitStatus failed")
+	test.AssertDeepEquals(t, status.Limits, []*rapb.RateLimitUsage{
+		{Limit: "pendingAuthorizationsPerAccount", Count: 3, Threshold: 100, Window: (168 * time.Hour).Nanoseconds()},
+		{Limit: "newOrdersPerAccount", Count: 5, Threshold: 1000, Window: (3 * time.Hour).Nanoseconds(), ResetTime: now.Add(3 * time.Hour).UnixNano()},
+	})
+
+	status, err = ra.GetRateLimitStatus(ctx, &rapb.GetRateLimitStatusRequest{
+		RegistrationID: 2,
+		Names:          []string{"www.example.com", "ZOMBO.com"},
+	})
+	test.AssertNotError(t, err, "GetRateLimitStatus failed")
+	test.AssertDeepEquals(t, status.Limits, []*rapb.RateLimitUsage{
+		{Limit: "pendingAuthorizationsPerAccount", Count: 3, Threshold: 100, Window: (168 * time.Hour).Nanoseconds()},
+		{Limit: "newOrdersPerAccount", Count: 5, Threshold: 300, Window: (3 * time.Hour).Nanoseconds(), ResetTime: now.Add(3 * time.Hour).UnixNano()},
+		{Limit: "certificatesPerName", Key: "example.com", Count: 2, Threshold: 50, Window: (168 * time.Hour).Nanoseconds(), ResetTime: now.Add(167 * time.Hour).UnixNano()},
+		{Limit: "certificatesPerName", Key: "zombo.com", Count: 0, Threshold: 100, Window: (168 * time.Hour).Nanoseconds()},
+		{Limit: "invalidAuthorizationsPerAccount", Key: "www.example.com", Count: 1, Threshold: 5, Window: time.Hour.Nanoseconds(), ResetTime: now.Add(time.Hour).UnixNano()},
+		{Limit: "invalidAuthorizationsPerAccount", Key: "zombo.com", Count: 0, Threshold: 5, Window: time.Hour.Nanoseconds()},
+		{Limit: "certificatesPerFQDNSet", Key: "www.example.com,zombo.com", Count: 0, Threshold: 5, Window: (168 * time.Hour).Nanoseconds()},
+	})
+
+	// Authorizations for wildcard names are stored, and counted by
+	// checkInvalidAuthorizationLimit, under the wildcard name.
+	status, err = ra.GetRateLimitStatus(ctx, &rapb.GetRateLimitStatusRequest{
+		RegistrationID: 2,
+		Names:          []string{"*.example.com"},
+	})
+	test.AssertNotError(t, err, "GetRateLimitStatus failed")
+	test.AssertDeepEquals(t, status.Limits[3], &rapb.RateLimitUsage{
+		Limit: "invalidAuthorizationsPerAccount", Key: "*.example.com", Count: 4, Threshold: 5, Window: time.Hour.Nanoseconds(), ResetTime: now.Add(time.Hour).UnixNano(),
+	})
+
+	// Names the CA won't issue for are rejected.
+	_, err = ra.GetRateLimitStatus(ctx, &rapb.GetRateLimitStatusRequest{RegistrationID: 1, Names: []string{"not a name"}})
+	test.AssertError(t, err, "GetRateLimitStatus accepted an invalid name")
+}
+
+// mockSAWithAccountPolicy is a mock SA which has an identifier policy for a
+// single registration.
+type mockSAWithAccountPolicy struct {
+	mocks.StorageAuthority
+	policy *sapb.AccountIdentifierPolicy
+}
+
+func (m *mockSAWithAccountPolicy) GetAccountIdentifierPolicy(_ context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*sapb.AccountIdentifierPolicy, error) {
+	if req.Id != m.policy.RegistrationID {
+		return nil, berrors.NotFoundError("no identifier policy for registration ID %d", req.Id)
+	}
+	return m.policy, nil
+}
+
+func TestAccountIdentifierPolicy(t *testing.T) {
+	_ = features.Set(map[string]bool{"AccountIdentifierPolicies": true})
+	defer features.Reset()
+
+	fc := clock.NewFake()
+	ra := NewRegistrationAuthorityImpl(fc, blog.NewMock(), metrics.NoopRegisterer,
+		1, testKeyPolicy, 100, true, 300*24*time.Hour, 7*24*time.Hour, nil, noopCAA{}, 0, nil, nil, nil)
+	ra.SA = &mockSAWithAccountPolicy{
+		policy: &sapb.AccountIdentifierPolicy{
+			RegistrationID: 1,
+			Suffixes:       []string{"example.com"},
+		},
+	}
+	pa, err := policy.New(map[core.AcmeChallenge]bool{})
+	test.AssertNotError(t, err, "Couldn't create PA")
+	err = pa.SetHostnamePolicyFile("../test/hostname-policy.yaml")
+	test.AssertNotError(t, err, "Couldn't set hostname policy")
+	ra.PA = pa
+
+	// Accounts without a policy are unrestricted.
+	err = ra.checkAccountIdentifierPolicy(ctx, 2, []string{"zombo.com"})
+	test.AssertNotError(t, err, "unrestricted account was restricted")
+
+	err = ra.checkAccountIdentifierPolicy(ctx, 1, []string{"www.example.com"})
+	test.AssertNotError(t, err, "in scope name was rejected")
+
+	_, err = ra.NewOrder(ctx, &rapb.NewOrderRequest{
+		RegistrationID: 1,
+		Names:          []string{"www.example.com", "zombo.com"},
+	})
+	test.AssertError(t, err, "NewOrder allowed out of scope name")
+	test.AssertErrorIs(t, err, berrors.RestrictedIdentifier)
+	test.AssertEquals(t, err.Error(), "Cannot issue for \"zombo.com\": This account is not permitted to issue for this identifier")
+
+	// An order created before the policy was put in place is checked again at
+	// finalization.
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		DNSNames: []string{"zombo.com"},
+	}, key)
+	test.AssertNotError(t, err, "creating CSR")
+	_, err = ra.FinalizeOrder(ctx, &rapb.FinalizeOrderRequest{
+		Order: &corepb.Order{
+			Id:             1,
+			RegistrationID: 1,
+			Status:         string(core.StatusReady),
+			Names:          []string{"zombo.com"},
+		},
+		Csr: csrDER,
+	})
+	test.AssertError(t, err, "FinalizeOrder allowed out of scope name")
+	test.AssertErrorIs(t, err, berrors.RestrictedIdentifier)
+
+	// With the feature disabled policies aren't enforced.
+	features.Reset()
+	err = ra.checkAccountIdentifierPolicy(ctx, 1, []string{"zombo.com"})
+	test.AssertNotError(t, err, "policy enforced with feature disabled")
+}
+
+// mockSAWithSuspensions is a mock SA which stores account suspensions in
+// memory and reports a fixed number of invalid authorizations.
+type mockSAWithSuspensions struct {
+	mocks.StorageAuthority
+	clk          clock.Clock
+	invalidCount int64
+	suspensions  map[int64]*sapb.AccountSuspension
+}
+
+func (m *mockSAWithSuspensions) GetAccountSuspension(_ context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*sapb.AccountSuspension, error) {
+	s, ok := m.suspensions[req.Id]
+	if !ok || s.Expires <= m.clk.Now().UnixNano() {
+		return nil, berrors.NotFoundError("registration ID %d is not suspended", req.Id)
+	}
+	return s, nil
+}
+
+func (m *mockSAWithSuspensions) SuspendAccount(_ context.Context, req *sapb.AccountSuspension, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.suspensions[req.RegistrationID] = req
+	return &emptypb.Empty{}, nil
+}
+
+func (m *mockSAWithSuspensions) UnsuspendAccount(_ context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if _, ok := m.suspensions[req.Id]; !ok {
+		return nil, berrors.NotFoundError("registration ID %d is not suspended", req.Id)
+	}
+	delete(m.suspensions, req.Id)
+	return &emptypb.Empty{}, nil
+}
+
+func (m *mockSAWithSuspensions) CountInvalidAuthorizations2(_ context.Context, _ *sapb.CountInvalidAuthorizationsRequest, _ ...grpc.CallOption) (*sapb.Count, error) {
+	return &sapb.Count{Count: m.invalidCount}, nil
+}
+
+func TestAccountSuspension(t *testing.T) {
+	_ = features.Set(map[string]bool{"AccountSuspensions": true})
+	defer features.Reset()
+
+	fc := clock.NewFake()
+	ra := NewRegistrationAuthorityImpl(fc, blog.NewMock(), metrics.NoopRegisterer,
+		1, testKeyPolicy, 100, true, 300*24*time.Hour, 7*24*time.Hour, nil, noopCAA{}, 0, nil, nil, nil)
+	msa := &mockSAWithSuspensions{clk: fc, suspensions: make(map[int64]*sapb.AccountSuspension)}
+	ra.SA = msa
+	pa, err := policy.New(map[core.AcmeChallenge]bool{core.ChallengeTypeHTTP01: true})
+	test.AssertNotError(t, err, "Couldn't create PA")
+	ra.PA = pa
+
+	_, err = ra.SuspendAccount(ctx, &rapb.SuspendAccountRequest{RegistrationID: 1, AdminName: "admin"})
+	test.AssertEquals(t, err, errIncompleteGRPCRequest)
+
+	expires := fc.Now().Add(24 * time.Hour)
+	_, err = ra.SuspendAccount(ctx, &rapb.SuspendAccountRequest{
+		RegistrationID: 1,
+		Reason:         "abuse",
+		AdminName:      "admin",
+		Expires:        expires.UnixNano(),
+	})
+	test.AssertNotError(t, err, "SuspendAccount failed")
+
+	// A suspended account can't create or finalize orders or validate.
+	_, err = ra.NewOrder(ctx, &rapb.NewOrderRequest{RegistrationID: 1, Names: []string{"example.com"}})
+	test.AssertErrorIs(t, err, berrors.Unauthorized)
+	test.AssertEquals(t, err.Error(), "Account is suspended until "+expires.UTC().Format(time.RFC3339)+": abuse")
+	_, err = ra.FinalizeOrder(ctx, &rapb.FinalizeOrderRequest{
+		Order: &corepb.Order{
+			Id:             1,
+			RegistrationID: 1,
+			Status:         string(core.StatusReady),
+			Names:          []string{"example.com"},
+		},
+	})
+	test.AssertErrorIs(t, err, berrors.Unauthorized)
+	_, err = ra.PerformValidation(ctx, &rapb.PerformValidationRequest{
+		Authz: &corepb.Authorization{
+			Id:             "1",
+			Identifier:     "example.com",
+			RegistrationID: 1,
+			Status:         string(core.StatusPending),
+			Expires:        fc.Now().Add(time.Hour).UnixNano(),
+			Challenges:     []*corepb.Challenge{{Type: string(core.ChallengeTypeHTTP01), Status: string(core.StatusPending), Token: core.NewToken()}},
+		},
+	})
+	test.AssertErrorIs(t, err, berrors.Unauthorized)
+
+	_, err = ra.UnsuspendAccount(ctx, &rapb.UnsuspendAccountRequest{RegistrationID: 1, AdminName: "admin"})
+	test.AssertNotError(t, err, "UnsuspendAccount failed")
+	err = ra.checkAccountSuspended(ctx, 1)
+	test.AssertNotError(t, err, "account still suspended after UnsuspendAccount")
+
+	// Automatic suspension is disabled until it's configured.
+	msa.invalidCount = 10
+	err = ra.maybeAutoSuspend(ctx, 1, "example.com")
+	test.AssertNotError(t, err, "maybeAutoSuspend failed")
+	test.AssertNotError(t, ra.checkAccountSuspended(ctx, 1), "account suspended without auto-suspend configured")
+
+	ra.SetAutoSuspend(AutoSuspendConfig{
+		FailureThreshold: 11,
+		Window:           30 * 24 * time.Hour,
+		Duration:         7 * 24 * time.Hour,
+	})
+	err = ra.maybeAutoSuspend(ctx, 1, "example.com")
+	test.AssertNotError(t, err, "maybeAutoSuspend failed")
+	test.AssertNotError(t, ra.checkAccountSuspended(ctx, 1), "account suspended below the failure threshold")
+
+	msa.invalidCount = 11
+	err = ra.maybeAutoSuspend(ctx, 1, "example.com")
+	test.AssertNotError(t, err, "maybeAutoSuspend failed")
+	err = ra.checkAccountSuspended(ctx, 1)
+	test.AssertErrorIs(t, err, berrors.Unauthorized)
+	test.AssertEquals(t, msa.suspensions[1].SuspendedBy, "automatic")
+	test.AssertEquals(t, msa.suspensions[1].Expires, fc.Now().Add(7*24*time.Hour).UnixNano())
+
+	// The automatic suspension expires on its own.
+	fc.Add(7*24*time.Hour + time.Second)
+	test.AssertNotError(t, ra.checkAccountSuspended(ctx, 1), "automatic suspension didn't expire")
+}
+
+// mockSAWithBlockedNames is a mock SA which reports the names in blocked as
+// blocked.
+type mockSAWithBlockedNames struct {
+	mocks.StorageAuthority
+	blocked map[string]bool
+}
+
+func (m *mockSAWithBlockedNames) CheckNamesBlocked(_ context.Context, req *sapb.Identifiers, _ ...grpc.CallOption) (*sapb.Identifiers, error) {
+	var blocked []string
+	for _, name := range req.Identifiers {
+		if m.blocked[name] {
+			blocked = append(blocked, name)
+		}
+	}
+	return &sapb.Identifiers{Identifiers: blocked}, nil
+}
+
+func TestCheckNamesBlocked(t *testing.T) {
+	ra := NewRegistrationAuthorityImpl(clock.NewFake(), blog.NewMock(), metrics.NoopRegisterer,
+		1, testKeyPolicy, 100, true, 300*24*time.Hour, 7*24*time.Hour, nil, noopCAA{}, 0, nil, nil, nil)
+	ra.SA = &mockSAWithBlockedNames{blocked: map[string]bool{"blocked.com": true, "www.blocked.com": true}}
+	pa, err := policy.New(map[core.AcmeChallenge]bool{core.ChallengeTypeHTTP01: true})
+	test.AssertNotError(t, err, "Couldn't create PA")
+	err = pa.SetHostnamePolicyFile("../test/hostname-policy.yaml")
+	test.AssertNotError(t, err, "Couldn't set hostname policy")
+	ra.PA = pa
+
+	// With the feature disabled blocked names aren't checked.
+	err = ra.checkNamesBlocked(ctx, []string{"blocked.com"})
+	test.AssertNotError(t, err, "blocked name refused with feature disabled")
+
+	_ = features.Set(map[string]bool{"BlockedNames": true})
+	defer features.Reset()
+
+	err = ra.checkNamesBlocked(ctx, []string{"example.com"})
+	test.AssertNotError(t, err, "unblocked name refused")
+
+	err = ra.checkNamesBlocked(ctx, []string{"example.com", "blocked.com"})
+	test.AssertErrorIs(t, err, berrors.RejectedIdentifier)
+	test.AssertContains(t, err.Error(), `Cannot issue for "blocked.com"`)
+
+	err = ra.checkNamesBlocked(ctx, []string{"blocked.com", "www.blocked.com"})
+	test.AssertErrorIs(t, err, berrors.RejectedIdentifier)
+	test.AssertEquals(t, len(err.(*berrors.BoulderError).SubErrors), 2)
+
+	_, err = ra.NewOrder(ctx, &rapb.NewOrderRequest{RegistrationID: Registration.Id, Names: []string{"www.blocked.com"}})
+	test.AssertErrorIs(t, err, berrors.RejectedIdentifier)
+}
+
+// mockSAWithValidationFailures is a mock SA which tracks consecutive
+// validation failures and paused identifiers in memory.
+type mockSAWithValidationFailures struct {
+	mocks.StorageAuthority
+	failures map[string]int64
+	paused   map[string]bool
+}
+
+func (m *mockSAWithValidationFailures) RecordValidationFailure(_ context.Context, req *sapb.AccountIdentifier, _ ...grpc.CallOption) (*sapb.Count, error) {
+	m.failures[req.Identifier]++
+	return &sapb.Count{Count: m.failures[req.Identifier]}, nil
+}
+
+func (m *mockSAWithValidationFailures) ResetValidationFailures(_ context.Context, req *sapb.AccountIdentifier, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	delete(m.failures, req.Identifier)
+	delete(m.paused, req.Identifier)
+	return &emptypb.Empty{}, nil
+}
+
+func (m *mockSAWithValidationFailures) PauseIdentifier(_ context.Context, req *sapb.AccountIdentifier, _ ...grpc.CallOption) (*sapb.Exists, error) {
+	if m.paused[req.Identifier] {
+		return &sapb.Exists{Exists: false}, nil
+	}
+	m.paused[req.Identifier] = true
+	return &sapb.Exists{Exists: true}, nil
+}
+
+func (m *mockSAWithValidationFailures) UnpauseAccount(_ context.Context, _ *sapb.RegistrationID, _ ...grpc.CallOption) (*sapb.Count, error) {
+	count := int64(len(m.paused))
+	for ident := range m.paused {
+		delete(m.failures, ident)
+	}
+	m.paused = make(map[string]bool)
+	return &sapb.Count{Count: count}, nil
+}
+
+func (m *mockSAWithValidationFailures) CheckIdentifiersPaused(_ context.Context, req *sapb.CheckIdentifiersPausedRequest, _ ...grpc.CallOption) (*sapb.Identifiers, error) {
+	var paused []string
+	for _, ident := range req.Identifiers {
+		if m.paused[ident] {
+			paused = append(paused, ident)
+		}
+	}
+	return &sapb.Identifiers{Identifiers: paused}, nil
+}
+
+func TestNewOrderPausedIdentifiers(t *testing.T) {
+	ra := NewRegistrationAuthorityImpl(clock.NewFake(), blog.NewMock(), metrics.NoopRegisterer,
+		1, testKeyPolicy, 100, true, 300*24*time.Hour, 7*24*time.Hour, nil, noopCAA{}, 0, nil, nil, nil)
+	ra.SetMaxConsecutiveFailures(3)
+	ra.SA = &mockSAWithValidationFailures{
+		failures: make(map[string]int64),
+		paused:   map[string]bool{"example.com": true},
+	}
+
+	// Paused identifiers aren't checked unless the feature is enabled.
+	err := ra.checkIdentifiersPaused(ctx, 1, []string{"example.com"})
+	test.AssertNotError(t, err, "checkIdentifiersPaused failed with the feature disabled")
+
+	_ = features.Set(map[string]bool{"PauseIdentifiers": true})
+	defer features.Reset()
+
+	err = ra.checkIdentifiersPaused(ctx, 1, []string{"www.example.com"})
+	test.AssertNotError(t, err, "checkIdentifiersPaused failed for an identifier which isn't paused")
+
+	_, err = ra.NewOrder(ctx, &rapb.NewOrderRequest{
+		RegistrationID: 1,
+		Names:          []string{"www.example.com", "EXAMPLE.com"},
+	})
+	test.AssertErrorIs(t, err, berrors.RateLimit)
+	test.AssertEquals(t, err.Error(), "Your account is paused from requesting certificates for example.com after too many failed validations in a row: see https://letsencrypt.org/docs/rate-limits/")
+	var bErr *berrors.BoulderError
+	test.AssertErrorWraps(t, err, &bErr)
+	test.AssertDeepEquals(t, bErr.RateLimitDetails, &berrors.RateLimitDetails{
+		Limit:     ratelimit.PausedIdentifiers,
+		Count:     3,
+		Threshold: 3,
+	})
+}
+
+func TestTrackConsecutiveFailures(t *testing.T) {
+	_ = features.Set(map[string]bool{"PauseIdentifiers": true})
+	defer features.Reset()
+
+	ra := NewRegistrationAuthorityImpl(clock.NewFake(), blog.NewMock(), metrics.NoopRegisterer,
+		1, testKeyPolicy, 100, true, 300*24*time.Hour, 7*24*time.Hour, nil, noopCAA{}, 0, nil, nil, nil)
+	msa := &mockSAWithValidationFailures{
+		failures: make(map[string]int64),
+		paused:   make(map[string]bool),
+	}
+	ra.SA = msa
+
+	// Pausing is disabled until it's configured.
+	err := ra.trackConsecutiveFailures(ctx, 1, "example.com", core.StatusInvalid)
+	test.AssertNotError(t, err, "trackConsecutiveFailures failed")
+	test.AssertEquals(t, msa.failures["example.com"], int64(0))
+
+	ra.SetMaxConsecutiveFailures(3)
+	for i := 0; i < 2; i++ {
+		err = ra.trackConsecutiveFailures(ctx, 1, "example.com", core.StatusInvalid)
+		test.AssertNotError(t, err, "trackConsecutiveFailures failed")
+	}
+	test.AssertEquals(t, msa.paused["example.com"], false)
+
+	// A success resets the count of consecutive failures.
+	err = ra.trackConsecutiveFailures(ctx, 1, "example.com", core.StatusValid)
+	test.AssertNotError(t, err, "trackConsecutiveFailures failed")
+	for i := 0; i < 2; i++ {
+		err = ra.trackConsecutiveFailures(ctx, 1, "example.com", core.StatusInvalid)
+		test.AssertNotError(t, err, "trackConsecutiveFailures failed")
+	}
+	test.AssertEquals(t, msa.paused["example.com"], false)
+
+	err = ra.trackConsecutiveFailures(ctx, 1, "example.com", core.StatusInvalid)
+	test.AssertNotError(t, err, "trackConsecutiveFailures failed")
+	test.AssertEquals(t, msa.paused["example.com"], true)
+	test.AssertMetricWithLabelsEquals(t, ra.pausedIdentifiersCounter, prometheus.Labels{"action": "paused"}, 1)
+
+	// Further failures don't pause the identifier again.
+	err = ra.trackConsecutiveFailures(ctx, 1, "example.com", core.StatusInvalid)
+	test.AssertNotError(t, err, "trackConsecutiveFailures failed")
+	test.AssertMetricWithLabelsEquals(t, ra.pausedIdentifiersCounter, prometheus.Labels{"action": "paused"}, 1)
+
+	_, err = ra.UnpauseAccount(ctx, &rapb.UnpauseAccountRequest{})
+	test.AssertEquals(t, err, errIncompleteGRPCRequest)
+	resp, err := ra.UnpauseAccount(ctx, &rapb.UnpauseAccountRequest{RegistrationID: 1})
+	test.AssertNotError(t, err, "UnpauseAccount failed")
+	test.AssertEquals(t, resp.Count, int64(1))
+	test.AssertEquals(t, msa.paused["example.com"], false)
+	test.AssertMetricWithLabelsEquals(t, ra.pausedIdentifiersCounter, prometheus.Labels{"action": "unpaused"}, 1)
+}
@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"fmt"
+)
+
+// Override is a rate limit override stored outside of the policy file, in the
+// database, so that it can be added and expired without a deploy. It applies
+// to either a key or a registration, with the same meaning as an entry in the
+// Overrides or RegistrationOverrides of the named limit in the policy file.
+type Override struct {
+	ID             int64
+	Limit          string
+	Key            string
+	RegistrationID int64
+	Threshold      int64
+}
+
+// Validate returns an error if the override doesn't name a known limit,
+// doesn't specify exactly one of a key and a registration ID, or has a negative
+// threshold.
+func (o Override) Validate() error {
+	if !ValidLimitName(o.Limit) {
+		return fmt.Errorf("override %d: unknown limit %q", o.ID, o.Limit)
+	}
+	if (o.Key == "") == (o.RegistrationID == 0) {
+		return fmt.Errorf("override %d: exactly one of key and registration ID must be set", o.ID)
+	}
+	if o.Threshold < 0 {
+		return fmt.Errorf("override %d: threshold must not be negative", o.ID)
+	}
+	return nil
+}
+
+// PausedIdentifiers is the Limit reported in the details of the error returned
+// when an account is paused from ordering certificates for some identifiers
+// after too many consecutive failed validations. It isn't a limit in the
+// policy file, so it can't be overridden.
+const PausedIdentifiers = "pausedIdentifiers"
+
+// ValidLimitName returns true if name is the name of a limit in the rate limit
+// policy file, e.g. "certificatesPerName".
+func ValidLimitName(name string) bool {
+	return (&rateLimitConfig{}).policyByName(name) != nil
+}
+
+// policyByName returns a pointer to the policy in the config with the given
+// name, as used in the policy file, or nil if there is no such policy.
+func (c *rateLimitConfig) policyByName(name string) *RateLimitPolicy {
+	switch name {
+	case "certificatesPerName":
+		return &c.CertificatesPerName
+	case "registrationsPerIP":
+		return &c.RegistrationsPerIP
+	case "registrationsPerIPRange":
+		return &c.RegistrationsPerIPRange
+	case "pendingAuthorizationsPerAccount":
+		return &c.PendingAuthorizationsPerAccount
+	case "invalidAuthorizationsPerAccount":
+		return &c.InvalidAuthorizationsPerAccount
+	case "pendingOrdersPerAccount":
+		return &c.PendingOrdersPerAccount
+	case "newOrdersPerAccount":
+		return &c.NewOrdersPerAccount
+	case "certificatesPerFQDNSet":
+		return &c.CertificatesPerFQDNSet
+	case "certificatesPerFQDNSetFast":
+		return &c.CertificatesPerFQDNSetFast
+	}
+	return nil
+}
+
+// mergeOverrides returns a copy of base with the given overrides applied.
+// The maps of any policy which is modified are copied first, so base is left
+// unchanged.
+func mergeOverrides(base *rateLimitConfig, overrides []Override) (*rateLimitConfig, error) {
+	merged := *base
+	copied := make(map[string]bool)
+	for _, o := range overrides {
+		policy := merged.policyByName(o.Limit)
+		if policy == nil {
+			return nil, fmt.Errorf("override %d: unknown limit %q", o.ID, o.Limit)
+		}
+		if !copied[o.Limit] {
+			policy.Overrides = copyOverrides(policy.Overrides)
+			policy.RegistrationOverrides = copyRegOverrides(policy.RegistrationOverrides)
+			policy.overrideIDs = make(map[string]int64)
+			policy.regOverrideIDs = make(map[int64]int64)
+			copied[o.Limit] = true
+		}
+		if o.Key != "" {
+			policy.Overrides[o.Key] = o.Threshold
+			policy.overrideIDs[o.Key] = o.ID
+		} else {
+			policy.RegistrationOverrides[o.RegistrationID] = o.Threshold
+			policy.regOverrideIDs[o.RegistrationID] = o.ID
+		}
+	}
+	return &merged, nil
+}
+
+func copyOverrides(m map[string]int64) map[string]int64 {
+	c := make(map[string]int64, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+func copyRegOverrides(m map[int64]int64) map[int64]int64 {
+	c := make(map[int64]int64, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
@@ -22,15 +22,21 @@ type Limits interface {
 	PendingOrdersPerAccount() RateLimitPolicy
 	NewOrdersPerAccount() RateLimitPolicy
 	LoadPolicies(contents []byte) error
+	LoadOverrides(overrides []Override) error
 }
 
 // limitsImpl is an unexported implementation of the Limits interface. It acts
 // as a container for a rateLimitConfig and a mutex. This allows the inner
 // rateLimitConfig pointer to be updated safely when the overall configuration
-// changes (e.g. due to a reload of the policy file)
+// changes (e.g. due to a reload of the policy file or of the overrides)
 type limitsImpl struct {
 	sync.RWMutex
+	// rlPolicy is filePolicy with overrides applied. It is what the accessors
+	// return.
 	rlPolicy *rateLimitConfig
+	// filePolicy is the policy as most recently loaded from the policy file.
+	filePolicy *rateLimitConfig
+	overrides  []Override
 }
 
 func (r *limitsImpl) CertificatesPerName() RateLimitPolicy {
@@ -124,8 +130,38 @@ func (r *limitsImpl) LoadPolicies(contents []byte) error {
 	}
 
 	r.Lock()
-	r.rlPolicy = &newPolicy
-	r.Unlock()
+	defer r.Unlock()
+	merged, err := mergeOverrides(&newPolicy, r.overrides)
+	if err != nil {
+		return err
+	}
+	r.filePolicy = &newPolicy
+	r.rlPolicy = merged
+	return nil
+}
+
+// LoadOverrides replaces the set of overrides applied on top of the policy
+// file, typically with the overrides stored in the database. Overrides take
+// precedence over overrides for the same key or registration in the policy
+// file.
+func (r *limitsImpl) LoadOverrides(overrides []Override) error {
+	for _, o := range overrides {
+		err := o.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if r.filePolicy != nil {
+		merged, err := mergeOverrides(r.filePolicy, overrides)
+		if err != nil {
+			return err
+		}
+		r.rlPolicy = merged
+	}
+	r.overrides = overrides
 	return nil
 }
 
@@ -193,6 +229,12 @@ type RateLimitPolicy struct {
 	// available, whichever is larger takes priority. Note that a zero entry in
 	// the overrides map does not mean "no limit", it means a limit of zero.
 	RegistrationOverrides map[int64]int64 `yaml:"registrationOverrides"`
+
+	// overrideIDs and regOverrideIDs map the keys and registrations whose
+	// overrides came from the database, rather than the policy file, to the
+	// IDs of those overrides.
+	overrideIDs    map[string]int64
+	regOverrideIDs map[int64]int64
 }
 
 // Enabled returns true iff the RateLimitPolicy is enabled.
@@ -204,27 +246,35 @@ func (rlp *RateLimitPolicy) Enabled() bool {
 // any overrides for `key` or `regID`. If both `key` and `regID` have an
 // override the largest of the two will be used.
 func (rlp *RateLimitPolicy) GetThreshold(key string, regID int64) int64 {
+	threshold, _ := rlp.GetThresholdWithOverride(key, regID)
+	return threshold
+}
+
+// GetThresholdWithOverride is like GetThreshold, but also returns the ID of the
+// database override which determined the threshold. The ID is zero if the
+// threshold came from the policy file.
+func (rlp *RateLimitPolicy) GetThresholdWithOverride(key string, regID int64) (int64, int64) {
 	regOverride, regOverrideExists := rlp.RegistrationOverrides[regID]
 	keyOverride, keyOverrideExists := rlp.Overrides[key]
 
 	if regOverrideExists && !keyOverrideExists {
 		// If there is a regOverride and no keyOverride use the regOverride
-		return regOverride
+		return regOverride, rlp.regOverrideIDs[regID]
 	} else if !regOverrideExists && keyOverrideExists {
 		// If there is a keyOverride and no regOverride use the keyOverride
-		return keyOverride
+		return keyOverride, rlp.overrideIDs[key]
 	} else if regOverrideExists && keyOverrideExists {
 		// If there is both a regOverride and a keyOverride use whichever is larger.
 		if regOverride > keyOverride {
-			return regOverride
+			return regOverride, rlp.regOverrideIDs[regID]
 		} else {
-			return keyOverride
+			return keyOverride, rlp.overrideIDs[key]
 		}
 	}
 
 	// Otherwise there was no regOverride and no keyOverride, use the base
 	// Threshold
-	return rlp.Threshold
+	return rlp.Threshold, 0
 }
 
 // WindowBegin returns the time that a RateLimitPolicy's window begins, given a
@@ -184,3 +184,64 @@ func TestLoadPolicies(t *testing.T) {
 	test.AssertEquals(t, emptyPolicy.PendingAuthorizationsPerAccount().Threshold, int64(0))
 	test.AssertEquals(t, emptyPolicy.CertificatesPerFQDNSet().Threshold, int64(0))
 }
+
+func TestLoadOverrides(t *testing.T) {
+	policy := New()
+
+	// Overrides loaded before the policy file are applied once it's loaded.
+	err := policy.LoadOverrides([]Override{
+		{ID: 1, Limit: "certificatesPerName", Key: "example.com", Threshold: 500},
+		{ID: 2, Limit: "certificatesPerName", RegistrationID: 101, Threshold: 2000},
+		{ID: 3, Limit: "newOrdersPerAccount", RegistrationID: 7, Threshold: 50},
+	})
+	test.AssertNotError(t, err, "Failed to load overrides")
+
+	policyContent, err := ioutil.ReadFile("../test/rate-limit-policies.yml")
+	test.AssertNotError(t, err, "Failed to load rate-limit-policies.yml")
+	err = policy.LoadPolicies(policyContent)
+	test.AssertNotError(t, err, "Failed to parse rate-limit-policies.yml")
+
+	certsPerName := policy.CertificatesPerName()
+	threshold, id := certsPerName.GetThresholdWithOverride("example.com", 0)
+	test.AssertEquals(t, threshold, int64(500))
+	test.AssertEquals(t, id, int64(1))
+	// The database override replaces the policy file's override for 101.
+	threshold, id = certsPerName.GetThresholdWithOverride("other.com", 101)
+	test.AssertEquals(t, threshold, int64(2000))
+	test.AssertEquals(t, id, int64(2))
+	// Overrides from the policy file have no ID.
+	threshold, id = certsPerName.GetThresholdWithOverride("le.wtf", 0)
+	test.AssertEquals(t, threshold, int64(10000))
+	test.AssertEquals(t, id, int64(0))
+	newOrders := policy.NewOrdersPerAccount()
+	test.AssertEquals(t, newOrders.GetThreshold("", 7), int64(50))
+
+	// Replacing the overrides drops those which are no longer present, and
+	// restores the policy file's overrides.
+	err = policy.LoadOverrides([]Override{
+		{ID: 4, Limit: "certificatesPerName", Key: "example.net", Threshold: 5},
+	})
+	test.AssertNotError(t, err, "Failed to load overrides")
+	certsPerName = policy.CertificatesPerName()
+	test.AssertEquals(t, certsPerName.GetThreshold("example.com", 0), int64(2))
+	test.AssertEquals(t, certsPerName.GetThreshold("example.net", 0), int64(5))
+	threshold, id = certsPerName.GetThresholdWithOverride("other.com", 101)
+	test.AssertEquals(t, threshold, int64(1000))
+	test.AssertEquals(t, id, int64(0))
+	newOrders = policy.NewOrdersPerAccount()
+	test.AssertEquals(t, newOrders.GetThreshold("", 7), int64(1500))
+
+	// Reloading the policy file keeps the overrides.
+	err = policy.LoadPolicies(policyContent)
+	test.AssertNotError(t, err, "Failed to parse rate-limit-policies.yml")
+	certsPerName = policy.CertificatesPerName()
+	test.AssertEquals(t, certsPerName.GetThreshold("example.net", 0), int64(5))
+
+	// Invalid overrides are rejected and leave the current overrides in place.
+	err = policy.LoadOverrides([]Override{{ID: 5, Limit: "bogus", Key: "example.org", Threshold: 1}})
+	test.AssertError(t, err, "Loaded override for unknown limit")
+	err = policy.LoadOverrides([]Override{{ID: 6, Limit: "certificatesPerName", Key: "example.org", RegistrationID: 1, Threshold: 1}})
+	test.AssertError(t, err, "Loaded override with both key and registration")
+	certsPerName = policy.CertificatesPerName()
+	test.AssertEquals(t, certsPerName.GetThreshold("example.net", 0), int64(5))
+}
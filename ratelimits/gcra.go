@@ -0,0 +1,88 @@
+package ratelimits
+
+import (
+	"time"
+
+	"github.com/jmhodges/clock"
+)
+
+// The Generic Cell Rate Algorithm (GCRA) tracks a token bucket using a single
+// timestamp, the Theoretical Arrival Time (TAT): the time at which the bucket
+// would be full again if no further tokens were spent. A bucket whose TAT is in
+// the past is full, which means that a bucket which doesn't exist yet can be
+// treated as having a TAT of now.
+
+// maybeSpend uses the GCRA algorithm to decide whether to allow a request
+// costing cost tokens from a bucket with the given limit and TAT. The returned
+// Decision contains the TAT the bucket should be updated to if the request is
+// allowed; the caller is responsible for storing it.
+func maybeSpend(clk clock.Clock, l Limit, tat time.Time, cost int64) *Decision {
+	nowUnix := clk.Now().UnixNano()
+	tatUnix := tat.UnixNano()
+
+	// If the TAT is in the past, the bucket is full and any unused capacity
+	// has been forfeit.
+	if tatUnix < nowUnix {
+		tatUnix = nowUnix
+	}
+
+	emissionInterval := l.emissionInterval()
+	burstOffset := l.burstOffset()
+	newTAT := tatUnix + emissionInterval*cost
+	difference := nowUnix - (newTAT - burstOffset)
+
+	if difference < 0 {
+		// Too little capacity to satisfy the cost, deny the request and
+		// leave the bucket as it was.
+		residual := (nowUnix - (tatUnix - burstOffset)) / emissionInterval
+		return &Decision{
+			Allowed:   false,
+			Remaining: residual,
+			RetryIn:   time.Duration(-difference),
+			ResetIn:   time.Duration(tatUnix - nowUnix),
+			newTAT:    time.Unix(0, tatUnix).UTC(),
+		}
+	}
+
+	return &Decision{
+		Allowed:   true,
+		Remaining: difference / emissionInterval,
+		RetryIn:   0,
+		ResetIn:   time.Duration(newTAT - nowUnix),
+		newTAT:    time.Unix(0, newTAT).UTC(),
+	}
+}
+
+// maybeRefund uses the GCRA algorithm to return cost tokens to a bucket with
+// the given limit and TAT. A bucket is never refilled beyond its burst, so a
+// refund to a full bucket is not allowed.
+func maybeRefund(clk clock.Clock, l Limit, tat time.Time, cost int64) *Decision {
+	nowUnix := clk.Now().UnixNano()
+	tatUnix := tat.UnixNano()
+
+	if nowUnix >= tatUnix {
+		// The bucket is already full, there is nothing to refund.
+		return &Decision{
+			Allowed:   false,
+			Remaining: l.Burst,
+			RetryIn:   0,
+			ResetIn:   0,
+			newTAT:    time.Unix(0, nowUnix).UTC(),
+		}
+	}
+
+	emissionInterval := l.emissionInterval()
+	newTAT := tatUnix - emissionInterval*cost
+	if newTAT < nowUnix {
+		newTAT = nowUnix
+	}
+	difference := nowUnix - (newTAT - l.burstOffset())
+
+	return &Decision{
+		Allowed:   true,
+		Remaining: difference / emissionInterval,
+		RetryIn:   0,
+		ResetIn:   time.Duration(newTAT - nowUnix),
+		newTAT:    time.Unix(0, newTAT).UTC(),
+	}
+}
@@ -0,0 +1,86 @@
+package ratelimits
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestDecide(t *testing.T) {
+	clk := clock.NewFake()
+	limit := Limit{Burst: 10, Count: 1, Period: time.Second}
+
+	// Begin by using 1 of our 10 requests.
+	d := maybeSpend(clk, limit, clk.Now(), 1)
+	test.Assert(t, d.Allowed, "should be allowed")
+	test.AssertEquals(t, d.Remaining, int64(9))
+	test.AssertEquals(t, d.RetryIn, time.Duration(0))
+	test.AssertEquals(t, d.ResetIn, time.Second)
+
+	// Immediately use another 9 of our remaining requests.
+	d = maybeSpend(clk, limit, d.newTAT, 9)
+	test.Assert(t, d.Allowed, "should be allowed")
+	test.AssertEquals(t, d.Remaining, int64(0))
+	test.AssertEquals(t, d.ResetIn, 10*time.Second)
+
+	// Our new TAT should be 10 seconds (limit.Burst) in the future.
+	test.AssertEquals(t, d.newTAT, clk.Now().Add(10*time.Second))
+
+	// Let's try using just 1 more request without waiting.
+	d = maybeSpend(clk, limit, d.newTAT, 1)
+	test.Assert(t, !d.Allowed, "should not be allowed")
+	test.AssertEquals(t, d.Remaining, int64(0))
+	test.AssertEquals(t, d.RetryIn, time.Second)
+	test.AssertEquals(t, d.ResetIn, 10*time.Second)
+
+	// Let's try being exactly as patient as we're told to be.
+	clk.Add(d.RetryIn)
+	d = maybeSpend(clk, limit, d.newTAT, 0)
+	test.AssertEquals(t, d.Remaining, int64(1))
+
+	// We are 1 second in the future, we should have 1 new request.
+	d = maybeSpend(clk, limit, d.newTAT, 1)
+	test.Assert(t, d.Allowed, "should be allowed")
+	test.AssertEquals(t, d.Remaining, int64(0))
+	test.AssertEquals(t, d.ResetIn, 10*time.Second)
+
+	// Let's try asking for more than we have.
+	d = maybeSpend(clk, limit, d.newTAT, 2)
+	test.Assert(t, !d.Allowed, "should not be allowed")
+	test.AssertEquals(t, d.RetryIn, 2*time.Second)
+
+	// Wait for the bucket to refill completely; unused capacity beyond the
+	// burst is forfeit.
+	clk.Add(time.Hour)
+	d = maybeSpend(clk, limit, d.newTAT, 0)
+	test.Assert(t, d.Allowed, "should be allowed")
+	test.AssertEquals(t, d.Remaining, int64(10))
+	test.AssertEquals(t, d.ResetIn, time.Duration(0))
+}
+
+func TestMaybeRefund(t *testing.T) {
+	clk := clock.NewFake()
+	limit := Limit{Burst: 10, Count: 1, Period: time.Second}
+
+	// Refunding to a full bucket is not allowed.
+	d := maybeRefund(clk, limit, clk.Now(), 1)
+	test.Assert(t, !d.Allowed, "should not be allowed")
+	test.AssertEquals(t, d.Remaining, int64(10))
+
+	// Spend 5, then refund 2 of them.
+	d = maybeSpend(clk, limit, clk.Now(), 5)
+	test.Assert(t, d.Allowed, "should be allowed")
+	test.AssertEquals(t, d.Remaining, int64(5))
+	d = maybeRefund(clk, limit, d.newTAT, 2)
+	test.Assert(t, d.Allowed, "should be allowed")
+	test.AssertEquals(t, d.Remaining, int64(7))
+	test.AssertEquals(t, d.ResetIn, 3*time.Second)
+
+	// Refunding more than was spent only refills the bucket.
+	d = maybeRefund(clk, limit, d.newTAT, 5)
+	test.Assert(t, d.Allowed, "should be allowed")
+	test.AssertEquals(t, d.Remaining, int64(10))
+	test.AssertEquals(t, d.ResetIn, time.Duration(0))
+}
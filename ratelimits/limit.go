@@ -0,0 +1,144 @@
+package ratelimits
+
+import (
+	"fmt"
+	"time"
+)
+
+// Name is an enumeration of all rate limit names. It is used to namespace
+// bucket keys, so that the same identifier (e.g. an IP address) can have a
+// separate bucket for each limit which applies to it.
+//
+// Note: Bucket keys are stored using the numeric value of the Name, so new
+// Names must only ever be appended, never inserted or reordered.
+type Name int
+
+const (
+	// Unknown is the zero value of Name and is not a valid limit.
+	Unknown Name = iota
+
+	// NewRegistrationsPerIPAddress uses bucket key 'enum:ipAddress'.
+	NewRegistrationsPerIPAddress
+
+	// NewRegistrationsPerIPv6Range uses bucket key 'enum:ipv6rangeCIDR'. The
+	// range is a /48.
+	NewRegistrationsPerIPv6Range
+
+	// NewOrdersPerAccount uses bucket key 'enum:regId'.
+	NewOrdersPerAccount
+
+	// CertificatesPerDomain uses bucket key 'enum:domain', where domain is the
+	// registered domain (eTLD+1) of a name being issued for.
+	CertificatesPerDomain
+
+	// CertificatesPerFQDNSet uses bucket key 'enum:fqdnSet', where fqdnSet is
+	// the sorted, lowercased and comma separated names in the certificate.
+	CertificatesPerFQDNSet
+)
+
+var nameToString = map[Name]string{
+	Unknown:                      "Unknown",
+	NewRegistrationsPerIPAddress: "NewRegistrationsPerIPAddress",
+	NewRegistrationsPerIPv6Range: "NewRegistrationsPerIPv6Range",
+	NewOrdersPerAccount:          "NewOrdersPerAccount",
+	CertificatesPerDomain:        "CertificatesPerDomain",
+	CertificatesPerFQDNSet:       "CertificatesPerFQDNSet",
+}
+
+// String returns the human readable name of the limit.
+func (n Name) String() string {
+	s, ok := nameToString[n]
+	if !ok {
+		return fmt.Sprintf("Name(%d)", int(n))
+	}
+	return s
+}
+
+// isValid returns true if the Name is a known limit other than Unknown.
+func (n Name) isValid() bool {
+	_, ok := nameToString[n]
+	return ok && n != Unknown
+}
+
+// Limit describes a token bucket. Count tokens are added to the bucket every
+// Period, and the bucket holds at most Burst tokens. A bucket which has never
+// been spent from, or which has fully refilled, is full.
+type Limit struct {
+	// Burst is the maximum number of tokens the bucket can hold, and
+	// therefore the maximum number of requests which can be made at once.
+	Burst int64
+
+	// Count is the number of tokens added to the bucket every Period.
+	Count int64
+
+	// Period is the amount of time over which Count tokens are added.
+	Period time.Duration
+}
+
+func (l Limit) validate() error {
+	if l.Burst <= 0 {
+		return fmt.Errorf("invalid burst '%d', must be > 0", l.Burst)
+	}
+	if l.Count <= 0 {
+		return fmt.Errorf("invalid count '%d', must be > 0", l.Count)
+	}
+	if l.Period <= 0 {
+		return fmt.Errorf("invalid period '%s', must be > 0", l.Period)
+	}
+	return nil
+}
+
+// emissionInterval is the amount of time it takes for one token to be added to
+// the bucket.
+func (l Limit) emissionInterval() int64 {
+	return l.Period.Nanoseconds() / l.Count
+}
+
+// burstOffset is the amount of time it takes for an empty bucket to refill
+// completely.
+func (l Limit) burstOffset() int64 {
+	return l.emissionInterval() * l.Burst
+}
+
+// Transaction is a request to spend (or refund) Cost tokens from the bucket
+// identified by a limit Name and an identifier.
+type Transaction struct {
+	name      Name
+	bucketKey string
+	limit     Limit
+	cost      int64
+}
+
+// NewTransaction returns a Transaction for the bucket of the named limit
+// belonging to id, for example an IP address, account ID or domain name. The
+// limit is supplied by the caller so that per-bucket overrides can be applied.
+// A cost of 0 only checks the bucket without spending from it.
+func NewTransaction(name Name, id string, limit Limit, cost int64) (Transaction, error) {
+	if !name.isValid() {
+		return Transaction{}, fmt.Errorf("invalid limit name %s", name)
+	}
+	if id == "" {
+		return Transaction{}, fmt.Errorf("empty id for limit %s", name)
+	}
+	err := limit.validate()
+	if err != nil {
+		return Transaction{}, fmt.Errorf("invalid limit for %s: %w", name, err)
+	}
+	if cost < 0 {
+		return Transaction{}, fmt.Errorf("invalid cost '%d', must be >= 0", cost)
+	}
+	if cost > limit.Burst {
+		return Transaction{}, fmt.Errorf("invalid cost '%d', must be <= burst '%d'", cost, limit.Burst)
+	}
+	return Transaction{
+		name:      name,
+		bucketKey: fmt.Sprintf("%d:%s", name, id),
+		limit:     limit,
+		cost:      cost,
+	}, nil
+}
+
+// BucketKey returns the key under which the transaction's bucket is stored.
+func (txn Transaction) BucketKey() string {
+	return txn.bucketKey
+}
@@ -0,0 +1,265 @@
+package ratelimits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Decision is the result of checking, spending from or refunding to a bucket.
+type Decision struct {
+	// Allowed is true if the bucket had enough capacity for the request (or,
+	// for a refund, if any tokens were returned to it).
+	Allowed bool
+
+	// Remaining is the number of tokens left in the bucket after the request.
+	Remaining int64
+
+	// RetryIn is how long the caller should wait before the same request
+	// would be allowed. It is zero if the request was allowed.
+	RetryIn time.Duration
+
+	// ResetIn is how long it will take for the bucket to refill completely.
+	ResetIn time.Duration
+
+	// newTAT is the TAT the bucket should be updated to if the request is
+	// allowed.
+	newTAT time.Time
+}
+
+// Limiter provides a high-level interface for rate limiting requests using
+// token buckets stored in a source.
+type Limiter struct {
+	source source
+	clk    clock.Clock
+
+	spendLatency *prometheus.HistogramVec
+}
+
+// NewLimiter returns a new *Limiter backed by the given source, usually a
+// *RedisSource.
+func NewLimiter(clk clock.Clock, source source, stats prometheus.Registerer) *Limiter {
+	spendLatency := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "ratelimits_spend_latency",
+			Help: "Latency of ratelimit checks labeled by limit=[name] and decision=[Allowed|Denied], in seconds",
+			// Exponential buckets ranging from 0.0005s to 3s.
+			Buckets: prometheus.ExponentialBuckets(0.0005, 3, 8),
+		},
+		[]string{"limit", "decision"},
+	)
+	stats.MustRegister(spendLatency)
+
+	return &Limiter{
+		source:       source,
+		clk:          clk,
+		spendLatency: spendLatency,
+	}
+}
+
+func (l *Limiter) observe(limit string, start time.Time, d *Decision) {
+	decision := "Denied"
+	if d.Allowed {
+		decision = "Allowed"
+	}
+	l.spendLatency.With(prometheus.Labels{"limit": limit, "decision": decision}).Observe(l.clk.Since(start).Seconds())
+}
+
+// getTAT returns the TAT of the given bucket, or the current time if the
+// bucket doesn't exist (i.e. is full).
+func (l *Limiter) getTAT(ctx context.Context, bucketKey string) (time.Time, error) {
+	tat, err := l.source.Get(ctx, bucketKey)
+	if err != nil {
+		if errors.Is(err, ErrBucketNotFound) {
+			return l.clk.Now(), nil
+		}
+		return time.Time{}, err
+	}
+	return tat, nil
+}
+
+// Check returns a Decision describing whether the transaction would be allowed,
+// without spending any tokens from the bucket.
+func (l *Limiter) Check(ctx context.Context, txn Transaction) (*Decision, error) {
+	tat, err := l.getTAT(ctx, txn.bucketKey)
+	if err != nil {
+		return nil, err
+	}
+	return maybeSpend(l.clk, txn.limit, tat, txn.cost), nil
+}
+
+// Spend attempts to spend the transaction's cost from its bucket. If the
+// returned Decision is allowed the tokens have been spent, otherwise the bucket
+// is unchanged.
+func (l *Limiter) Spend(ctx context.Context, txn Transaction) (*Decision, error) {
+	start := l.clk.Now()
+	d, err := l.Check(ctx, txn)
+	if err != nil {
+		return nil, err
+	}
+	if d.Allowed && txn.cost > 0 {
+		err = l.source.BatchSet(ctx, map[string]time.Time{txn.bucketKey: d.newTAT})
+		if err != nil {
+			return nil, err
+		}
+	}
+	l.observe(txn.name.String(), start, d)
+	return d, nil
+}
+
+// Refund attempts to return the transaction's cost to its bucket, for instance
+// when the action it was spent on failed. A bucket is never refilled beyond its
+// burst, so refunding to a full bucket returns a Decision which is not
+// allowed.
+func (l *Limiter) Refund(ctx context.Context, txn Transaction) (*Decision, error) {
+	tat, err := l.source.Get(ctx, txn.bucketKey)
+	if err != nil {
+		if errors.Is(err, ErrBucketNotFound) {
+			// A missing bucket is full.
+			return &Decision{Allowed: false, Remaining: txn.limit.Burst}, nil
+		}
+		return nil, err
+	}
+	d := maybeRefund(l.clk, txn.limit, tat, txn.cost)
+	if d.Allowed {
+		err = l.source.BatchSet(ctx, map[string]time.Time{txn.bucketKey: d.newTAT})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// batchTATs fetches the TATs of the buckets of all of the given transactions
+// in one call, treating missing buckets as full.
+func (l *Limiter) batchTATs(ctx context.Context, txns []Transaction) (map[string]time.Time, error) {
+	bucketKeys := make([]string, 0, len(txns))
+	seen := make(map[string]bool, len(txns))
+	for _, txn := range txns {
+		if seen[txn.bucketKey] {
+			return nil, fmt.Errorf("duplicate bucket key %q in batch", txn.bucketKey)
+		}
+		seen[txn.bucketKey] = true
+		bucketKeys = append(bucketKeys, txn.bucketKey)
+	}
+	tats, err := l.source.BatchGet(ctx, bucketKeys)
+	if err != nil {
+		return nil, err
+	}
+	now := l.clk.Now()
+	for _, bucketKey := range bucketKeys {
+		_, ok := tats[bucketKey]
+		if !ok {
+			tats[bucketKey] = now
+		}
+	}
+	return tats, nil
+}
+
+// BatchCheck returns a Decision describing whether all of the given
+// transactions, such as one per name in an order, would be allowed, without
+// spending any tokens. The Decision is combined as for BatchSpend.
+func (l *Limiter) BatchCheck(ctx context.Context, txns []Transaction) (*Decision, error) {
+	return l.batchSpend(ctx, txns, false)
+}
+
+// BatchSpend attempts to spend from the buckets of all of the given
+// transactions, such as one bucket per name in an order, in a single round
+// trip to the source. Tokens are only spent if every transaction is allowed.
+// If any are denied, the returned Decision is the denial with the longest
+// RetryIn. Otherwise it is allowed, with the lowest Remaining and the longest
+// ResetIn of all of the buckets.
+func (l *Limiter) BatchSpend(ctx context.Context, txns []Transaction) (*Decision, error) {
+	return l.batchSpend(ctx, txns, true)
+}
+
+func (l *Limiter) batchSpend(ctx context.Context, txns []Transaction, persist bool) (*Decision, error) {
+	if len(txns) == 0 {
+		return nil, errors.New("no transactions to spend")
+	}
+	start := l.clk.Now()
+	tats, err := l.batchTATs(ctx, txns)
+	if err != nil {
+		return nil, err
+	}
+
+	var denied *Decision
+	batch := &Decision{Allowed: true, Remaining: txns[0].limit.Burst}
+	newTATs := make(map[string]time.Time, len(txns))
+	for _, txn := range txns {
+		d := maybeSpend(l.clk, txn.limit, tats[txn.bucketKey], txn.cost)
+		if !d.Allowed {
+			if denied == nil || d.RetryIn > denied.RetryIn {
+				denied = d
+			}
+			continue
+		}
+		if d.Remaining < batch.Remaining {
+			batch.Remaining = d.Remaining
+		}
+		if d.ResetIn > batch.ResetIn {
+			batch.ResetIn = d.ResetIn
+		}
+		if txn.cost > 0 {
+			newTATs[txn.bucketKey] = d.newTAT
+		}
+	}
+	if denied != nil {
+		l.observe("batch", start, denied)
+		return denied, nil
+	}
+
+	if persist && len(newTATs) > 0 {
+		err = l.source.BatchSet(ctx, newTATs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	l.observe("batch", start, batch)
+	return batch, nil
+}
+
+// BatchRefund attempts to return the cost of each of the given transactions
+// to its bucket in a single round trip to the source. The returned Decision is
+// allowed if any tokens were returned.
+func (l *Limiter) BatchRefund(ctx context.Context, txns []Transaction) (*Decision, error) {
+	if len(txns) == 0 {
+		return nil, errors.New("no transactions to refund")
+	}
+	tats, err := l.batchTATs(ctx, txns)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &Decision{Allowed: false, Remaining: txns[0].limit.Burst}
+	newTATs := make(map[string]time.Time, len(txns))
+	for _, txn := range txns {
+		d := maybeRefund(l.clk, txn.limit, tats[txn.bucketKey], txn.cost)
+		if d.Remaining < batch.Remaining {
+			batch.Remaining = d.Remaining
+		}
+		if d.ResetIn > batch.ResetIn {
+			batch.ResetIn = d.ResetIn
+		}
+		if d.Allowed {
+			batch.Allowed = true
+			newTATs[txn.bucketKey] = d.newTAT
+		}
+	}
+	if len(newTATs) > 0 {
+		err = l.source.BatchSet(ctx, newTATs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return batch, nil
+}
+
+// Reset resets the bucket of the given transaction to full.
+func (l *Limiter) Reset(ctx context.Context, txn Transaction) error {
+	return l.source.Delete(ctx, txn.bucketKey)
+}
@@ -0,0 +1,154 @@
+package ratelimits
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+var testLimit = Limit{Burst: 10, Count: 10, Period: time.Second}
+
+func setupLimiter() (*Limiter, clock.FakeClock) {
+	clk := clock.NewFake()
+	return NewLimiter(clk, NewInmemSource(), metrics.NoopRegisterer), clk
+}
+
+func newTestTxn(t *testing.T, id string, cost int64) Transaction {
+	t.Helper()
+	txn, err := NewTransaction(NewOrdersPerAccount, id, testLimit, cost)
+	test.AssertNotError(t, err, "creating transaction")
+	return txn
+}
+
+func TestNewTransaction(t *testing.T) {
+	txn, err := NewTransaction(CertificatesPerDomain, "example.com", testLimit, 1)
+	test.AssertNotError(t, err, "valid transaction")
+	test.AssertEquals(t, txn.BucketKey(), "4:example.com")
+
+	_, err = NewTransaction(Unknown, "example.com", testLimit, 1)
+	test.AssertError(t, err, "Unknown limit name")
+	_, err = NewTransaction(Name(9999), "example.com", testLimit, 1)
+	test.AssertError(t, err, "invalid limit name")
+	_, err = NewTransaction(CertificatesPerDomain, "", testLimit, 1)
+	test.AssertError(t, err, "empty id")
+	_, err = NewTransaction(CertificatesPerDomain, "example.com", Limit{Burst: 1, Count: 0, Period: time.Second}, 1)
+	test.AssertError(t, err, "zero count")
+	_, err = NewTransaction(CertificatesPerDomain, "example.com", testLimit, -1)
+	test.AssertError(t, err, "negative cost")
+	_, err = NewTransaction(CertificatesPerDomain, "example.com", testLimit, 11)
+	test.AssertError(t, err, "cost greater than burst")
+}
+
+func TestSpendAndRefund(t *testing.T) {
+	l, clk := setupLimiter()
+	ctx := context.Background()
+
+	// Checking doesn't spend.
+	d, err := l.Check(ctx, newTestTxn(t, "1", 1))
+	test.AssertNotError(t, err, "checking")
+	test.Assert(t, d.Allowed, "should be allowed")
+	test.AssertEquals(t, d.Remaining, int64(9))
+	d, err = l.Check(ctx, newTestTxn(t, "1", 0))
+	test.AssertNotError(t, err, "checking")
+	test.AssertEquals(t, d.Remaining, int64(10))
+
+	// Spend the whole bucket.
+	d, err = l.Spend(ctx, newTestTxn(t, "1", 10))
+	test.AssertNotError(t, err, "spending")
+	test.Assert(t, d.Allowed, "should be allowed")
+	test.AssertEquals(t, d.Remaining, int64(0))
+
+	// The next spend is denied, and doesn't change the bucket.
+	d, err = l.Spend(ctx, newTestTxn(t, "1", 1))
+	test.AssertNotError(t, err, "spending")
+	test.Assert(t, !d.Allowed, "should not be allowed")
+	test.AssertEquals(t, d.RetryIn, 100*time.Millisecond)
+
+	// Other buckets are unaffected.
+	d, err = l.Spend(ctx, newTestTxn(t, "2", 1))
+	test.AssertNotError(t, err, "spending")
+	test.Assert(t, d.Allowed, "should be allowed")
+
+	// Refunding makes room for another request.
+	d, err = l.Refund(ctx, newTestTxn(t, "1", 1))
+	test.AssertNotError(t, err, "refunding")
+	test.Assert(t, d.Allowed, "should be allowed")
+	test.AssertEquals(t, d.Remaining, int64(1))
+	d, err = l.Spend(ctx, newTestTxn(t, "1", 1))
+	test.AssertNotError(t, err, "spending")
+	test.Assert(t, d.Allowed, "should be allowed")
+
+	// Refunding to a bucket which doesn't exist does nothing.
+	d, err = l.Refund(ctx, newTestTxn(t, "3", 1))
+	test.AssertNotError(t, err, "refunding")
+	test.Assert(t, !d.Allowed, "should not be allowed")
+	test.AssertEquals(t, d.Remaining, int64(10))
+
+	// Waiting refills the bucket.
+	clk.Add(time.Second)
+	d, err = l.Check(ctx, newTestTxn(t, "1", 0))
+	test.AssertNotError(t, err, "checking")
+	test.AssertEquals(t, d.Remaining, int64(10))
+
+	// Resetting refills the bucket immediately.
+	_, err = l.Spend(ctx, newTestTxn(t, "1", 10))
+	test.AssertNotError(t, err, "spending")
+	err = l.Reset(ctx, newTestTxn(t, "1", 0))
+	test.AssertNotError(t, err, "resetting")
+	d, err = l.Check(ctx, newTestTxn(t, "1", 0))
+	test.AssertNotError(t, err, "checking")
+	test.AssertEquals(t, d.Remaining, int64(10))
+}
+
+func TestBatchSpendAndRefund(t *testing.T) {
+	l, _ := setupLimiter()
+	ctx := context.Background()
+
+	_, err := l.BatchSpend(ctx, nil)
+	test.AssertError(t, err, "empty batch")
+	_, err = l.BatchSpend(ctx, []Transaction{newTestTxn(t, "1", 1), newTestTxn(t, "1", 1)})
+	test.AssertError(t, err, "duplicate bucket keys")
+
+	// Drain most of bucket "1".
+	_, err = l.Spend(ctx, newTestTxn(t, "1", 8))
+	test.AssertNotError(t, err, "spending")
+
+	// Checking a batch doesn't spend from any bucket.
+	d, err := l.BatchCheck(ctx, []Transaction{newTestTxn(t, "1", 2), newTestTxn(t, "2", 1)})
+	test.AssertNotError(t, err, "batch checking")
+	test.Assert(t, d.Allowed, "should be allowed")
+	test.AssertEquals(t, d.Remaining, int64(0))
+	d, err = l.Check(ctx, newTestTxn(t, "2", 0))
+	test.AssertNotError(t, err, "checking")
+	test.AssertEquals(t, d.Remaining, int64(10))
+
+	// A batch which fits is spent from every bucket, and reports the lowest
+	// remaining capacity.
+	d, err = l.BatchSpend(ctx, []Transaction{newTestTxn(t, "1", 1), newTestTxn(t, "2", 1), newTestTxn(t, "3", 1)})
+	test.AssertNotError(t, err, "batch spending")
+	test.Assert(t, d.Allowed, "should be allowed")
+	test.AssertEquals(t, d.Remaining, int64(1))
+	test.AssertEquals(t, d.ResetIn, 900*time.Millisecond)
+
+	// A batch where one bucket lacks capacity spends from none of them.
+	d, err = l.BatchSpend(ctx, []Transaction{newTestTxn(t, "1", 2), newTestTxn(t, "2", 2)})
+	test.AssertNotError(t, err, "batch spending")
+	test.Assert(t, !d.Allowed, "should not be allowed")
+	test.AssertEquals(t, d.RetryIn, 100*time.Millisecond)
+	d, err = l.Check(ctx, newTestTxn(t, "2", 0))
+	test.AssertNotError(t, err, "checking")
+	test.AssertEquals(t, d.Remaining, int64(9))
+
+	// Refunds are applied to every bucket that has something to refund.
+	d, err = l.BatchRefund(ctx, []Transaction{newTestTxn(t, "1", 1), newTestTxn(t, "2", 1), newTestTxn(t, "4", 1)})
+	test.AssertNotError(t, err, "batch refunding")
+	test.Assert(t, d.Allowed, "should be allowed")
+	test.AssertEquals(t, d.Remaining, int64(2))
+	d, err = l.Check(ctx, newTestTxn(t, "2", 0))
+	test.AssertNotError(t, err, "checking")
+	test.AssertEquals(t, d.Remaining, int64(10))
+}
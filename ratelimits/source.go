@@ -0,0 +1,83 @@
+package ratelimits
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrBucketNotFound indicates that the bucket was not found.
+var ErrBucketNotFound = errors.New("bucket not found")
+
+// source is an interface for storing and retrieving the Theoretical Arrival
+// Time (TAT) of each bucket. Buckets which have been refilled completely may be
+// forgotten by the source, since a missing bucket is treated as full.
+type source interface {
+	// BatchSet stores the TAT of each bucket key in the map. It is called
+	// once a spend or refund has been allowed.
+	BatchSet(ctx context.Context, bucketKeys map[string]time.Time) error
+
+	// Get retrieves the TAT of the given bucket key. If the bucket key does
+	// not exist, it returns ErrBucketNotFound.
+	Get(ctx context.Context, bucketKey string) (time.Time, error)
+
+	// BatchGet retrieves the TATs of the given bucket keys. Bucket keys which
+	// do not exist are omitted from the returned map.
+	BatchGet(ctx context.Context, bucketKeys []string) (map[string]time.Time, error)
+
+	// Delete removes the TAT of the given bucket key, resetting the bucket to
+	// full. Deleting a bucket key which does not exist is not an error.
+	Delete(ctx context.Context, bucketKey string) error
+}
+
+// InmemSource is an in-memory implementation of the source interface, for use
+// in tests of this package and of its users.
+type InmemSource struct {
+	sync.RWMutex
+	m map[string]time.Time
+}
+
+// NewInmemSource returns a new, empty InmemSource.
+func NewInmemSource() *InmemSource {
+	return &InmemSource{m: make(map[string]time.Time)}
+}
+
+func (in *InmemSource) BatchSet(_ context.Context, bucketKeys map[string]time.Time) error {
+	in.Lock()
+	defer in.Unlock()
+	for k, v := range bucketKeys {
+		in.m[k] = v
+	}
+	return nil
+}
+
+func (in *InmemSource) Get(_ context.Context, bucketKey string) (time.Time, error) {
+	in.RLock()
+	defer in.RUnlock()
+	tat, ok := in.m[bucketKey]
+	if !ok {
+		return time.Time{}, ErrBucketNotFound
+	}
+	return tat, nil
+}
+
+func (in *InmemSource) BatchGet(_ context.Context, bucketKeys []string) (map[string]time.Time, error) {
+	in.RLock()
+	defer in.RUnlock()
+	tats := make(map[string]time.Time, len(bucketKeys))
+	for _, k := range bucketKeys {
+		tat, ok := in.m[k]
+		if ok {
+			tats[k] = tat
+		}
+	}
+	return tats, nil
+}
+
+func (in *InmemSource) Delete(_ context.Context, bucketKey string) error {
+	in.Lock()
+	defer in.Unlock()
+	delete(in.m, bucketKey)
+	return nil
+}
@@ -0,0 +1,152 @@
+package ratelimits
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Compile-time check that RedisSource implements the source interface.
+var _ source = (*RedisSource)(nil)
+
+// RedisSource is a ratelimits source backed by a Redis cluster. Each bucket's
+// TAT is stored as the number of nanoseconds since the Unix epoch, and expires
+// when the bucket would be full again.
+type RedisSource struct {
+	client  *redis.ClusterClient
+	timeout time.Duration
+	clk     clock.Clock
+	latency *prometheus.HistogramVec
+}
+
+// NewRedisSource returns a new Redis backed source using the provided
+// *redis.ClusterClient, usually created by rocsp_config.MakeClusterClient. The
+// timeout applies to every call made to Redis.
+func NewRedisSource(client *redis.ClusterClient, timeout time.Duration, clk clock.Clock, stats prometheus.Registerer) *RedisSource {
+	latency := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "ratelimits_latency",
+			Help: "Histogram of Redis call latencies labeled by call=[set|get|delete] and result=[success|notFound|deadlineExceeded|canceled|failed]",
+			// Exponential buckets ranging from 0.0005s to 3s.
+			Buckets: prometheus.ExponentialBuckets(0.0005, 3, 8),
+		},
+		[]string{"call", "result"},
+	)
+	stats.MustRegister(latency)
+
+	return &RedisSource{
+		client:  client,
+		timeout: timeout,
+		clk:     clk,
+		latency: latency,
+	}
+}
+
+// resultForError returns a string representing the result of the operation
+// based on the provided error.
+func resultForError(err error) string {
+	switch {
+	case err == nil:
+		return "success"
+	case errors.Is(err, redis.Nil):
+		return "notFound"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "deadlineExceeded"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	}
+	return "failed"
+}
+
+func (r *RedisSource) observe(call string, start time.Time, err error) {
+	r.latency.With(prometheus.Labels{"call": call, "result": resultForError(err)}).Observe(r.clk.Since(start).Seconds())
+}
+
+// BatchSet stores the TAT of each bucket key in a single pipeline. Each key
+// expires once its TAT has passed, at which point the bucket is full and the
+// key is no longer needed.
+func (r *RedisSource) BatchSet(ctx context.Context, buckets map[string]time.Time) error {
+	start := r.clk.Now()
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	pipeline := r.client.Pipeline()
+	for bucketKey, tat := range buckets {
+		ttl := tat.Sub(start)
+		if ttl < time.Millisecond {
+			// An expiration of zero means no expiration to Redis.
+			ttl = time.Millisecond
+		}
+		pipeline.Set(ctx, bucketKey, tat.UnixNano(), ttl)
+	}
+	_, err := pipeline.Exec(ctx)
+	r.observe("set", start, err)
+	return err
+}
+
+// Get retrieves the TAT of the given bucket key, returning ErrBucketNotFound if
+// it doesn't exist.
+func (r *RedisSource) Get(ctx context.Context, bucketKey string) (time.Time, error) {
+	start := r.clk.Now()
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	tatNano, err := r.client.Get(ctx, bucketKey).Int64()
+	r.observe("get", start, err)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return time.Time{}, ErrBucketNotFound
+		}
+		return time.Time{}, err
+	}
+	return time.Unix(0, tatNano).UTC(), nil
+}
+
+// BatchGet retrieves the TATs of the given bucket keys in a single pipeline.
+// Bucket keys which don't exist are omitted from the returned map.
+func (r *RedisSource) BatchGet(ctx context.Context, bucketKeys []string) (map[string]time.Time, error) {
+	start := r.clk.Now()
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	pipeline := r.client.Pipeline()
+	for _, bucketKey := range bucketKeys {
+		pipeline.Get(ctx, bucketKey)
+	}
+	results, err := pipeline.Exec(ctx)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		r.observe("get", start, err)
+		return nil, err
+	}
+
+	tats := make(map[string]time.Time, len(bucketKeys))
+	for i, result := range results {
+		tatNano, err := result.(*redis.StringCmd).Int64()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				continue
+			}
+			r.observe("get", start, err)
+			return nil, err
+		}
+		tats[bucketKeys[i]] = time.Unix(0, tatNano).UTC()
+	}
+	r.observe("get", start, nil)
+	return tats, nil
+}
+
+// Delete removes the TAT of the given bucket key. UNLINK is used because DEL is
+// disabled in our Redis configuration.
+func (r *RedisSource) Delete(ctx context.Context, bucketKey string) error {
+	start := r.clk.Now()
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	err := r.client.Unlink(ctx, bucketKey).Err()
+	r.observe("delete", start, err)
+	return err
+}
@@ -0,0 +1,83 @@
+package ratelimits
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func newTestRedisSource(clk clock.Clock) *RedisSource {
+	CACertFile := "../test/redis-tls/minica.pem"
+	CertFile := "../test/redis-tls/boulder/cert.pem"
+	KeyFile := "../test/redis-tls/boulder/key.pem"
+	tlsConfig := cmd.TLSConfig{
+		CACertFile: &CACertFile,
+		CertFile:   &CertFile,
+		KeyFile:    &KeyFile,
+	}
+	tlsConfig2, err := tlsConfig.Load()
+	if err != nil {
+		panic(err)
+	}
+
+	client := redis.NewClusterClient(&redis.ClusterOptions{
+		Addrs:     []string{"10.33.33.2:4218"},
+		Username:  "unittest-rw",
+		Password:  "824968fa490f4ecec1e52d5e34916bdb60d45f8d",
+		TLSConfig: tlsConfig2,
+	})
+	return NewRedisSource(client, 5*time.Second, clk, metrics.NoopRegisterer)
+}
+
+func TestRedisSource(t *testing.T) {
+	// Redis expires keys using the real clock, so use it here too.
+	clk := clock.New()
+	src := newTestRedisSource(clk)
+	ctx := context.Background()
+
+	tat := clk.Now().Add(time.Minute).Truncate(time.Microsecond).UTC()
+	err := src.BatchSet(ctx, map[string]time.Time{
+		"test:redis-source:1": tat,
+		"test:redis-source:2": tat.Add(time.Second),
+	})
+	test.AssertNotError(t, err, "setting TATs")
+
+	got, err := src.Get(ctx, "test:redis-source:1")
+	test.AssertNotError(t, err, "getting TAT")
+	test.AssertEquals(t, got, tat)
+
+	tats, err := src.BatchGet(ctx, []string{"test:redis-source:1", "test:redis-source:2", "test:redis-source:missing"})
+	test.AssertNotError(t, err, "batch getting TATs")
+	test.AssertEquals(t, len(tats), 2)
+	test.AssertEquals(t, tats["test:redis-source:2"], tat.Add(time.Second))
+
+	_, err = src.Get(ctx, "test:redis-source:missing")
+	test.AssertErrorIs(t, err, ErrBucketNotFound)
+
+	err = src.Delete(ctx, "test:redis-source:1")
+	test.AssertNotError(t, err, "deleting TAT")
+	_, err = src.Get(ctx, "test:redis-source:1")
+	test.AssertErrorIs(t, err, ErrBucketNotFound)
+
+	// A Limiter backed by Redis spends and refunds like any other.
+	l := NewLimiter(clk, src, metrics.NoopRegisterer)
+	txn, err := NewTransaction(NewOrdersPerAccount, "redis-source-test", Limit{Burst: 2, Count: 1, Period: time.Hour}, 2)
+	test.AssertNotError(t, err, "creating transaction")
+	err = l.Reset(ctx, txn)
+	test.AssertNotError(t, err, "resetting")
+	d, err := l.Spend(ctx, txn)
+	test.AssertNotError(t, err, "spending")
+	test.Assert(t, d.Allowed, "should be allowed")
+	d, err = l.Spend(ctx, txn)
+	test.AssertNotError(t, err, "spending")
+	test.Assert(t, !d.Allowed, "should not be allowed")
+	d, err = l.Refund(ctx, txn)
+	test.AssertNotError(t, err, "refunding")
+	test.Assert(t, d.Allowed, "should be allowed")
+}
@@ -77,6 +77,18 @@ type RedisConfig struct {
 
 // MakeClient produces a *rocsp.WritingClient from a config.
 func MakeClient(c *RedisConfig, clk clock.Clock, stats prometheus.Registerer) (*rocsp.WritingClient, error) {
+	rdb, err := MakeClusterClient(c)
+	if err != nil {
+		return nil, err
+	}
+	return rocsp.NewWritingClient(rdb, c.Timeout.Duration, clk, stats), nil
+}
+
+// MakeClusterClient produces a *redis.ClusterClient which can both read and
+// write from a config. It is used by MakeClient, and by other components (such
+// as the ratelimits package) which keep their own data in the same Redis
+// cluster.
+func MakeClusterClient(c *RedisConfig) (*redis.ClusterClient, error) {
 	password, err := c.PasswordConfig.Pass()
 	if err != nil {
 		return nil, fmt.Errorf("loading password: %w", err)
@@ -87,9 +99,7 @@ func MakeClient(c *RedisConfig, clk clock.Clock, stats prometheus.Registerer) (*
 		return nil, fmt.Errorf("loading TLS config: %w", err)
 	}
 
-	timeout := c.Timeout.Duration
-
-	rdb := redis.NewClusterClient(&redis.ClusterOptions{
+	return redis.NewClusterClient(&redis.ClusterOptions{
 		Addrs:     c.Addrs,
 		Username:  c.Username,
 		Password:  password,
@@ -108,8 +118,7 @@ func MakeClient(c *RedisConfig, clk clock.Clock, stats prometheus.Registerer) (*
 		PoolTimeout:        c.PoolTimeout.Duration,
 		IdleTimeout:        c.IdleTimeout.Duration,
 		IdleCheckFrequency: c.IdleCheckFrequency.Duration,
-	})
-	return rocsp.NewWritingClient(rdb, timeout, clk, stats), nil
+	}), nil
 }
 
 // MakeReadClient produces a *rocsp.Client from a config.
@@ -0,0 +1 @@
+../../_db/migrations/20220120000000_RateLimitOverrides.sql
\ No newline at end of file
@@ -0,0 +1 @@
+../../_db/migrations/20220121000000_AccountIdentifierPolicies.sql
\ No newline at end of file
@@ -0,0 +1 @@
+../../_db/migrations/20220124000000_AccountSuspensions.sql
\ No newline at end of file
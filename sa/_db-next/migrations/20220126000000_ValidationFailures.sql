@@ -0,0 +1 @@
+../../_db/migrations/20220126000000_ValidationFailures.sql
\ No newline at end of file
@@ -0,0 +1 @@
+../../_db/migrations/20220128000000_OrderApprovals.sql
\ No newline at end of file
@@ -0,0 +1 @@
+../../_db/migrations/20220131000000_BlockedNames.sql
\ No newline at end of file
@@ -0,0 +1 @@
+../../_db/migrations/20220201000000_ReplicationHeartbeats.sql
\ No newline at end of file
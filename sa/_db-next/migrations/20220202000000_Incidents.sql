@@ -0,0 +1 @@
+../../_db/migrations/20220202000000_Incidents.sql
\ No newline at end of file
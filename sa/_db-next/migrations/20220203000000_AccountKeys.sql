@@ -0,0 +1 @@
+../../_db/migrations/20220203000000_AccountKeys.sql
\ No newline at end of file
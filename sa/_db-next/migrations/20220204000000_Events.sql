@@ -0,0 +1 @@
+../../_db/migrations/20220204000000_Events.sql
\ No newline at end of file
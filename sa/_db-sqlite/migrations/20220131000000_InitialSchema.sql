@@ -0,0 +1,292 @@
+-- The SQLite schema is equivalent to sa/_db's migrations up to and including
+-- 20220131000000_BlockedNames. Later migrations there need a counterpart
+-- here.
+--
+-- SQLite's index names share one namespace, so each is prefixed with its
+-- table. The MySQL schema's unique keys on serial columns were dropped when
+-- its tables were partitioned, so they are plain indexes here too.
+
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `authz2` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `identifierType` INTEGER NOT NULL,
+  `identifierValue` TEXT NOT NULL,
+  `registrationID` INTEGER NOT NULL,
+  `status` INTEGER NOT NULL,
+  `expires` DATETIME NOT NULL,
+  `challenges` INTEGER NOT NULL,
+  `attempted` INTEGER DEFAULT NULL,
+  `attemptedAt` DATETIME DEFAULT NULL,
+  `token` BLOB NOT NULL,
+  `validationError` BLOB DEFAULT NULL,
+  `validationRecord` BLOB DEFAULT NULL
+);
+CREATE INDEX `authz2_regID_expires_idx` ON `authz2` (`registrationID`,`status`,`expires`);
+CREATE INDEX `authz2_regID_identifier_status_expires_idx` ON `authz2` (`registrationID`,`identifierType`,`identifierValue`,`status`,`expires`);
+CREATE INDEX `authz2_expires_idx` ON `authz2` (`expires`);
+
+CREATE TABLE `blockedKeys` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `keyHash` BLOB NOT NULL UNIQUE,
+  `added` DATETIME NOT NULL,
+  `source` INTEGER NOT NULL,
+  `comment` TEXT DEFAULT NULL,
+  `revokedBy` INTEGER DEFAULT 0,
+  `extantCertificatesChecked` INTEGER DEFAULT 0
+);
+CREATE INDEX `blockedKeys_extantCertificatesChecked_idx` ON `blockedKeys` (`extantCertificatesChecked`);
+
+CREATE TABLE `certificateStatus` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `serial` TEXT NOT NULL UNIQUE,
+  `subscriberApproved` INTEGER DEFAULT 0,
+  `status` TEXT NOT NULL,
+  `ocspLastUpdated` DATETIME NOT NULL,
+  `revokedDate` DATETIME NOT NULL,
+  `revokedReason` INTEGER NOT NULL,
+  `lastExpirationNagSent` DATETIME NOT NULL,
+  `LockCol` INTEGER DEFAULT 0,
+  `ocspResponse` BLOB DEFAULT NULL,
+  `notAfter` DATETIME DEFAULT NULL,
+  `isExpired` INTEGER DEFAULT 0,
+  `issuerID` INTEGER DEFAULT NULL
+);
+CREATE INDEX `certificateStatus_isExpired_ocspLastUpdated_idx` ON `certificateStatus` (`isExpired`,`ocspLastUpdated`);
+CREATE INDEX `certificateStatus_notAfter_idx` ON `certificateStatus` (`notAfter`);
+
+CREATE TABLE `certificatesPerName` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `eTLDPlusOne` TEXT NOT NULL,
+  `time` DATETIME NOT NULL,
+  `count` INTEGER NOT NULL,
+  UNIQUE (`eTLDPlusOne`,`time`)
+);
+
+CREATE TABLE `crls` (
+  `serial` TEXT NOT NULL PRIMARY KEY,
+  `createdAt` DATETIME NOT NULL,
+  `crl` TEXT NOT NULL
+);
+
+CREATE TABLE `fqdnSets` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `setHash` BLOB NOT NULL,
+  `serial` TEXT NOT NULL,
+  `issued` DATETIME NOT NULL,
+  `expires` DATETIME NOT NULL
+);
+CREATE INDEX `fqdnSets_serial` ON `fqdnSets` (`serial`);
+CREATE INDEX `fqdnSets_setHash_issued_idx` ON `fqdnSets` (`setHash`,`issued`);
+
+CREATE TABLE `fqdnSets_old` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `setHash` BLOB NOT NULL,
+  `serial` TEXT NOT NULL UNIQUE,
+  `issued` DATETIME NOT NULL,
+  `expires` DATETIME NOT NULL
+);
+CREATE INDEX `fqdnSets_old_setHash_issued_idx` ON `fqdnSets_old` (`setHash`,`issued`);
+
+CREATE TABLE `issuedNames` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `reversedName` TEXT NOT NULL,
+  `notBefore` DATETIME NOT NULL,
+  `serial` TEXT NOT NULL,
+  `renewal` INTEGER NOT NULL DEFAULT 0
+);
+CREATE INDEX `issuedNames_reversedName_notBefore_Idx` ON `issuedNames` (`reversedName`,`notBefore`);
+CREATE INDEX `issuedNames_reversedName_renewal_notBefore_Idx` ON `issuedNames` (`reversedName`,`renewal`,`notBefore`);
+
+CREATE TABLE `keyHashToSerial` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `keyHash` BLOB NOT NULL,
+  `certNotAfter` DATETIME NOT NULL,
+  `certSerial` TEXT NOT NULL,
+  UNIQUE (`keyHash`,`certSerial`)
+);
+CREATE INDEX `keyHashToSerial_keyHash_certNotAfter` ON `keyHashToSerial` (`keyHash`,`certNotAfter`);
+
+CREATE TABLE `newOrdersRL` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `regID` INTEGER NOT NULL,
+  `time` DATETIME NOT NULL,
+  `count` INTEGER NOT NULL,
+  UNIQUE (`regID`,`time`)
+);
+
+CREATE TABLE `orderToAuthz2` (
+  `orderID` INTEGER NOT NULL,
+  `authzID` INTEGER NOT NULL,
+  PRIMARY KEY (`orderID`,`authzID`)
+);
+CREATE INDEX `orderToAuthz2_authzID` ON `orderToAuthz2` (`authzID`);
+
+CREATE TABLE `orders` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `registrationID` INTEGER NOT NULL,
+  `expires` DATETIME NOT NULL,
+  `error` BLOB DEFAULT NULL,
+  `certificateSerial` TEXT DEFAULT NULL,
+  `beganProcessing` INTEGER NOT NULL DEFAULT 0,
+  `created` DATETIME NOT NULL
+);
+CREATE INDEX `orders_reg_status_expires` ON `orders` (`registrationID`,`expires`);
+CREATE INDEX `orders_regID_created_idx` ON `orders` (`registrationID`,`created`);
+
+CREATE TABLE `registrations` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `jwk` BLOB NOT NULL,
+  `jwk_sha256` TEXT NOT NULL UNIQUE,
+  `contact` TEXT NOT NULL,
+  `agreement` TEXT NOT NULL,
+  `LockCol` INTEGER NOT NULL,
+  `initialIP` BLOB NOT NULL DEFAULT X'00000000000000000000000000000000',
+  `createdAt` DATETIME NOT NULL,
+  `status` TEXT NOT NULL DEFAULT 'valid'
+);
+CREATE INDEX `registrations_initialIP_createdAt` ON `registrations` (`initialIP`,`createdAt`);
+
+CREATE TABLE `certificates` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `registrationID` INTEGER NOT NULL,
+  `serial` TEXT NOT NULL,
+  `digest` TEXT NOT NULL,
+  `der` BLOB NOT NULL,
+  `issued` DATETIME NOT NULL,
+  `expires` DATETIME NOT NULL
+);
+CREATE INDEX `certificates_serial` ON `certificates` (`serial`);
+CREATE INDEX `certificates_regId_certificates_idx` ON `certificates` (`registrationID`);
+CREATE INDEX `certificates_issued_idx` ON `certificates` (`issued`);
+
+CREATE TABLE `orderFqdnSets` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `setHash` BLOB NOT NULL,
+  `orderID` INTEGER NOT NULL,
+  `registrationID` INTEGER NOT NULL,
+  `expires` DATETIME NOT NULL
+);
+CREATE INDEX `orderFqdnSets_setHash_expires_idx` ON `orderFqdnSets` (`setHash`,`expires`);
+CREATE INDEX `orderFqdnSets_orderID_idx` ON `orderFqdnSets` (`orderID`);
+CREATE INDEX `orderFqdnSets_registrationID_registrations` ON `orderFqdnSets` (`registrationID`);
+
+CREATE TABLE `precertificates` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `registrationID` INTEGER NOT NULL,
+  `serial` TEXT NOT NULL,
+  `der` BLOB NOT NULL,
+  `issued` DATETIME NOT NULL,
+  `expires` DATETIME NOT NULL
+);
+CREATE INDEX `precertificates_serial` ON `precertificates` (`serial`);
+CREATE INDEX `precertificates_regId_precertificates_idx` ON `precertificates` (`registrationID`);
+CREATE INDEX `precertificates_issued_precertificates_idx` ON `precertificates` (`issued`);
+
+CREATE TABLE `requestedNames` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `orderID` INTEGER NOT NULL,
+  `reversedName` TEXT NOT NULL
+);
+CREATE INDEX `requestedNames_orderID_idx` ON `requestedNames` (`orderID`);
+CREATE INDEX `requestedNames_reversedName_idx` ON `requestedNames` (`reversedName`);
+
+CREATE TABLE `serials` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `registrationID` INTEGER NOT NULL REFERENCES `registrations` (`id`),
+  `serial` TEXT NOT NULL UNIQUE,
+  `created` DATETIME NOT NULL,
+  `expires` DATETIME NOT NULL
+);
+CREATE INDEX `serials_regId_serials_idx` ON `serials` (`registrationID`);
+
+CREATE TABLE `rateLimitOverrides` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `limitName` TEXT NOT NULL,
+  `overrideKey` TEXT NOT NULL,
+  `registrationID` INTEGER NOT NULL,
+  `threshold` INTEGER NOT NULL,
+  `owner` TEXT NOT NULL,
+  `reason` TEXT NOT NULL,
+  `ticket` TEXT NOT NULL,
+  `created` DATETIME NOT NULL,
+  `expires` DATETIME NOT NULL
+);
+CREATE INDEX `rateLimitOverrides_expires_idx` ON `rateLimitOverrides` (`expires`);
+
+CREATE TABLE `accountIdentifierPolicies` (
+  `registrationID` INTEGER NOT NULL PRIMARY KEY,
+  `suffixes` TEXT NOT NULL,
+  `exactNames` TEXT NOT NULL,
+  `allowWildcards` INTEGER NOT NULL DEFAULT 0,
+  `updatedBy` TEXT NOT NULL,
+  `updated` DATETIME NOT NULL
+);
+
+CREATE TABLE `accountSuspensions` (
+  `registrationID` INTEGER NOT NULL PRIMARY KEY,
+  `reason` TEXT NOT NULL,
+  `suspendedBy` TEXT NOT NULL,
+  `created` DATETIME NOT NULL,
+  `expires` DATETIME NOT NULL
+);
+CREATE INDEX `accountSuspensions_expires_idx` ON `accountSuspensions` (`expires`);
+
+CREATE TABLE `validationFailures` (
+  `registrationID` INTEGER NOT NULL,
+  `identifier` TEXT NOT NULL,
+  `consecutiveFailures` INTEGER NOT NULL,
+  `lastFailure` DATETIME NOT NULL,
+  `pausedAt` DATETIME DEFAULT NULL,
+  PRIMARY KEY (`registrationID`, `identifier`)
+);
+CREATE INDEX `validationFailures_regID_pausedAt_idx` ON `validationFailures` (`registrationID`, `pausedAt`);
+
+CREATE TABLE `orderApprovals` (
+  `orderID` INTEGER NOT NULL PRIMARY KEY,
+  `registrationID` INTEGER NOT NULL,
+  `names` TEXT NOT NULL,
+  `csr` BLOB NOT NULL,
+  `status` TEXT NOT NULL,
+  `created` DATETIME NOT NULL,
+  `decidedBy` TEXT DEFAULT NULL,
+  `reason` TEXT DEFAULT NULL,
+  `decided` DATETIME DEFAULT NULL
+);
+CREATE INDEX `orderApprovals_status_created_idx` ON `orderApprovals` (`status`, `created`);
+
+CREATE TABLE `blockedNames` (
+  `reversedName` TEXT NOT NULL PRIMARY KEY,
+  `addedBy` TEXT NOT NULL,
+  `comment` TEXT NOT NULL,
+  `added` DATETIME NOT NULL
+);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `blockedNames`;
+DROP TABLE `orderApprovals`;
+DROP TABLE `validationFailures`;
+DROP TABLE `accountSuspensions`;
+DROP TABLE `accountIdentifierPolicies`;
+DROP TABLE `rateLimitOverrides`;
+DROP TABLE `serials`;
+DROP TABLE `requestedNames`;
+DROP TABLE `precertificates`;
+DROP TABLE `orderFqdnSets`;
+DROP TABLE `certificates`;
+DROP TABLE `registrations`;
+DROP TABLE `orders`;
+DROP TABLE `orderToAuthz2`;
+DROP TABLE `newOrdersRL`;
+DROP TABLE `keyHashToSerial`;
+DROP TABLE `issuedNames`;
+DROP TABLE `fqdnSets_old`;
+DROP TABLE `fqdnSets`;
+DROP TABLE `crls`;
+DROP TABLE `certificatesPerName`;
+DROP TABLE `certificateStatus`;
+DROP TABLE `blockedKeys`;
+DROP TABLE `authz2`;
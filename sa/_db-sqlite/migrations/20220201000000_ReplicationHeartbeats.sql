@@ -0,0 +1,12 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `replicationHeartbeats` (
+  `id` INTEGER NOT NULL PRIMARY KEY,
+  `beat` INTEGER NOT NULL
+);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `replicationHeartbeats`;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `incidents` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `name` TEXT NOT NULL UNIQUE,
+  `url` TEXT NOT NULL,
+  `renewBy` DATETIME NOT NULL,
+  `createdBy` TEXT NOT NULL,
+  `created` DATETIME NOT NULL
+);
+
+CREATE TABLE `incidentSerials` (
+  `incidentID` INTEGER NOT NULL,
+  `serial` TEXT NOT NULL,
+  `registrationID` INTEGER NOT NULL,
+  `lastNoticeSent` DATETIME DEFAULT NULL,
+  PRIMARY KEY (`incidentID`, `serial`)
+);
+CREATE INDEX `incidentSerials_serial_idx` ON `incidentSerials` (`serial`);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `incidentSerials`;
+DROP TABLE `incidents`;
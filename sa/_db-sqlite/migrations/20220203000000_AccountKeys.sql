@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `accountKeys` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `registrationID` INTEGER NOT NULL,
+  `keySHA256` TEXT NOT NULL,
+  `added` DATETIME DEFAULT NULL,
+  `replaced` DATETIME DEFAULT NULL
+);
+CREATE INDEX `accountKeys_registrationID_idx` ON `accountKeys` (`registrationID`);
+CREATE INDEX `accountKeys_keySHA256_idx` ON `accountKeys` (`keySHA256`);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `accountKeys`;
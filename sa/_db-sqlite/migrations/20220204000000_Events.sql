@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `events` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `eventType` TEXT NOT NULL,
+  `created` DATETIME NOT NULL,
+  `registrationID` INTEGER NOT NULL,
+  `serial` TEXT DEFAULT NULL,
+  `details` BLOB NOT NULL
+);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `events`;
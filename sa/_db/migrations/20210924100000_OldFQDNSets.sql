@@ -21,4 +21,4 @@ ALTER TABLE fqdnSets PARTITION BY RANGE(id) (
 -- +goose Down
 -- SQL section 'Down' is executed when this migration is rolled back
 
-DROP TABLE `fqdnSets_old`
+DROP TABLE `fqdnSets_old`;
@@ -0,0 +1,23 @@
+
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `rateLimitOverrides` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `limitName` varchar(255) NOT NULL,
+  `overrideKey` varchar(255) NOT NULL,
+  `registrationID` bigint(20) NOT NULL,
+  `threshold` bigint(20) NOT NULL,
+  `owner` varchar(255) NOT NULL,
+  `reason` varchar(1024) NOT NULL,
+  `ticket` varchar(255) NOT NULL,
+  `created` datetime NOT NULL,
+  `expires` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `expires_idx` (`expires`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `rateLimitOverrides`;
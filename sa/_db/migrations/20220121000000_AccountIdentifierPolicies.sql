@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `accountIdentifierPolicies` (
+  `registrationID` bigint(20) NOT NULL,
+  `suffixes` mediumtext NOT NULL,
+  `exactNames` mediumtext NOT NULL,
+  `allowWildcards` tinyint(1) NOT NULL DEFAULT 0,
+  `updatedBy` varchar(255) NOT NULL,
+  `updated` datetime NOT NULL,
+  PRIMARY KEY (`registrationID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `accountIdentifierPolicies`;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `validationFailures` (
+  `registrationID` bigint(20) NOT NULL,
+  `identifier` varchar(255) NOT NULL,
+  `consecutiveFailures` int(11) NOT NULL,
+  `lastFailure` datetime NOT NULL,
+  `pausedAt` datetime DEFAULT NULL,
+  PRIMARY KEY (`registrationID`, `identifier`),
+  KEY `regID_pausedAt_idx` (`registrationID`, `pausedAt`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `validationFailures`;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `orderApprovals` (
+  `orderID` bigint(20) NOT NULL,
+  `registrationID` bigint(20) NOT NULL,
+  `names` text NOT NULL,
+  `csr` mediumblob NOT NULL,
+  `status` varchar(16) NOT NULL,
+  `created` datetime NOT NULL,
+  `decidedBy` varchar(255) DEFAULT NULL,
+  `reason` varchar(1024) DEFAULT NULL,
+  `decided` datetime DEFAULT NULL,
+  PRIMARY KEY (`orderID`),
+  KEY `status_created_idx` (`status`, `created`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `orderApprovals`;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `blockedNames` (
+  `reversedName` varchar(253) CHARACTER SET ascii NOT NULL,
+  `addedBy` varchar(255) NOT NULL,
+  `comment` varchar(1024) NOT NULL,
+  `added` datetime NOT NULL,
+  PRIMARY KEY (`reversedName`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `blockedNames`;
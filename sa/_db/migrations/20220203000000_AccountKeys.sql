@@ -0,0 +1,18 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `accountKeys` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `registrationID` bigint(20) NOT NULL,
+  `keySHA256` varchar(255) NOT NULL,
+  `added` datetime DEFAULT NULL,
+  `replaced` datetime DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `registrationID_idx` (`registrationID`),
+  KEY `keySHA256_idx` (`keySHA256`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `accountKeys`;
@@ -0,0 +1,136 @@
+package sa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/db"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/policy"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetAccountIdentifierPolicy returns the identifier policy for the given
+// account. A NotFound error is returned if the account has no policy, in which
+// case it is unrestricted.
+func (ssa *SQLStorageAuthority) GetAccountIdentifierPolicy(ctx context.Context, req *sapb.RegistrationID) (*sapb.AccountIdentifierPolicy, error) {
+	if req == nil || req.Id == 0 {
+		return nil, errIncompleteRequest
+	}
+	var model accountIdentifierPolicyModel
+	err := ssa.reader(ctx, "GetAccountIdentifierPolicy").SelectOne(
+		&model,
+		`SELECT registrationID, suffixes, exactNames, allowWildcards, updatedBy, updated
+		FROM accountIdentifierPolicies
+		WHERE registrationID = ?`,
+		req.Id,
+	)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, berrors.NotFoundError("no identifier policy for registration ID %d", req.Id)
+		}
+		return nil, err
+	}
+	pb := &sapb.AccountIdentifierPolicy{
+		RegistrationID: model.RegistrationID,
+		AllowWildcards: model.AllowWildcards,
+		UpdatedBy:      model.UpdatedBy,
+		Updated:        model.Updated.UnixNano(),
+	}
+	err = json.Unmarshal([]byte(model.Suffixes), &pb.Suffixes)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal([]byte(model.ExactNames), &pb.ExactNames)
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// SetAccountIdentifierPolicy creates or replaces the identifier policy for the
+// given account.
+func (ssa *SQLStorageAuthority) SetAccountIdentifierPolicy(ctx context.Context, req *sapb.AccountIdentifierPolicy) (*emptypb.Empty, error) {
+	if req == nil || core.IsAnyNilOrZero(req.RegistrationID, req.UpdatedBy) {
+		return nil, errIncompleteRequest
+	}
+	err := policy.AccountPolicy{
+		Suffixes:       req.Suffixes,
+		ExactNames:     req.ExactNames,
+		AllowWildcards: req.AllowWildcards,
+	}.Validate()
+	if err != nil {
+		return nil, berrors.MalformedError("invalid account identifier policy: %s", err)
+	}
+	// Store empty lists as "[]" rather than "null" so that the column always
+	// holds a JSON array.
+	suffixes := req.Suffixes
+	if suffixes == nil {
+		suffixes = []string{}
+	}
+	exactNames := req.ExactNames
+	if exactNames == nil {
+		exactNames = []string{}
+	}
+	suffixesJSON, err := json.Marshal(suffixes)
+	if err != nil {
+		return nil, err
+	}
+	exactNamesJSON, err := json.Marshal(exactNames)
+	if err != nil {
+		return nil, err
+	}
+	_, err = ssa.dbMap.WithContext(ctx).Exec(
+		`INSERT INTO accountIdentifierPolicies
+		(registrationID, suffixes, exactNames, allowWildcards, updatedBy, updated)
+		VALUES (?, ?, ?, ?, ?, ?) `+
+			ssa.dialect.OnDuplicateKeyUpdate([]string{"registrationID"}, fmt.Sprintf(
+				`suffixes = %s,
+				exactNames = %s,
+				allowWildcards = %s,
+				updatedBy = %s,
+				updated = %s`,
+				ssa.dialect.Inserted("suffixes"),
+				ssa.dialect.Inserted("exactNames"),
+				ssa.dialect.Inserted("allowWildcards"),
+				ssa.dialect.Inserted("updatedBy"),
+				ssa.dialect.Inserted("updated"))),
+		req.RegistrationID,
+		string(suffixesJSON),
+		string(exactNamesJSON),
+		req.AllowWildcards,
+		req.UpdatedBy,
+		ssa.clk.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveAccountIdentifierPolicy deletes the identifier policy for the given
+// account, leaving it unrestricted. A NotFound error is returned if the account
+// has no policy.
+func (ssa *SQLStorageAuthority) RemoveAccountIdentifierPolicy(ctx context.Context, req *sapb.RegistrationID) (*emptypb.Empty, error) {
+	if req == nil || req.Id == 0 {
+		return nil, errIncompleteRequest
+	}
+	result, err := ssa.dbMap.WithContext(ctx).Exec(
+		`DELETE FROM accountIdentifierPolicies WHERE registrationID = ?`,
+		req.Id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, berrors.NotFoundError("no identifier policy for registration ID %d", req.Id)
+	}
+	return &emptypb.Empty{}, nil
+}
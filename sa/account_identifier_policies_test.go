@@ -0,0 +1,80 @@
+package sa
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestAccountIdentifierPolicies(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+	ctx := context.Background()
+
+	reg := createWorkingRegistration(t, sa)
+	regID := &sapb.RegistrationID{Id: reg.Id}
+
+	// An account without a policy is unrestricted.
+	_, err := sa.GetAccountIdentifierPolicy(ctx, regID)
+	test.AssertError(t, err, "got policy for unrestricted account")
+	test.Assert(t, errors.Is(err, berrors.NotFound), "wrong error type for missing policy")
+
+	// Invalid and incomplete policies are rejected.
+	_, err = sa.SetAccountIdentifierPolicy(ctx, &sapb.AccountIdentifierPolicy{
+		RegistrationID: reg.Id, UpdatedBy: "admin",
+	})
+	test.AssertError(t, err, "set empty policy")
+	test.Assert(t, errors.Is(err, berrors.Malformed), "wrong error type for empty policy")
+	_, err = sa.SetAccountIdentifierPolicy(ctx, &sapb.AccountIdentifierPolicy{
+		RegistrationID: reg.Id, Suffixes: []string{"example.com"},
+	})
+	test.AssertError(t, err, "set policy without updatedBy")
+
+	_, err = sa.SetAccountIdentifierPolicy(ctx, &sapb.AccountIdentifierPolicy{
+		RegistrationID: reg.Id,
+		Suffixes:       []string{"example.com"},
+		UpdatedBy:      "admin",
+	})
+	test.AssertNotError(t, err, "SetAccountIdentifierPolicy failed")
+
+	policy, err := sa.GetAccountIdentifierPolicy(ctx, regID)
+	test.AssertNotError(t, err, "GetAccountIdentifierPolicy failed")
+	test.AssertDeepEquals(t, policy.Suffixes, []string{"example.com"})
+	test.AssertEquals(t, len(policy.ExactNames), 0)
+	test.AssertEquals(t, policy.AllowWildcards, false)
+	test.AssertEquals(t, policy.UpdatedBy, "admin")
+	test.AssertEquals(t, policy.Updated, fc.Now().UnixNano())
+
+	// Setting a policy again replaces it.
+	fc.Add(time.Hour)
+	_, err = sa.SetAccountIdentifierPolicy(ctx, &sapb.AccountIdentifierPolicy{
+		RegistrationID: reg.Id,
+		Suffixes:       []string{"example.com", "zombo.com"},
+		ExactNames:     []string{"www.letsencrypt.org"},
+		AllowWildcards: true,
+		UpdatedBy:      "other-admin",
+	})
+	test.AssertNotError(t, err, "SetAccountIdentifierPolicy failed to replace policy")
+
+	policy, err = sa.GetAccountIdentifierPolicy(ctx, regID)
+	test.AssertNotError(t, err, "GetAccountIdentifierPolicy failed")
+	test.AssertDeepEquals(t, policy.Suffixes, []string{"example.com", "zombo.com"})
+	test.AssertDeepEquals(t, policy.ExactNames, []string{"www.letsencrypt.org"})
+	test.AssertEquals(t, policy.AllowWildcards, true)
+	test.AssertEquals(t, policy.UpdatedBy, "other-admin")
+	test.AssertEquals(t, policy.Updated, fc.Now().UnixNano())
+
+	_, err = sa.RemoveAccountIdentifierPolicy(ctx, regID)
+	test.AssertNotError(t, err, "RemoveAccountIdentifierPolicy failed")
+	_, err = sa.GetAccountIdentifierPolicy(ctx, regID)
+	test.Assert(t, errors.Is(err, berrors.NotFound), "policy still present after removal")
+
+	// Removing a policy which doesn't exist is NotFound.
+	_, err = sa.RemoveAccountIdentifierPolicy(ctx, regID)
+	test.Assert(t, errors.Is(err, berrors.NotFound), "wrong error type removing missing policy")
+}
@@ -0,0 +1,110 @@
+package sa
+
+import (
+	"context"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/db"
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// checkKeyReusable returns a BadPublicKey error if the key with the given
+// digest has ever belonged to an account which is now deactivated, revoked or
+// suspended. Such keys can't be used for new accounts or rolled over to, so
+// that a key which was given up, or taken away, doesn't come back under a
+// different account.
+func checkKeyReusable(s db.OneSelector, keySHA256 string, now time.Time) error {
+	var count int64
+	err := s.SelectOne(
+		&count,
+		`SELECT COUNT(*)
+		FROM accountKeys AS k
+		JOIN registrations AS r ON r.id = k.registrationID
+		LEFT JOIN accountSuspensions AS s ON s.registrationID = k.registrationID AND s.expires > ?
+		WHERE k.keySHA256 = ? AND (r.status IN (?, ?) OR s.registrationID IS NOT NULL)`,
+		now,
+		keySHA256,
+		string(core.StatusDeactivated),
+		string(core.StatusRevoked),
+	)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return berrors.BadPublicKeyError("key was previously used by a deactivated or suspended account")
+	}
+	return nil
+}
+
+// recordKeyRollover records in the account's key history that it has replaced
+// oldSHA256 with newSHA256. Accounts created before key history was recorded
+// have no entry for their old key, so one is added without an added time.
+func recordKeyRollover(tx db.Executor, regID int64, oldSHA256, newSHA256 string, now time.Time) error {
+	result, err := tx.Exec(
+		`UPDATE accountKeys SET replaced = ?
+		WHERE registrationID = ? AND keySHA256 = ? AND replaced IS NULL`,
+		now,
+		regID,
+		oldSHA256,
+	)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		err = tx.Insert(&accountKeyModel{
+			RegistrationID: regID,
+			KeySHA256:      oldSHA256,
+			Replaced:       &now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Insert(&accountKeyModel{
+		RegistrationID: regID,
+		KeySHA256:      newSHA256,
+		Added:          &now,
+	})
+}
+
+// GetAccountKeys returns the keys which an account has used or, if the request
+// has a key digest instead, the accounts which have used that key, oldest
+// first. Accounts created before key history was recorded have no history
+// until their key is first rolled over.
+func (ssa *SQLStorageAuthority) GetAccountKeys(ctx context.Context, req *sapb.GetAccountKeysRequest) (*sapb.AccountKeys, error) {
+	if req == nil || (req.RegistrationID == 0) == (req.KeySHA256 == "") {
+		return nil, errIncompleteRequest
+	}
+	query := `SELECT id, registrationID, keySHA256, added, replaced FROM accountKeys WHERE registrationID = ? ORDER BY id`
+	var arg interface{} = req.RegistrationID
+	if req.KeySHA256 != "" {
+		query = `SELECT id, registrationID, keySHA256, added, replaced FROM accountKeys WHERE keySHA256 = ? ORDER BY id`
+		arg = req.KeySHA256
+	}
+	var models []*accountKeyModel
+	_, err := ssa.reader(ctx, "GetAccountKeys").Select(&models, query, arg)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*sapb.AccountKey, len(models))
+	for i, m := range models {
+		key := &sapb.AccountKey{
+			RegistrationID: m.RegistrationID,
+			KeySHA256:      m.KeySHA256,
+		}
+		if m.Added != nil {
+			key.Added = m.Added.UnixNano()
+		}
+		if m.Replaced != nil {
+			key.Replaced = m.Replaced.UnixNano()
+		}
+		keys[i] = key
+	}
+	return &sapb.AccountKeys{Keys: keys}, nil
+}
@@ -0,0 +1,170 @@
+package sa
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+// newAccountKey returns a fresh JWK along with its digest.
+func newAccountKey(t *testing.T) ([]byte, string) {
+	t.Helper()
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+	jwk := jose.JSONWebKey{Key: k.Public()}
+	jwkJSON, err := jwk.MarshalJSON()
+	test.AssertNotError(t, err, "marshaling key")
+	digest, err := core.KeyDigestB64(jwk.Key)
+	test.AssertNotError(t, err, "computing key digest")
+	return jwkJSON, digest
+}
+
+func newAccountWithKey(t *testing.T, sa *SQLStorageAuthority, key []byte) (*corepb.Registration, error) {
+	t.Helper()
+	initialIP, _ := net.ParseIP("88.77.66.11").MarshalText()
+	return sa.NewRegistration(context.Background(), &corepb.Registration{
+		Key:       key,
+		InitialIP: initialIP,
+		Status:    string(core.StatusValid),
+	})
+}
+
+func rollOver(sa *SQLStorageAuthority, reg *corepb.Registration, key []byte) error {
+	reg.Key = key
+	_, err := sa.UpdateRegistration(context.Background(), reg)
+	return err
+}
+
+func TestAccountKeyHistory(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	_, err := sa.GetAccountKeys(ctx, &sapb.GetAccountKeysRequest{})
+	test.AssertEquals(t, err, errIncompleteRequest)
+	_, err = sa.GetAccountKeys(ctx, &sapb.GetAccountKeysRequest{RegistrationID: 1, KeySHA256: "digest"})
+	test.AssertEquals(t, err, errIncompleteRequest)
+
+	oldKey, oldDigest := newAccountKey(t)
+	newKey, newDigest := newAccountKey(t)
+	reg, err := newAccountWithKey(t, sa, oldKey)
+	test.AssertNotError(t, err, "NewRegistration failed")
+	created := fc.Now()
+
+	// Updating an account without changing its key doesn't touch its history.
+	reg.Contact = []string{"mailto:foo@example.com"}
+	_, err = sa.UpdateRegistration(ctx, reg)
+	test.AssertNotError(t, err, "UpdateRegistration failed")
+
+	fc.Add(time.Hour)
+	err = rollOver(sa, reg, newKey)
+	test.AssertNotError(t, err, "rolling over key")
+
+	keys, err := sa.GetAccountKeys(ctx, &sapb.GetAccountKeysRequest{RegistrationID: reg.Id})
+	test.AssertNotError(t, err, "GetAccountKeys failed")
+	test.AssertEquals(t, len(keys.Keys), 2)
+	test.AssertDeepEquals(t, keys.Keys[0], &sapb.AccountKey{
+		RegistrationID: reg.Id,
+		KeySHA256:      oldDigest,
+		Added:          created.UnixNano(),
+		Replaced:       fc.Now().UnixNano(),
+	})
+	test.AssertDeepEquals(t, keys.Keys[1], &sapb.AccountKey{
+		RegistrationID: reg.Id,
+		KeySHA256:      newDigest,
+		Added:          fc.Now().UnixNano(),
+	})
+
+	// The old key is free for another account while this one is in good
+	// standing, and is then recorded against both.
+	other, err := newAccountWithKey(t, sa, oldKey)
+	test.AssertNotError(t, err, "reusing a key from an account in good standing")
+	keys, err = sa.GetAccountKeys(ctx, &sapb.GetAccountKeysRequest{KeySHA256: oldDigest})
+	test.AssertNotError(t, err, "GetAccountKeys failed")
+	test.AssertEquals(t, len(keys.Keys), 2)
+	test.AssertEquals(t, keys.Keys[0].RegistrationID, reg.Id)
+	test.AssertEquals(t, keys.Keys[1].RegistrationID, other.Id)
+}
+
+func TestAccountKeyHistoryWithoutAddedKey(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	// Accounts created before key history was recorded have no entry for
+	// their key until it's rolled over.
+	oldKey, oldDigest := newAccountKey(t)
+	newKey, _ := newAccountKey(t)
+	reg, err := newAccountWithKey(t, sa, oldKey)
+	test.AssertNotError(t, err, "NewRegistration failed")
+	_, err = sa.dbMap.Exec("DELETE FROM accountKeys")
+	test.AssertNotError(t, err, "clearing key history")
+
+	err = rollOver(sa, reg, newKey)
+	test.AssertNotError(t, err, "rolling over key")
+	keys, err := sa.GetAccountKeys(ctx, &sapb.GetAccountKeysRequest{KeySHA256: oldDigest})
+	test.AssertNotError(t, err, "GetAccountKeys failed")
+	test.AssertEquals(t, len(keys.Keys), 1)
+	test.AssertDeepEquals(t, keys.Keys[0], &sapb.AccountKey{
+		RegistrationID: reg.Id,
+		KeySHA256:      oldDigest,
+		Replaced:       fc.Now().UnixNano(),
+	})
+}
+
+func TestAccountKeyReuse(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	// A key given up by a deactivated account can't be used again, by a new
+	// account or by rolling over to it.
+	deactivatedKey, _ := newAccountKey(t)
+	deactivated, err := newAccountWithKey(t, sa, deactivatedKey)
+	test.AssertNotError(t, err, "NewRegistration failed")
+	replacement, _ := newAccountKey(t)
+	err = rollOver(sa, deactivated, replacement)
+	test.AssertNotError(t, err, "rolling over key")
+	_, err = sa.DeactivateRegistration(ctx, &sapb.RegistrationID{Id: deactivated.Id})
+	test.AssertNotError(t, err, "DeactivateRegistration failed")
+
+	_, err = newAccountWithKey(t, sa, deactivatedKey)
+	test.Assert(t, errors.Is(err, berrors.BadPublicKey), "reused a deactivated account's key")
+	reg := createWorkingRegistration(t, sa)
+	err = rollOver(sa, reg, deactivatedKey)
+	test.Assert(t, errors.Is(err, berrors.BadPublicKey), "rolled over to a deactivated account's key")
+
+	// The same goes for a suspended account's current and old keys, until
+	// the suspension expires.
+	suspendedKey, _ := newAccountKey(t)
+	suspended, err := newAccountWithKey(t, sa, suspendedKey)
+	test.AssertNotError(t, err, "NewRegistration failed")
+	replacement, _ = newAccountKey(t)
+	err = rollOver(sa, suspended, replacement)
+	test.AssertNotError(t, err, "rolling over key")
+	_, err = sa.SuspendAccount(ctx, &sapb.AccountSuspension{
+		RegistrationID: suspended.Id,
+		Reason:         "abuse",
+		SuspendedBy:    "admin",
+		Expires:        fc.Now().Add(24 * time.Hour).UnixNano(),
+	})
+	test.AssertNotError(t, err, "SuspendAccount failed")
+
+	_, err = newAccountWithKey(t, sa, suspendedKey)
+	test.Assert(t, errors.Is(err, berrors.BadPublicKey), "reused a suspended account's old key")
+	_, err = newAccountWithKey(t, sa, replacement)
+	test.Assert(t, errors.Is(err, berrors.BadPublicKey), "reused a suspended account's current key")
+
+	fc.Add(48 * time.Hour)
+	_, err = newAccountWithKey(t, sa, suspendedKey)
+	test.AssertNotError(t, err, "reusing a key after the suspension expired")
+}
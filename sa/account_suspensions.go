@@ -0,0 +1,104 @@
+package sa
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/db"
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetAccountSuspension returns the suspension in effect for the given account.
+// A NotFound error is returned if the account isn't suspended, including when
+// its suspension has expired.
+func (ssa *SQLStorageAuthority) GetAccountSuspension(ctx context.Context, req *sapb.RegistrationID) (*sapb.AccountSuspension, error) {
+	if req == nil || req.Id == 0 {
+		return nil, errIncompleteRequest
+	}
+	var model accountSuspensionModel
+	err := ssa.reader(ctx, "GetAccountSuspension").SelectOne(
+		&model,
+		`SELECT registrationID, reason, suspendedBy, created, expires
+		FROM accountSuspensions
+		WHERE registrationID = ? AND expires > ?`,
+		req.Id,
+		ssa.clk.Now(),
+	)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, berrors.NotFoundError("registration ID %d is not suspended", req.Id)
+		}
+		return nil, err
+	}
+	return &sapb.AccountSuspension{
+		RegistrationID: model.RegistrationID,
+		Reason:         model.Reason,
+		SuspendedBy:    model.SuspendedBy,
+		Created:        model.Created.UnixNano(),
+		Expires:        model.Expires.UnixNano(),
+	}, nil
+}
+
+// SuspendAccount suspends the given account until the request's expiry,
+// replacing any suspension already in effect.
+func (ssa *SQLStorageAuthority) SuspendAccount(ctx context.Context, req *sapb.AccountSuspension) (*emptypb.Empty, error) {
+	if req == nil || core.IsAnyNilOrZero(req.RegistrationID, req.Reason, req.SuspendedBy, req.Expires) {
+		return nil, errIncompleteRequest
+	}
+	now := ssa.clk.Now().Truncate(time.Second)
+	expires := time.Unix(0, req.Expires)
+	if !expires.After(now) {
+		return nil, berrors.MalformedError("account suspension must expire in the future")
+	}
+	_, err := ssa.dbMap.WithContext(ctx).Exec(
+		`INSERT INTO accountSuspensions
+		(registrationID, reason, suspendedBy, created, expires)
+		VALUES (?, ?, ?, ?, ?) `+
+			ssa.dialect.OnDuplicateKeyUpdate([]string{"registrationID"}, fmt.Sprintf(
+				`reason = %s,
+				suspendedBy = %s,
+				created = %s,
+				expires = %s`,
+				ssa.dialect.Inserted("reason"),
+				ssa.dialect.Inserted("suspendedBy"),
+				ssa.dialect.Inserted("created"),
+				ssa.dialect.Inserted("expires"))),
+		req.RegistrationID,
+		req.Reason,
+		req.SuspendedBy,
+		now,
+		expires,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// UnsuspendAccount lifts the suspension in effect for the given account. A
+// NotFound error is returned if the account isn't suspended.
+func (ssa *SQLStorageAuthority) UnsuspendAccount(ctx context.Context, req *sapb.RegistrationID) (*emptypb.Empty, error) {
+	if req == nil || req.Id == 0 {
+		return nil, errIncompleteRequest
+	}
+	result, err := ssa.dbMap.WithContext(ctx).Exec(
+		`DELETE FROM accountSuspensions WHERE registrationID = ? AND expires > ?`,
+		req.Id,
+		ssa.clk.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, berrors.NotFoundError("registration ID %d is not suspended", req.Id)
+	}
+	return &emptypb.Empty{}, nil
+}
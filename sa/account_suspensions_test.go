@@ -0,0 +1,80 @@
+package sa
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestAccountSuspensions(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+	ctx := context.Background()
+
+	reg := createWorkingRegistration(t, sa)
+	regID := &sapb.RegistrationID{Id: reg.Id}
+
+	_, err := sa.GetAccountSuspension(ctx, regID)
+	test.Assert(t, errors.Is(err, berrors.NotFound), "new account is suspended")
+
+	// Incomplete and already expired suspensions are rejected.
+	_, err = sa.SuspendAccount(ctx, &sapb.AccountSuspension{
+		RegistrationID: reg.Id,
+		SuspendedBy:    "admin",
+		Expires:        fc.Now().Add(time.Hour).UnixNano(),
+	})
+	test.AssertError(t, err, "suspended account without a reason")
+	_, err = sa.SuspendAccount(ctx, &sapb.AccountSuspension{
+		RegistrationID: reg.Id,
+		Reason:         "abuse",
+		SuspendedBy:    "admin",
+		Expires:        fc.Now().Add(-time.Hour).UnixNano(),
+	})
+	test.AssertError(t, err, "suspended account with an expiry in the past")
+	test.Assert(t, errors.Is(err, berrors.Malformed), "wrong error type for past expiry")
+
+	_, err = sa.SuspendAccount(ctx, &sapb.AccountSuspension{
+		RegistrationID: reg.Id,
+		Reason:         "abuse",
+		SuspendedBy:    "admin",
+		Expires:        fc.Now().Add(24 * time.Hour).UnixNano(),
+	})
+	test.AssertNotError(t, err, "SuspendAccount failed")
+
+	suspension, err := sa.GetAccountSuspension(ctx, regID)
+	test.AssertNotError(t, err, "GetAccountSuspension failed")
+	test.AssertEquals(t, suspension.Reason, "abuse")
+	test.AssertEquals(t, suspension.SuspendedBy, "admin")
+	test.AssertEquals(t, suspension.Created, fc.Now().UnixNano())
+	test.AssertEquals(t, suspension.Expires, fc.Now().Add(24*time.Hour).UnixNano())
+
+	// Suspensions stop applying once they expire.
+	fc.Add(25 * time.Hour)
+	_, err = sa.GetAccountSuspension(ctx, regID)
+	test.Assert(t, errors.Is(err, berrors.NotFound), "expired suspension still in effect")
+	_, err = sa.UnsuspendAccount(ctx, regID)
+	test.Assert(t, errors.Is(err, berrors.NotFound), "unsuspended an account with an expired suspension")
+
+	// Suspending again replaces the expired suspension, and it can be lifted
+	// early.
+	_, err = sa.SuspendAccount(ctx, &sapb.AccountSuspension{
+		RegistrationID: reg.Id,
+		Reason:         "validation failures",
+		SuspendedBy:    "automatic",
+		Expires:        fc.Now().Add(24 * time.Hour).UnixNano(),
+	})
+	test.AssertNotError(t, err, "SuspendAccount failed to replace suspension")
+	suspension, err = sa.GetAccountSuspension(ctx, regID)
+	test.AssertNotError(t, err, "GetAccountSuspension failed")
+	test.AssertEquals(t, suspension.Reason, "validation failures")
+
+	_, err = sa.UnsuspendAccount(ctx, regID)
+	test.AssertNotError(t, err, "UnsuspendAccount failed")
+	_, err = sa.GetAccountSuspension(ctx, regID)
+	test.Assert(t, errors.Is(err, berrors.NotFound), "account still suspended after UnsuspendAccount")
+}
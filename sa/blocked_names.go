@@ -0,0 +1,101 @@
+package sa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/db"
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// AddBlockedName blocks issuance for a name and all of its subdomains. Blocking
+// a name which is already blocked is not an error, and leaves the original
+// entry in place.
+func (ssa *SQLStorageAuthority) AddBlockedName(ctx context.Context, req *sapb.AddBlockedNameRequest) (*emptypb.Empty, error) {
+	if req == nil || core.IsAnyNilOrZero(req.Name, req.AddedBy, req.Comment) {
+		return nil, errIncompleteRequest
+	}
+	name := strings.ToLower(req.Name)
+	if !strings.Contains(name, ".") || strings.HasPrefix(name, "*.") {
+		return nil, berrors.MalformedError("cannot block %q: only non-wildcard domains below a TLD can be blocked", req.Name)
+	}
+	err := ssa.dbMap.WithContext(ctx).Insert(&blockedNameModel{
+		ReversedName: ReverseName(name),
+		AddedBy:      req.AddedBy,
+		Comment:      req.Comment,
+		Added:        ssa.clk.Now(),
+	})
+	if err != nil {
+		if db.IsDuplicate(err) {
+			return &emptypb.Empty{}, nil
+		}
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CheckNamesBlocked returns those of the given names which are blocked, either
+// directly or because one of their parent domains is. Wildcard names are
+// checked using their base domain.
+func (ssa *SQLStorageAuthority) CheckNamesBlocked(ctx context.Context, req *sapb.Identifiers) (*sapb.Identifiers, error) {
+	if req == nil || len(req.Identifiers) == 0 {
+		return nil, errIncompleteRequest
+	}
+	// Every name is blocked by an entry for itself or any of its parent
+	// domains, so look up all of those at once.
+	var qmarks []string
+	var args []interface{}
+	seen := make(map[string]bool)
+	for _, name := range req.Identifiers {
+		for _, candidate := range blockCandidates(name) {
+			if seen[candidate] {
+				continue
+			}
+			seen[candidate] = true
+			qmarks = append(qmarks, "?")
+			args = append(args, candidate)
+		}
+	}
+
+	var blocked []string
+	_, err := ssa.reader(ctx, "CheckNamesBlocked").Select(
+		&blocked,
+		fmt.Sprintf(`SELECT reversedName FROM blockedNames WHERE reversedName IN (%s)`, strings.Join(qmarks, ",")),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	blockedSet := make(map[string]bool, len(blocked))
+	for _, reversed := range blocked {
+		blockedSet[reversed] = true
+	}
+
+	var matches []string
+	for _, name := range req.Identifiers {
+		for _, candidate := range blockCandidates(name) {
+			if blockedSet[candidate] {
+				matches = append(matches, name)
+				break
+			}
+		}
+	}
+	return &sapb.Identifiers{Identifiers: matches}, nil
+}
+
+// blockCandidates returns the reversed forms of the name and each of its
+// parent domains, e.g. "com.example.www" and "com.example" for
+// "www.example.com". Top-level domains aren't included since they can't be
+// blocked.
+func blockCandidates(name string) []string {
+	reversed := strings.Split(ReverseName(strings.TrimPrefix(strings.ToLower(name), "*.")), ".")
+	var candidates []string
+	for i := len(reversed); i >= 2; i-- {
+		candidates = append(candidates, strings.Join(reversed[:i], "."))
+	}
+	return candidates
+}
@@ -0,0 +1,54 @@
+package sa
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestBlockCandidates(t *testing.T) {
+	test.AssertDeepEquals(t, blockCandidates("www.Example.com"), []string{"com.example.www", "com.example"})
+	test.AssertDeepEquals(t, blockCandidates("*.example.com"), []string{"com.example"})
+	test.AssertEquals(t, len(blockCandidates("com")), 0)
+}
+
+func TestBlockedNames(t *testing.T) {
+	sa, _, cleanUp := initSA(t)
+	defer cleanUp()
+	ctx := context.Background()
+
+	_, err := sa.CheckNamesBlocked(ctx, &sapb.Identifiers{})
+	test.AssertEquals(t, err, errIncompleteRequest)
+	_, err = sa.AddBlockedName(ctx, &sapb.AddBlockedNameRequest{Name: "example.com", AddedBy: "admin"})
+	test.AssertEquals(t, err, errIncompleteRequest)
+	_, err = sa.AddBlockedName(ctx, &sapb.AddBlockedNameRequest{Name: "com", AddedBy: "admin", Comment: "oops"})
+	test.Assert(t, errors.Is(err, berrors.Malformed), "blocked a TLD")
+	_, err = sa.AddBlockedName(ctx, &sapb.AddBlockedNameRequest{Name: "*.example.com", AddedBy: "admin", Comment: "oops"})
+	test.Assert(t, errors.Is(err, berrors.Malformed), "blocked a wildcard")
+
+	names := &sapb.Identifiers{Identifiers: []string{"example.com", "www.example.com", "*.example.com", "example.net"}}
+	blocked, err := sa.CheckNamesBlocked(ctx, names)
+	test.AssertNotError(t, err, "CheckNamesBlocked failed")
+	test.AssertEquals(t, len(blocked.Identifiers), 0)
+
+	// Blocking a subdomain doesn't block its parent.
+	_, err = sa.AddBlockedName(ctx, &sapb.AddBlockedNameRequest{Name: "www.example.com", AddedBy: "admin", Comment: "phishing"})
+	test.AssertNotError(t, err, "AddBlockedName failed")
+	blocked, err = sa.CheckNamesBlocked(ctx, names)
+	test.AssertNotError(t, err, "CheckNamesBlocked failed")
+	test.AssertDeepEquals(t, blocked.Identifiers, []string{"www.example.com"})
+
+	// Blocking a domain blocks its subdomains and wildcards, and blocking it
+	// twice isn't an error.
+	for i := 0; i < 2; i++ {
+		_, err = sa.AddBlockedName(ctx, &sapb.AddBlockedNameRequest{Name: "Example.com", AddedBy: "admin", Comment: "court order"})
+		test.AssertNotError(t, err, "AddBlockedName failed")
+	}
+	blocked, err = sa.CheckNamesBlocked(ctx, names)
+	test.AssertNotError(t, err, "CheckNamesBlocked failed")
+	test.AssertDeepEquals(t, blocked.Identifiers, []string{"example.com", "www.example.com", "*.example.com"})
+}
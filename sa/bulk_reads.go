@@ -0,0 +1,365 @@
+package sa
+
+import (
+	"context"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// The methods in this file stream the results of the bulk reads made by
+// Boulder's offline tools, such as the expiration mailer and cert-checker, so
+// that the tools don't need their own database credentials. Each reads its
+// table a page at a time, in the order of an index, so that no single query
+// holds a long-running read.
+
+// streamPageSize is the number of rows the streaming reads in this file read
+// at a time. It's a variable so that tests can page through fewer rows.
+var streamPageSize = 1000
+
+// serialSender is the server stream of the SA methods which stream serials.
+type serialSender interface {
+	Send(*sapb.Serial) error
+	Context() context.Context
+}
+
+// streamSerials sends the serials selected by query a page at a time, in the
+// order of idColumn. The query must select the id and serial of each row and
+// end with a WHERE clause, to which the paging conditions are added. A serial
+// which the query selects more than once is only sent once.
+func (ssa *SQLStorageAuthority) streamSerials(stream serialSender, method string, idColumn string, query string, args ...interface{}) error {
+	reader := ssa.reader(stream.Context(), method)
+	sent := make(map[string]bool)
+	var after int64
+	for {
+		var page []struct {
+			ID     int64
+			Serial string
+		}
+		_, err := reader.Select(
+			&page,
+			query+` AND `+idColumn+` > ? ORDER BY `+idColumn+` LIMIT ?`,
+			append(args, after, streamPageSize)...,
+		)
+		if err != nil {
+			return err
+		}
+		for _, row := range page {
+			if sent[row.Serial] {
+				continue
+			}
+			sent[row.Serial] = true
+			err := stream.Send(&sapb.Serial{Serial: row.Serial})
+			if err != nil {
+				return err
+			}
+		}
+		if len(page) < streamPageSize {
+			return nil
+		}
+		after = page[len(page)-1].ID
+	}
+}
+
+// StreamCertificates streams the certificates issued at or after the
+// request's issuedAfter which expire at or after its expiresAfter, in the
+// order they were added.
+func (ssa *SQLStorageAuthority) StreamCertificates(req *sapb.StreamCertificatesRequest, stream sapb.StorageAuthority_StreamCertificatesServer) error {
+	if req == nil {
+		return errIncompleteRequest
+	}
+	reader := ssa.reader(stream.Context(), "StreamCertificates")
+	issuedAfter := time.Unix(0, req.IssuedAfter)
+	expiresAfter := time.Unix(0, req.ExpiresAfter)
+
+	// Find the first certificate using the issued index, rather than paging
+	// through every older certificate's ID.
+	firstID, err := reader.SelectNullInt(
+		`SELECT MIN(id) FROM certificates WHERE issued >= ? AND expires >= ?`,
+		issuedAfter,
+		expiresAfter,
+	)
+	if err != nil {
+		return err
+	}
+	if !firstID.Valid {
+		return nil
+	}
+	after := firstID.Int64 - 1
+	for {
+		var page []CertWithID
+		_, err := reader.Select(
+			&page,
+			`SELECT id, `+certFields+`
+			FROM certificates
+			WHERE id > ? AND issued >= ? AND expires >= ?
+			ORDER BY id
+			LIMIT ?`,
+			after,
+			issuedAfter,
+			expiresAfter,
+			streamPageSize,
+		)
+		if err != nil {
+			return err
+		}
+		for _, cert := range page {
+			err := stream.Send(bgrpc.CertToPB(cert.Certificate))
+			if err != nil {
+				return err
+			}
+		}
+		if len(page) < streamPageSize {
+			return nil
+		}
+		after = page[len(page)-1].ID
+	}
+}
+
+// StreamExpiringSerials streams the serials of up to the request's limit of
+// unrevoked certificates and precertificates which expire within the
+// request's range, soonest to expire first. Those whose last expiration nag
+// was sent within the request's nagWindow of their expiry are skipped.
+func (ssa *SQLStorageAuthority) StreamExpiringSerials(req *sapb.StreamExpiringSerialsRequest, stream sapb.StorageAuthority_StreamExpiringSerialsServer) error {
+	if req == nil || req.Expires == nil || core.IsAnyNilOrZero(req.Expires.Latest, req.Limit) {
+		return errIncompleteRequest
+	}
+	// The results are limited rather than paged, so they're read in a single
+	// query.
+	var serials []string
+	_, err := ssa.reader(stream.Context(), "StreamExpiringSerials").Select(
+		&serials,
+		`SELECT serial
+		FROM certificateStatus
+		WHERE notAfter > ?
+		AND notAfter <= ?
+		AND status != ?
+		AND COALESCE(`+ssa.dialect.SecondsBetween("lastExpirationNagSent", "notAfter")+` > ?, 1)
+		ORDER BY notAfter ASC
+		LIMIT ?`,
+		time.Unix(0, req.Expires.Earliest),
+		time.Unix(0, req.Expires.Latest),
+		string(core.OCSPStatusRevoked),
+		time.Duration(req.NagWindow).Seconds(),
+		req.Limit,
+	)
+	if err != nil {
+		return err
+	}
+	for _, serial := range serials {
+		err := stream.Send(&sapb.Serial{Serial: serial})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamRegistrationsWithContacts streams the registrations which have
+// contacts and a certificate which expires at or after the request's
+// expiresAfter, in order of their IDs. Their contacts are sent as stored, so
+// that malformed contacts can be audited.
+func (ssa *SQLStorageAuthority) StreamRegistrationsWithContacts(req *sapb.StreamRegistrationsWithContactsRequest, stream sapb.StorageAuthority_StreamRegistrationsWithContactsServer) error {
+	if req == nil {
+		return errIncompleteRequest
+	}
+	reader := ssa.reader(stream.Context(), "StreamRegistrationsWithContacts")
+	var after int64
+	for {
+		var page []struct {
+			ID        int64
+			Contact   []byte
+			CreatedAt time.Time
+		}
+		_, err := reader.Select(
+			&page,
+			`SELECT r.id, r.contact, r.createdAt
+			FROM registrations AS r
+			WHERE r.id > ?
+			AND r.contact NOT IN ('[]', 'null')
+			AND EXISTS (
+				SELECT c.id FROM certificates AS c
+				WHERE c.registrationID = r.id AND c.expires >= ?
+			)
+			ORDER BY r.id
+			LIMIT ?`,
+			after,
+			time.Unix(0, req.ExpiresAfter),
+			streamPageSize,
+		)
+		if err != nil {
+			return err
+		}
+		for _, reg := range page {
+			err := stream.Send(&sapb.RegistrationContacts{
+				Id:        reg.ID,
+				Contact:   reg.Contact,
+				CreatedAt: reg.CreatedAt.UnixNano(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(page) < streamPageSize {
+			return nil
+		}
+		after = page[len(page)-1].ID
+	}
+}
+
+// StreamRegistrationsWithNames streams, in order of their IDs, the
+// registrations with a certificate which expires at or after the request's
+// expiresAfter, each with one of the names in their certificates. If the
+// request has a name, only certificates for that name count.
+func (ssa *SQLStorageAuthority) StreamRegistrationsWithNames(req *sapb.StreamRegistrationsWithNamesRequest, stream sapb.StorageAuthority_StreamRegistrationsWithNamesServer) error {
+	if req == nil || req.ExpiresAfter == 0 {
+		return errIncompleteRequest
+	}
+	nameClause := ""
+	var nameArgs []interface{}
+	if req.Name != "" {
+		nameClause = ` AND n.reversedName = ?`
+		nameArgs = append(nameArgs, ReverseName(req.Name))
+	}
+
+	reader := ssa.reader(stream.Context(), "StreamRegistrationsWithNames")
+	var after int64
+	for {
+		args := append([]interface{}{after, time.Unix(0, req.ExpiresAfter)}, nameArgs...)
+		args = append(args, streamPageSize)
+		var page []struct {
+			RegistrationID int64
+			ReversedName   string
+		}
+		_, err := reader.Select(
+			&page,
+			`SELECT c.registrationID, MIN(n.reversedName) AS reversedName
+			FROM certificates AS c
+			JOIN issuedNames AS n ON n.serial = c.serial
+			WHERE c.registrationID > ? AND c.expires >= ?`+nameClause+`
+			GROUP BY c.registrationID
+			ORDER BY c.registrationID
+			LIMIT ?`,
+			args...,
+		)
+		if err != nil {
+			return err
+		}
+		for _, reg := range page {
+			err := stream.Send(&sapb.RegistrationName{
+				RegistrationID: reg.RegistrationID,
+				Name:           ReverseName(reg.ReversedName),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(page) < streamPageSize {
+			return nil
+		}
+		after = page[len(page)-1].RegistrationID
+	}
+}
+
+// StreamUncheckedBlockedKeys streams the blocked keys which haven't yet been
+// checked for unrevoked certificates, in the order they were blocked.
+func (ssa *SQLStorageAuthority) StreamUncheckedBlockedKeys(_ *emptypb.Empty, stream sapb.StorageAuthority_StreamUncheckedBlockedKeysServer) error {
+	reader := ssa.reader(stream.Context(), "StreamUncheckedBlockedKeys")
+	var after int64
+	for {
+		var page []struct {
+			ID        int64
+			KeyHash   []byte
+			RevokedBy int64
+		}
+		_, err := reader.Select(
+			&page,
+			`SELECT id, keyHash, revokedBy
+			FROM blockedKeys
+			WHERE extantCertificatesChecked = ? AND id > ?
+			ORDER BY id
+			LIMIT ?`,
+			false,
+			after,
+			streamPageSize,
+		)
+		if err != nil {
+			return err
+		}
+		for _, key := range page {
+			err := stream.Send(&sapb.BlockedKey{KeyHash: key.KeyHash, RevokedBy: key.RevokedBy})
+			if err != nil {
+				return err
+			}
+		}
+		if len(page) < streamPageSize {
+			return nil
+		}
+		after = page[len(page)-1].ID
+	}
+}
+
+// StreamUnrevokedCertificatesByKey streams the unexpired, unrevoked
+// precertificates whose public key has the given SPKI hash. Each final
+// certificate has the same serial as its precertificate, so revoking the
+// precertificates revokes both.
+func (ssa *SQLStorageAuthority) StreamUnrevokedCertificatesByKey(req *sapb.SPKIHash, stream sapb.StorageAuthority_StreamUnrevokedCertificatesByKeyServer) error {
+	if req == nil || len(req.KeyHash) != 32 {
+		return errIncompleteRequest
+	}
+	reader := ssa.reader(stream.Context(), "StreamUnrevokedCertificatesByKey")
+	var after int64
+	for {
+		var page []struct {
+			ID         int64
+			CertSerial string
+		}
+		_, err := reader.Select(
+			&page,
+			`SELECT id, certSerial
+			FROM keyHashToSerial
+			WHERE keyHash = ? AND id > ? AND certNotAfter > ?
+			ORDER BY id
+			LIMIT ?`,
+			req.KeyHash,
+			after,
+			ssa.clk.Now(),
+			streamPageSize,
+		)
+		if err != nil {
+			return err
+		}
+		for _, serial := range page {
+			var cert struct {
+				core.Certificate
+				Status    core.OCSPStatus
+				IsExpired bool
+			}
+			err := reader.SelectOne(
+				&cert,
+				`SELECT c.registrationID, c.serial, c.der, c.issued, c.expires, cs.status, cs.isExpired
+				FROM certificateStatus AS cs
+				JOIN precertificates AS c ON cs.serial = c.serial
+				WHERE cs.serial = ?`,
+				serial.CertSerial,
+			)
+			if err != nil {
+				return err
+			}
+			if cert.IsExpired || cert.Status == core.OCSPStatusRevoked {
+				continue
+			}
+			err = stream.Send(bgrpc.CertToPB(cert.Certificate))
+			if err != nil {
+				return err
+			}
+		}
+		if len(page) < streamPageSize {
+			return nil
+		}
+		after = page[len(page)-1].ID
+	}
+}
@@ -0,0 +1,406 @@
+package sa
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/features"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// serverStream is the part of a test's server stream which doesn't depend on
+// the type sent.
+type serverStream struct {
+	grpc.ServerStream
+}
+
+func (s serverStream) Context() context.Context {
+	return context.Background()
+}
+
+type certificateStream struct {
+	serverStream
+	sent []*corepb.Certificate
+}
+
+func (s *certificateStream) Send(cert *corepb.Certificate) error {
+	s.sent = append(s.sent, cert)
+	return nil
+}
+
+type serialStream struct {
+	serverStream
+	sent []string
+}
+
+func (s *serialStream) Send(serial *sapb.Serial) error {
+	s.sent = append(s.sent, serial.Serial)
+	return nil
+}
+
+type registrationContactsStream struct {
+	serverStream
+	sent []*sapb.RegistrationContacts
+}
+
+func (s *registrationContactsStream) Send(reg *sapb.RegistrationContacts) error {
+	s.sent = append(s.sent, reg)
+	return nil
+}
+
+type registrationNameStream struct {
+	serverStream
+	sent []*sapb.RegistrationName
+}
+
+func (s *registrationNameStream) Send(reg *sapb.RegistrationName) error {
+	s.sent = append(s.sent, reg)
+	return nil
+}
+
+type blockedKeyStream struct {
+	serverStream
+	sent []*sapb.BlockedKey
+}
+
+func (s *blockedKeyStream) Send(key *sapb.BlockedKey) error {
+	s.sent = append(s.sent, key)
+	return nil
+}
+
+func certSerials(certs []*corepb.Certificate) []string {
+	var serials []string
+	for _, cert := range certs {
+		serials = append(serials, cert.Serial)
+	}
+	return serials
+}
+
+// insertBulkCert inserts a certificate row, and an issuedNames row for each of
+// names, for the bulk read tests.
+func insertBulkCert(t *testing.T, sa *SQLStorageAuthority, regID int64, serial string, issued, expires time.Time, names ...string) {
+	t.Helper()
+	err := sa.dbMap.Insert(&core.Certificate{
+		RegistrationID: regID,
+		Serial:         serial,
+		Digest:         "digest",
+		DER:            []byte{1},
+		Issued:         issued,
+		Expires:        expires,
+	})
+	test.AssertNotError(t, err, "inserting certificate")
+	for _, name := range names {
+		err := sa.dbMap.Insert(&issuedNameModel{
+			ReversedName: ReverseName(name),
+			NotBefore:    issued,
+			Serial:       serial,
+		})
+		test.AssertNotError(t, err, "inserting issued name")
+	}
+}
+
+func TestStreamCertificates(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	now := fc.Now()
+	insertBulkCert(t, sa, 1, "old", now.Add(-48*time.Hour), now.Add(time.Hour))
+	insertBulkCert(t, sa, 1, "expired", now.Add(-2*time.Hour), now.Add(-time.Hour))
+	insertBulkCert(t, sa, 1, "recent", now.Add(-2*time.Hour), now.Add(time.Hour))
+	insertBulkCert(t, sa, 2, "newest", now, now.Add(time.Hour))
+
+	stream := func(req *sapb.StreamCertificatesRequest) []string {
+		t.Helper()
+		s := &certificateStream{}
+		err := sa.StreamCertificates(req, s)
+		test.AssertNotError(t, err, "StreamCertificates failed")
+		return certSerials(s.sent)
+	}
+	req := &sapb.StreamCertificatesRequest{
+		IssuedAfter:  now.Add(-24 * time.Hour).UnixNano(),
+		ExpiresAfter: now.UnixNano(),
+	}
+	test.AssertDeepEquals(t, stream(req), []string{"recent", "newest"})
+	test.AssertDeepEquals(t, stream(&sapb.StreamCertificatesRequest{IssuedAfter: req.IssuedAfter}), []string{"expired", "recent", "newest"})
+	test.AssertEquals(t, len(stream(&sapb.StreamCertificatesRequest{IssuedAfter: now.Add(time.Hour).UnixNano()})), 0)
+
+	defer func(pageSize int) { streamPageSize = pageSize }(streamPageSize)
+	streamPageSize = 1
+	test.AssertDeepEquals(t, stream(req), []string{"recent", "newest"})
+}
+
+func TestStreamExpiringSerials(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	now := fc.Now()
+	for _, row := range []struct {
+		serial   string
+		notAfter time.Time
+		lastNag  time.Time
+		status   core.OCSPStatus
+	}{
+		{"later", now.Add(10 * 24 * time.Hour), time.Time{}, core.OCSPStatusGood},
+		{"soon", now.Add(5 * 24 * time.Hour), time.Time{}, core.OCSPStatusGood},
+		{"naggedLongAgo", now.Add(6 * 24 * time.Hour), now.Add(-20 * 24 * time.Hour), core.OCSPStatusGood},
+		{"naggedRecently", now.Add(7 * 24 * time.Hour), now.Add(-time.Hour), core.OCSPStatusGood},
+		{"revoked", now.Add(5 * 24 * time.Hour), time.Time{}, core.OCSPStatusRevoked},
+		{"outsideRange", now.Add(30 * 24 * time.Hour), time.Time{}, core.OCSPStatusGood},
+	} {
+		err := sa.dbMap.Insert(&core.CertificateStatus{
+			Serial:                row.serial,
+			Status:                row.status,
+			LastExpirationNagSent: row.lastNag,
+			NotAfter:              row.notAfter,
+		})
+		test.AssertNotError(t, err, "inserting certificate status")
+	}
+
+	stream := func(req *sapb.StreamExpiringSerialsRequest) []string {
+		t.Helper()
+		s := &serialStream{}
+		err := sa.StreamExpiringSerials(req, s)
+		test.AssertNotError(t, err, "StreamExpiringSerials failed")
+		return s.sent
+	}
+	err := sa.StreamExpiringSerials(&sapb.StreamExpiringSerialsRequest{Limit: 10}, &serialStream{})
+	test.AssertEquals(t, err, errIncompleteRequest)
+
+	req := &sapb.StreamExpiringSerialsRequest{
+		Expires:   &sapb.Range{Earliest: now.UnixNano(), Latest: now.Add(15 * 24 * time.Hour).UnixNano()},
+		NagWindow: (15 * 24 * time.Hour).Nanoseconds(),
+		Limit:     10,
+	}
+	test.AssertDeepEquals(t, stream(req), []string{"soon", "naggedLongAgo", "later"})
+	req.Limit = 1
+	test.AssertDeepEquals(t, stream(req), []string{"soon"})
+
+	// Once a nag has been sent, the certificate isn't streamed again.
+	_, err = sa.MarkExpirationNagSent(ctx, &sapb.MarkExpirationNagSentRequest{Serial: "soon"})
+	test.AssertEquals(t, err, errIncompleteRequest)
+	_, err = sa.MarkExpirationNagSent(ctx, &sapb.MarkExpirationNagSentRequest{Serial: "soon", Sent: now.UnixNano()})
+	test.AssertNotError(t, err, "MarkExpirationNagSent failed")
+	req.Limit = 10
+	test.AssertDeepEquals(t, stream(req), []string{"naggedLongAgo", "later"})
+}
+
+func TestStreamRegistrationsWithContacts(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	now := fc.Now()
+	withCert := createWorkingRegistration(t, sa)
+	insertBulkCert(t, sa, withCert.Id, "1", now, now.Add(time.Hour))
+	key, _ := newAccountKey(t)
+	withExpiredCert, err := newAccountWithKey(t, sa, key)
+	test.AssertNotError(t, err, "creating registration")
+	_, err = sa.dbMap.Exec("UPDATE registrations SET contact = ? WHERE id = ?", `["mailto:expired@example.com"]`, withExpiredCert.Id)
+	test.AssertNotError(t, err, "setting contact")
+	insertBulkCert(t, sa, withExpiredCert.Id, "2", now.Add(-2*time.Hour), now.Add(-time.Hour))
+	key, _ = newAccountKey(t)
+	withoutContacts, err := newAccountWithKey(t, sa, key)
+	test.AssertNotError(t, err, "creating registration")
+	insertBulkCert(t, sa, withoutContacts.Id, "3", now, now.Add(time.Hour))
+	key, _ = newAccountKey(t)
+	_, err = newAccountWithKey(t, sa, key)
+	test.AssertNotError(t, err, "creating registration")
+
+	stream := func(req *sapb.StreamRegistrationsWithContactsRequest) []*sapb.RegistrationContacts {
+		t.Helper()
+		s := &registrationContactsStream{}
+		err := sa.StreamRegistrationsWithContacts(req, s)
+		test.AssertNotError(t, err, "StreamRegistrationsWithContacts failed")
+		return s.sent
+	}
+	regs := stream(&sapb.StreamRegistrationsWithContactsRequest{})
+	test.AssertEquals(t, len(regs), 2)
+	test.AssertEquals(t, regs[0].Id, withCert.Id)
+	test.AssertEquals(t, string(regs[0].Contact), `["mailto:foo@example.com"]`)
+	test.AssertEquals(t, regs[0].CreatedAt, withCert.CreatedAt)
+	test.AssertEquals(t, regs[1].Id, withExpiredCert.Id)
+
+	regs = stream(&sapb.StreamRegistrationsWithContactsRequest{ExpiresAfter: now.UnixNano()})
+	test.AssertEquals(t, len(regs), 1)
+	test.AssertEquals(t, regs[0].Id, withCert.Id)
+
+	defer func(pageSize int) { streamPageSize = pageSize }(streamPageSize)
+	streamPageSize = 1
+	test.AssertEquals(t, len(stream(&sapb.StreamRegistrationsWithContactsRequest{})), 2)
+}
+
+func TestStreamRegistrationsWithNames(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	now := fc.Now()
+	insertBulkCert(t, sa, 1, "1", now, now.Add(time.Hour), "www.example.com", "example.com")
+	insertBulkCert(t, sa, 1, "2", now, now.Add(time.Hour), "example.net")
+	insertBulkCert(t, sa, 2, "3", now, now.Add(time.Hour), "example.net")
+	insertBulkCert(t, sa, 3, "4", now.Add(-2*time.Hour), now.Add(-time.Hour), "example.com")
+
+	stream := func(req *sapb.StreamRegistrationsWithNamesRequest) []string {
+		t.Helper()
+		s := &registrationNameStream{}
+		err := sa.StreamRegistrationsWithNames(req, s)
+		test.AssertNotError(t, err, "StreamRegistrationsWithNames failed")
+		var results []string
+		for _, reg := range s.sent {
+			results = append(results, fmt.Sprintf("%d:%s", reg.RegistrationID, reg.Name))
+		}
+		return results
+	}
+	err := sa.StreamRegistrationsWithNames(&sapb.StreamRegistrationsWithNamesRequest{}, &registrationNameStream{})
+	test.AssertEquals(t, err, errIncompleteRequest)
+
+	req := &sapb.StreamRegistrationsWithNamesRequest{ExpiresAfter: now.UnixNano()}
+	test.AssertDeepEquals(t, stream(req), []string{"1:example.com", "2:example.net"})
+	req.Name = "example.net"
+	test.AssertDeepEquals(t, stream(req), []string{"1:example.net", "2:example.net"})
+	req.Name = "example.com"
+	test.AssertDeepEquals(t, stream(req), []string{"1:example.com"})
+	req.ExpiresAfter = now.Add(-2 * time.Hour).UnixNano()
+	test.AssertDeepEquals(t, stream(req), []string{"1:example.com", "3:example.com"})
+
+	defer func(pageSize int) { streamPageSize = pageSize }(streamPageSize)
+	streamPageSize = 1
+	req.Name = ""
+	test.AssertDeepEquals(t, stream(req), []string{"1:example.com", "2:example.net", "3:example.com"})
+}
+
+func TestBlockedKeyStreams(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	err := features.Set(map[string]bool{"StoreRevokerInfo": true})
+	test.AssertNotError(t, err, "failed to set features")
+	defer features.Reset()
+
+	keyHash := make([]byte, 32)
+	keyHash[0] = 1
+	otherKeyHash := make([]byte, 32)
+	otherKeyHash[0] = 2
+	for _, hash := range [][]byte{keyHash, otherKeyHash} {
+		_, err = sa.AddBlockedKey(ctx, &sapb.AddBlockedKeyRequest{
+			KeyHash:   hash,
+			Added:     fc.Now().UnixNano(),
+			Source:    "API",
+			RevokedBy: 3,
+		})
+		test.AssertNotError(t, err, "AddBlockedKey failed")
+	}
+
+	streamKeys := func() []*sapb.BlockedKey {
+		t.Helper()
+		s := &blockedKeyStream{}
+		err := sa.StreamUncheckedBlockedKeys(&emptypb.Empty{}, s)
+		test.AssertNotError(t, err, "StreamUncheckedBlockedKeys failed")
+		return s.sent
+	}
+	keys := streamKeys()
+	test.AssertEquals(t, len(keys), 2)
+	test.AssertByteEquals(t, keys[0].KeyHash, keyHash)
+	test.AssertEquals(t, keys[0].RevokedBy, int64(3))
+
+	_, err = sa.MarkBlockedKeyChecked(ctx, &sapb.SPKIHash{KeyHash: []byte{1}})
+	test.AssertEquals(t, err, errIncompleteRequest)
+	_, err = sa.MarkBlockedKeyChecked(ctx, &sapb.SPKIHash{KeyHash: keyHash})
+	test.AssertNotError(t, err, "MarkBlockedKeyChecked failed")
+	keys = streamKeys()
+	test.AssertEquals(t, len(keys), 1)
+	test.AssertByteEquals(t, keys[0].KeyHash, otherKeyHash)
+
+	now := fc.Now()
+	for _, row := range []struct {
+		serial    string
+		keyHash   []byte
+		notAfter  time.Time
+		status    core.OCSPStatus
+		isExpired bool
+	}{
+		{"good", keyHash, now.Add(time.Hour), core.OCSPStatusGood, false},
+		{"revoked", keyHash, now.Add(time.Hour), core.OCSPStatusRevoked, false},
+		{"markedExpired", keyHash, now.Add(time.Hour), core.OCSPStatusGood, true},
+		{"expired", keyHash, now.Add(-time.Hour), core.OCSPStatusGood, false},
+		{"otherKey", otherKeyHash, now.Add(time.Hour), core.OCSPStatusGood, false},
+		{"alsoGood", keyHash, now.Add(time.Hour), core.OCSPStatusGood, false},
+	} {
+		err := sa.dbMap.Insert(&keyHashModel{
+			KeyHash:      row.keyHash,
+			CertNotAfter: row.notAfter,
+			CertSerial:   row.serial,
+		})
+		test.AssertNotError(t, err, "inserting key hash")
+		err = sa.dbMap.Insert(&precertificateModel{
+			Serial:         row.serial,
+			RegistrationID: 1,
+			DER:            []byte(row.serial),
+			Issued:         now,
+			Expires:        row.notAfter,
+		})
+		test.AssertNotError(t, err, "inserting precertificate")
+		err = sa.dbMap.Insert(&core.CertificateStatus{
+			Serial:    row.serial,
+			Status:    row.status,
+			NotAfter:  row.notAfter,
+			IsExpired: row.isExpired,
+		})
+		test.AssertNotError(t, err, "inserting certificate status")
+	}
+
+	streamCerts := func() []*corepb.Certificate {
+		t.Helper()
+		s := &certificateStream{}
+		err := sa.StreamUnrevokedCertificatesByKey(&sapb.SPKIHash{KeyHash: keyHash}, s)
+		test.AssertNotError(t, err, "StreamUnrevokedCertificatesByKey failed")
+		return s.sent
+	}
+	err = sa.StreamUnrevokedCertificatesByKey(&sapb.SPKIHash{KeyHash: []byte{1}}, &certificateStream{})
+	test.AssertEquals(t, err, errIncompleteRequest)
+	certs := streamCerts()
+	test.AssertDeepEquals(t, certSerials(certs), []string{"good", "alsoGood"})
+	test.AssertEquals(t, certs[0].RegistrationID, int64(1))
+	test.AssertByteEquals(t, certs[0].Der, []byte("good"))
+
+	defer func(pageSize int) { streamPageSize = pageSize }(streamPageSize)
+	streamPageSize = 1
+	test.AssertDeepEquals(t, certSerials(streamCerts()), []string{"good", "alsoGood"})
+}
+
+func TestFQDNSetExistsIssuedAfter(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	names := []string{"example.com", "www.example.com"}
+	err := sa.dbMap.Insert(&core.FQDNSet{
+		SetHash: HashNames(names),
+		Serial:  "1",
+		Issued:  fc.Now(),
+		Expires: fc.Now().Add(time.Hour),
+	})
+	test.AssertNotError(t, err, "inserting FQDN set")
+
+	for _, tc := range []struct {
+		issuedAfter time.Time
+		expected    bool
+	}{
+		{time.Time{}, true},
+		{fc.Now().Add(-time.Hour), true},
+		{fc.Now(), false},
+	} {
+		req := &sapb.FQDNSetExistsRequest{Domains: names}
+		if !tc.issuedAfter.IsZero() {
+			req.IssuedAfter = tc.issuedAfter.UnixNano()
+		}
+		exists, err := sa.FQDNSetExists(ctx, req)
+		test.AssertNotError(t, err, "FQDNSetExists failed")
+		test.AssertEquals(t, exists.Exists, tc.expected)
+	}
+}
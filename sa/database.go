@@ -58,8 +58,13 @@ func NewDbSettingsFromDBConfig(dbconfig cmd.DBConfig) DbSettings {
 // NewDbMap creates a wrapped root gorp mapping object. Create one of these for
 // each database schema you wish to map. Each DbMap contains a list of mapped
 // tables. It automatically maps the tables for the primary parts of Boulder
-// around the Storage Authority.
+// around the Storage Authority. A DSN starting with "sqlite:" is the path of a
+// SQLite database file; any other is a MySQL DSN.
 func NewDbMap(dbConnect string, settings DbSettings) (*boulderDB.WrappedMap, error) {
+	if boulderDB.IsSQLiteDSN(dbConnect) {
+		return newSQLiteDbMap(dbConnect, settings)
+	}
+
 	var err error
 	var config *mysql.Config
 
@@ -199,11 +204,19 @@ func initTables(dbMap *gorp.DbMap) {
 	dbMap.AddTableWithName(core.FQDNSet{}, "fqdnSets").SetKeys(true, "ID")
 	dbMap.AddTableWithName(orderModel{}, "orders").SetKeys(true, "ID")
 	dbMap.AddTableWithName(orderToAuthzModel{}, "orderToAuthz").SetKeys(false, "OrderID", "AuthzID")
-	dbMap.AddTableWithName(requestedNameModel{}, "requestedNames").SetKeys(false, "OrderID")
+	dbMap.AddTableWithName(requestedNameModel{}, "requestedNames").SetKeys(true, "ID")
 	dbMap.AddTableWithName(orderFQDNSet{}, "orderFqdnSets").SetKeys(true, "ID")
 	dbMap.AddTableWithName(authzModel{}, "authz2").SetKeys(true, "ID")
 	dbMap.AddTableWithName(orderToAuthzModel{}, "orderToAuthz2").SetKeys(false, "OrderID", "AuthzID")
 	dbMap.AddTableWithName(recordedSerialModel{}, "serials").SetKeys(true, "ID")
 	dbMap.AddTableWithName(precertificateModel{}, "precertificates").SetKeys(true, "ID")
 	dbMap.AddTableWithName(keyHashModel{}, "keyHashToSerial").SetKeys(true, "ID")
+	dbMap.AddTableWithName(rateLimitOverrideModel{}, "rateLimitOverrides").SetKeys(true, "ID")
+	dbMap.AddTableWithName(accountIdentifierPolicyModel{}, "accountIdentifierPolicies").SetKeys(false, "RegistrationID")
+	dbMap.AddTableWithName(accountSuspensionModel{}, "accountSuspensions").SetKeys(false, "RegistrationID")
+	dbMap.AddTableWithName(orderApprovalModel{}, "orderApprovals").SetKeys(false, "OrderID")
+	dbMap.AddTableWithName(blockedNameModel{}, "blockedNames").SetKeys(false, "ReversedName")
+	dbMap.AddTableWithName(incidentModel{}, "incidents").SetKeys(true, "ID")
+	dbMap.AddTableWithName(accountKeyModel{}, "accountKeys").SetKeys(true, "ID")
+	dbMap.AddTableWithName(eventModel{}, "events").SetKeys(true, "ID")
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/letsencrypt/boulder/db"
 	"github.com/letsencrypt/boulder/test"
 	"github.com/letsencrypt/boulder/test/vars"
 )
@@ -19,6 +20,7 @@ func TestInvalidDSN(t *testing.T) {
 var errExpected = errors.New("expected")
 
 func TestDbSettings(t *testing.T) {
+	skipUnlessMySQL(t)
 	// TODO(#5248): Add a full db.mockWrappedMap to sa/database tests
 	oldSetMaxOpenConns := setMaxOpenConns
 	oldSetMaxIdleConns := setMaxIdleConns
@@ -100,7 +102,38 @@ func TestNewDbMap(t *testing.T) {
 
 }
 
+func TestSQLiteDSN(t *testing.T) {
+	dsn, err := sqliteDSN("sqlite:/var/lib/boulder/sa.db?_busy_timeout=1&cache=shared")
+	test.AssertNotError(t, err, "failed to convert SQLite DSN")
+	test.AssertEquals(t, dsn, "file:/var/lib/boulder/sa.db?_busy_timeout=10000&_foreign_keys=1&_journal_mode=WAL&_loc=UTC&_txlock=immediate&cache=shared")
+
+	_, err = sqliteDSN("sqlite:")
+	test.AssertError(t, err, "converted a SQLite DSN without a path")
+}
+
+func TestSQLiteDbMap(t *testing.T) {
+	dbMap, cleanUp := initSQLiteTestDB(t)
+	defer cleanUp()
+	test.AssertEquals(t, dbMap.SQLDialect(), db.SQLite)
+
+	// Times are stored as UTC whatever their location, so that they compare
+	// correctly as text.
+	est := time.FixedZone("EST", -5*60*60)
+	_, err := dbMap.Exec(`INSERT INTO newOrdersRL (regID, time, count) VALUES (1, ?, 1), (2, ?, 1)`,
+		time.Date(2022, 1, 1, 4, 0, 0, 0, est), time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC))
+	test.AssertNotError(t, err, "failed to insert rows")
+	var regIDs []int64
+	_, err = dbMap.Select(&regIDs, `SELECT regID FROM newOrdersRL WHERE time > ? ORDER BY time`,
+		time.Date(2022, 1, 1, 8, 0, 0, 0, time.UTC))
+	test.AssertNotError(t, err, "failed to select rows")
+	test.AssertDeepEquals(t, regIDs, []int64{1, 2})
+
+	_, err = dbMap.Exec(`INSERT INTO newOrdersRL (regID, time, count) VALUES (1, ?, 1)`, time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC))
+	test.Assert(t, db.IsDuplicate(err), "duplicate row wasn't detected")
+}
+
 func TestStrictness(t *testing.T) {
+	skipUnlessMySQL(t)
 	dbMap, err := NewDbMap(vars.DBConnSA, DbSettings{1, 0, 0, 0})
 	if err != nil {
 		t.Fatal(err)
@@ -117,6 +150,7 @@ func TestStrictness(t *testing.T) {
 }
 
 func TestTimeouts(t *testing.T) {
+	skipUnlessMySQL(t)
 	dbMap, err := NewDbMap(vars.DBConnSA+"?readTimeout=1s", DbSettings{1, 0, 0, 0})
 	if err != nil {
 		t.Fatal("Error setting up DB:", err)
@@ -141,6 +175,7 @@ func TestTimeouts(t *testing.T) {
 // databases that have auto_increment columns use BIGINT for the data type. Our
 // data is too big for INT.
 func TestAutoIncrementSchema(t *testing.T) {
+	skipUnlessMySQL(t)
 	dbMap, err := NewDbMap(vars.DBInfoSchemaRoot, DbSettings{1, 0, 0, 0})
 	test.AssertNotError(t, err, "unexpected err making NewDbMap")
 
@@ -0,0 +1,185 @@
+package sa
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/letsencrypt/boulder/db"
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// The types of event which the SA records. Each is written in the same
+// transaction as the change it describes, so an event is recorded if and only
+// if its change is.
+const (
+	EventAccountCreated     = "accountCreated"
+	EventAccountUpdated     = "accountUpdated"
+	EventAccountDeactivated = "accountDeactivated"
+	EventCertificateIssued  = "certificateIssued"
+	EventCertificateRevoked = "certificateRevoked"
+)
+
+var eventTypes = map[string]bool{
+	EventAccountCreated:     true,
+	EventAccountUpdated:     true,
+	EventAccountDeactivated: true,
+	EventCertificateIssued:  true,
+	EventCertificateRevoked: true,
+}
+
+// accountEventDetails are the details of accountCreated and accountUpdated
+// events. KeyChanged is set when an update rolled over the account's key.
+type accountEventDetails struct {
+	Status     string `json:"status"`
+	KeySHA256  string `json:"keySHA256"`
+	KeyChanged bool   `json:"keyChanged,omitempty"`
+}
+
+// certificateIssuedDetails are the details of certificateIssued events.
+type certificateIssuedDetails struct {
+	Digest    string    `json:"digest"`
+	IssuerID  int64     `json:"issuerID,omitempty"`
+	Names     []string  `json:"names"`
+	NotBefore time.Time `json:"notBefore"`
+	NotAfter  time.Time `json:"notAfter"`
+}
+
+// certificateRevokedDetails are the details of certificateRevoked events.
+type certificateRevokedDetails struct {
+	Reason      int64     `json:"reason"`
+	RevokedDate time.Time `json:"revokedDate"`
+}
+
+// eventsPageSize is the number of rows StreamEvents reads at a time. It's a
+// variable so that tests can page through fewer rows.
+var eventsPageSize = 1000
+
+// eventGapTimeout is how long StreamEvents waits for a missing event ID to
+// fill. Event IDs are assigned when the row is inserted rather than when its
+// transaction commits, so a later event can be visible while an earlier one
+// is still uncommitted. StreamEvents never sends an event past such a gap
+// until the gap fills, or until the event after it is eventGapTimeout old, at
+// which point the gap is assumed to be from a transaction which rolled back.
+//
+// SA transactions take well under a second, so an event is only skipped if
+// its transaction commits more than eventGapTimeout after the event was
+// created. Late commits are counted by the events_committed_late metric and
+// logged with the event's ID. Event IDs must be consecutive, which requires
+// an auto_increment_increment of 1.
+const eventGapTimeout = time.Minute
+
+// insertEvent records an event as part of the given transaction, and returns
+// it so that the caller can pass it to checkEventCommitted once the
+// transaction has committed. Details is marshaled to JSON, and serial may be
+// empty for account events.
+func insertEvent(tx db.Executor, eventType string, now time.Time, regID int64, serial string, details interface{}) (*eventModel, error) {
+	detailsJSON, err := json.Marshal(details)
+	if err != nil {
+		return nil, err
+	}
+	model := &eventModel{
+		EventType:      eventType,
+		Created:        now,
+		RegistrationID: regID,
+		Details:        detailsJSON,
+	}
+	if serial != "" {
+		model.Serial = &serial
+	}
+	err = tx.Insert(model)
+	if err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// checkEventCommitted is called once the transaction which inserted an event
+// has committed. If that took longer than eventGapTimeout, StreamEvents may
+// already have skipped the event's ID, and consumers who have moved past it
+// will never see it, so it's counted and logged. A nil event, from
+// a transaction which didn't insert one, is ignored.
+func (ssa *SQLStorageAuthority) checkEventCommitted(event *eventModel) {
+	if event == nil {
+		return
+	}
+	delay := ssa.clk.Now().Sub(event.Created)
+	if delay <= eventGapTimeout {
+		return
+	}
+	ssa.eventsCommittedLate.WithLabelValues(event.EventType).Inc()
+	ssa.log.Warningf("Event committed %s after it was created, consumers of StreamEvents may have skipped it: id=[%d] type=[%s]",
+		delay, event.ID, event.EventType)
+}
+
+func eventModelToPB(m *eventModel) *sapb.Event {
+	pb := &sapb.Event{
+		Id:             m.ID,
+		Type:           m.EventType,
+		Created:        m.Created.UnixNano(),
+		RegistrationID: m.RegistrationID,
+		Details:        m.Details,
+	}
+	if m.Serial != nil {
+		pb.Serial = *m.Serial
+	}
+	return pb
+}
+
+// StreamEvents streams the events after the request's cursor, oldest first,
+// ending the stream once it has caught up. Consumers follow the stream by
+// reconnecting with the ID of the last event they received. The stream also
+// ends at the first missing ID which hasn't yet been missing for
+// eventGapTimeout, so that an event whose transaction is still in progress
+// isn't skipped; see eventGapTimeout. It always reads from the primary,
+// because a replica's lag would add to the time a gap takes to fill.
+func (ssa *SQLStorageAuthority) StreamEvents(req *sapb.StreamEventsRequest, stream sapb.StorageAuthority_StreamEventsServer) error {
+	if req == nil {
+		return errIncompleteRequest
+	}
+	types := make(map[string]bool)
+	for _, t := range req.Types {
+		if !eventTypes[t] {
+			return berrors.MalformedError("unknown event type %q", t)
+		}
+		types[t] = true
+	}
+
+	// Events of every type are read, so that gaps can be told apart from
+	// events which aren't wanted, and filtered here.
+	ctx := stream.Context()
+	stale := ssa.clk.Now().Add(-eventGapTimeout)
+	after := req.AfterID
+	for {
+		var page []*eventModel
+		_, err := ssa.dbMap.WithContext(ctx).Select(
+			&page,
+			`SELECT id, eventType, created, registrationID, serial, details
+			FROM events
+			WHERE id > ?
+			ORDER BY id
+			LIMIT ?`,
+			after,
+			eventsPageSize,
+		)
+		if err != nil {
+			return err
+		}
+		for _, m := range page {
+			if m.ID != after+1 && m.Created.After(stale) {
+				return nil
+			}
+			after = m.ID
+			if len(types) > 0 && !types[m.EventType] {
+				continue
+			}
+			err := stream.Send(eventModelToPB(m))
+			if err != nil {
+				return err
+			}
+		}
+		if len(page) < eventsPageSize {
+			return nil
+		}
+	}
+}
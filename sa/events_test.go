@@ -0,0 +1,202 @@
+package sa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+
+	"github.com/letsencrypt/boulder/core"
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// eventStream is a StorageAuthority_StreamEventsServer which collects the
+// events it's sent.
+type eventStream struct {
+	grpc.ServerStream
+	sent []*sapb.Event
+}
+
+func (s *eventStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *eventStream) Send(event *sapb.Event) error {
+	s.sent = append(s.sent, event)
+	return nil
+}
+
+func streamEvents(t *testing.T, sa *SQLStorageAuthority, req *sapb.StreamEventsRequest) []*sapb.Event {
+	t.Helper()
+	stream := &eventStream{}
+	err := sa.StreamEvents(req, stream)
+	test.AssertNotError(t, err, "StreamEvents failed")
+	return stream.sent
+}
+
+func TestEvents(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	reg := createWorkingRegistration(t, sa)
+	reg.Contact = []string{"mailto:bar@example.com"}
+	_, err := sa.UpdateRegistration(ctx, reg)
+	test.AssertNotError(t, err, "UpdateRegistration failed")
+
+	certDER, err := ioutil.ReadFile("www.eff.org.der")
+	test.AssertNotError(t, err, "Couldn't read example cert DER")
+	serial := "000000000000000000000000000000021bd4"
+	_, err = sa.AddSerial(ctx, &sapb.AddSerialRequest{
+		RegID:   reg.Id,
+		Serial:  serial,
+		Created: fc.Now().UnixNano(),
+		Expires: fc.Now().Add(time.Hour).UnixNano(),
+	})
+	test.AssertNotError(t, err, "AddSerial failed")
+	_, err = sa.AddPrecertificate(ctx, &sapb.AddCertificateRequest{
+		Der:      certDER,
+		RegID:    reg.Id,
+		Issued:   fc.Now().UnixNano(),
+		IssuerID: 1,
+	})
+	test.AssertNotError(t, err, "AddPrecertificate failed")
+	_, err = sa.AddCertificate(ctx, &sapb.AddCertificateRequest{
+		Der:      certDER,
+		RegID:    reg.Id,
+		Issued:   fc.Now().UnixNano(),
+		IssuerID: 1,
+	})
+	test.AssertNotError(t, err, "AddCertificate failed")
+
+	// A change which fails records no event.
+	_, err = sa.AddCertificate(ctx, &sapb.AddCertificateRequest{
+		Der:    certDER,
+		RegID:  reg.Id,
+		Issued: fc.Now().UnixNano(),
+	})
+	test.Assert(t, errors.Is(err, berrors.Duplicate), "added a duplicate certificate")
+
+	_, err = sa.RevokeCertificate(ctx, &sapb.RevokeCertificateRequest{
+		Serial:   serial,
+		Date:     fc.Now().UnixNano(),
+		Reason:   1,
+		Response: []byte{1, 2, 3},
+	})
+	test.AssertNotError(t, err, "RevokeCertificate failed")
+	_, err = sa.DeactivateRegistration(ctx, &sapb.RegistrationID{Id: reg.Id})
+	test.AssertNotError(t, err, "DeactivateRegistration failed")
+	_, err = sa.DeactivateRegistration(ctx, &sapb.RegistrationID{Id: reg.Id})
+	test.AssertNotError(t, err, "DeactivateRegistration failed")
+
+	// The failed change may have used up an event ID, leaving a gap which
+	// the stream waits for until it's stale.
+	fc.Add(eventGapTimeout)
+	events := streamEvents(t, sa, &sapb.StreamEventsRequest{})
+	var types []string
+	for _, e := range events {
+		types = append(types, e.Type)
+		test.AssertEquals(t, e.RegistrationID, reg.Id)
+	}
+	test.AssertDeepEquals(t, types, []string{
+		EventAccountCreated,
+		EventAccountUpdated,
+		EventCertificateIssued,
+		EventCertificateRevoked,
+		EventAccountDeactivated,
+	})
+
+	var account accountEventDetails
+	err = json.Unmarshal(events[1].Details, &account)
+	test.AssertNotError(t, err, "unmarshaling account details")
+	test.AssertEquals(t, account.Status, string(core.StatusValid))
+	test.Assert(t, !account.KeyChanged, "key changed without a rollover")
+
+	test.AssertEquals(t, events[2].Serial, serial)
+	var issued certificateIssuedDetails
+	err = json.Unmarshal(events[2].Details, &issued)
+	test.AssertNotError(t, err, "unmarshaling certificate details")
+	test.AssertDeepEquals(t, issued.Names, []string{"www.eff.org", "eff.org", "*.eff.org"})
+	test.AssertEquals(t, issued.IssuerID, int64(1))
+
+	var revoked certificateRevokedDetails
+	err = json.Unmarshal(events[3].Details, &revoked)
+	test.AssertNotError(t, err, "unmarshaling revocation details")
+	test.AssertEquals(t, revoked.Reason, int64(1))
+
+	// Consumers can resume from a cursor, and filter by type.
+	resumed := streamEvents(t, sa, &sapb.StreamEventsRequest{AfterID: events[2].Id})
+	test.AssertEquals(t, len(resumed), 2)
+	test.AssertDeepEquals(t, resumed[0], events[3])
+	filtered := streamEvents(t, sa, &sapb.StreamEventsRequest{Types: []string{EventCertificateIssued, EventCertificateRevoked}})
+	test.AssertEquals(t, len(filtered), 2)
+	test.AssertEquals(t, filtered[1].Type, EventCertificateRevoked)
+	err = sa.StreamEvents(&sapb.StreamEventsRequest{Types: []string{"unknown"}}, &eventStream{})
+	test.Assert(t, errors.Is(err, berrors.Malformed), "streamed an unknown event type")
+
+	// The stream is read a page at a time.
+	defer func(pageSize int) { eventsPageSize = pageSize }(eventsPageSize)
+	eventsPageSize = 2
+	paged := streamEvents(t, sa, &sapb.StreamEventsRequest{})
+	test.AssertDeepEquals(t, paged, events)
+}
+
+func TestStreamEventsGaps(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	insert := func(id int64, eventType string) {
+		t.Helper()
+		_, err := sa.dbMap.Exec(
+			`INSERT INTO events (id, eventType, created, registrationID, details) VALUES (?, ?, ?, ?, ?)`,
+			id, eventType, fc.Now(), 1, []byte("{}"))
+		test.AssertNotError(t, err, "inserting event")
+	}
+	ids := func(events []*sapb.Event) []int64 {
+		var ids []int64
+		for _, e := range events {
+			ids = append(ids, e.Id)
+		}
+		return ids
+	}
+
+	// Events are sent straight away, up to the first missing ID, even for a
+	// consumer which is starting from the beginning.
+	insert(1, EventAccountCreated)
+	insert(2, EventAccountCreated)
+	insert(4, EventAccountCreated)
+	test.AssertDeepEquals(t, ids(streamEvents(t, sa, &sapb.StreamEventsRequest{})), []int64{1, 2})
+	test.AssertDeepEquals(t, ids(streamEvents(t, sa, &sapb.StreamEventsRequest{AfterID: 2})), []int64(nil))
+
+	// Once the missing event commits, both are sent.
+	insert(3, EventAccountCreated)
+	test.AssertDeepEquals(t, ids(streamEvents(t, sa, &sapb.StreamEventsRequest{AfterID: 2})), []int64{3, 4})
+
+	// A gap which doesn't fill is skipped once the event after it is stale.
+	// Events filtered out of the stream aren't gaps.
+	insert(5, EventCertificateIssued)
+	insert(7, EventAccountCreated)
+	filter := []string{EventAccountCreated}
+	test.AssertDeepEquals(t, ids(streamEvents(t, sa, &sapb.StreamEventsRequest{AfterID: 4, Types: filter})), []int64(nil))
+	fc.Add(eventGapTimeout)
+	test.AssertDeepEquals(t, ids(streamEvents(t, sa, &sapb.StreamEventsRequest{AfterID: 4, Types: filter})), []int64{7})
+}
+
+func TestCheckEventCommitted(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	// Events which commit within the gap timeout aren't counted.
+	sa.checkEventCommitted(nil)
+	sa.checkEventCommitted(&eventModel{ID: 1, EventType: EventAccountCreated, Created: fc.Now().Add(-eventGapTimeout)})
+	test.AssertMetricWithLabelsEquals(t, sa.eventsCommittedLate, prometheus.Labels{"type": EventAccountCreated}, 0)
+
+	sa.checkEventCommitted(&eventModel{ID: 2, EventType: EventAccountCreated, Created: fc.Now().Add(-eventGapTimeout - time.Second)})
+	test.AssertMetricWithLabelsEquals(t, sa.eventsCommittedLate, prometheus.Labels{"type": EventAccountCreated}, 1)
+}
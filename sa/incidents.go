@@ -0,0 +1,336 @@
+package sa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/db"
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// maxIncidentSerialsPerRequest is the most serials AddIncidentSerials and
+	// MarkIncidentSerialsNotified accept at once. It keeps their queries
+	// within SQLite's limit of 999 variables.
+	maxIncidentSerialsPerRequest = 500
+
+	// maxIncidentSerialsIssuedBetween is the most serials, and the default
+	// number, AddIncidentSerialsIssuedBetween adds at once.
+	maxIncidentSerialsIssuedBetween = 1000
+
+	// incidentSerialsInsertBatch is the most rows inserted into
+	// incidentSerials by a single statement, each of which takes three
+	// variables.
+	incidentSerialsInsertBatch = 250
+)
+
+// incidentSerialsPageSize is the number of rows SerialsForIncident reads at a
+// time. It's a variable so that tests can page through fewer rows.
+var incidentSerialsPageSize = 1000
+
+func incidentModelToPB(m *incidentModel) *sapb.Incident {
+	return &sapb.Incident{
+		Id:        m.ID,
+		Name:      m.Name,
+		Url:       m.URL,
+		RenewBy:   m.RenewBy.UnixNano(),
+		CreatedBy: m.CreatedBy,
+		Created:   m.Created.UnixNano(),
+	}
+}
+
+func incidentSerialModelToPB(m *incidentSerialModel) *sapb.IncidentSerial {
+	pb := &sapb.IncidentSerial{
+		Serial:         m.Serial,
+		RegistrationID: m.RegistrationID,
+	}
+	if m.Status != nil {
+		pb.Status = *m.Status
+	}
+	if m.RevokedReason != nil {
+		pb.RevokedReason = *m.RevokedReason
+	}
+	if m.NotAfter != nil {
+		pb.NotAfter = m.NotAfter.UnixNano()
+	}
+	if m.LastNoticeSent != nil {
+		pb.LastNoticeSent = m.LastNoticeSent.UnixNano()
+	}
+	return pb
+}
+
+// AddIncident stores a new incident, returning it with its ID and creation
+// time populated. Incident names are unique.
+func (ssa *SQLStorageAuthority) AddIncident(ctx context.Context, req *sapb.Incident) (*sapb.Incident, error) {
+	if req == nil || core.IsAnyNilOrZero(req.Name, req.Url, req.RenewBy, req.CreatedBy) {
+		return nil, errIncompleteRequest
+	}
+	model := &incidentModel{
+		Name:      req.Name,
+		URL:       req.Url,
+		RenewBy:   time.Unix(0, req.RenewBy),
+		CreatedBy: req.CreatedBy,
+		Created:   ssa.clk.Now().Truncate(time.Second),
+	}
+	err := ssa.dbMap.WithContext(ctx).Insert(model)
+	if err != nil {
+		if db.IsDuplicate(err) {
+			return nil, berrors.DuplicateError("an incident named %q already exists", req.Name)
+		}
+		return nil, err
+	}
+	return incidentModelToPB(model), nil
+}
+
+// GetIncidents returns every incident, oldest first.
+func (ssa *SQLStorageAuthority) GetIncidents(ctx context.Context, _ *emptypb.Empty) (*sapb.Incidents, error) {
+	var models []*incidentModel
+	_, err := ssa.reader(ctx, "GetIncidents").Select(
+		&models,
+		`SELECT id, name, url, renewBy, createdBy, created FROM incidents ORDER BY id`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return incidentModelsToPB(models), nil
+}
+
+// IncidentsForSerial returns the incidents which the given serial has been
+// added to, oldest first.
+func (ssa *SQLStorageAuthority) IncidentsForSerial(ctx context.Context, req *sapb.Serial) (*sapb.Incidents, error) {
+	if req == nil || req.Serial == "" {
+		return nil, errIncompleteRequest
+	}
+	var models []*incidentModel
+	_, err := ssa.reader(ctx, "IncidentsForSerial").Select(
+		&models,
+		`SELECT inc.id, inc.name, inc.url, inc.renewBy, inc.createdBy, inc.created
+		FROM incidentSerials AS i
+		JOIN incidents AS inc ON inc.id = i.incidentID
+		WHERE i.serial = ?
+		ORDER BY inc.id`,
+		req.Serial,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return incidentModelsToPB(models), nil
+}
+
+func incidentModelsToPB(models []*incidentModel) *sapb.Incidents {
+	incidents := make([]*sapb.Incident, len(models))
+	for i, m := range models {
+		incidents[i] = incidentModelToPB(m)
+	}
+	return &sapb.Incidents{Incidents: incidents}
+}
+
+// SerialsForIncident streams the serials which have been added to an
+// incident, in order, along with each one's account and status. It reads the
+// serials a page at a time, so that incidents affecting millions of
+// certificates can be streamed without holding them all in memory.
+func (ssa *SQLStorageAuthority) SerialsForIncident(req *sapb.SerialsForIncidentRequest, stream sapb.StorageAuthority_SerialsForIncidentServer) error {
+	if req == nil || req.IncidentID == 0 {
+		return errIncompleteRequest
+	}
+	ctx := stream.Context()
+	err := ssa.checkIncidentExists(ctx, req.IncidentID)
+	if err != nil {
+		return err
+	}
+
+	after := req.AfterSerial
+	for {
+		var page []*incidentSerialModel
+		_, err := ssa.reader(ctx, "SerialsForIncident").Select(
+			&page,
+			`SELECT i.serial, i.registrationID, i.lastNoticeSent, cs.status, cs.revokedReason, cs.notAfter
+			FROM incidentSerials AS i
+			LEFT JOIN certificateStatus AS cs ON cs.serial = i.serial
+			WHERE i.incidentID = ? AND i.serial > ?
+			ORDER BY i.serial
+			LIMIT ?`,
+			req.IncidentID, after, incidentSerialsPageSize,
+		)
+		if err != nil {
+			return err
+		}
+		for _, m := range page {
+			err := stream.Send(incidentSerialModelToPB(m))
+			if err != nil {
+				return err
+			}
+		}
+		if len(page) < incidentSerialsPageSize {
+			return nil
+		}
+		after = page[len(page)-1].Serial
+	}
+}
+
+// AddIncidentSerials adds the given serials to an incident, along with the
+// accounts which they were issued to. Serials the SA has no record of are
+// returned as unknown rather than added. Adding a serial which has already
+// been added is not an error.
+func (ssa *SQLStorageAuthority) AddIncidentSerials(ctx context.Context, req *sapb.AddIncidentSerialsRequest) (*sapb.AddIncidentSerialsResponse, error) {
+	if req == nil || req.IncidentID == 0 || len(req.Serials) == 0 {
+		return nil, errIncompleteRequest
+	}
+	if len(req.Serials) > maxIncidentSerialsPerRequest {
+		return nil, berrors.MalformedError("cannot add more than %d serials to an incident at once", maxIncidentSerialsPerRequest)
+	}
+	err := ssa.checkIncidentExists(ctx, req.IncidentID)
+	if err != nil {
+		return nil, err
+	}
+
+	qmarks := make([]string, len(req.Serials))
+	args := make([]interface{}, len(req.Serials))
+	for i, serial := range req.Serials {
+		qmarks[i] = "?"
+		args[i] = serial
+	}
+	var found []recordedSerialModel
+	_, err = ssa.dbMap.WithContext(ctx).Select(
+		&found,
+		fmt.Sprintf(`SELECT id, serial, registrationID FROM serials WHERE serial IN (%s)`, strings.Join(qmarks, ",")),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = ssa.insertIncidentSerials(ctx, req.IncidentID, found)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(found))
+	for _, s := range found {
+		known[s.Serial] = true
+	}
+	var unknown []string
+	for _, serial := range req.Serials {
+		if !known[serial] {
+			unknown = append(unknown, serial)
+			known[serial] = true
+		}
+	}
+	return &sapb.AddIncidentSerialsResponse{Added: int64(len(found)), Unknown: unknown}, nil
+}
+
+// AddIncidentSerialsIssuedBetween adds the serials issued in the given range,
+// optionally only by the given issuer, to an incident. It considers at most
+// limit serials, in the order they were issued, starting after afterID: the
+// caller pages through the range by passing the returned lastID as the next
+// request's afterID until it is zero.
+func (ssa *SQLStorageAuthority) AddIncidentSerialsIssuedBetween(ctx context.Context, req *sapb.AddIncidentSerialsIssuedBetweenRequest) (*sapb.AddIncidentSerialsResponse, error) {
+	if req == nil || req.IncidentID == 0 || req.Issued == nil || req.Issued.Earliest == 0 || req.Issued.Latest == 0 {
+		return nil, errIncompleteRequest
+	}
+	limit := req.Limit
+	if limit <= 0 || limit > maxIncidentSerialsIssuedBetween {
+		limit = maxIncidentSerialsIssuedBetween
+	}
+	err := ssa.checkIncidentExists(ctx, req.IncidentID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT s.id, s.serial, s.registrationID FROM serials AS s`
+	var args []interface{}
+	if req.IssuerID != 0 {
+		query += ` JOIN certificateStatus AS cs ON cs.serial = s.serial AND cs.issuerID = ?`
+		args = append(args, req.IssuerID)
+	}
+	query += ` WHERE s.id > ? AND s.created >= ? AND s.created < ? ORDER BY s.id LIMIT ?`
+	args = append(args, req.AfterID, time.Unix(0, req.Issued.Earliest), time.Unix(0, req.Issued.Latest), limit)
+
+	var found []recordedSerialModel
+	_, err = ssa.dbMap.WithContext(ctx).Select(&found, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	err = ssa.insertIncidentSerials(ctx, req.IncidentID, found)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &sapb.AddIncidentSerialsResponse{Added: int64(len(found))}
+	if int64(len(found)) == limit {
+		resp.LastID = found[len(found)-1].ID
+	}
+	return resp, nil
+}
+
+// insertIncidentSerials adds the given serials to an incident, leaving those
+// which it already has in place.
+func (ssa *SQLStorageAuthority) insertIncidentSerials(ctx context.Context, incidentID int64, serials []recordedSerialModel) error {
+	dialect := ssa.dbMap.SQLDialect()
+	for len(serials) > 0 {
+		batch := serials
+		if len(batch) > incidentSerialsInsertBatch {
+			batch = batch[:incidentSerialsInsertBatch]
+		}
+		serials = serials[len(batch):]
+
+		qmarks := make([]string, len(batch))
+		args := make([]interface{}, 0, 3*len(batch))
+		for i, s := range batch {
+			qmarks[i] = "(?, ?, ?)"
+			args = append(args, incidentID, s.Serial, s.RegistrationID)
+		}
+		_, err := ssa.dbMap.WithContext(ctx).Exec(
+			fmt.Sprintf(`INSERT INTO incidentSerials (incidentID, serial, registrationID) VALUES %s `, strings.Join(qmarks, ","))+
+				dialect.OnDuplicateKeyUpdate([]string{"incidentID", "serial"}, "registrationID = "+dialect.Inserted("registrationID")),
+			args...,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarkIncidentSerialsNotified records that the accounts which the given
+// serials were issued to have been notified of the incident.
+func (ssa *SQLStorageAuthority) MarkIncidentSerialsNotified(ctx context.Context, req *sapb.MarkIncidentSerialsNotifiedRequest) (*emptypb.Empty, error) {
+	if req == nil || core.IsAnyNilOrZero(req.IncidentID, req.Notified) || len(req.Serials) == 0 {
+		return nil, errIncompleteRequest
+	}
+	if len(req.Serials) > maxIncidentSerialsPerRequest {
+		return nil, berrors.MalformedError("cannot mark more than %d serials notified at once", maxIncidentSerialsPerRequest)
+	}
+	qmarks := make([]string, len(req.Serials))
+	args := []interface{}{time.Unix(0, req.Notified), req.IncidentID}
+	for i, serial := range req.Serials {
+		qmarks[i] = "?"
+		args = append(args, serial)
+	}
+	_, err := ssa.dbMap.WithContext(ctx).Exec(
+		fmt.Sprintf(`UPDATE incidentSerials SET lastNoticeSent = ? WHERE incidentID = ? AND serial IN (%s)`, strings.Join(qmarks, ",")),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkIncidentExists returns a NotFound error if there is no incident with the
+// given ID.
+func (ssa *SQLStorageAuthority) checkIncidentExists(ctx context.Context, id int64) error {
+	var count int64
+	err := ssa.dbMap.WithContext(ctx).SelectOne(&count, `SELECT COUNT(*) FROM incidents WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return berrors.NotFoundError("no incident with ID %d", id)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package sa
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/letsencrypt/boulder/core"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/revocation"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// incidentSerialStream is a StorageAuthority_SerialsForIncidentServer which
+// collects the serials it's sent.
+type incidentSerialStream struct {
+	grpc.ServerStream
+	sent []*sapb.IncidentSerial
+}
+
+func (s *incidentSerialStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *incidentSerialStream) Send(serial *sapb.IncidentSerial) error {
+	s.sent = append(s.sent, serial)
+	return nil
+}
+
+func streamIncidentSerials(t *testing.T, sa *SQLStorageAuthority, req *sapb.SerialsForIncidentRequest) []*sapb.IncidentSerial {
+	t.Helper()
+	stream := &incidentSerialStream{}
+	err := sa.SerialsForIncident(req, stream)
+	test.AssertNotError(t, err, "SerialsForIncident failed")
+	return stream.sent
+}
+
+func TestAddIncident(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	_, err := sa.AddIncident(ctx, &sapb.Incident{Name: "incident", Url: "https://example.com"})
+	test.AssertEquals(t, err, errIncompleteRequest)
+
+	req := &sapb.Incident{
+		Name:      "2022-01-incident",
+		Url:       "https://example.com/incident",
+		RenewBy:   fc.Now().Add(5 * 24 * time.Hour).UnixNano(),
+		CreatedBy: "admin",
+	}
+	incident, err := sa.AddIncident(ctx, req)
+	test.AssertNotError(t, err, "AddIncident failed")
+	test.Assert(t, incident.Id != 0, "incident ID wasn't set")
+	test.AssertEquals(t, incident.Created, fc.Now().UnixNano())
+
+	_, err = sa.AddIncident(ctx, req)
+	test.Assert(t, errors.Is(err, berrors.Duplicate), "added two incidents with the same name")
+
+	incidents, err := sa.GetIncidents(ctx, &emptypb.Empty{})
+	test.AssertNotError(t, err, "GetIncidents failed")
+	test.AssertEquals(t, len(incidents.Incidents), 1)
+	test.AssertDeepEquals(t, incidents.Incidents[0], incident)
+}
+
+func TestIncidentSerials(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+	reg := createWorkingRegistration(t, sa)
+
+	incident, err := sa.AddIncident(ctx, &sapb.Incident{
+		Name:      "incident",
+		Url:       "https://example.com/incident",
+		RenewBy:   fc.Now().Add(24 * time.Hour).UnixNano(),
+		CreatedBy: "admin",
+	})
+	test.AssertNotError(t, err, "AddIncident failed")
+
+	// Serial "1" only has a serials row, "2" is good and "3" is revoked.
+	for _, serial := range []string{"1", "2", "3"} {
+		err := sa.dbMap.Insert(&recordedSerialModel{
+			Serial:         serial,
+			RegistrationID: reg.Id,
+			Created:        fc.Now(),
+			Expires:        fc.Now().Add(90 * 24 * time.Hour),
+		})
+		test.AssertNotError(t, err, "inserting serial")
+	}
+	notAfter := fc.Now().Add(90 * 24 * time.Hour)
+	for _, status := range []core.CertificateStatus{
+		{Serial: "2", Status: core.OCSPStatusGood, NotAfter: notAfter, IssuerID: 1},
+		{Serial: "3", Status: core.OCSPStatusRevoked, RevokedReason: revocation.Reason(1), NotAfter: notAfter, IssuerID: 1},
+	} {
+		status := status
+		err := sa.dbMap.Insert(&status)
+		test.AssertNotError(t, err, "inserting certificate status")
+	}
+
+	_, err = sa.AddIncidentSerials(ctx, &sapb.AddIncidentSerialsRequest{IncidentID: incident.Id})
+	test.AssertEquals(t, err, errIncompleteRequest)
+	_, err = sa.AddIncidentSerials(ctx, &sapb.AddIncidentSerialsRequest{IncidentID: incident.Id + 1, Serials: []string{"1"}})
+	test.Assert(t, errors.Is(err, berrors.NotFound), "added serials to a nonexistent incident")
+	_, err = sa.AddIncidentSerials(ctx, &sapb.AddIncidentSerialsRequest{IncidentID: incident.Id, Serials: make([]string, maxIncidentSerialsPerRequest+1)})
+	test.Assert(t, errors.Is(err, berrors.Malformed), "added too many serials at once")
+
+	resp, err := sa.AddIncidentSerials(ctx, &sapb.AddIncidentSerialsRequest{
+		IncidentID: incident.Id,
+		Serials:    []string{"1", "3", "4", "4"},
+	})
+	test.AssertNotError(t, err, "AddIncidentSerials failed")
+	test.AssertEquals(t, resp.Added, int64(2))
+	test.AssertDeepEquals(t, resp.Unknown, []string{"4"})
+
+	serials := streamIncidentSerials(t, sa, &sapb.SerialsForIncidentRequest{IncidentID: incident.Id})
+	test.AssertEquals(t, len(serials), 2)
+	test.AssertDeepEquals(t, serials[0], &sapb.IncidentSerial{Serial: "1", RegistrationID: reg.Id})
+	test.AssertDeepEquals(t, serials[1], &sapb.IncidentSerial{
+		Serial:         "3",
+		RegistrationID: reg.Id,
+		Status:         string(core.OCSPStatusRevoked),
+		RevokedReason:  1,
+		NotAfter:       notAfter.UnixNano(),
+	})
+
+	// Serials issued in a range can be added a page at a time, and adding
+	// serials twice isn't an error.
+	issued := &sapb.Range{Earliest: fc.Now().Add(-time.Hour).UnixNano(), Latest: fc.Now().Add(time.Hour).UnixNano()}
+	resp, err = sa.AddIncidentSerialsIssuedBetween(ctx, &sapb.AddIncidentSerialsIssuedBetweenRequest{
+		IncidentID: incident.Id,
+		Issued:     issued,
+		Limit:      2,
+	})
+	test.AssertNotError(t, err, "AddIncidentSerialsIssuedBetween failed")
+	test.AssertEquals(t, resp.Added, int64(2))
+	test.Assert(t, resp.LastID != 0, "lastID wasn't set for a full page")
+	resp, err = sa.AddIncidentSerialsIssuedBetween(ctx, &sapb.AddIncidentSerialsIssuedBetweenRequest{
+		IncidentID: incident.Id,
+		Issued:     issued,
+		AfterID:    resp.LastID,
+		Limit:      2,
+	})
+	test.AssertNotError(t, err, "AddIncidentSerialsIssuedBetween failed")
+	test.AssertEquals(t, resp.Added, int64(1))
+	test.AssertEquals(t, resp.LastID, int64(0))
+
+	// Serials can be streamed a page at a time, and resumed.
+	defer func(pageSize int) { incidentSerialsPageSize = pageSize }(incidentSerialsPageSize)
+	incidentSerialsPageSize = 2
+	serials = streamIncidentSerials(t, sa, &sapb.SerialsForIncidentRequest{IncidentID: incident.Id})
+	test.AssertEquals(t, len(serials), 3)
+	test.AssertEquals(t, serials[1].Status, string(core.OCSPStatusGood))
+	serials = streamIncidentSerials(t, sa, &sapb.SerialsForIncidentRequest{IncidentID: incident.Id, AfterSerial: "1"})
+	test.AssertEquals(t, len(serials), 2)
+	test.AssertEquals(t, serials[0].Serial, "2")
+
+	// Only the issuer's serials are added when an issuer is given.
+	other, err := sa.AddIncident(ctx, &sapb.Incident{
+		Name:      "other",
+		Url:       "https://example.com/other",
+		RenewBy:   fc.Now().Add(24 * time.Hour).UnixNano(),
+		CreatedBy: "admin",
+	})
+	test.AssertNotError(t, err, "AddIncident failed")
+	resp, err = sa.AddIncidentSerialsIssuedBetween(ctx, &sapb.AddIncidentSerialsIssuedBetweenRequest{
+		IncidentID: other.Id,
+		Issued:     issued,
+		IssuerID:   1,
+	})
+	test.AssertNotError(t, err, "AddIncidentSerialsIssuedBetween failed")
+	test.AssertEquals(t, resp.Added, int64(2))
+
+	incidents, err := sa.IncidentsForSerial(ctx, &sapb.Serial{Serial: "2"})
+	test.AssertNotError(t, err, "IncidentsForSerial failed")
+	test.AssertEquals(t, len(incidents.Incidents), 2)
+	incidents, err = sa.IncidentsForSerial(ctx, &sapb.Serial{Serial: "1"})
+	test.AssertNotError(t, err, "IncidentsForSerial failed")
+	test.AssertEquals(t, len(incidents.Incidents), 1)
+	test.AssertEquals(t, incidents.Incidents[0].Name, "incident")
+
+	// Notifications are recorded per incident.
+	_, err = sa.MarkIncidentSerialsNotified(ctx, &sapb.MarkIncidentSerialsNotifiedRequest{
+		IncidentID: incident.Id,
+		Serials:    []string{"2", "3"},
+		Notified:   fc.Now().UnixNano(),
+	})
+	test.AssertNotError(t, err, "MarkIncidentSerialsNotified failed")
+	serials = streamIncidentSerials(t, sa, &sapb.SerialsForIncidentRequest{IncidentID: incident.Id})
+	test.AssertEquals(t, serials[0].LastNoticeSent, int64(0))
+	test.AssertEquals(t, serials[1].LastNoticeSent, fc.Now().UnixNano())
+	test.AssertEquals(t, serials[2].LastNoticeSent, fc.Now().UnixNano())
+	serials = streamIncidentSerials(t, sa, &sapb.SerialsForIncidentRequest{IncidentID: other.Id})
+	test.AssertEquals(t, serials[0].LastNoticeSent, int64(0))
+}
@@ -0,0 +1,335 @@
+package sa
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/letsencrypt/boulder/db"
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+// migrationsFS holds the goose-style migrations from _db, _db-next and
+// _db-sqlite. Most of _db-next's migrations are symlinks to those in _db,
+// which can't be embedded, so only its own migrations are listed here.
+// TestMigrationsEmbedded checks that none are missing.
+//
+//go:embed _db/migrations/*.sql
+//go:embed _db-sqlite/migrations/*.sql
+//go:embed _db-next/migrations/20210223140001_DropCertStatusSubscriberApproved.sql
+//go:embed _db-next/migrations/20210223140002_DropCertStatusLockCol.sql
+//go:embed _db-next/migrations/20210223140003_IssuedNamesDropIndex.sql
+var migrationsFS embed.FS
+
+const (
+	currentMigrationsDir = "_db/migrations"
+	nextMigrationsDir    = "_db-next/migrations"
+	sqliteMigrationsDir  = "_db-sqlite/migrations"
+)
+
+// Migration is a single schema migration, identified by the timestamp which
+// prefixes its file name.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      []string
+	Down    []string
+}
+
+// Migrations returns the migrations embedded in this binary, ordered by
+// version: those from sa/_db or, if next is true, sa/_db-next.
+func Migrations(next bool) ([]Migration, error) {
+	dirs := []string{currentMigrationsDir}
+	if next {
+		dirs = append(dirs, nextMigrationsDir)
+	}
+	return readMigrations(dirs)
+}
+
+// SQLiteMigrations returns the migrations from sa/_db-sqlite embedded in this
+// binary, ordered by version. They create a SQLite schema equivalent to that
+// from Migrations(false).
+func SQLiteMigrations() ([]Migration, error) {
+	return readMigrations([]string{sqliteMigrationsDir})
+}
+
+// readMigrations parses the migrations in the embedded directories. Where
+// more than one has a file with the same name, the last one's is used.
+func readMigrations(dirs []string) ([]Migration, error) {
+	files := make(map[string]string)
+	for _, dir := range dirs {
+		entries, err := fs.ReadDir(migrationsFS, dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			files[entry.Name()] = path.Join(dir, entry.Name())
+		}
+	}
+
+	var migrations []Migration
+	for name, file := range files {
+		contents, err := migrationsFS.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		m, err := parseMigration(name, string(contents))
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// parseMigration parses a goose-style migration. The version is the number
+// before the first underscore in the file name. Statements in the Up and Down
+// sections end with a semicolon at the end of a line, unless they are between
+// StatementBegin and StatementEnd annotations.
+func parseMigration(name, contents string) (Migration, error) {
+	m := Migration{Name: strings.TrimSuffix(name, ".sql")}
+	prefix := strings.SplitN(m.Name, "_", 2)[0]
+	version, err := strconv.ParseInt(prefix, 10, 64)
+	if err != nil {
+		return Migration{}, fmt.Errorf("migration %q: name doesn't start with a version: %w", name, err)
+	}
+	m.Version = version
+
+	var section *[]string
+	var buf strings.Builder
+	inStatement := false
+	scanner := bufio.NewScanner(strings.NewReader(contents))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		switch trimmed {
+		case "-- +goose Up", "-- +goose Down":
+			if strings.TrimSpace(buf.String()) != "" {
+				return Migration{}, fmt.Errorf("migration %q: statement without a terminating semicolon", name)
+			}
+			buf.Reset()
+			section = &m.Up
+			if trimmed == "-- +goose Down" {
+				section = &m.Down
+			}
+			continue
+		case "-- +goose StatementBegin":
+			inStatement = true
+			continue
+		case "-- +goose StatementEnd":
+			inStatement = false
+			if section != nil {
+				*section = append(*section, strings.TrimSpace(buf.String()))
+			}
+			buf.Reset()
+			continue
+		}
+		if section == nil || (!inStatement && (trimmed == "" || strings.HasPrefix(trimmed, "--"))) {
+			continue
+		}
+		buf.WriteString(line)
+		buf.WriteString("\n")
+		if !inStatement && strings.HasSuffix(trimmed, ";") {
+			*section = append(*section, strings.TrimSpace(buf.String()))
+			buf.Reset()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Migration{}, err
+	}
+	if inStatement || strings.TrimSpace(buf.String()) != "" {
+		return Migration{}, fmt.Errorf("migration %q: statement without a terminating semicolon", name)
+	}
+	if len(m.Up) == 0 {
+		return Migration{}, fmt.Errorf("migration %q has no Up statements", name)
+	}
+	return m, nil
+}
+
+// AppliedMigrations returns the versions of the migrations which have been
+// applied to the database, in ascending order. Versions are recorded in
+// goose's goose_db_version table, so databases migrated with goose and with
+// this package can be used interchangeably. A version's most recent row
+// determines whether it is applied; version 0 is goose's initial marker.
+func AppliedMigrations(dbMap db.Selector) ([]int64, error) {
+	var rows []struct {
+		VersionID int64 `db:"version_id"`
+		IsApplied bool  `db:"is_applied"`
+	}
+	_, err := dbMap.Select(&rows, `SELECT version_id, is_applied FROM goose_db_version ORDER BY id DESC`)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[int64]bool)
+	var applied []int64
+	for _, row := range rows {
+		if seen[row.VersionID] {
+			continue
+		}
+		seen[row.VersionID] = true
+		if row.IsApplied && row.VersionID != 0 {
+			applied = append(applied, row.VersionID)
+		}
+	}
+	sort.Slice(applied, func(i, j int) bool { return applied[i] < applied[j] })
+	return applied, nil
+}
+
+// schemaDiff returns the migrations which haven't been applied, and the
+// applied versions which aren't among the migrations.
+func schemaDiff(migrations []Migration, applied []int64) ([]Migration, []int64) {
+	isApplied := make(map[int64]bool)
+	for _, version := range applied {
+		isApplied[version] = true
+	}
+	known := make(map[int64]bool)
+	var pending []Migration
+	for _, m := range migrations {
+		known[m.Version] = true
+		if !isApplied[m.Version] {
+			pending = append(pending, m)
+		}
+	}
+	var unknown []int64
+	for _, version := range applied {
+		if !known[version] {
+			unknown = append(unknown, version)
+		}
+	}
+	return pending, unknown
+}
+
+// CheckSchema returns an error describing the difference if the migrations
+// applied to the database aren't exactly the given ones: either the database
+// is behind this binary and needs migrating, or it has been migrated by a
+// newer binary.
+func CheckSchema(dbMap db.Selector, migrations []Migration) error {
+	applied, err := AppliedMigrations(dbMap)
+	if err != nil {
+		return fmt.Errorf("reading applied migrations: %w", err)
+	}
+	pending, unknown := schemaDiff(migrations, applied)
+	var problems []string
+	if len(pending) > 0 {
+		var names []string
+		for _, m := range pending {
+			names = append(names, m.Name)
+		}
+		problems = append(problems, fmt.Sprintf("%d migrations not applied to the database (%s)", len(pending), strings.Join(names, ", ")))
+	}
+	if len(unknown) > 0 {
+		var versions []string
+		for _, version := range unknown {
+			versions = append(versions, strconv.FormatInt(version, 10))
+		}
+		problems = append(problems, fmt.Sprintf("%d applied migrations unknown to this binary (%s)", len(unknown), strings.Join(versions, ", ")))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("database schema doesn't match this binary: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// ensureVersionTable creates goose's version table, with its initial marker
+// row, if it doesn't exist.
+func ensureVersionTable(dbMap db.SelectExecer, dialect db.Dialect) error {
+	schema := `CREATE TABLE IF NOT EXISTS goose_db_version (
+		id serial NOT NULL,
+		version_id bigint NOT NULL,
+		is_applied boolean NOT NULL,
+		tstamp timestamp NULL default now(),
+		PRIMARY KEY(id)
+	)`
+	if dialect == db.SQLite {
+		schema = `CREATE TABLE IF NOT EXISTS goose_db_version (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			version_id INTEGER NOT NULL,
+			is_applied INTEGER NOT NULL,
+			tstamp TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP
+		)`
+	}
+	_, err := dbMap.Exec(schema)
+	if err != nil {
+		return err
+	}
+	var count []int64
+	_, err = dbMap.Select(&count, `SELECT COUNT(1) FROM goose_db_version`)
+	if err != nil {
+		return err
+	}
+	if count[0] == 0 {
+		_, err = dbMap.Exec(`INSERT INTO goose_db_version (version_id, is_applied) VALUES (0, true)`)
+	}
+	return err
+}
+
+// MigrateUp applies each of the migrations which hasn't been applied to the
+// database, in order, and returns how many were applied. It refuses to run if
+// the database has applied migrations this binary doesn't know about.
+func MigrateUp(dbMap *db.WrappedMap, migrations []Migration, log blog.Logger) (int, error) {
+	err := ensureVersionTable(dbMap, dbMap.SQLDialect())
+	if err != nil {
+		return 0, err
+	}
+	applied, err := AppliedMigrations(dbMap)
+	if err != nil {
+		return 0, err
+	}
+	pending, unknown := schemaDiff(migrations, applied)
+	if len(unknown) > 0 {
+		return 0, fmt.Errorf("database has applied migrations unknown to this binary: %v", unknown)
+	}
+	for i, m := range pending {
+		log.Infof("Applying migration %s", m.Name)
+		err = runMigration(dbMap, m.Version, m.Up, true)
+		if err != nil {
+			return i, fmt.Errorf("applying migration %s: %w", m.Name, err)
+		}
+	}
+	return len(pending), nil
+}
+
+// MigrateDown rolls back the most recently applied migration and returns it.
+func MigrateDown(dbMap db.SelectExecer, migrations []Migration, log blog.Logger) (*Migration, error) {
+	applied, err := AppliedMigrations(dbMap)
+	if err != nil {
+		return nil, err
+	}
+	if len(applied) == 0 {
+		return nil, fmt.Errorf("no migrations have been applied")
+	}
+	latest := applied[len(applied)-1]
+	for _, m := range migrations {
+		if m.Version == latest {
+			log.Infof("Rolling back migration %s", m.Name)
+			err = runMigration(dbMap, m.Version, m.Down, false)
+			if err != nil {
+				return nil, fmt.Errorf("rolling back migration %s: %w", m.Name, err)
+			}
+			return &m, nil
+		}
+	}
+	return nil, fmt.Errorf("latest applied migration %d is unknown to this binary", latest)
+}
+
+// runMigration executes the statements and then records the version as
+// applied or not. MySQL commits DDL implicitly, so a migration which fails
+// part way through has to be repaired by hand.
+func runMigration(dbMap db.SelectExecer, version int64, statements []string, apply bool) error {
+	for _, statement := range statements {
+		_, err := dbMap.Exec(statement)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := dbMap.Exec(`INSERT INTO goose_db_version (version_id, is_applied) VALUES (?, ?)`, version, apply)
+	return err
+}
@@ -0,0 +1,120 @@
+package sa
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/letsencrypt/boulder/db"
+	"github.com/letsencrypt/boulder/test"
+	"github.com/letsencrypt/boulder/test/vars"
+)
+
+func migrationNames(t *testing.T, load func() ([]Migration, error)) map[string]bool {
+	t.Helper()
+	migrations, err := load()
+	test.AssertNotError(t, err, "failed to load migrations")
+	names := make(map[string]bool)
+	for i, m := range migrations {
+		if i > 0 {
+			test.Assert(t, migrations[i-1].Version < m.Version, "migrations aren't ordered by version")
+		}
+		names[m.Name+".sql"] = true
+	}
+	return names
+}
+
+// TestMigrationsEmbedded checks that every migration on disk is embedded, so
+// that a migration added to _db-next isn't forgotten in migrationsFS.
+func TestMigrationsEmbedded(t *testing.T) {
+	for dir, load := range map[string]func() ([]Migration, error){
+		currentMigrationsDir: func() ([]Migration, error) { return Migrations(false) },
+		nextMigrationsDir:    func() ([]Migration, error) { return Migrations(true) },
+		sqliteMigrationsDir:  SQLiteMigrations,
+	} {
+		embedded := migrationNames(t, load)
+		entries, err := os.ReadDir(dir)
+		test.AssertNotError(t, err, "failed to read migrations directory")
+		test.AssertEquals(t, len(embedded), len(entries))
+		for _, entry := range entries {
+			test.Assert(t, embedded[entry.Name()], "migration "+filepath.Join(dir, entry.Name())+" isn't embedded")
+		}
+	}
+}
+
+func TestParseMigration(t *testing.T) {
+	m, err := parseMigration("20220101000000_Example.sql", `
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE a (
+  id bigint(20) NOT NULL
+);
+ALTER TABLE b ADD COLUMN c int;
+
+-- +goose StatementBegin
+CREATE PROCEDURE p() BEGIN SELECT 1; END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE a;
+`)
+	test.AssertNotError(t, err, "failed to parse migration")
+	test.AssertEquals(t, m.Version, int64(20220101000000))
+	test.AssertEquals(t, m.Name, "20220101000000_Example")
+	test.AssertDeepEquals(t, m.Up, []string{
+		"CREATE TABLE a (\n  id bigint(20) NOT NULL\n);",
+		"ALTER TABLE b ADD COLUMN c int;",
+		"CREATE PROCEDURE p() BEGIN SELECT 1; END;",
+	})
+	test.AssertDeepEquals(t, m.Down, []string{"DROP TABLE a;"})
+
+	_, err = parseMigration("Example.sql", "-- +goose Up\nDROP TABLE a;\n")
+	test.AssertError(t, err, "parsed a migration without a version")
+	_, err = parseMigration("20220101000000_Example.sql", "-- +goose Up\nDROP TABLE a;\n-- +goose Down\nCREATE TABLE a\n")
+	test.AssertError(t, err, "parsed a migration with an unterminated statement")
+	_, err = parseMigration("20220101000000_Example.sql", "-- +goose Down\nDROP TABLE a;\n")
+	test.AssertError(t, err, "parsed a migration without Up statements")
+}
+
+func TestSchemaDiff(t *testing.T) {
+	migrations := []Migration{{Version: 1, Name: "1_A"}, {Version: 2, Name: "2_B"}, {Version: 3, Name: "3_C"}}
+
+	pending, unknown := schemaDiff(migrations, []int64{1, 2, 3})
+	test.AssertEquals(t, len(pending), 0)
+	test.AssertEquals(t, len(unknown), 0)
+
+	pending, unknown = schemaDiff(migrations, []int64{1, 4})
+	test.AssertEquals(t, len(pending), 2)
+	test.AssertEquals(t, pending[0].Name, "2_B")
+	test.AssertEquals(t, pending[1].Name, "3_C")
+	test.AssertDeepEquals(t, unknown, []int64{4})
+}
+
+// TestCheckSchema checks that the test database, which create_db.sh migrates
+// with goose, matches the embedded migrations. Against SQLite, it checks a
+// database created with MigrateUp.
+func TestCheckSchema(t *testing.T) {
+	var dbMap *db.WrappedMap
+	var migrations []Migration
+	var err error
+	if sqliteTestEngine {
+		var cleanUp func()
+		dbMap, cleanUp = initSQLiteTestDB(t)
+		defer cleanUp()
+		migrations, err = SQLiteMigrations()
+	} else {
+		dbMap, err = NewDbMap(vars.DBConnSAFullPerms, DbSettings{})
+		test.AssertNotError(t, err, "failed to create dbMap")
+		next := strings.Contains(os.Getenv("BOULDER_CONFIG_DIR"), "test/config-next")
+		migrations, err = Migrations(next)
+	}
+	test.AssertNotError(t, err, "failed to load migrations")
+	err = CheckSchema(dbMap, migrations)
+	test.AssertNotError(t, err, "test database doesn't match the embedded migrations")
+
+	err = CheckSchema(dbMap, migrations[:len(migrations)-1])
+	test.AssertError(t, err, "database ahead of the migrations passed the check")
+	test.AssertContains(t, err.Error(), "unknown to this binary")
+}
@@ -209,6 +209,20 @@ type issuedNameModel struct {
 	Serial       string    `db:"serial"`
 }
 
+// certificateSearchModel represents one row in the issuedNames table, joined
+// with the serial's registration ID and certificateStatus row. Status,
+// RevokedReason and NotAfter are NULL if there is no certificateStatus row.
+type certificateSearchModel struct {
+	ID             int64      `db:"id"`
+	ReversedName   string     `db:"reversedName"`
+	NotBefore      time.Time  `db:"notBefore"`
+	Serial         string     `db:"serial"`
+	RegistrationID int64      `db:"registrationID"`
+	Status         *string    `db:"status"`
+	RevokedReason  *int64     `db:"revokedReason"`
+	NotAfter       *time.Time `db:"notAfter"`
+}
+
 // regModel is the description of a core.Registration in the database before
 type regModel struct {
 	ID        int64    `db:"id"`
@@ -697,7 +711,111 @@ type keyHashModel struct {
 	CertSerial   string
 }
 
+// rateLimitOverrideModel represents one row in the rateLimitOverrides table.
+type rateLimitOverrideModel struct {
+	ID             int64     `db:"id"`
+	LimitName      string    `db:"limitName"`
+	OverrideKey    string    `db:"overrideKey"`
+	RegistrationID int64     `db:"registrationID"`
+	Threshold      int64     `db:"threshold"`
+	Owner          string    `db:"owner"`
+	Reason         string    `db:"reason"`
+	Ticket         string    `db:"ticket"`
+	Created        time.Time `db:"created"`
+	Expires        time.Time `db:"expires"`
+}
+
+// accountIdentifierPolicyModel represents one row in the
+// accountIdentifierPolicies table. Suffixes and ExactNames are stored as JSON
+// arrays.
+type accountIdentifierPolicyModel struct {
+	RegistrationID int64     `db:"registrationID"`
+	Suffixes       string    `db:"suffixes"`
+	ExactNames     string    `db:"exactNames"`
+	AllowWildcards bool      `db:"allowWildcards"`
+	UpdatedBy      string    `db:"updatedBy"`
+	Updated        time.Time `db:"updated"`
+}
+
+// accountSuspensionModel represents one row in the accountSuspensions table.
+type accountSuspensionModel struct {
+	RegistrationID int64     `db:"registrationID"`
+	Reason         string    `db:"reason"`
+	SuspendedBy    string    `db:"suspendedBy"`
+	Created        time.Time `db:"created"`
+	Expires        time.Time `db:"expires"`
+}
+
+// orderApprovalModel represents one row in the orderApprovals table. Names is
+// stored as a JSON array. DecidedBy, Reason and Decided are NULL until the
+// approval is decided.
+type orderApprovalModel struct {
+	OrderID        int64      `db:"orderID"`
+	RegistrationID int64      `db:"registrationID"`
+	Names          string     `db:"names"`
+	CSR            []byte     `db:"csr"`
+	Status         string     `db:"status"`
+	Created        time.Time  `db:"created"`
+	DecidedBy      *string    `db:"decidedBy"`
+	Reason         *string    `db:"reason"`
+	Decided        *time.Time `db:"decided"`
+}
+
+// blockedNameModel represents one row in the blockedNames table.
+type blockedNameModel struct {
+	ReversedName string    `db:"reversedName"`
+	AddedBy      string    `db:"addedBy"`
+	Comment      string    `db:"comment"`
+	Added        time.Time `db:"added"`
+}
+
+// accountKeyModel represents one row in the accountKeys table. Added is NULL
+// for keys which accounts were created with before the table existed, and
+// Replaced is NULL while the key is in use.
+type accountKeyModel struct {
+	ID             int64      `db:"id"`
+	RegistrationID int64      `db:"registrationID"`
+	KeySHA256      string     `db:"keySHA256"`
+	Added          *time.Time `db:"added"`
+	Replaced       *time.Time `db:"replaced"`
+}
+
+// eventModel represents one row in the events table. Serial is NULL for
+// account events, and Details is a JSON object.
+type eventModel struct {
+	ID             int64     `db:"id"`
+	EventType      string    `db:"eventType"`
+	Created        time.Time `db:"created"`
+	RegistrationID int64     `db:"registrationID"`
+	Serial         *string   `db:"serial"`
+	Details        []byte    `db:"details"`
+}
+
+// incidentModel represents one row in the incidents table.
+type incidentModel struct {
+	ID        int64     `db:"id"`
+	Name      string    `db:"name"`
+	URL       string    `db:"url"`
+	RenewBy   time.Time `db:"renewBy"`
+	CreatedBy string    `db:"createdBy"`
+	Created   time.Time `db:"created"`
+}
+
+// incidentSerialModel represents one row in the incidentSerials table, joined
+// with the serial's certificateStatus row. Status, RevokedReason and NotAfter
+// are NULL if the serial has no certificateStatus row, and LastNoticeSent is
+// NULL until the account is notified.
+type incidentSerialModel struct {
+	Serial         string     `db:"serial"`
+	RegistrationID int64      `db:"registrationID"`
+	LastNoticeSent *time.Time `db:"lastNoticeSent"`
+	Status         *string    `db:"status"`
+	RevokedReason  *int64     `db:"revokedReason"`
+	NotAfter       *time.Time `db:"notAfter"`
+}
+
 var stringToSourceInt = map[string]int{
 	"API":           1,
 	"admin-revoker": 2,
+	"admin":         3,
 }
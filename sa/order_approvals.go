@@ -0,0 +1,154 @@
+package sa
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/db"
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Statuses of an orderApprovals row.
+const (
+	orderApprovalPending  = "pending"
+	orderApprovalApproved = "approved"
+	orderApprovalRejected = "rejected"
+)
+
+const orderApprovalFields = "orderID, registrationID, names, csr, status, created, decidedBy, reason, decided"
+
+func modelToOrderApproval(model *orderApprovalModel) (*sapb.OrderApproval, error) {
+	pb := &sapb.OrderApproval{
+		OrderID:        model.OrderID,
+		RegistrationID: model.RegistrationID,
+		Csr:            model.CSR,
+		Status:         model.Status,
+		Created:        model.Created.UnixNano(),
+	}
+	err := json.Unmarshal([]byte(model.Names), &pb.Names)
+	if err != nil {
+		return nil, err
+	}
+	if model.DecidedBy != nil {
+		pb.DecidedBy = *model.DecidedBy
+	}
+	if model.Reason != nil {
+		pb.Reason = *model.Reason
+	}
+	if model.Decided != nil {
+		pb.Decided = model.Decided.UnixNano()
+	}
+	return pb, nil
+}
+
+// GetOrderApproval returns the approval record for the given order. A NotFound
+// error is returned if the order was never held for approval.
+func (ssa *SQLStorageAuthority) GetOrderApproval(ctx context.Context, req *sapb.OrderRequest) (*sapb.OrderApproval, error) {
+	if req == nil || req.Id == 0 {
+		return nil, errIncompleteRequest
+	}
+	var model orderApprovalModel
+	err := ssa.reader(ctx, "GetOrderApproval").SelectOne(
+		&model,
+		`SELECT `+orderApprovalFields+` FROM orderApprovals WHERE orderID = ?`,
+		req.Id,
+	)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, berrors.NotFoundError("order ID %d is not held for approval", req.Id)
+		}
+		return nil, err
+	}
+	return modelToOrderApproval(&model)
+}
+
+// GetPendingOrderApprovals returns the orders awaiting a decision, oldest
+// first.
+func (ssa *SQLStorageAuthority) GetPendingOrderApprovals(ctx context.Context, _ *emptypb.Empty) (*sapb.OrderApprovals, error) {
+	var models []orderApprovalModel
+	_, err := ssa.reader(ctx, "GetPendingOrderApprovals").Select(
+		&models,
+		`SELECT `+orderApprovalFields+` FROM orderApprovals
+		WHERE status = ?
+		ORDER BY created, orderID`,
+		orderApprovalPending,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp := &sapb.OrderApprovals{}
+	for i := range models {
+		pb, err := modelToOrderApproval(&models[i])
+		if err != nil {
+			return nil, err
+		}
+		resp.Approvals = append(resp.Approvals, pb)
+	}
+	return resp, nil
+}
+
+// HoldOrderForApproval records that the given order, which must already be
+// processing, may only be issued for once an administrator approves it. The
+// CSR it was finalized with is stored so that issuance can resume afterwards.
+func (ssa *SQLStorageAuthority) HoldOrderForApproval(ctx context.Context, req *sapb.OrderApproval) (*emptypb.Empty, error) {
+	if req == nil || core.IsAnyNilOrZero(req.OrderID, req.RegistrationID, req.Names, req.Csr) {
+		return nil, errIncompleteRequest
+	}
+	namesJSON, err := json.Marshal(req.Names)
+	if err != nil {
+		return nil, err
+	}
+	err = ssa.dbMap.WithContext(ctx).Insert(&orderApprovalModel{
+		OrderID:        req.OrderID,
+		RegistrationID: req.RegistrationID,
+		Names:          string(namesJSON),
+		CSR:            req.Csr,
+		Status:         orderApprovalPending,
+		Created:        ssa.clk.Now(),
+	})
+	if err != nil {
+		if db.IsDuplicate(err) {
+			return nil, berrors.DuplicateError("order ID %d is already held for approval", req.OrderID)
+		}
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DecideOrderApproval approves or rejects an order held for approval. Each
+// order can only be decided once, so a NotFound error is returned if the order
+// isn't awaiting a decision.
+func (ssa *SQLStorageAuthority) DecideOrderApproval(ctx context.Context, req *sapb.DecideOrderApprovalRequest) (*emptypb.Empty, error) {
+	if req == nil || core.IsAnyNilOrZero(req.OrderID, req.DecidedBy, req.Reason) {
+		return nil, errIncompleteRequest
+	}
+	status := orderApprovalRejected
+	if req.Approved {
+		status = orderApprovalApproved
+	}
+	result, err := ssa.dbMap.WithContext(ctx).Exec(
+		`UPDATE orderApprovals
+		SET status = ?, decidedBy = ?, reason = ?, decided = ?
+		WHERE orderID = ? AND status = ?`,
+		status,
+		req.DecidedBy,
+		req.Reason,
+		ssa.clk.Now(),
+		req.OrderID,
+		orderApprovalPending,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, berrors.NotFoundError("order ID %d is not awaiting approval", req.OrderID)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,105 @@
+package sa
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestOrderApprovals(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+	ctx := context.Background()
+
+	reg := createWorkingRegistration(t, sa)
+
+	_, err := sa.GetOrderApproval(ctx, &sapb.OrderRequest{Id: 1})
+	test.Assert(t, errors.Is(err, berrors.NotFound), "order never held for approval was found")
+
+	_, err = sa.HoldOrderForApproval(ctx, &sapb.OrderApproval{
+		OrderID:        1,
+		RegistrationID: reg.Id,
+		Names:          []string{"bank.com"},
+	})
+	test.AssertError(t, err, "held order for approval without a CSR")
+
+	for _, orderID := range []int64{1, 2} {
+		_, err = sa.HoldOrderForApproval(ctx, &sapb.OrderApproval{
+			OrderID:        orderID,
+			RegistrationID: reg.Id,
+			Names:          []string{"bank.com", "www.bank.com"},
+			Csr:            []byte{1, 2, 3},
+		})
+		test.AssertNotError(t, err, "HoldOrderForApproval failed")
+		fc.Add(time.Minute)
+	}
+	_, err = sa.HoldOrderForApproval(ctx, &sapb.OrderApproval{
+		OrderID:        1,
+		RegistrationID: reg.Id,
+		Names:          []string{"bank.com"},
+		Csr:            []byte{1, 2, 3},
+	})
+	test.Assert(t, errors.Is(err, berrors.Duplicate), "held the same order for approval twice")
+
+	approval, err := sa.GetOrderApproval(ctx, &sapb.OrderRequest{Id: 1})
+	test.AssertNotError(t, err, "GetOrderApproval failed")
+	test.AssertEquals(t, approval.RegistrationID, reg.Id)
+	test.AssertDeepEquals(t, approval.Names, []string{"bank.com", "www.bank.com"})
+	test.AssertByteEquals(t, approval.Csr, []byte{1, 2, 3})
+	test.AssertEquals(t, approval.Status, "pending")
+	test.AssertEquals(t, approval.DecidedBy, "")
+
+	pending, err := sa.GetPendingOrderApprovals(ctx, &emptypb.Empty{})
+	test.AssertNotError(t, err, "GetPendingOrderApprovals failed")
+	test.AssertEquals(t, len(pending.Approvals), 2)
+	test.AssertEquals(t, pending.Approvals[0].OrderID, int64(1))
+	test.AssertEquals(t, pending.Approvals[1].OrderID, int64(2))
+
+	_, err = sa.DecideOrderApproval(ctx, &sapb.DecideOrderApprovalRequest{
+		OrderID:   1,
+		Approved:  true,
+		DecidedBy: "admin",
+	})
+	test.AssertError(t, err, "decided approval without a reason")
+
+	_, err = sa.DecideOrderApproval(ctx, &sapb.DecideOrderApprovalRequest{
+		OrderID:   1,
+		Approved:  true,
+		DecidedBy: "admin",
+		Reason:    "verified with the subscriber",
+	})
+	test.AssertNotError(t, err, "DecideOrderApproval failed")
+	_, err = sa.DecideOrderApproval(ctx, &sapb.DecideOrderApprovalRequest{
+		OrderID:   1,
+		DecidedBy: "admin",
+		Reason:    "changed my mind",
+	})
+	test.Assert(t, errors.Is(err, berrors.NotFound), "decided the same approval twice")
+
+	approval, err = sa.GetOrderApproval(ctx, &sapb.OrderRequest{Id: 1})
+	test.AssertNotError(t, err, "GetOrderApproval failed")
+	test.AssertEquals(t, approval.Status, "approved")
+	test.AssertEquals(t, approval.DecidedBy, "admin")
+	test.AssertEquals(t, approval.Reason, "verified with the subscriber")
+	test.AssertEquals(t, approval.Decided, fc.Now().UnixNano())
+
+	_, err = sa.DecideOrderApproval(ctx, &sapb.DecideOrderApprovalRequest{
+		OrderID:   2,
+		DecidedBy: "admin",
+		Reason:    "phishing",
+	})
+	test.AssertNotError(t, err, "DecideOrderApproval failed")
+	approval, err = sa.GetOrderApproval(ctx, &sapb.OrderRequest{Id: 2})
+	test.AssertNotError(t, err, "GetOrderApproval failed")
+	test.AssertEquals(t, approval.Status, "rejected")
+
+	pending, err = sa.GetPendingOrderApprovals(ctx, &emptypb.Empty{})
+	test.AssertNotError(t, err, "GetPendingOrderApprovals failed")
+	test.AssertEquals(t, len(pending.Approvals), 0)
+}
@@ -70,7 +70,7 @@ func (ssa *SQLStorageAuthority) AddPrecertificate(ctx context.Context, req *sapb
 			return nil, err
 		}
 
-		err = ssa.dbMap.WithContext(ctx).Insert(
+		err = txWithCtx.Insert(
 			&core.CertificateStatus{
 				Serial:                serialHex,
 				Status:                core.OCSPStatusGood,
@@ -83,6 +83,9 @@ func (ssa *SQLStorageAuthority) AddPrecertificate(ctx context.Context, req *sapb
 				IsExpired:             false,
 				IssuerID:              req.IssuerID,
 			})
+		if err != nil {
+			return nil, err
+		}
 
 		// NOTE(@cpu): When we collect up names to check if an FQDN set exists (e.g.
 		// that it is a renewal) we use just the DNSNames from the certificate and
@@ -92,7 +95,8 @@ func (ssa *SQLStorageAuthority) AddPrecertificate(ctx context.Context, req *sapb
 		// not for the purpose of rate limiting is the least of our troubles.
 		isRenewal, err := ssa.checkFQDNSetExists(
 			txWithCtx.SelectOne,
-			parsed.DNSNames)
+			parsed.DNSNames,
+			time.Time{})
 		if err != nil {
 			return nil, err
 		}
@@ -120,7 +124,7 @@ func (ssa *SQLStorageAuthority) GetPrecertificate(ctx context.Context, req *sapb
 	if !core.ValidSerial(req.Serial) {
 		return nil, fmt.Errorf("Invalid precertificate serial %q", req.Serial)
 	}
-	cert, err := SelectPrecertificate(ssa.dbMap.WithContext(ctx), req.Serial)
+	cert, err := SelectPrecertificate(ssa.reader(ctx, "GetPrecertificate"), req.Serial)
 	if err != nil {
 		if db.IsNoRows(err) {
 			return nil, berrors.NotFoundError(
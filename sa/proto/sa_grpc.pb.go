@@ -43,6 +43,28 @@ type StorageAuthorityClient interface {
 	CountInvalidAuthorizations2(ctx context.Context, in *CountInvalidAuthorizationsRequest, opts ...grpc.CallOption) (*Count, error)
 	GetValidAuthorizations2(ctx context.Context, in *GetValidAuthorizationsRequest, opts ...grpc.CallOption) (*Authorizations, error)
 	KeyBlocked(ctx context.Context, in *KeyBlockedRequest, opts ...grpc.CallOption) (*Exists, error)
+	GetRateLimitOverrides(ctx context.Context, in *GetRateLimitOverridesRequest, opts ...grpc.CallOption) (*RateLimitOverrides, error)
+	GetAccountIdentifierPolicy(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*AccountIdentifierPolicy, error)
+	GetAccountSuspension(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*AccountSuspension, error)
+	CheckIdentifiersPaused(ctx context.Context, in *CheckIdentifiersPausedRequest, opts ...grpc.CallOption) (*Identifiers, error)
+	GetOrderApproval(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderApproval, error)
+	GetPendingOrderApprovals(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*OrderApprovals, error)
+	GetSerialsByKey(ctx context.Context, in *SPKIHash, opts ...grpc.CallOption) (StorageAuthority_GetSerialsByKeyClient, error)
+	GetSerialsByAccount(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (StorageAuthority_GetSerialsByAccountClient, error)
+	GetSerialsByName(ctx context.Context, in *GetSerialsByNameRequest, opts ...grpc.CallOption) (StorageAuthority_GetSerialsByNameClient, error)
+	CheckNamesBlocked(ctx context.Context, in *Identifiers, opts ...grpc.CallOption) (*Identifiers, error)
+	GetIncidents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Incidents, error)
+	IncidentsForSerial(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*Incidents, error)
+	SerialsForIncident(ctx context.Context, in *SerialsForIncidentRequest, opts ...grpc.CallOption) (StorageAuthority_SerialsForIncidentClient, error)
+	SearchCertificatesByName(ctx context.Context, in *SearchCertificatesByNameRequest, opts ...grpc.CallOption) (*CertificateSearchResults, error)
+	GetAccountKeys(ctx context.Context, in *GetAccountKeysRequest, opts ...grpc.CallOption) (*AccountKeys, error)
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (StorageAuthority_StreamEventsClient, error)
+	StreamCertificates(ctx context.Context, in *StreamCertificatesRequest, opts ...grpc.CallOption) (StorageAuthority_StreamCertificatesClient, error)
+	StreamExpiringSerials(ctx context.Context, in *StreamExpiringSerialsRequest, opts ...grpc.CallOption) (StorageAuthority_StreamExpiringSerialsClient, error)
+	StreamRegistrationsWithContacts(ctx context.Context, in *StreamRegistrationsWithContactsRequest, opts ...grpc.CallOption) (StorageAuthority_StreamRegistrationsWithContactsClient, error)
+	StreamRegistrationsWithNames(ctx context.Context, in *StreamRegistrationsWithNamesRequest, opts ...grpc.CallOption) (StorageAuthority_StreamRegistrationsWithNamesClient, error)
+	StreamUncheckedBlockedKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (StorageAuthority_StreamUncheckedBlockedKeysClient, error)
+	StreamUnrevokedCertificatesByKey(ctx context.Context, in *SPKIHash, opts ...grpc.CallOption) (StorageAuthority_StreamUnrevokedCertificatesByKeyClient, error)
 	// Adders
 	NewRegistration(ctx context.Context, in *proto.Registration, opts ...grpc.CallOption) (*proto.Registration, error)
 	UpdateRegistration(ctx context.Context, in *proto.Registration, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -62,6 +84,25 @@ type StorageAuthorityClient interface {
 	FinalizeAuthorization2(ctx context.Context, in *FinalizeAuthorizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeactivateAuthorization2(ctx context.Context, in *AuthorizationID2, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddBlockedKey(ctx context.Context, in *AddBlockedKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddRateLimitOverride(ctx context.Context, in *RateLimitOverride, opts ...grpc.CallOption) (*RateLimitOverride, error)
+	ExpireRateLimitOverride(ctx context.Context, in *ExpireRateLimitOverrideRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetAccountIdentifierPolicy(ctx context.Context, in *AccountIdentifierPolicy, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveAccountIdentifierPolicy(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SuspendAccount(ctx context.Context, in *AccountSuspension, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnsuspendAccount(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RecordValidationFailure(ctx context.Context, in *AccountIdentifier, opts ...grpc.CallOption) (*Count, error)
+	ResetValidationFailures(ctx context.Context, in *AccountIdentifier, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PauseIdentifier(ctx context.Context, in *AccountIdentifier, opts ...grpc.CallOption) (*Exists, error)
+	UnpauseAccount(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*Count, error)
+	HoldOrderForApproval(ctx context.Context, in *OrderApproval, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DecideOrderApproval(ctx context.Context, in *DecideOrderApprovalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddBlockedName(ctx context.Context, in *AddBlockedNameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddIncident(ctx context.Context, in *Incident, opts ...grpc.CallOption) (*Incident, error)
+	AddIncidentSerials(ctx context.Context, in *AddIncidentSerialsRequest, opts ...grpc.CallOption) (*AddIncidentSerialsResponse, error)
+	AddIncidentSerialsIssuedBetween(ctx context.Context, in *AddIncidentSerialsIssuedBetweenRequest, opts ...grpc.CallOption) (*AddIncidentSerialsResponse, error)
+	MarkIncidentSerialsNotified(ctx context.Context, in *MarkIncidentSerialsNotifiedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MarkBlockedKeyChecked(ctx context.Context, in *SPKIHash, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MarkExpirationNagSent(ctx context.Context, in *MarkExpirationNagSentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type storageAuthorityClient struct {
@@ -252,6 +293,457 @@ func (c *storageAuthorityClient) KeyBlocked(ctx context.Context, in *KeyBlockedR
 	return out, nil
 }
 
+func (c *storageAuthorityClient) GetRateLimitOverrides(ctx context.Context, in *GetRateLimitOverridesRequest, opts ...grpc.CallOption) (*RateLimitOverrides, error) {
+	out := new(RateLimitOverrides)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetRateLimitOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) GetAccountIdentifierPolicy(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*AccountIdentifierPolicy, error) {
+	out := new(AccountIdentifierPolicy)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetAccountIdentifierPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) GetAccountSuspension(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*AccountSuspension, error) {
+	out := new(AccountSuspension)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetAccountSuspension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) CheckIdentifiersPaused(ctx context.Context, in *CheckIdentifiersPausedRequest, opts ...grpc.CallOption) (*Identifiers, error) {
+	out := new(Identifiers)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/CheckIdentifiersPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) GetOrderApproval(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderApproval, error) {
+	out := new(OrderApproval)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetOrderApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) GetPendingOrderApprovals(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*OrderApprovals, error) {
+	out := new(OrderApprovals)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetPendingOrderApprovals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) GetSerialsByKey(ctx context.Context, in *SPKIHash, opts ...grpc.CallOption) (StorageAuthority_GetSerialsByKeyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[0], "/sa.StorageAuthority/GetSerialsByKey", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageAuthorityGetSerialsByKeyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StorageAuthority_GetSerialsByKeyClient interface {
+	Recv() (*Serial, error)
+	grpc.ClientStream
+}
+
+type storageAuthorityGetSerialsByKeyClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageAuthorityGetSerialsByKeyClient) Recv() (*Serial, error) {
+	m := new(Serial)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageAuthorityClient) GetSerialsByAccount(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (StorageAuthority_GetSerialsByAccountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[1], "/sa.StorageAuthority/GetSerialsByAccount", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageAuthorityGetSerialsByAccountClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StorageAuthority_GetSerialsByAccountClient interface {
+	Recv() (*Serial, error)
+	grpc.ClientStream
+}
+
+type storageAuthorityGetSerialsByAccountClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageAuthorityGetSerialsByAccountClient) Recv() (*Serial, error) {
+	m := new(Serial)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageAuthorityClient) GetSerialsByName(ctx context.Context, in *GetSerialsByNameRequest, opts ...grpc.CallOption) (StorageAuthority_GetSerialsByNameClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[2], "/sa.StorageAuthority/GetSerialsByName", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageAuthorityGetSerialsByNameClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StorageAuthority_GetSerialsByNameClient interface {
+	Recv() (*Serial, error)
+	grpc.ClientStream
+}
+
+type storageAuthorityGetSerialsByNameClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageAuthorityGetSerialsByNameClient) Recv() (*Serial, error) {
+	m := new(Serial)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageAuthorityClient) CheckNamesBlocked(ctx context.Context, in *Identifiers, opts ...grpc.CallOption) (*Identifiers, error) {
+	out := new(Identifiers)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/CheckNamesBlocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) GetIncidents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Incidents, error) {
+	out := new(Incidents)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetIncidents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) IncidentsForSerial(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*Incidents, error) {
+	out := new(Incidents)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/IncidentsForSerial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) SerialsForIncident(ctx context.Context, in *SerialsForIncidentRequest, opts ...grpc.CallOption) (StorageAuthority_SerialsForIncidentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[3], "/sa.StorageAuthority/SerialsForIncident", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageAuthoritySerialsForIncidentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StorageAuthority_SerialsForIncidentClient interface {
+	Recv() (*IncidentSerial, error)
+	grpc.ClientStream
+}
+
+type storageAuthoritySerialsForIncidentClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageAuthoritySerialsForIncidentClient) Recv() (*IncidentSerial, error) {
+	m := new(IncidentSerial)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageAuthorityClient) SearchCertificatesByName(ctx context.Context, in *SearchCertificatesByNameRequest, opts ...grpc.CallOption) (*CertificateSearchResults, error) {
+	out := new(CertificateSearchResults)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/SearchCertificatesByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) GetAccountKeys(ctx context.Context, in *GetAccountKeysRequest, opts ...grpc.CallOption) (*AccountKeys, error) {
+	out := new(AccountKeys)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/GetAccountKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (StorageAuthority_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[4], "/sa.StorageAuthority/StreamEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageAuthorityStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StorageAuthority_StreamEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type storageAuthorityStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageAuthorityStreamEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageAuthorityClient) StreamCertificates(ctx context.Context, in *StreamCertificatesRequest, opts ...grpc.CallOption) (StorageAuthority_StreamCertificatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[5], "/sa.StorageAuthority/StreamCertificates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageAuthorityStreamCertificatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StorageAuthority_StreamCertificatesClient interface {
+	Recv() (*proto.Certificate, error)
+	grpc.ClientStream
+}
+
+type storageAuthorityStreamCertificatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageAuthorityStreamCertificatesClient) Recv() (*proto.Certificate, error) {
+	m := new(proto.Certificate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageAuthorityClient) StreamExpiringSerials(ctx context.Context, in *StreamExpiringSerialsRequest, opts ...grpc.CallOption) (StorageAuthority_StreamExpiringSerialsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[6], "/sa.StorageAuthority/StreamExpiringSerials", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageAuthorityStreamExpiringSerialsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StorageAuthority_StreamExpiringSerialsClient interface {
+	Recv() (*Serial, error)
+	grpc.ClientStream
+}
+
+type storageAuthorityStreamExpiringSerialsClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageAuthorityStreamExpiringSerialsClient) Recv() (*Serial, error) {
+	m := new(Serial)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageAuthorityClient) StreamRegistrationsWithContacts(ctx context.Context, in *StreamRegistrationsWithContactsRequest, opts ...grpc.CallOption) (StorageAuthority_StreamRegistrationsWithContactsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[7], "/sa.StorageAuthority/StreamRegistrationsWithContacts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageAuthorityStreamRegistrationsWithContactsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StorageAuthority_StreamRegistrationsWithContactsClient interface {
+	Recv() (*RegistrationContacts, error)
+	grpc.ClientStream
+}
+
+type storageAuthorityStreamRegistrationsWithContactsClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageAuthorityStreamRegistrationsWithContactsClient) Recv() (*RegistrationContacts, error) {
+	m := new(RegistrationContacts)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageAuthorityClient) StreamRegistrationsWithNames(ctx context.Context, in *StreamRegistrationsWithNamesRequest, opts ...grpc.CallOption) (StorageAuthority_StreamRegistrationsWithNamesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[8], "/sa.StorageAuthority/StreamRegistrationsWithNames", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageAuthorityStreamRegistrationsWithNamesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StorageAuthority_StreamRegistrationsWithNamesClient interface {
+	Recv() (*RegistrationName, error)
+	grpc.ClientStream
+}
+
+type storageAuthorityStreamRegistrationsWithNamesClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageAuthorityStreamRegistrationsWithNamesClient) Recv() (*RegistrationName, error) {
+	m := new(RegistrationName)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageAuthorityClient) StreamUncheckedBlockedKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (StorageAuthority_StreamUncheckedBlockedKeysClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[9], "/sa.StorageAuthority/StreamUncheckedBlockedKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageAuthorityStreamUncheckedBlockedKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StorageAuthority_StreamUncheckedBlockedKeysClient interface {
+	Recv() (*BlockedKey, error)
+	grpc.ClientStream
+}
+
+type storageAuthorityStreamUncheckedBlockedKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageAuthorityStreamUncheckedBlockedKeysClient) Recv() (*BlockedKey, error) {
+	m := new(BlockedKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageAuthorityClient) StreamUnrevokedCertificatesByKey(ctx context.Context, in *SPKIHash, opts ...grpc.CallOption) (StorageAuthority_StreamUnrevokedCertificatesByKeyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[10], "/sa.StorageAuthority/StreamUnrevokedCertificatesByKey", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageAuthorityStreamUnrevokedCertificatesByKeyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StorageAuthority_StreamUnrevokedCertificatesByKeyClient interface {
+	Recv() (*proto.Certificate, error)
+	grpc.ClientStream
+}
+
+type storageAuthorityStreamUnrevokedCertificatesByKeyClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageAuthorityStreamUnrevokedCertificatesByKeyClient) Recv() (*proto.Certificate, error) {
+	m := new(proto.Certificate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *storageAuthorityClient) NewRegistration(ctx context.Context, in *proto.Registration, opts ...grpc.CallOption) (*proto.Registration, error) {
 	out := new(proto.Registration)
 	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/NewRegistration", in, out, opts...)
@@ -414,9 +906,180 @@ func (c *storageAuthorityClient) AddBlockedKey(ctx context.Context, in *AddBlock
 	return out, nil
 }
 
-// StorageAuthorityServer is the server API for StorageAuthority service.
-// All implementations must embed UnimplementedStorageAuthorityServer
-// for forward compatibility
+func (c *storageAuthorityClient) AddRateLimitOverride(ctx context.Context, in *RateLimitOverride, opts ...grpc.CallOption) (*RateLimitOverride, error) {
+	out := new(RateLimitOverride)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/AddRateLimitOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) ExpireRateLimitOverride(ctx context.Context, in *ExpireRateLimitOverrideRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/ExpireRateLimitOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) SetAccountIdentifierPolicy(ctx context.Context, in *AccountIdentifierPolicy, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/SetAccountIdentifierPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) RemoveAccountIdentifierPolicy(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/RemoveAccountIdentifierPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) SuspendAccount(ctx context.Context, in *AccountSuspension, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/SuspendAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) UnsuspendAccount(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/UnsuspendAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) RecordValidationFailure(ctx context.Context, in *AccountIdentifier, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/RecordValidationFailure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) ResetValidationFailures(ctx context.Context, in *AccountIdentifier, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/ResetValidationFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) PauseIdentifier(ctx context.Context, in *AccountIdentifier, opts ...grpc.CallOption) (*Exists, error) {
+	out := new(Exists)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/PauseIdentifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) UnpauseAccount(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/UnpauseAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) HoldOrderForApproval(ctx context.Context, in *OrderApproval, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/HoldOrderForApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) DecideOrderApproval(ctx context.Context, in *DecideOrderApprovalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/DecideOrderApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) AddBlockedName(ctx context.Context, in *AddBlockedNameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/AddBlockedName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) AddIncident(ctx context.Context, in *Incident, opts ...grpc.CallOption) (*Incident, error) {
+	out := new(Incident)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/AddIncident", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) AddIncidentSerials(ctx context.Context, in *AddIncidentSerialsRequest, opts ...grpc.CallOption) (*AddIncidentSerialsResponse, error) {
+	out := new(AddIncidentSerialsResponse)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/AddIncidentSerials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) AddIncidentSerialsIssuedBetween(ctx context.Context, in *AddIncidentSerialsIssuedBetweenRequest, opts ...grpc.CallOption) (*AddIncidentSerialsResponse, error) {
+	out := new(AddIncidentSerialsResponse)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/AddIncidentSerialsIssuedBetween", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) MarkIncidentSerialsNotified(ctx context.Context, in *MarkIncidentSerialsNotifiedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/MarkIncidentSerialsNotified", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) MarkBlockedKeyChecked(ctx context.Context, in *SPKIHash, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/MarkBlockedKeyChecked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) MarkExpirationNagSent(ctx context.Context, in *MarkExpirationNagSentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/sa.StorageAuthority/MarkExpirationNagSent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StorageAuthorityServer is the server API for StorageAuthority service.
+// All implementations must embed UnimplementedStorageAuthorityServer
+// for forward compatibility
 type StorageAuthorityServer interface {
 	// Getters
 	GetRegistration(context.Context, *RegistrationID) (*proto.Registration, error)
@@ -441,6 +1104,28 @@ type StorageAuthorityServer interface {
 	CountInvalidAuthorizations2(context.Context, *CountInvalidAuthorizationsRequest) (*Count, error)
 	GetValidAuthorizations2(context.Context, *GetValidAuthorizationsRequest) (*Authorizations, error)
 	KeyBlocked(context.Context, *KeyBlockedRequest) (*Exists, error)
+	GetRateLimitOverrides(context.Context, *GetRateLimitOverridesRequest) (*RateLimitOverrides, error)
+	GetAccountIdentifierPolicy(context.Context, *RegistrationID) (*AccountIdentifierPolicy, error)
+	GetAccountSuspension(context.Context, *RegistrationID) (*AccountSuspension, error)
+	CheckIdentifiersPaused(context.Context, *CheckIdentifiersPausedRequest) (*Identifiers, error)
+	GetOrderApproval(context.Context, *OrderRequest) (*OrderApproval, error)
+	GetPendingOrderApprovals(context.Context, *emptypb.Empty) (*OrderApprovals, error)
+	GetSerialsByKey(*SPKIHash, StorageAuthority_GetSerialsByKeyServer) error
+	GetSerialsByAccount(*RegistrationID, StorageAuthority_GetSerialsByAccountServer) error
+	GetSerialsByName(*GetSerialsByNameRequest, StorageAuthority_GetSerialsByNameServer) error
+	CheckNamesBlocked(context.Context, *Identifiers) (*Identifiers, error)
+	GetIncidents(context.Context, *emptypb.Empty) (*Incidents, error)
+	IncidentsForSerial(context.Context, *Serial) (*Incidents, error)
+	SerialsForIncident(*SerialsForIncidentRequest, StorageAuthority_SerialsForIncidentServer) error
+	SearchCertificatesByName(context.Context, *SearchCertificatesByNameRequest) (*CertificateSearchResults, error)
+	GetAccountKeys(context.Context, *GetAccountKeysRequest) (*AccountKeys, error)
+	StreamEvents(*StreamEventsRequest, StorageAuthority_StreamEventsServer) error
+	StreamCertificates(*StreamCertificatesRequest, StorageAuthority_StreamCertificatesServer) error
+	StreamExpiringSerials(*StreamExpiringSerialsRequest, StorageAuthority_StreamExpiringSerialsServer) error
+	StreamRegistrationsWithContacts(*StreamRegistrationsWithContactsRequest, StorageAuthority_StreamRegistrationsWithContactsServer) error
+	StreamRegistrationsWithNames(*StreamRegistrationsWithNamesRequest, StorageAuthority_StreamRegistrationsWithNamesServer) error
+	StreamUncheckedBlockedKeys(*emptypb.Empty, StorageAuthority_StreamUncheckedBlockedKeysServer) error
+	StreamUnrevokedCertificatesByKey(*SPKIHash, StorageAuthority_StreamUnrevokedCertificatesByKeyServer) error
 	// Adders
 	NewRegistration(context.Context, *proto.Registration) (*proto.Registration, error)
 	UpdateRegistration(context.Context, *proto.Registration) (*emptypb.Empty, error)
@@ -460,6 +1145,25 @@ type StorageAuthorityServer interface {
 	FinalizeAuthorization2(context.Context, *FinalizeAuthorizationRequest) (*emptypb.Empty, error)
 	DeactivateAuthorization2(context.Context, *AuthorizationID2) (*emptypb.Empty, error)
 	AddBlockedKey(context.Context, *AddBlockedKeyRequest) (*emptypb.Empty, error)
+	AddRateLimitOverride(context.Context, *RateLimitOverride) (*RateLimitOverride, error)
+	ExpireRateLimitOverride(context.Context, *ExpireRateLimitOverrideRequest) (*emptypb.Empty, error)
+	SetAccountIdentifierPolicy(context.Context, *AccountIdentifierPolicy) (*emptypb.Empty, error)
+	RemoveAccountIdentifierPolicy(context.Context, *RegistrationID) (*emptypb.Empty, error)
+	SuspendAccount(context.Context, *AccountSuspension) (*emptypb.Empty, error)
+	UnsuspendAccount(context.Context, *RegistrationID) (*emptypb.Empty, error)
+	RecordValidationFailure(context.Context, *AccountIdentifier) (*Count, error)
+	ResetValidationFailures(context.Context, *AccountIdentifier) (*emptypb.Empty, error)
+	PauseIdentifier(context.Context, *AccountIdentifier) (*Exists, error)
+	UnpauseAccount(context.Context, *RegistrationID) (*Count, error)
+	HoldOrderForApproval(context.Context, *OrderApproval) (*emptypb.Empty, error)
+	DecideOrderApproval(context.Context, *DecideOrderApprovalRequest) (*emptypb.Empty, error)
+	AddBlockedName(context.Context, *AddBlockedNameRequest) (*emptypb.Empty, error)
+	AddIncident(context.Context, *Incident) (*Incident, error)
+	AddIncidentSerials(context.Context, *AddIncidentSerialsRequest) (*AddIncidentSerialsResponse, error)
+	AddIncidentSerialsIssuedBetween(context.Context, *AddIncidentSerialsIssuedBetweenRequest) (*AddIncidentSerialsResponse, error)
+	MarkIncidentSerialsNotified(context.Context, *MarkIncidentSerialsNotifiedRequest) (*emptypb.Empty, error)
+	MarkBlockedKeyChecked(context.Context, *SPKIHash) (*emptypb.Empty, error)
+	MarkExpirationNagSent(context.Context, *MarkExpirationNagSentRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedStorageAuthorityServer()
 }
 
@@ -488,792 +1192,1686 @@ func (UnimplementedStorageAuthorityServer) CountCertificatesByNames(context.Cont
 func (UnimplementedStorageAuthorityServer) CountRegistrationsByIP(context.Context, *CountRegistrationsByIPRequest) (*Count, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CountRegistrationsByIP not implemented")
 }
-func (UnimplementedStorageAuthorityServer) CountRegistrationsByIPRange(context.Context, *CountRegistrationsByIPRequest) (*Count, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CountRegistrationsByIPRange not implemented")
+func (UnimplementedStorageAuthorityServer) CountRegistrationsByIPRange(context.Context, *CountRegistrationsByIPRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountRegistrationsByIPRange not implemented")
+}
+func (UnimplementedStorageAuthorityServer) CountOrders(context.Context, *CountOrdersRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountOrders not implemented")
+}
+func (UnimplementedStorageAuthorityServer) CountFQDNSets(context.Context, *CountFQDNSetsRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountFQDNSets not implemented")
+}
+func (UnimplementedStorageAuthorityServer) FQDNSetExists(context.Context, *FQDNSetExistsRequest) (*Exists, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FQDNSetExists not implemented")
+}
+func (UnimplementedStorageAuthorityServer) PreviousCertificateExists(context.Context, *PreviousCertificateExistsRequest) (*Exists, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviousCertificateExists not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetAuthorization2(context.Context, *AuthorizationID2) (*proto.Authorization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorization2 not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetAuthorizations2(context.Context, *GetAuthorizationsRequest) (*Authorizations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorizations2 not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetPendingAuthorization2(context.Context, *GetPendingAuthorizationRequest) (*proto.Authorization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingAuthorization2 not implemented")
+}
+func (UnimplementedStorageAuthorityServer) CountPendingAuthorizations2(context.Context, *RegistrationID) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountPendingAuthorizations2 not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetValidOrderAuthorizations2(context.Context, *GetValidOrderAuthorizationsRequest) (*Authorizations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidOrderAuthorizations2 not implemented")
+}
+func (UnimplementedStorageAuthorityServer) CountInvalidAuthorizations2(context.Context, *CountInvalidAuthorizationsRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountInvalidAuthorizations2 not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetValidAuthorizations2(context.Context, *GetValidAuthorizationsRequest) (*Authorizations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidAuthorizations2 not implemented")
+}
+func (UnimplementedStorageAuthorityServer) KeyBlocked(context.Context, *KeyBlockedRequest) (*Exists, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyBlocked not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetRateLimitOverrides(context.Context, *GetRateLimitOverridesRequest) (*RateLimitOverrides, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateLimitOverrides not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetAccountIdentifierPolicy(context.Context, *RegistrationID) (*AccountIdentifierPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountIdentifierPolicy not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetAccountSuspension(context.Context, *RegistrationID) (*AccountSuspension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountSuspension not implemented")
+}
+func (UnimplementedStorageAuthorityServer) CheckIdentifiersPaused(context.Context, *CheckIdentifiersPausedRequest) (*Identifiers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIdentifiersPaused not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetOrderApproval(context.Context, *OrderRequest) (*OrderApproval, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderApproval not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetPendingOrderApprovals(context.Context, *emptypb.Empty) (*OrderApprovals, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingOrderApprovals not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetSerialsByKey(*SPKIHash, StorageAuthority_GetSerialsByKeyServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetSerialsByKey not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetSerialsByAccount(*RegistrationID, StorageAuthority_GetSerialsByAccountServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetSerialsByAccount not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetSerialsByName(*GetSerialsByNameRequest, StorageAuthority_GetSerialsByNameServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetSerialsByName not implemented")
+}
+func (UnimplementedStorageAuthorityServer) CheckNamesBlocked(context.Context, *Identifiers) (*Identifiers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckNamesBlocked not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetIncidents(context.Context, *emptypb.Empty) (*Incidents, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidents not implemented")
+}
+func (UnimplementedStorageAuthorityServer) IncidentsForSerial(context.Context, *Serial) (*Incidents, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncidentsForSerial not implemented")
+}
+func (UnimplementedStorageAuthorityServer) SerialsForIncident(*SerialsForIncidentRequest, StorageAuthority_SerialsForIncidentServer) error {
+	return status.Errorf(codes.Unimplemented, "method SerialsForIncident not implemented")
+}
+func (UnimplementedStorageAuthorityServer) SearchCertificatesByName(context.Context, *SearchCertificatesByNameRequest) (*CertificateSearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCertificatesByName not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetAccountKeys(context.Context, *GetAccountKeysRequest) (*AccountKeys, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountKeys not implemented")
+}
+func (UnimplementedStorageAuthorityServer) StreamEvents(*StreamEventsRequest, StorageAuthority_StreamEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedStorageAuthorityServer) StreamCertificates(*StreamCertificatesRequest, StorageAuthority_StreamCertificatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCertificates not implemented")
+}
+func (UnimplementedStorageAuthorityServer) StreamExpiringSerials(*StreamExpiringSerialsRequest, StorageAuthority_StreamExpiringSerialsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamExpiringSerials not implemented")
+}
+func (UnimplementedStorageAuthorityServer) StreamRegistrationsWithContacts(*StreamRegistrationsWithContactsRequest, StorageAuthority_StreamRegistrationsWithContactsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRegistrationsWithContacts not implemented")
+}
+func (UnimplementedStorageAuthorityServer) StreamRegistrationsWithNames(*StreamRegistrationsWithNamesRequest, StorageAuthority_StreamRegistrationsWithNamesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRegistrationsWithNames not implemented")
+}
+func (UnimplementedStorageAuthorityServer) StreamUncheckedBlockedKeys(*emptypb.Empty, StorageAuthority_StreamUncheckedBlockedKeysServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamUncheckedBlockedKeys not implemented")
+}
+func (UnimplementedStorageAuthorityServer) StreamUnrevokedCertificatesByKey(*SPKIHash, StorageAuthority_StreamUnrevokedCertificatesByKeyServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamUnrevokedCertificatesByKey not implemented")
+}
+func (UnimplementedStorageAuthorityServer) NewRegistration(context.Context, *proto.Registration) (*proto.Registration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewRegistration not implemented")
+}
+func (UnimplementedStorageAuthorityServer) UpdateRegistration(context.Context, *proto.Registration) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRegistration not implemented")
+}
+func (UnimplementedStorageAuthorityServer) AddCertificate(context.Context, *AddCertificateRequest) (*AddCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCertificate not implemented")
+}
+func (UnimplementedStorageAuthorityServer) AddPrecertificate(context.Context, *AddCertificateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPrecertificate not implemented")
+}
+func (UnimplementedStorageAuthorityServer) AddSerial(context.Context, *AddSerialRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSerial not implemented")
+}
+func (UnimplementedStorageAuthorityServer) DeactivateRegistration(context.Context, *RegistrationID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateRegistration not implemented")
+}
+func (UnimplementedStorageAuthorityServer) NewOrder(context.Context, *NewOrderRequest) (*proto.Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewOrder not implemented")
+}
+func (UnimplementedStorageAuthorityServer) NewOrderAndAuthzs(context.Context, *NewOrderAndAuthzsRequest) (*proto.Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewOrderAndAuthzs not implemented")
+}
+func (UnimplementedStorageAuthorityServer) SetOrderProcessing(context.Context, *OrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrderProcessing not implemented")
+}
+func (UnimplementedStorageAuthorityServer) SetOrderError(context.Context, *SetOrderErrorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrderError not implemented")
+}
+func (UnimplementedStorageAuthorityServer) FinalizeOrder(context.Context, *FinalizeOrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeOrder not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetOrder(context.Context, *OrderRequest) (*proto.Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetOrderForNames(context.Context, *GetOrderForNamesRequest) (*proto.Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderForNames not implemented")
+}
+func (UnimplementedStorageAuthorityServer) RevokeCertificate(context.Context, *RevokeCertificateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCertificate not implemented")
+}
+func (UnimplementedStorageAuthorityServer) NewAuthorizations2(context.Context, *AddPendingAuthorizationsRequest) (*Authorization2IDs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewAuthorizations2 not implemented")
+}
+func (UnimplementedStorageAuthorityServer) FinalizeAuthorization2(context.Context, *FinalizeAuthorizationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeAuthorization2 not implemented")
+}
+func (UnimplementedStorageAuthorityServer) DeactivateAuthorization2(context.Context, *AuthorizationID2) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateAuthorization2 not implemented")
+}
+func (UnimplementedStorageAuthorityServer) AddBlockedKey(context.Context, *AddBlockedKeyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedKey not implemented")
+}
+func (UnimplementedStorageAuthorityServer) AddRateLimitOverride(context.Context, *RateLimitOverride) (*RateLimitOverride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRateLimitOverride not implemented")
+}
+func (UnimplementedStorageAuthorityServer) ExpireRateLimitOverride(context.Context, *ExpireRateLimitOverrideRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireRateLimitOverride not implemented")
+}
+func (UnimplementedStorageAuthorityServer) SetAccountIdentifierPolicy(context.Context, *AccountIdentifierPolicy) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountIdentifierPolicy not implemented")
+}
+func (UnimplementedStorageAuthorityServer) RemoveAccountIdentifierPolicy(context.Context, *RegistrationID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAccountIdentifierPolicy not implemented")
+}
+func (UnimplementedStorageAuthorityServer) SuspendAccount(context.Context, *AccountSuspension) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendAccount not implemented")
+}
+func (UnimplementedStorageAuthorityServer) UnsuspendAccount(context.Context, *RegistrationID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendAccount not implemented")
+}
+func (UnimplementedStorageAuthorityServer) RecordValidationFailure(context.Context, *AccountIdentifier) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordValidationFailure not implemented")
+}
+func (UnimplementedStorageAuthorityServer) ResetValidationFailures(context.Context, *AccountIdentifier) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetValidationFailures not implemented")
+}
+func (UnimplementedStorageAuthorityServer) PauseIdentifier(context.Context, *AccountIdentifier) (*Exists, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseIdentifier not implemented")
+}
+func (UnimplementedStorageAuthorityServer) UnpauseAccount(context.Context, *RegistrationID) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseAccount not implemented")
+}
+func (UnimplementedStorageAuthorityServer) HoldOrderForApproval(context.Context, *OrderApproval) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldOrderForApproval not implemented")
+}
+func (UnimplementedStorageAuthorityServer) DecideOrderApproval(context.Context, *DecideOrderApprovalRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecideOrderApproval not implemented")
+}
+func (UnimplementedStorageAuthorityServer) AddBlockedName(context.Context, *AddBlockedNameRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedName not implemented")
+}
+func (UnimplementedStorageAuthorityServer) AddIncident(context.Context, *Incident) (*Incident, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddIncident not implemented")
+}
+func (UnimplementedStorageAuthorityServer) AddIncidentSerials(context.Context, *AddIncidentSerialsRequest) (*AddIncidentSerialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddIncidentSerials not implemented")
+}
+func (UnimplementedStorageAuthorityServer) AddIncidentSerialsIssuedBetween(context.Context, *AddIncidentSerialsIssuedBetweenRequest) (*AddIncidentSerialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddIncidentSerialsIssuedBetween not implemented")
+}
+func (UnimplementedStorageAuthorityServer) MarkIncidentSerialsNotified(context.Context, *MarkIncidentSerialsNotifiedRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkIncidentSerialsNotified not implemented")
+}
+func (UnimplementedStorageAuthorityServer) MarkBlockedKeyChecked(context.Context, *SPKIHash) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkBlockedKeyChecked not implemented")
+}
+func (UnimplementedStorageAuthorityServer) MarkExpirationNagSent(context.Context, *MarkExpirationNagSentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkExpirationNagSent not implemented")
+}
+func (UnimplementedStorageAuthorityServer) mustEmbedUnimplementedStorageAuthorityServer() {}
+
+// UnsafeStorageAuthorityServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StorageAuthorityServer will
+// result in compilation errors.
+type UnsafeStorageAuthorityServer interface {
+	mustEmbedUnimplementedStorageAuthorityServer()
+}
+
+func RegisterStorageAuthorityServer(s grpc.ServiceRegistrar, srv StorageAuthorityServer) {
+	s.RegisterService(&StorageAuthority_ServiceDesc, srv)
+}
+
+func _StorageAuthority_GetRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetRegistration(ctx, req.(*RegistrationID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetRegistrationByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONWebKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetRegistrationByKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetRegistrationByKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetRegistrationByKey(ctx, req.(*JSONWebKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Serial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetCertificate(ctx, req.(*Serial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetPrecertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Serial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetPrecertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetPrecertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetPrecertificate(ctx, req.(*Serial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetCertificateStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Serial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetCertificateStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetCertificateStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetCertificateStatus(ctx, req.(*Serial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_CountCertificatesByNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountCertificatesByNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).CountCertificatesByNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/CountCertificatesByNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).CountCertificatesByNames(ctx, req.(*CountCertificatesByNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_CountRegistrationsByIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRegistrationsByIPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).CountRegistrationsByIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/CountRegistrationsByIP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).CountRegistrationsByIP(ctx, req.(*CountRegistrationsByIPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_CountRegistrationsByIPRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRegistrationsByIPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).CountRegistrationsByIPRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/CountRegistrationsByIPRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).CountRegistrationsByIPRange(ctx, req.(*CountRegistrationsByIPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_CountOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).CountOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/CountOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).CountOrders(ctx, req.(*CountOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_CountFQDNSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountFQDNSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).CountFQDNSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/CountFQDNSets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).CountFQDNSets(ctx, req.(*CountFQDNSetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_FQDNSetExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FQDNSetExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).FQDNSetExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/FQDNSetExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).FQDNSetExists(ctx, req.(*FQDNSetExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_PreviousCertificateExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviousCertificateExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).PreviousCertificateExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/PreviousCertificateExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).PreviousCertificateExists(ctx, req.(*PreviousCertificateExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetAuthorization2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizationID2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetAuthorization2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetAuthorization2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetAuthorization2(ctx, req.(*AuthorizationID2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetAuthorizations2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetAuthorizations2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetAuthorizations2(ctx, req.(*GetAuthorizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetPendingAuthorization2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetPendingAuthorization2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetPendingAuthorization2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetPendingAuthorization2(ctx, req.(*GetPendingAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_CountPendingAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).CountPendingAuthorizations2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/CountPendingAuthorizations2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).CountPendingAuthorizations2(ctx, req.(*RegistrationID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetValidOrderAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidOrderAuthorizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetValidOrderAuthorizations2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetValidOrderAuthorizations2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetValidOrderAuthorizations2(ctx, req.(*GetValidOrderAuthorizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_CountInvalidAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountInvalidAuthorizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).CountInvalidAuthorizations2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/CountInvalidAuthorizations2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).CountInvalidAuthorizations2(ctx, req.(*CountInvalidAuthorizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetValidAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidAuthorizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetValidAuthorizations2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetValidAuthorizations2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetValidAuthorizations2(ctx, req.(*GetValidAuthorizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_KeyBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).KeyBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/KeyBlocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).KeyBlocked(ctx, req.(*KeyBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetRateLimitOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateLimitOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetRateLimitOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetRateLimitOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetRateLimitOverrides(ctx, req.(*GetRateLimitOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetAccountIdentifierPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetAccountIdentifierPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetAccountIdentifierPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetAccountIdentifierPolicy(ctx, req.(*RegistrationID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetAccountSuspension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetAccountSuspension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetAccountSuspension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetAccountSuspension(ctx, req.(*RegistrationID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_CheckIdentifiersPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckIdentifiersPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).CheckIdentifiersPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/CheckIdentifiersPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).CheckIdentifiersPaused(ctx, req.(*CheckIdentifiersPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetOrderApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetOrderApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetOrderApproval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetOrderApproval(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetPendingOrderApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetPendingOrderApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetPendingOrderApprovals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetPendingOrderApprovals(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetSerialsByKey_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SPKIHash)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).GetSerialsByKey(m, &storageAuthorityGetSerialsByKeyServer{stream})
+}
+
+type StorageAuthority_GetSerialsByKeyServer interface {
+	Send(*Serial) error
+	grpc.ServerStream
+}
+
+type storageAuthorityGetSerialsByKeyServer struct {
+	grpc.ServerStream
+}
+
+func (x *storageAuthorityGetSerialsByKeyServer) Send(m *Serial) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StorageAuthority_GetSerialsByAccount_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RegistrationID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).GetSerialsByAccount(m, &storageAuthorityGetSerialsByAccountServer{stream})
+}
+
+type StorageAuthority_GetSerialsByAccountServer interface {
+	Send(*Serial) error
+	grpc.ServerStream
+}
+
+type storageAuthorityGetSerialsByAccountServer struct {
+	grpc.ServerStream
+}
+
+func (x *storageAuthorityGetSerialsByAccountServer) Send(m *Serial) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StorageAuthority_GetSerialsByName_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSerialsByNameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).GetSerialsByName(m, &storageAuthorityGetSerialsByNameServer{stream})
+}
+
+type StorageAuthority_GetSerialsByNameServer interface {
+	Send(*Serial) error
+	grpc.ServerStream
+}
+
+type storageAuthorityGetSerialsByNameServer struct {
+	grpc.ServerStream
+}
+
+func (x *storageAuthorityGetSerialsByNameServer) Send(m *Serial) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StorageAuthority_CheckNamesBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).CheckNamesBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/CheckNamesBlocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).CheckNamesBlocked(ctx, req.(*Identifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetIncidents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetIncidents(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_IncidentsForSerial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Serial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).IncidentsForSerial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/IncidentsForSerial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).IncidentsForSerial(ctx, req.(*Serial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_SerialsForIncident_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SerialsForIncidentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).SerialsForIncident(m, &storageAuthoritySerialsForIncidentServer{stream})
 }
-func (UnimplementedStorageAuthorityServer) CountOrders(context.Context, *CountOrdersRequest) (*Count, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CountOrders not implemented")
+
+type StorageAuthority_SerialsForIncidentServer interface {
+	Send(*IncidentSerial) error
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) CountFQDNSets(context.Context, *CountFQDNSetsRequest) (*Count, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CountFQDNSets not implemented")
+
+type storageAuthoritySerialsForIncidentServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) FQDNSetExists(context.Context, *FQDNSetExistsRequest) (*Exists, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FQDNSetExists not implemented")
+
+func (x *storageAuthoritySerialsForIncidentServer) Send(m *IncidentSerial) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedStorageAuthorityServer) PreviousCertificateExists(context.Context, *PreviousCertificateExistsRequest) (*Exists, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PreviousCertificateExists not implemented")
+
+func _StorageAuthority_SearchCertificatesByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCertificatesByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).SearchCertificatesByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/SearchCertificatesByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).SearchCertificatesByName(ctx, req.(*SearchCertificatesByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStorageAuthorityServer) GetAuthorization2(context.Context, *AuthorizationID2) (*proto.Authorization, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorization2 not implemented")
+
+func _StorageAuthority_GetAccountKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetAccountKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sa.StorageAuthority/GetAccountKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetAccountKeys(ctx, req.(*GetAccountKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStorageAuthorityServer) GetAuthorizations2(context.Context, *GetAuthorizationsRequest) (*Authorizations, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorizations2 not implemented")
+
+func _StorageAuthority_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).StreamEvents(m, &storageAuthorityStreamEventsServer{stream})
 }
-func (UnimplementedStorageAuthorityServer) GetPendingAuthorization2(context.Context, *GetPendingAuthorizationRequest) (*proto.Authorization, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPendingAuthorization2 not implemented")
+
+type StorageAuthority_StreamEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) CountPendingAuthorizations2(context.Context, *RegistrationID) (*Count, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CountPendingAuthorizations2 not implemented")
+
+type storageAuthorityStreamEventsServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) GetValidOrderAuthorizations2(context.Context, *GetValidOrderAuthorizationsRequest) (*Authorizations, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidOrderAuthorizations2 not implemented")
+
+func (x *storageAuthorityStreamEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedStorageAuthorityServer) CountInvalidAuthorizations2(context.Context, *CountInvalidAuthorizationsRequest) (*Count, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CountInvalidAuthorizations2 not implemented")
+
+func _StorageAuthority_StreamCertificates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamCertificatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).StreamCertificates(m, &storageAuthorityStreamCertificatesServer{stream})
 }
-func (UnimplementedStorageAuthorityServer) GetValidAuthorizations2(context.Context, *GetValidAuthorizationsRequest) (*Authorizations, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidAuthorizations2 not implemented")
+
+type StorageAuthority_StreamCertificatesServer interface {
+	Send(*proto.Certificate) error
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) KeyBlocked(context.Context, *KeyBlockedRequest) (*Exists, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KeyBlocked not implemented")
+
+type storageAuthorityStreamCertificatesServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) NewRegistration(context.Context, *proto.Registration) (*proto.Registration, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewRegistration not implemented")
+
+func (x *storageAuthorityStreamCertificatesServer) Send(m *proto.Certificate) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedStorageAuthorityServer) UpdateRegistration(context.Context, *proto.Registration) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRegistration not implemented")
+
+func _StorageAuthority_StreamExpiringSerials_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamExpiringSerialsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).StreamExpiringSerials(m, &storageAuthorityStreamExpiringSerialsServer{stream})
 }
-func (UnimplementedStorageAuthorityServer) AddCertificate(context.Context, *AddCertificateRequest) (*AddCertificateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddCertificate not implemented")
+
+type StorageAuthority_StreamExpiringSerialsServer interface {
+	Send(*Serial) error
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) AddPrecertificate(context.Context, *AddCertificateRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPrecertificate not implemented")
+
+type storageAuthorityStreamExpiringSerialsServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) AddSerial(context.Context, *AddSerialRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddSerial not implemented")
+
+func (x *storageAuthorityStreamExpiringSerialsServer) Send(m *Serial) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedStorageAuthorityServer) DeactivateRegistration(context.Context, *RegistrationID) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeactivateRegistration not implemented")
+
+func _StorageAuthority_StreamRegistrationsWithContacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRegistrationsWithContactsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).StreamRegistrationsWithContacts(m, &storageAuthorityStreamRegistrationsWithContactsServer{stream})
 }
-func (UnimplementedStorageAuthorityServer) NewOrder(context.Context, *NewOrderRequest) (*proto.Order, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewOrder not implemented")
+
+type StorageAuthority_StreamRegistrationsWithContactsServer interface {
+	Send(*RegistrationContacts) error
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) NewOrderAndAuthzs(context.Context, *NewOrderAndAuthzsRequest) (*proto.Order, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewOrderAndAuthzs not implemented")
+
+type storageAuthorityStreamRegistrationsWithContactsServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) SetOrderProcessing(context.Context, *OrderRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetOrderProcessing not implemented")
+
+func (x *storageAuthorityStreamRegistrationsWithContactsServer) Send(m *RegistrationContacts) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedStorageAuthorityServer) SetOrderError(context.Context, *SetOrderErrorRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetOrderError not implemented")
+
+func _StorageAuthority_StreamRegistrationsWithNames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRegistrationsWithNamesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).StreamRegistrationsWithNames(m, &storageAuthorityStreamRegistrationsWithNamesServer{stream})
 }
-func (UnimplementedStorageAuthorityServer) FinalizeOrder(context.Context, *FinalizeOrderRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalizeOrder not implemented")
+
+type StorageAuthority_StreamRegistrationsWithNamesServer interface {
+	Send(*RegistrationName) error
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) GetOrder(context.Context, *OrderRequest) (*proto.Order, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+
+type storageAuthorityStreamRegistrationsWithNamesServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) GetOrderForNames(context.Context, *GetOrderForNamesRequest) (*proto.Order, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderForNames not implemented")
+
+func (x *storageAuthorityStreamRegistrationsWithNamesServer) Send(m *RegistrationName) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedStorageAuthorityServer) RevokeCertificate(context.Context, *RevokeCertificateRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeCertificate not implemented")
+
+func _StorageAuthority_StreamUncheckedBlockedKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).StreamUncheckedBlockedKeys(m, &storageAuthorityStreamUncheckedBlockedKeysServer{stream})
 }
-func (UnimplementedStorageAuthorityServer) NewAuthorizations2(context.Context, *AddPendingAuthorizationsRequest) (*Authorization2IDs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewAuthorizations2 not implemented")
+
+type StorageAuthority_StreamUncheckedBlockedKeysServer interface {
+	Send(*BlockedKey) error
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) FinalizeAuthorization2(context.Context, *FinalizeAuthorizationRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalizeAuthorization2 not implemented")
+
+type storageAuthorityStreamUncheckedBlockedKeysServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedStorageAuthorityServer) DeactivateAuthorization2(context.Context, *AuthorizationID2) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeactivateAuthorization2 not implemented")
+
+func (x *storageAuthorityStreamUncheckedBlockedKeysServer) Send(m *BlockedKey) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedStorageAuthorityServer) AddBlockedKey(context.Context, *AddBlockedKeyRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedKey not implemented")
+
+func _StorageAuthority_StreamUnrevokedCertificatesByKey_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SPKIHash)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).StreamUnrevokedCertificatesByKey(m, &storageAuthorityStreamUnrevokedCertificatesByKeyServer{stream})
 }
-func (UnimplementedStorageAuthorityServer) mustEmbedUnimplementedStorageAuthorityServer() {}
 
-// UnsafeStorageAuthorityServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to StorageAuthorityServer will
-// result in compilation errors.
-type UnsafeStorageAuthorityServer interface {
-	mustEmbedUnimplementedStorageAuthorityServer()
+type StorageAuthority_StreamUnrevokedCertificatesByKeyServer interface {
+	Send(*proto.Certificate) error
+	grpc.ServerStream
 }
 
-func RegisterStorageAuthorityServer(s grpc.ServiceRegistrar, srv StorageAuthorityServer) {
-	s.RegisterService(&StorageAuthority_ServiceDesc, srv)
+type storageAuthorityStreamUnrevokedCertificatesByKeyServer struct {
+	grpc.ServerStream
 }
 
-func _StorageAuthority_GetRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegistrationID)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StorageAuthorityServer).GetRegistration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/GetRegistration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).GetRegistration(ctx, req.(*RegistrationID))
-	}
-	return interceptor(ctx, in, info, handler)
+func (x *storageAuthorityStreamUnrevokedCertificatesByKeyServer) Send(m *proto.Certificate) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _StorageAuthority_GetRegistrationByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JSONWebKey)
+func _StorageAuthority_NewRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.Registration)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).GetRegistrationByKey(ctx, in)
+		return srv.(StorageAuthorityServer).NewRegistration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/GetRegistrationByKey",
+		FullMethod: "/sa.StorageAuthority/NewRegistration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).GetRegistrationByKey(ctx, req.(*JSONWebKey))
+		return srv.(StorageAuthorityServer).NewRegistration(ctx, req.(*proto.Registration))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_GetCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Serial)
+func _StorageAuthority_UpdateRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.Registration)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).GetCertificate(ctx, in)
+		return srv.(StorageAuthorityServer).UpdateRegistration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/GetCertificate",
+		FullMethod: "/sa.StorageAuthority/UpdateRegistration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).GetCertificate(ctx, req.(*Serial))
+		return srv.(StorageAuthorityServer).UpdateRegistration(ctx, req.(*proto.Registration))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_GetPrecertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Serial)
+func _StorageAuthority_AddCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCertificateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).GetPrecertificate(ctx, in)
+		return srv.(StorageAuthorityServer).AddCertificate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/GetPrecertificate",
+		FullMethod: "/sa.StorageAuthority/AddCertificate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).GetPrecertificate(ctx, req.(*Serial))
+		return srv.(StorageAuthorityServer).AddCertificate(ctx, req.(*AddCertificateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_GetCertificateStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Serial)
+func _StorageAuthority_AddPrecertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCertificateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).GetCertificateStatus(ctx, in)
+		return srv.(StorageAuthorityServer).AddPrecertificate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/GetCertificateStatus",
+		FullMethod: "/sa.StorageAuthority/AddPrecertificate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).GetCertificateStatus(ctx, req.(*Serial))
+		return srv.(StorageAuthorityServer).AddPrecertificate(ctx, req.(*AddCertificateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_CountCertificatesByNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CountCertificatesByNamesRequest)
+func _StorageAuthority_AddSerial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSerialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).CountCertificatesByNames(ctx, in)
+		return srv.(StorageAuthorityServer).AddSerial(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/CountCertificatesByNames",
+		FullMethod: "/sa.StorageAuthority/AddSerial",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).CountCertificatesByNames(ctx, req.(*CountCertificatesByNamesRequest))
+		return srv.(StorageAuthorityServer).AddSerial(ctx, req.(*AddSerialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_CountRegistrationsByIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CountRegistrationsByIPRequest)
+func _StorageAuthority_DeactivateRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).CountRegistrationsByIP(ctx, in)
+		return srv.(StorageAuthorityServer).DeactivateRegistration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/CountRegistrationsByIP",
+		FullMethod: "/sa.StorageAuthority/DeactivateRegistration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).CountRegistrationsByIP(ctx, req.(*CountRegistrationsByIPRequest))
+		return srv.(StorageAuthorityServer).DeactivateRegistration(ctx, req.(*RegistrationID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_CountRegistrationsByIPRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CountRegistrationsByIPRequest)
+func _StorageAuthority_NewOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).CountRegistrationsByIPRange(ctx, in)
+		return srv.(StorageAuthorityServer).NewOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/CountRegistrationsByIPRange",
+		FullMethod: "/sa.StorageAuthority/NewOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).CountRegistrationsByIPRange(ctx, req.(*CountRegistrationsByIPRequest))
+		return srv.(StorageAuthorityServer).NewOrder(ctx, req.(*NewOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_CountOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CountOrdersRequest)
+func _StorageAuthority_NewOrderAndAuthzs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewOrderAndAuthzsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).CountOrders(ctx, in)
+		return srv.(StorageAuthorityServer).NewOrderAndAuthzs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/CountOrders",
+		FullMethod: "/sa.StorageAuthority/NewOrderAndAuthzs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).CountOrders(ctx, req.(*CountOrdersRequest))
+		return srv.(StorageAuthorityServer).NewOrderAndAuthzs(ctx, req.(*NewOrderAndAuthzsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_CountFQDNSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CountFQDNSetsRequest)
+func _StorageAuthority_SetOrderProcessing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).CountFQDNSets(ctx, in)
+		return srv.(StorageAuthorityServer).SetOrderProcessing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/CountFQDNSets",
+		FullMethod: "/sa.StorageAuthority/SetOrderProcessing",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).CountFQDNSets(ctx, req.(*CountFQDNSetsRequest))
+		return srv.(StorageAuthorityServer).SetOrderProcessing(ctx, req.(*OrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_FQDNSetExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FQDNSetExistsRequest)
+func _StorageAuthority_SetOrderError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrderErrorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).FQDNSetExists(ctx, in)
+		return srv.(StorageAuthorityServer).SetOrderError(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/FQDNSetExists",
+		FullMethod: "/sa.StorageAuthority/SetOrderError",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).FQDNSetExists(ctx, req.(*FQDNSetExistsRequest))
+		return srv.(StorageAuthorityServer).SetOrderError(ctx, req.(*SetOrderErrorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_PreviousCertificateExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PreviousCertificateExistsRequest)
+func _StorageAuthority_FinalizeOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).PreviousCertificateExists(ctx, in)
+		return srv.(StorageAuthorityServer).FinalizeOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/PreviousCertificateExists",
+		FullMethod: "/sa.StorageAuthority/FinalizeOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).PreviousCertificateExists(ctx, req.(*PreviousCertificateExistsRequest))
+		return srv.(StorageAuthorityServer).FinalizeOrder(ctx, req.(*FinalizeOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_GetAuthorization2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthorizationID2)
+func _StorageAuthority_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).GetAuthorization2(ctx, in)
+		return srv.(StorageAuthorityServer).GetOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/GetAuthorization2",
+		FullMethod: "/sa.StorageAuthority/GetOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).GetAuthorization2(ctx, req.(*AuthorizationID2))
+		return srv.(StorageAuthorityServer).GetOrder(ctx, req.(*OrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_GetAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAuthorizationsRequest)
+func _StorageAuthority_GetOrderForNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderForNamesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).GetAuthorizations2(ctx, in)
+		return srv.(StorageAuthorityServer).GetOrderForNames(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/GetAuthorizations2",
+		FullMethod: "/sa.StorageAuthority/GetOrderForNames",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).GetAuthorizations2(ctx, req.(*GetAuthorizationsRequest))
+		return srv.(StorageAuthorityServer).GetOrderForNames(ctx, req.(*GetOrderForNamesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_GetPendingAuthorization2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPendingAuthorizationRequest)
+func _StorageAuthority_RevokeCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCertificateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).GetPendingAuthorization2(ctx, in)
+		return srv.(StorageAuthorityServer).RevokeCertificate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/GetPendingAuthorization2",
+		FullMethod: "/sa.StorageAuthority/RevokeCertificate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).GetPendingAuthorization2(ctx, req.(*GetPendingAuthorizationRequest))
+		return srv.(StorageAuthorityServer).RevokeCertificate(ctx, req.(*RevokeCertificateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_CountPendingAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegistrationID)
+func _StorageAuthority_NewAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPendingAuthorizationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).CountPendingAuthorizations2(ctx, in)
+		return srv.(StorageAuthorityServer).NewAuthorizations2(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/CountPendingAuthorizations2",
+		FullMethod: "/sa.StorageAuthority/NewAuthorizations2",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).CountPendingAuthorizations2(ctx, req.(*RegistrationID))
+		return srv.(StorageAuthorityServer).NewAuthorizations2(ctx, req.(*AddPendingAuthorizationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_GetValidOrderAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetValidOrderAuthorizationsRequest)
+func _StorageAuthority_FinalizeAuthorization2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeAuthorizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).GetValidOrderAuthorizations2(ctx, in)
+		return srv.(StorageAuthorityServer).FinalizeAuthorization2(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/GetValidOrderAuthorizations2",
+		FullMethod: "/sa.StorageAuthority/FinalizeAuthorization2",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).GetValidOrderAuthorizations2(ctx, req.(*GetValidOrderAuthorizationsRequest))
+		return srv.(StorageAuthorityServer).FinalizeAuthorization2(ctx, req.(*FinalizeAuthorizationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_CountInvalidAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CountInvalidAuthorizationsRequest)
+func _StorageAuthority_DeactivateAuthorization2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizationID2)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).CountInvalidAuthorizations2(ctx, in)
+		return srv.(StorageAuthorityServer).DeactivateAuthorization2(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/CountInvalidAuthorizations2",
+		FullMethod: "/sa.StorageAuthority/DeactivateAuthorization2",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).CountInvalidAuthorizations2(ctx, req.(*CountInvalidAuthorizationsRequest))
+		return srv.(StorageAuthorityServer).DeactivateAuthorization2(ctx, req.(*AuthorizationID2))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_GetValidAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetValidAuthorizationsRequest)
+func _StorageAuthority_AddBlockedKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBlockedKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).GetValidAuthorizations2(ctx, in)
+		return srv.(StorageAuthorityServer).AddBlockedKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/GetValidAuthorizations2",
+		FullMethod: "/sa.StorageAuthority/AddBlockedKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).GetValidAuthorizations2(ctx, req.(*GetValidAuthorizationsRequest))
+		return srv.(StorageAuthorityServer).AddBlockedKey(ctx, req.(*AddBlockedKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_KeyBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KeyBlockedRequest)
+func _StorageAuthority_AddRateLimitOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitOverride)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).KeyBlocked(ctx, in)
+		return srv.(StorageAuthorityServer).AddRateLimitOverride(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/KeyBlocked",
+		FullMethod: "/sa.StorageAuthority/AddRateLimitOverride",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).KeyBlocked(ctx, req.(*KeyBlockedRequest))
+		return srv.(StorageAuthorityServer).AddRateLimitOverride(ctx, req.(*RateLimitOverride))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_NewRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(proto.Registration)
+func _StorageAuthority_ExpireRateLimitOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireRateLimitOverrideRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).NewRegistration(ctx, in)
+		return srv.(StorageAuthorityServer).ExpireRateLimitOverride(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/NewRegistration",
+		FullMethod: "/sa.StorageAuthority/ExpireRateLimitOverride",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).NewRegistration(ctx, req.(*proto.Registration))
+		return srv.(StorageAuthorityServer).ExpireRateLimitOverride(ctx, req.(*ExpireRateLimitOverrideRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_UpdateRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(proto.Registration)
+func _StorageAuthority_SetAccountIdentifierPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountIdentifierPolicy)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).UpdateRegistration(ctx, in)
+		return srv.(StorageAuthorityServer).SetAccountIdentifierPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/UpdateRegistration",
+		FullMethod: "/sa.StorageAuthority/SetAccountIdentifierPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).UpdateRegistration(ctx, req.(*proto.Registration))
+		return srv.(StorageAuthorityServer).SetAccountIdentifierPolicy(ctx, req.(*AccountIdentifierPolicy))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_AddCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddCertificateRequest)
+func _StorageAuthority_RemoveAccountIdentifierPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).AddCertificate(ctx, in)
+		return srv.(StorageAuthorityServer).RemoveAccountIdentifierPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/AddCertificate",
+		FullMethod: "/sa.StorageAuthority/RemoveAccountIdentifierPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).AddCertificate(ctx, req.(*AddCertificateRequest))
+		return srv.(StorageAuthorityServer).RemoveAccountIdentifierPolicy(ctx, req.(*RegistrationID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_AddPrecertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddCertificateRequest)
+func _StorageAuthority_SuspendAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountSuspension)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).AddPrecertificate(ctx, in)
+		return srv.(StorageAuthorityServer).SuspendAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/AddPrecertificate",
+		FullMethod: "/sa.StorageAuthority/SuspendAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).AddPrecertificate(ctx, req.(*AddCertificateRequest))
+		return srv.(StorageAuthorityServer).SuspendAccount(ctx, req.(*AccountSuspension))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_AddSerial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddSerialRequest)
+func _StorageAuthority_UnsuspendAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).AddSerial(ctx, in)
+		return srv.(StorageAuthorityServer).UnsuspendAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/AddSerial",
+		FullMethod: "/sa.StorageAuthority/UnsuspendAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).AddSerial(ctx, req.(*AddSerialRequest))
+		return srv.(StorageAuthorityServer).UnsuspendAccount(ctx, req.(*RegistrationID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_DeactivateRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegistrationID)
+func _StorageAuthority_RecordValidationFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountIdentifier)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).DeactivateRegistration(ctx, in)
+		return srv.(StorageAuthorityServer).RecordValidationFailure(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/DeactivateRegistration",
+		FullMethod: "/sa.StorageAuthority/RecordValidationFailure",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).DeactivateRegistration(ctx, req.(*RegistrationID))
+		return srv.(StorageAuthorityServer).RecordValidationFailure(ctx, req.(*AccountIdentifier))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_NewOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewOrderRequest)
+func _StorageAuthority_ResetValidationFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountIdentifier)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).NewOrder(ctx, in)
+		return srv.(StorageAuthorityServer).ResetValidationFailures(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/NewOrder",
+		FullMethod: "/sa.StorageAuthority/ResetValidationFailures",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).NewOrder(ctx, req.(*NewOrderRequest))
+		return srv.(StorageAuthorityServer).ResetValidationFailures(ctx, req.(*AccountIdentifier))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_NewOrderAndAuthzs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewOrderAndAuthzsRequest)
+func _StorageAuthority_PauseIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountIdentifier)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).NewOrderAndAuthzs(ctx, in)
+		return srv.(StorageAuthorityServer).PauseIdentifier(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/NewOrderAndAuthzs",
+		FullMethod: "/sa.StorageAuthority/PauseIdentifier",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).NewOrderAndAuthzs(ctx, req.(*NewOrderAndAuthzsRequest))
+		return srv.(StorageAuthorityServer).PauseIdentifier(ctx, req.(*AccountIdentifier))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_SetOrderProcessing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OrderRequest)
+func _StorageAuthority_UnpauseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).SetOrderProcessing(ctx, in)
+		return srv.(StorageAuthorityServer).UnpauseAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/SetOrderProcessing",
+		FullMethod: "/sa.StorageAuthority/UnpauseAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).SetOrderProcessing(ctx, req.(*OrderRequest))
+		return srv.(StorageAuthorityServer).UnpauseAccount(ctx, req.(*RegistrationID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_SetOrderError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetOrderErrorRequest)
+func _StorageAuthority_HoldOrderForApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderApproval)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).SetOrderError(ctx, in)
+		return srv.(StorageAuthorityServer).HoldOrderForApproval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/SetOrderError",
+		FullMethod: "/sa.StorageAuthority/HoldOrderForApproval",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).SetOrderError(ctx, req.(*SetOrderErrorRequest))
+		return srv.(StorageAuthorityServer).HoldOrderForApproval(ctx, req.(*OrderApproval))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_FinalizeOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FinalizeOrderRequest)
+func _StorageAuthority_DecideOrderApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideOrderApprovalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).FinalizeOrder(ctx, in)
+		return srv.(StorageAuthorityServer).DecideOrderApproval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/FinalizeOrder",
+		FullMethod: "/sa.StorageAuthority/DecideOrderApproval",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).FinalizeOrder(ctx, req.(*FinalizeOrderRequest))
+		return srv.(StorageAuthorityServer).DecideOrderApproval(ctx, req.(*DecideOrderApprovalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OrderRequest)
+func _StorageAuthority_AddBlockedName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBlockedNameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).GetOrder(ctx, in)
+		return srv.(StorageAuthorityServer).AddBlockedName(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/GetOrder",
+		FullMethod: "/sa.StorageAuthority/AddBlockedName",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).GetOrder(ctx, req.(*OrderRequest))
+		return srv.(StorageAuthorityServer).AddBlockedName(ctx, req.(*AddBlockedNameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_GetOrderForNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderForNamesRequest)
+func _StorageAuthority_AddIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Incident)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).GetOrderForNames(ctx, in)
+		return srv.(StorageAuthorityServer).AddIncident(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/GetOrderForNames",
+		FullMethod: "/sa.StorageAuthority/AddIncident",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).GetOrderForNames(ctx, req.(*GetOrderForNamesRequest))
+		return srv.(StorageAuthorityServer).AddIncident(ctx, req.(*Incident))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_RevokeCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevokeCertificateRequest)
+func _StorageAuthority_AddIncidentSerials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddIncidentSerialsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).RevokeCertificate(ctx, in)
+		return srv.(StorageAuthorityServer).AddIncidentSerials(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/RevokeCertificate",
+		FullMethod: "/sa.StorageAuthority/AddIncidentSerials",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).RevokeCertificate(ctx, req.(*RevokeCertificateRequest))
+		return srv.(StorageAuthorityServer).AddIncidentSerials(ctx, req.(*AddIncidentSerialsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_NewAuthorizations2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPendingAuthorizationsRequest)
+func _StorageAuthority_AddIncidentSerialsIssuedBetween_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddIncidentSerialsIssuedBetweenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).NewAuthorizations2(ctx, in)
+		return srv.(StorageAuthorityServer).AddIncidentSerialsIssuedBetween(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/NewAuthorizations2",
+		FullMethod: "/sa.StorageAuthority/AddIncidentSerialsIssuedBetween",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).NewAuthorizations2(ctx, req.(*AddPendingAuthorizationsRequest))
+		return srv.(StorageAuthorityServer).AddIncidentSerialsIssuedBetween(ctx, req.(*AddIncidentSerialsIssuedBetweenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_FinalizeAuthorization2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FinalizeAuthorizationRequest)
+func _StorageAuthority_MarkIncidentSerialsNotified_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkIncidentSerialsNotifiedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).FinalizeAuthorization2(ctx, in)
+		return srv.(StorageAuthorityServer).MarkIncidentSerialsNotified(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/FinalizeAuthorization2",
+		FullMethod: "/sa.StorageAuthority/MarkIncidentSerialsNotified",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).FinalizeAuthorization2(ctx, req.(*FinalizeAuthorizationRequest))
+		return srv.(StorageAuthorityServer).MarkIncidentSerialsNotified(ctx, req.(*MarkIncidentSerialsNotifiedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_DeactivateAuthorization2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthorizationID2)
+func _StorageAuthority_MarkBlockedKeyChecked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SPKIHash)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).DeactivateAuthorization2(ctx, in)
+		return srv.(StorageAuthorityServer).MarkBlockedKeyChecked(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/DeactivateAuthorization2",
+		FullMethod: "/sa.StorageAuthority/MarkBlockedKeyChecked",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).DeactivateAuthorization2(ctx, req.(*AuthorizationID2))
+		return srv.(StorageAuthorityServer).MarkBlockedKeyChecked(ctx, req.(*SPKIHash))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageAuthority_AddBlockedKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddBlockedKeyRequest)
+func _StorageAuthority_MarkExpirationNagSent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkExpirationNagSentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageAuthorityServer).AddBlockedKey(ctx, in)
+		return srv.(StorageAuthorityServer).MarkExpirationNagSent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sa.StorageAuthority/AddBlockedKey",
+		FullMethod: "/sa.StorageAuthority/MarkExpirationNagSent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageAuthorityServer).AddBlockedKey(ctx, req.(*AddBlockedKeyRequest))
+		return srv.(StorageAuthorityServer).MarkExpirationNagSent(ctx, req.(*MarkExpirationNagSentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1365,6 +2963,50 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "KeyBlocked",
 			Handler:    _StorageAuthority_KeyBlocked_Handler,
 		},
+		{
+			MethodName: "GetRateLimitOverrides",
+			Handler:    _StorageAuthority_GetRateLimitOverrides_Handler,
+		},
+		{
+			MethodName: "GetAccountIdentifierPolicy",
+			Handler:    _StorageAuthority_GetAccountIdentifierPolicy_Handler,
+		},
+		{
+			MethodName: "GetAccountSuspension",
+			Handler:    _StorageAuthority_GetAccountSuspension_Handler,
+		},
+		{
+			MethodName: "CheckIdentifiersPaused",
+			Handler:    _StorageAuthority_CheckIdentifiersPaused_Handler,
+		},
+		{
+			MethodName: "GetOrderApproval",
+			Handler:    _StorageAuthority_GetOrderApproval_Handler,
+		},
+		{
+			MethodName: "GetPendingOrderApprovals",
+			Handler:    _StorageAuthority_GetPendingOrderApprovals_Handler,
+		},
+		{
+			MethodName: "CheckNamesBlocked",
+			Handler:    _StorageAuthority_CheckNamesBlocked_Handler,
+		},
+		{
+			MethodName: "GetIncidents",
+			Handler:    _StorageAuthority_GetIncidents_Handler,
+		},
+		{
+			MethodName: "IncidentsForSerial",
+			Handler:    _StorageAuthority_IncidentsForSerial_Handler,
+		},
+		{
+			MethodName: "SearchCertificatesByName",
+			Handler:    _StorageAuthority_SearchCertificatesByName_Handler,
+		},
+		{
+			MethodName: "GetAccountKeys",
+			Handler:    _StorageAuthority_GetAccountKeys_Handler,
+		},
 		{
 			MethodName: "NewRegistration",
 			Handler:    _StorageAuthority_NewRegistration_Handler,
@@ -1437,7 +3079,139 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddBlockedKey",
 			Handler:    _StorageAuthority_AddBlockedKey_Handler,
 		},
+		{
+			MethodName: "AddRateLimitOverride",
+			Handler:    _StorageAuthority_AddRateLimitOverride_Handler,
+		},
+		{
+			MethodName: "ExpireRateLimitOverride",
+			Handler:    _StorageAuthority_ExpireRateLimitOverride_Handler,
+		},
+		{
+			MethodName: "SetAccountIdentifierPolicy",
+			Handler:    _StorageAuthority_SetAccountIdentifierPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveAccountIdentifierPolicy",
+			Handler:    _StorageAuthority_RemoveAccountIdentifierPolicy_Handler,
+		},
+		{
+			MethodName: "SuspendAccount",
+			Handler:    _StorageAuthority_SuspendAccount_Handler,
+		},
+		{
+			MethodName: "UnsuspendAccount",
+			Handler:    _StorageAuthority_UnsuspendAccount_Handler,
+		},
+		{
+			MethodName: "RecordValidationFailure",
+			Handler:    _StorageAuthority_RecordValidationFailure_Handler,
+		},
+		{
+			MethodName: "ResetValidationFailures",
+			Handler:    _StorageAuthority_ResetValidationFailures_Handler,
+		},
+		{
+			MethodName: "PauseIdentifier",
+			Handler:    _StorageAuthority_PauseIdentifier_Handler,
+		},
+		{
+			MethodName: "UnpauseAccount",
+			Handler:    _StorageAuthority_UnpauseAccount_Handler,
+		},
+		{
+			MethodName: "HoldOrderForApproval",
+			Handler:    _StorageAuthority_HoldOrderForApproval_Handler,
+		},
+		{
+			MethodName: "DecideOrderApproval",
+			Handler:    _StorageAuthority_DecideOrderApproval_Handler,
+		},
+		{
+			MethodName: "AddBlockedName",
+			Handler:    _StorageAuthority_AddBlockedName_Handler,
+		},
+		{
+			MethodName: "AddIncident",
+			Handler:    _StorageAuthority_AddIncident_Handler,
+		},
+		{
+			MethodName: "AddIncidentSerials",
+			Handler:    _StorageAuthority_AddIncidentSerials_Handler,
+		},
+		{
+			MethodName: "AddIncidentSerialsIssuedBetween",
+			Handler:    _StorageAuthority_AddIncidentSerialsIssuedBetween_Handler,
+		},
+		{
+			MethodName: "MarkIncidentSerialsNotified",
+			Handler:    _StorageAuthority_MarkIncidentSerialsNotified_Handler,
+		},
+		{
+			MethodName: "MarkBlockedKeyChecked",
+			Handler:    _StorageAuthority_MarkBlockedKeyChecked_Handler,
+		},
+		{
+			MethodName: "MarkExpirationNagSent",
+			Handler:    _StorageAuthority_MarkExpirationNagSent_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetSerialsByKey",
+			Handler:       _StorageAuthority_GetSerialsByKey_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetSerialsByAccount",
+			Handler:       _StorageAuthority_GetSerialsByAccount_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetSerialsByName",
+			Handler:       _StorageAuthority_GetSerialsByName_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SerialsForIncident",
+			Handler:       _StorageAuthority_SerialsForIncident_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _StorageAuthority_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamCertificates",
+			Handler:       _StorageAuthority_StreamCertificates_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamExpiringSerials",
+			Handler:       _StorageAuthority_StreamExpiringSerials_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamRegistrationsWithContacts",
+			Handler:       _StorageAuthority_StreamRegistrationsWithContacts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamRegistrationsWithNames",
+			Handler:       _StorageAuthority_StreamRegistrationsWithNames_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamUncheckedBlockedKeys",
+			Handler:       _StorageAuthority_StreamUncheckedBlockedKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamUnrevokedCertificatesByKey",
+			Handler:       _StorageAuthority_StreamUnrevokedCertificatesByKey_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "sa.proto",
 }
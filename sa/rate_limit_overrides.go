@@ -0,0 +1,136 @@
+package sa
+
+import (
+	"context"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/db"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/ratelimit"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func rateLimitOverrideModelToPB(m *rateLimitOverrideModel) *sapb.RateLimitOverride {
+	return &sapb.RateLimitOverride{
+		Id:             m.ID,
+		Limit:          m.LimitName,
+		Key:            m.OverrideKey,
+		RegistrationID: m.RegistrationID,
+		Threshold:      m.Threshold,
+		Owner:          m.Owner,
+		Reason:         m.Reason,
+		Ticket:         m.Ticket,
+		Created:        m.Created.UnixNano(),
+		Expires:        m.Expires.UnixNano(),
+	}
+}
+
+// AddRateLimitOverride stores a new rate limit override, returning it with its
+// ID and creation time populated. It is an error to add an override for a key
+// or registration which already has an unexpired override for the same limit;
+// the existing override must be expired first.
+func (ssa *SQLStorageAuthority) AddRateLimitOverride(ctx context.Context, req *sapb.RateLimitOverride) (*sapb.RateLimitOverride, error) {
+	if req == nil || core.IsAnyNilOrZero(req.Limit, req.Owner, req.Reason, req.Ticket, req.Expires) {
+		return nil, errIncompleteRequest
+	}
+	err := ratelimit.Override{
+		Limit:          req.Limit,
+		Key:            req.Key,
+		RegistrationID: req.RegistrationID,
+		Threshold:      req.Threshold,
+	}.Validate()
+	if err != nil {
+		return nil, berrors.MalformedError("invalid rate limit override: %s", err)
+	}
+	now := ssa.clk.Now().Truncate(time.Second)
+	expires := time.Unix(0, req.Expires)
+	if !expires.After(now) {
+		return nil, berrors.MalformedError("rate limit override must expire in the future")
+	}
+
+	model := &rateLimitOverrideModel{
+		LimitName:      req.Limit,
+		OverrideKey:    req.Key,
+		RegistrationID: req.RegistrationID,
+		Threshold:      req.Threshold,
+		Owner:          req.Owner,
+		Reason:         req.Reason,
+		Ticket:         req.Ticket,
+		Created:        now,
+		Expires:        expires,
+	}
+	_, overallError := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		var existing int64
+		err := txWithCtx.SelectOne(
+			&existing,
+			`SELECT COUNT(*) FROM rateLimitOverrides
+			WHERE limitName = ? AND overrideKey = ? AND registrationID = ? AND expires > ?`,
+			req.Limit, req.Key, req.RegistrationID, now,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if existing > 0 {
+			return nil, berrors.DuplicateError("an unexpired %s override already exists for this key or registration", req.Limit)
+		}
+		return nil, txWithCtx.Insert(model)
+	})
+	if overallError != nil {
+		return nil, overallError
+	}
+	return rateLimitOverrideModelToPB(model), nil
+}
+
+// GetRateLimitOverrides returns all of the rate limit overrides which haven't
+// yet expired, or all overrides ever added if includeExpired is set.
+func (ssa *SQLStorageAuthority) GetRateLimitOverrides(ctx context.Context, req *sapb.GetRateLimitOverridesRequest) (*sapb.RateLimitOverrides, error) {
+	if req == nil {
+		return nil, errIncompleteRequest
+	}
+	query := `SELECT id, limitName, overrideKey, registrationID, threshold, owner, reason, ticket, created, expires
+		FROM rateLimitOverrides`
+	var args []interface{}
+	if !req.IncludeExpired {
+		query += ` WHERE expires > ?`
+		args = append(args, ssa.clk.Now())
+	}
+	query += ` ORDER BY id`
+
+	var models []*rateLimitOverrideModel
+	_, err := ssa.reader(ctx, "GetRateLimitOverrides").Select(&models, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	overrides := make([]*sapb.RateLimitOverride, len(models))
+	for i, m := range models {
+		overrides[i] = rateLimitOverrideModelToPB(m)
+	}
+	return &sapb.RateLimitOverrides{Overrides: overrides}, nil
+}
+
+// ExpireRateLimitOverride expires the given rate limit override immediately.
+// Overrides are never deleted, so that there is a record of every override
+// which has been in effect.
+func (ssa *SQLStorageAuthority) ExpireRateLimitOverride(ctx context.Context, req *sapb.ExpireRateLimitOverrideRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Id == 0 {
+		return nil, errIncompleteRequest
+	}
+	now := ssa.clk.Now()
+	result, err := ssa.dbMap.WithContext(ctx).Exec(
+		`UPDATE rateLimitOverrides SET expires = ? WHERE id = ? AND expires > ?`,
+		now, req.Id, now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, berrors.NotFoundError("no unexpired rate limit override with ID %d", req.Id)
+	}
+	return &emptypb.Empty{}, nil
+}
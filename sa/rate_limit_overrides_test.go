@@ -0,0 +1,77 @@
+package sa
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestRateLimitOverrides(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+	ctx := context.Background()
+
+	override := &sapb.RateLimitOverride{
+		Limit:     "certificatesPerName",
+		Key:       "example.com",
+		Threshold: 1000,
+		Owner:     "admin",
+		Reason:    "large hosting provider",
+		Ticket:    "T-1",
+		Expires:   fc.Now().Add(24 * time.Hour).UnixNano(),
+	}
+	added, err := sa.AddRateLimitOverride(ctx, override)
+	test.AssertNotError(t, err, "AddRateLimitOverride failed")
+	test.Assert(t, added.Id != 0, "added override has no ID")
+	test.AssertEquals(t, added.Created, fc.Now().UnixNano())
+
+	// A second unexpired override for the same key and limit is a duplicate.
+	_, err = sa.AddRateLimitOverride(ctx, override)
+	test.AssertError(t, err, "added duplicate override")
+	test.Assert(t, errors.Is(err, berrors.Duplicate), "wrong error type for duplicate override")
+
+	// Invalid and already expired overrides are rejected.
+	_, err = sa.AddRateLimitOverride(ctx, &sapb.RateLimitOverride{
+		Limit: "bogus", Key: "example.com", Threshold: 1,
+		Owner: "admin", Reason: "reason", Ticket: "T-2", Expires: override.Expires,
+	})
+	test.Assert(t, errors.Is(err, berrors.Malformed), "added override for unknown limit")
+	_, err = sa.AddRateLimitOverride(ctx, &sapb.RateLimitOverride{
+		Limit: "certificatesPerName", Key: "example.net", Threshold: 1,
+		Owner: "admin", Reason: "reason", Ticket: "T-3", Expires: fc.Now().UnixNano(),
+	})
+	test.Assert(t, errors.Is(err, berrors.Malformed), "added expired override")
+	_, err = sa.AddRateLimitOverride(ctx, &sapb.RateLimitOverride{
+		Limit: "certificatesPerName", Key: "example.net", Threshold: 1, Expires: override.Expires,
+	})
+	test.AssertEquals(t, err, errIncompleteRequest)
+
+	overrides, err := sa.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{})
+	test.AssertNotError(t, err, "GetRateLimitOverrides failed")
+	test.AssertEquals(t, len(overrides.Overrides), 1)
+	test.AssertEquals(t, overrides.Overrides[0].Id, added.Id)
+	test.AssertEquals(t, overrides.Overrides[0].Ticket, "T-1")
+
+	_, err = sa.ExpireRateLimitOverride(ctx, &sapb.ExpireRateLimitOverrideRequest{Id: added.Id})
+	test.AssertNotError(t, err, "ExpireRateLimitOverride failed")
+	_, err = sa.ExpireRateLimitOverride(ctx, &sapb.ExpireRateLimitOverrideRequest{Id: added.Id})
+	test.Assert(t, errors.Is(err, berrors.NotFound), "expired an already expired override")
+
+	// Expired overrides are only returned when asked for.
+	fc.Add(time.Second)
+	overrides, err = sa.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{})
+	test.AssertNotError(t, err, "GetRateLimitOverrides failed")
+	test.AssertEquals(t, len(overrides.Overrides), 0)
+	overrides, err = sa.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{IncludeExpired: true})
+	test.AssertNotError(t, err, "GetRateLimitOverrides failed")
+	test.AssertEquals(t, len(overrides.Overrides), 1)
+
+	// Once expired, a new override for the same key can be added.
+	_, err = sa.AddRateLimitOverride(ctx, override)
+	test.AssertNotError(t, err, "AddRateLimitOverride after expiry failed")
+}
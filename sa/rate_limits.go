@@ -51,7 +51,8 @@ func (ssa *SQLStorageAuthority) addCertificatesPerName(
 	}
 
 	_, err := db.Exec(`INSERT INTO certificatesPerName (eTLDPlusOne, time, count) VALUES `+
-		strings.Join(qmarks, ", ")+` ON DUPLICATE KEY UPDATE count=count+1;`,
+		strings.Join(qmarks, ", ")+" "+
+		ssa.dialect.OnDuplicateKeyUpdate([]string{"eTLDPlusOne", "time"}, "count=count+1")+";",
 		values...)
 	if err != nil {
 		return err
@@ -61,12 +62,16 @@ func (ssa *SQLStorageAuthority) addCertificatesPerName(
 }
 
 // countCertificates returns the count of certificates issued for a domain's
-// eTLD+1 (aka base domain), during a given time range.
-func (ssa *SQLStorageAuthority) countCertificates(dbMap db.Selector, domain string, timeRange *sapb.Range) (int64, error) {
-	var counts []int64
+// eTLD+1 (aka base domain), during a given time range, and the time of the
+// earliest of those issuances.
+func (ssa *SQLStorageAuthority) countCertificates(dbMap db.Selector, domain string, timeRange *sapb.Range) (int64, time.Time, error) {
+	var buckets []struct {
+		Time  time.Time
+		Count int64
+	}
 	_, err := dbMap.Select(
-		&counts,
-		`SELECT count FROM certificatesPerName
+		&buckets,
+		`SELECT time, count FROM certificatesPerName
 		 WHERE eTLDPlusOne = :baseDomain AND
 		 time > :earliest AND
 		 time <= :latest`,
@@ -77,25 +82,29 @@ func (ssa *SQLStorageAuthority) countCertificates(dbMap db.Selector, domain stri
 		})
 	if err != nil {
 		if db.IsNoRows(err) {
-			return 0, nil
+			return 0, time.Time{}, nil
 		}
-		return 0, err
+		return 0, time.Time{}, err
 	}
 	var total int64
-	for _, count := range counts {
-		total += count
+	var earliest time.Time
+	for _, bucket := range buckets {
+		total += bucket.Count
+		if earliest.IsZero() || bucket.Time.Before(earliest) {
+			earliest = bucket.Time
+		}
 	}
-	return total, nil
+	return total, earliest, nil
 }
 
 // addNewOrdersRateLimit adds 1 to the rate limit count for the provided ID,
 // in a specific time bucket. It must be executed in a transaction, and the
 // input timeToTheMinute must be a time rounded to a minute.
-func addNewOrdersRateLimit(ctx context.Context, dbMap db.SelectExecer, regID int64, timeToTheMinute time.Time) error {
+func addNewOrdersRateLimit(ctx context.Context, dbMap db.SelectExecer, dialect db.Dialect, regID int64, timeToTheMinute time.Time) error {
 	_, err := dbMap.Exec(`INSERT INTO newOrdersRL
 		(regID, time, count)
-		VALUES (?, ?, 1)
-		ON DUPLICATE KEY UPDATE count=count+1;`,
+		VALUES (?, ?, 1) `+
+		dialect.OnDuplicateKeyUpdate([]string{"regID", "time"}, "count=count+1")+";",
 		regID,
 		timeToTheMinute,
 	)
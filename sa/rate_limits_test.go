@@ -79,13 +79,16 @@ func TestCertsPerNameRateLimitTable(t *testing.T) {
 				Earliest: aprilFirst.Add(-1 * time.Second).UnixNano(),
 				Latest:   aprilFirst.Add(aWeek).UnixNano(),
 			}
-			count, err := sa.countCertificatesByName(sa.dbMap, tc.domainName, timeRange)
+			count, earliest, err := sa.countCertificatesByName(sa.dbMap, tc.domainName, timeRange)
 			if err != nil {
 				t.Fatal(err)
 			}
 			if count != tc.expected {
 				t.Errorf("Expected count of %d for %q, got %d", tc.expected, tc.domainName, count)
 			}
+			if count > 0 && !earliest.Equal(aprilFirst) {
+				t.Errorf("Expected earliest issuance of %s for %q, got %s", aprilFirst, tc.domainName, earliest)
+			}
 		})
 	}
 }
@@ -108,7 +111,7 @@ func TestNewOrdersRateLimitTable(t *testing.T) {
 		tx, err := sa.dbMap.Begin()
 		test.AssertNotError(t, err, "failed to open tx")
 		for j := 0; j < i+1; j++ {
-			err = addNewOrdersRateLimit(context.Background(), tx, manyCountRegID, start.Add(time.Minute*time.Duration(i)))
+			err = addNewOrdersRateLimit(context.Background(), tx, sa.dialect, manyCountRegID, start.Add(time.Minute*time.Duration(i)))
 		}
 		test.AssertNotError(t, err, "addNewOrdersRateLimit failed")
 		test.AssertNotError(t, tx.Commit(), "failed to commit tx")
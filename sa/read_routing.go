@@ -0,0 +1,218 @@
+package sa
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-gorp/gorp/v3"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/db"
+	"github.com/letsencrypt/boulder/features"
+)
+
+// ReadRoute says which database an SA method reads from.
+type ReadRoute struct {
+	// Replica sends the method's reads to the read-only database, which is
+	// generally a replica of the primary.
+	Replica bool
+
+	// MaxLag, if nonzero, sends the method's reads to the primary instead
+	// whenever the replica's measured replication lag isn't below MaxLag, or
+	// hasn't been measured recently. It requires a ReplicaLagMonitor.
+	MaxLag time.Duration
+}
+
+// readMethod describes an SA method whose reads can be routed.
+type readMethod struct {
+	// route is used unless the SA's configuration routes the method.
+	route ReadRoute
+
+	// readYourWrites is set for methods which look up a single row that the
+	// caller may have only just written, such as an authorization it has just
+	// created. When such a lookup finds nothing on the replica, it's retried
+	// against the primary.
+	readYourWrites bool
+}
+
+// readMethods are the SA methods whose reads can be routed, with their default
+// routes. Methods which aren't listed always read from the primary, generally
+// because they make several queries which need to agree with each other.
+var readMethods = map[string]readMethod{
+	"GetRegistration":                  {readYourWrites: true},
+	"GetRegistrationByKey":             {readYourWrites: true},
+	"GetCertificate":                   {readYourWrites: true},
+	"GetPrecertificate":                {readYourWrites: true},
+	"GetCertificateStatus":             {readYourWrites: true},
+	"GetAuthorization2":                {readYourWrites: true},
+	"GetAuthorizations2":               {},
+	"GetPendingAuthorization2":         {},
+	"GetValidAuthorizations2":          {},
+	"GetValidOrderAuthorizations2":     {},
+	"FQDNSetExists":                    {},
+	"PreviousCertificateExists":        {},
+	"KeyBlocked":                       {},
+	"CheckNamesBlocked":                {},
+	"GetIncidents":                     {},
+	"IncidentsForSerial":               {},
+	"SerialsForIncident":               {},
+	"StreamExpiringSerials":            {},
+	"StreamUncheckedBlockedKeys":       {},
+	"StreamUnrevokedCertificatesByKey": {},
+	"GetAccountIdentifierPolicy":       {route: ReadRoute{Replica: true}},
+	"GetAccountKeys":                   {route: ReadRoute{Replica: true}},
+	"GetAccountSuspension":             {route: ReadRoute{Replica: true}},
+	"GetOrderApproval":                 {route: ReadRoute{Replica: true}, readYourWrites: true},
+	"GetPendingOrderApprovals":         {route: ReadRoute{Replica: true}},
+	"GetRateLimitOverrides":            {route: ReadRoute{Replica: true}},
+	"CountRegistrationsByIP":           {route: ReadRoute{Replica: true}},
+	"CountRegistrationsByIPRange":      {route: ReadRoute{Replica: true}},
+	"CountCertificatesByNames":         {route: ReadRoute{Replica: true}},
+	"CountOrders":                      {route: ReadRoute{Replica: true}},
+	"CountFQDNSets":                    {route: ReadRoute{Replica: true}},
+	"CountPendingAuthorizations2":      {route: ReadRoute{Replica: true}},
+	"CountInvalidAuthorizations2":      {route: ReadRoute{Replica: true}},
+	"GetSerialsByKey":                  {route: ReadRoute{Replica: true}},
+	"GetSerialsByAccount":              {route: ReadRoute{Replica: true}},
+	"GetSerialsByName":                 {route: ReadRoute{Replica: true}},
+	"SearchCertificatesByName":         {route: ReadRoute{Replica: true}},
+	"StreamCertificates":               {route: ReadRoute{Replica: true}},
+	"StreamRegistrationsWithContacts":  {route: ReadRoute{Replica: true}},
+	"StreamRegistrationsWithNames":     {route: ReadRoute{Replica: true}},
+	"CheckIdentifiersPaused":           {route: ReadRoute{Replica: true}},
+}
+
+// readRouter chooses the database for each routed read.
+type readRouter struct {
+	primary *db.WrappedMap
+	replica *db.WrappedMap
+
+	// routes are the configured routes, which override the defaults in
+	// readMethods.
+	routes map[string]ReadRoute
+	lag    *ReplicaLagMonitor
+
+	reads  *prometheus.CounterVec
+	misses *prometheus.CounterVec
+}
+
+func newReadRouter(primary, replica *db.WrappedMap, stats prometheus.Registerer) *readRouter {
+	reads := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "routed_reads",
+		Help: "number of SA reads by method and the database they were sent to",
+	}, []string{"method", "db"})
+	stats.MustRegister(reads)
+
+	misses := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "replica_read_misses",
+		Help: "number of SA lookups which found nothing on the replica and were retried against the primary",
+	}, []string{"method"})
+	stats.MustRegister(misses)
+
+	return &readRouter{
+		primary: primary,
+		replica: replica,
+		reads:   reads,
+		misses:  misses,
+	}
+}
+
+// SetReadRoutes overrides the default routes for the given SA methods. lag
+// must be provided if any of the routes has a MaxLag.
+func (ssa *SQLStorageAuthority) SetReadRoutes(routes map[string]ReadRoute, lag *ReplicaLagMonitor) error {
+	for method, route := range routes {
+		if _, ok := readMethods[method]; !ok {
+			return fmt.Errorf("%q isn't an SA method whose reads can be routed", method)
+		}
+		if route.MaxLag != 0 && !route.Replica {
+			return fmt.Errorf("read route for %q has a maxLag but doesn't use the replica", method)
+		}
+		if route.MaxLag < 0 {
+			return fmt.Errorf("read route for %q has a negative maxLag", method)
+		}
+		if route.MaxLag != 0 && lag == nil {
+			return fmt.Errorf("read route for %q has a maxLag but replica lag isn't being monitored", method)
+		}
+	}
+	ssa.router.routes = routes
+	ssa.router.lag = lag
+	return nil
+}
+
+// route returns the route for method's reads.
+func (r *readRouter) route(method string) ReadRoute {
+	route, ok := r.routes[method]
+	if ok {
+		return route
+	}
+	if method == "GetAuthorizations2" && features.Enabled(features.GetAuthzReadOnly) {
+		return ReadRoute{Replica: true}
+	}
+	return readMethods[method].route
+}
+
+// useReplica returns true if method's reads should go to the replica now.
+func (r *readRouter) useReplica(method string) bool {
+	if r.replica == r.primary {
+		return false
+	}
+	route := r.route(method)
+	if !route.Replica {
+		return false
+	}
+	if route.MaxLag != 0 {
+		lag, ok := r.lag.Lag()
+		if !ok || lag >= route.MaxLag {
+			return false
+		}
+	}
+	return true
+}
+
+// reader returns the executor for method's reads. Reads from the replica by
+// methods which need to read their callers' writes fall back to the primary
+// when they find nothing.
+func (ssa *SQLStorageAuthority) reader(ctx context.Context, method string) gorp.SqlExecutor {
+	r := ssa.router
+	if !r.useReplica(method) {
+		r.reads.WithLabelValues(method, "primary").Inc()
+		return r.primary.WithContext(ctx)
+	}
+	r.reads.WithLabelValues(method, "replica").Inc()
+	if !readMethods[method].readYourWrites {
+		return r.replica.WithContext(ctx)
+	}
+	return missFallbackExecutor{
+		SqlExecutor: r.replica.WithContext(ctx),
+		primary:     r.primary.WithContext(ctx),
+		onMiss:      r.misses.WithLabelValues(method).Inc,
+	}
+}
+
+// missFallbackExecutor reads from a replica, and retries Get and SelectOne
+// queries which find nothing against the primary, in case the row was written
+// too recently to have been replicated.
+type missFallbackExecutor struct {
+	gorp.SqlExecutor
+	primary gorp.SqlExecutor
+	onMiss  func()
+}
+
+func (e missFallbackExecutor) Get(holder interface{}, keys ...interface{}) (interface{}, error) {
+	res, err := e.SqlExecutor.Get(holder, keys...)
+	if (err == nil && res == nil) || db.IsNoRows(err) {
+		e.onMiss()
+		return e.primary.Get(holder, keys...)
+	}
+	return res, err
+}
+
+func (e missFallbackExecutor) SelectOne(holder interface{}, query string, args ...interface{}) error {
+	err := e.SqlExecutor.SelectOne(holder, query, args...)
+	if db.IsNoRows(err) {
+		e.onMiss()
+		return e.primary.SelectOne(holder, query, args...)
+	}
+	return err
+}
@@ -0,0 +1,202 @@
+package sa
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/db"
+	"github.com/letsencrypt/boulder/features"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// initReplicaSA returns an SA whose primary and read-only databases are two
+// separate SQLite databases, so that nothing written through the SA appears
+// on its "replica".
+func initReplicaSA(t *testing.T) (*SQLStorageAuthority, *db.WrappedMap, clock.FakeClock) {
+	t.Helper()
+	features.Reset()
+	primary, cleanUpPrimary := initSQLiteTestDB(t)
+	t.Cleanup(cleanUpPrimary)
+	replica, cleanUpReplica := initSQLiteTestDB(t)
+	t.Cleanup(cleanUpReplica)
+
+	fc := clock.NewFake()
+	fc.Set(time.Date(2015, 3, 4, 5, 0, 0, 0, time.UTC))
+	sa, err := NewSQLStorageAuthority(primary, replica, fc, log, prometheus.NewRegistry(), 1)
+	test.AssertNotError(t, err, "creating SA")
+	return sa, replica, fc
+}
+
+// countRegistrations returns the number of registrations visible to method's
+// reads.
+func countRegistrations(t *testing.T, sa *SQLStorageAuthority, method string) int64 {
+	t.Helper()
+	var count int64
+	err := sa.reader(context.Background(), method).SelectOne(&count, "SELECT COUNT(1) FROM registrations")
+	test.AssertNotError(t, err, "counting registrations")
+	return count
+}
+
+func TestReadMethodsAreSAMethods(t *testing.T) {
+	saMethods := make(map[string]bool)
+	for _, m := range sapb.StorageAuthority_ServiceDesc.Methods {
+		saMethods[m.MethodName] = true
+	}
+	for _, s := range sapb.StorageAuthority_ServiceDesc.Streams {
+		saMethods[s.StreamName] = true
+	}
+	for method := range readMethods {
+		if !saMethods[method] {
+			t.Errorf("%q in readMethods isn't a StorageAuthority method", method)
+		}
+	}
+}
+
+func TestSetReadRoutes(t *testing.T) {
+	sa, replica, fc := initReplicaSA(t)
+	lag := NewReplicaLagMonitor(sa.dbMap, replica, time.Second, fc, log, prometheus.NewRegistry())
+
+	testCases := []struct {
+		name   string
+		routes map[string]ReadRoute
+		lag    *ReplicaLagMonitor
+		errStr string
+	}{
+		{
+			name:   "unknown method",
+			routes: map[string]ReadRoute{"GetOrder": {Replica: true}},
+			errStr: `"GetOrder" isn't an SA method whose reads can be routed`,
+		},
+		{
+			name:   "maxLag on the primary",
+			routes: map[string]ReadRoute{"GetRegistration": {MaxLag: time.Second}},
+			lag:    lag,
+			errStr: `read route for "GetRegistration" has a maxLag but doesn't use the replica`,
+		},
+		{
+			name:   "maxLag without a lag monitor",
+			routes: map[string]ReadRoute{"GetRegistration": {Replica: true, MaxLag: time.Second}},
+			errStr: `read route for "GetRegistration" has a maxLag but replica lag isn't being monitored`,
+		},
+		{
+			name:   "valid",
+			routes: map[string]ReadRoute{"GetRegistration": {Replica: true, MaxLag: time.Second}, "CountOrders": {}},
+			lag:    lag,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := sa.SetReadRoutes(tc.routes, tc.lag)
+			if tc.errStr != "" {
+				test.AssertError(t, err, "expected an invalid route")
+				test.AssertEquals(t, err.Error(), tc.errStr)
+			} else {
+				test.AssertNotError(t, err, "setting valid routes")
+			}
+		})
+	}
+}
+
+func TestReadRouting(t *testing.T) {
+	sa, _, _ := initReplicaSA(t)
+	reg := createWorkingRegistration(t, sa)
+
+	// By default, rate limit counts read from the replica and everything else
+	// from the primary.
+	test.AssertEquals(t, countRegistrations(t, sa, "CountRegistrationsByIP"), int64(0))
+	test.AssertEquals(t, countRegistrations(t, sa, "GetRegistration"), int64(1))
+
+	// GetAuthzReadOnly sends GetAuthorizations2 to the replica unless it's
+	// routed explicitly.
+	test.AssertEquals(t, countRegistrations(t, sa, "GetAuthorizations2"), int64(1))
+	err := features.Set(map[string]bool{"GetAuthzReadOnly": true})
+	test.AssertNotError(t, err, "setting GetAuthzReadOnly")
+	defer features.Reset()
+	test.AssertEquals(t, countRegistrations(t, sa, "GetAuthorizations2"), int64(0))
+
+	err = sa.SetReadRoutes(map[string]ReadRoute{
+		"CountRegistrationsByIP": {},
+		"GetAuthorizations2":     {},
+		"GetRegistration":        {Replica: true},
+	}, nil)
+	test.AssertNotError(t, err, "setting read routes")
+	test.AssertEquals(t, countRegistrations(t, sa, "CountRegistrationsByIP"), int64(1))
+	test.AssertEquals(t, countRegistrations(t, sa, "GetAuthorizations2"), int64(1))
+	test.AssertEquals(t, countRegistrations(t, sa, "GetRegistration"), int64(0))
+	test.AssertMetricWithLabelsEquals(t, sa.router.reads, prometheus.Labels{"method": "GetRegistration", "db": "replica"}, 1)
+
+	// The registration hasn't reached the replica, so GetRegistration has to
+	// fall back to the primary to find it.
+	got, err := sa.GetRegistration(context.Background(), &sapb.RegistrationID{Id: reg.Id})
+	test.AssertNotError(t, err, "getting registration from the replica")
+	test.AssertEquals(t, got.Id, reg.Id)
+	test.AssertMetricWithLabelsEquals(t, sa.router.misses, prometheus.Labels{"method": "GetRegistration"}, 1)
+
+	// Lookups of rows which don't exist anywhere are still not found.
+	_, err = sa.GetRegistration(context.Background(), &sapb.RegistrationID{Id: reg.Id + 1})
+	test.AssertError(t, err, "getting a nonexistent registration")
+	test.AssertMetricWithLabelsEquals(t, sa.router.misses, prometheus.Labels{"method": "GetRegistration"}, 2)
+}
+
+func TestReplicaLagRouting(t *testing.T) {
+	sa, replica, fc := initReplicaSA(t)
+	createWorkingRegistration(t, sa)
+
+	lag := NewReplicaLagMonitor(sa.dbMap, replica, time.Second, fc, log, prometheus.NewRegistry())
+	err := sa.SetReadRoutes(map[string]ReadRoute{
+		"CountOrders": {Replica: true, MaxLag: 5 * time.Second},
+	}, lag)
+	test.AssertNotError(t, err, "setting read routes")
+
+	// The lag hasn't been measured, so reads go to the primary.
+	_, ok := lag.Lag()
+	test.Assert(t, !ok, "lag shouldn't be known before it's measured")
+	test.AssertEquals(t, countRegistrations(t, sa, "CountOrders"), int64(1))
+
+	// The heartbeat written to the primary never reaches this replica, so the
+	// check fails and the lag stays unknown.
+	err = lag.check(context.Background())
+	test.AssertError(t, err, "checking lag without a heartbeat on the replica")
+	test.AssertMetricWithLabelsEquals(t, lag.checkErrors, prometheus.Labels{"op": "read"}, 1)
+	test.AssertEquals(t, countRegistrations(t, sa, "CountOrders"), int64(1))
+
+	// Replicate a heartbeat from 2 seconds ago.
+	_, err = replica.Exec("INSERT INTO replicationHeartbeats (id, beat) VALUES (1, ?)", fc.Now().Add(-2*time.Second).UnixNano())
+	test.AssertNotError(t, err, "inserting heartbeat")
+	err = lag.check(context.Background())
+	test.AssertNotError(t, err, "checking lag")
+	measured, ok := lag.Lag()
+	test.Assert(t, ok, "lag should be known after it's measured")
+	test.AssertEquals(t, measured, 2*time.Second)
+	test.AssertEquals(t, countRegistrations(t, sa, "CountOrders"), int64(0))
+
+	// The replica falls further behind than the route allows.
+	fc.Add(4 * time.Second)
+	err = lag.check(context.Background())
+	test.AssertNotError(t, err, "checking lag")
+	measured, _ = lag.Lag()
+	test.AssertEquals(t, measured, 6*time.Second)
+	test.AssertEquals(t, countRegistrations(t, sa, "CountOrders"), int64(1))
+
+	// A measurement more than three intervals old is treated as unknown.
+	_, err = replica.Exec("UPDATE replicationHeartbeats SET beat = ?", fc.Now().UnixNano())
+	test.AssertNotError(t, err, "updating heartbeat")
+	err = lag.check(context.Background())
+	test.AssertNotError(t, err, "checking lag")
+	test.AssertEquals(t, countRegistrations(t, sa, "CountOrders"), int64(0))
+	fc.Add(4 * time.Second)
+	_, ok = lag.Lag()
+	test.Assert(t, !ok, "lag shouldn't be known after three intervals")
+	test.AssertEquals(t, countRegistrations(t, sa, "CountOrders"), int64(1))
+
+	// The heartbeat was written to the primary each time.
+	var beat int64
+	err = sa.dbMap.SelectOne(&beat, "SELECT beat FROM replicationHeartbeats WHERE id = 1")
+	test.AssertNotError(t, err, "reading heartbeat from the primary")
+	test.AssertEquals(t, beat, fc.Now().Add(-4*time.Second).UnixNano())
+}
@@ -0,0 +1,129 @@
+package sa
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/db"
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+// ReplicaLagMonitor measures how far the read-only database is behind the
+// primary. It periodically writes the current time to a heartbeat row on the
+// primary and reads the row back from the replica: the difference between the
+// time it's read and the time it holds is the replica's lag. Several SAs may
+// share the heartbeat row, in which case the lag includes the (normally
+// negligible) skew between their clocks.
+type ReplicaLagMonitor struct {
+	primary  *db.WrappedMap
+	replica  *db.WrappedMap
+	interval time.Duration
+	clk      clock.Clock
+	log      blog.Logger
+
+	mu       sync.RWMutex
+	lag      time.Duration
+	measured time.Time
+
+	lagGauge    prometheus.Gauge
+	checkErrors *prometheus.CounterVec
+}
+
+// NewReplicaLagMonitor returns a ReplicaLagMonitor which measures the replica's
+// lag every interval once it's started with Run.
+func NewReplicaLagMonitor(
+	primary *db.WrappedMap,
+	replica *db.WrappedMap,
+	interval time.Duration,
+	clk clock.Clock,
+	logger blog.Logger,
+	stats prometheus.Registerer,
+) *ReplicaLagMonitor {
+	lagGauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "replica_lag_seconds",
+		Help: "most recently measured replication lag of the SA's read-only database",
+	})
+	stats.MustRegister(lagGauge)
+
+	checkErrors := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "replica_lag_check_errors",
+		Help: "number of failures to write the replication heartbeat to the primary, or read it from the replica",
+	}, []string{"op"})
+	stats.MustRegister(checkErrors)
+
+	return &ReplicaLagMonitor{
+		primary:     primary,
+		replica:     replica,
+		interval:    interval,
+		clk:         clk,
+		log:         logger,
+		lagGauge:    lagGauge,
+		checkErrors: checkErrors,
+	}
+}
+
+// Run measures the replica's lag every interval. It never returns, so it
+// should be called in its own goroutine.
+func (m *ReplicaLagMonitor) Run() {
+	for {
+		err := m.check(context.Background())
+		if err != nil {
+			m.log.Warningf("checking replica lag: %s", err)
+		}
+		m.clk.Sleep(m.interval)
+	}
+}
+
+// check writes a heartbeat to the primary and then reads the latest heartbeat
+// from the replica. If the write fails, the lag is still measured from the
+// replica's latest heartbeat, which overestimates it.
+func (m *ReplicaLagMonitor) check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, m.interval)
+	defer cancel()
+
+	dialect := m.primary.SQLDialect()
+	_, writeErr := m.primary.WithContext(ctx).Exec(
+		"INSERT INTO replicationHeartbeats (id, beat) VALUES (1, ?) "+
+			dialect.OnDuplicateKeyUpdate([]string{"id"}, "beat = "+dialect.Inserted("beat")),
+		m.clk.Now().UnixNano(),
+	)
+	if writeErr != nil {
+		m.checkErrors.WithLabelValues("write").Inc()
+	}
+
+	var beat int64
+	err := m.replica.WithContext(ctx).SelectOne(&beat, "SELECT beat FROM replicationHeartbeats WHERE id = 1")
+	if err != nil {
+		m.checkErrors.WithLabelValues("read").Inc()
+		return err
+	}
+
+	now := m.clk.Now()
+	lag := now.Sub(time.Unix(0, beat))
+	if lag < 0 {
+		lag = 0
+	}
+	m.mu.Lock()
+	m.lag = lag
+	m.measured = now
+	m.mu.Unlock()
+	m.lagGauge.Set(lag.Seconds())
+
+	return writeErr
+}
+
+// Lag returns the replica's most recently measured lag. ok is false if the lag
+// hasn't been measured in the last three intervals, in which case it should be
+// treated as unknown.
+func (m *ReplicaLagMonitor) Lag() (lag time.Duration, ok bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.measured.IsZero() || m.clk.Since(m.measured) > 3*m.interval {
+		return 0, false
+	}
+	return m.lag, true
+}
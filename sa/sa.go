@@ -32,15 +32,19 @@ import (
 
 var errIncompleteRequest = errors.New("incomplete gRPC request message")
 
-type certCountFunc func(db db.Selector, domain string, timeRange *sapb.Range) (int64, error)
+type certCountFunc func(db db.Selector, domain string, timeRange *sapb.Range) (int64, time.Time, error)
 
 // SQLStorageAuthority defines a Storage Authority
 type SQLStorageAuthority struct {
 	sapb.UnimplementedStorageAuthorityServer
-	dbMap         *db.WrappedMap
-	dbReadOnlyMap *db.WrappedMap
-	clk           clock.Clock
-	log           blog.Logger
+	dbMap   *db.WrappedMap
+	dialect db.Dialect
+	clk     clock.Clock
+	log     blog.Logger
+
+	// router chooses whether each routable read goes to dbMap or the
+	// read-only database.
+	router *readRouter
 
 	// For RPCs that generate multiple, parallelizable SQL queries, this is the
 	// max parallelism they will use (to avoid consuming too many MariaDB
@@ -57,6 +61,10 @@ type SQLStorageAuthority struct {
 	// transactions fail and so use this stat to maintain visibility into the rate
 	// this occurs.
 	rateLimitWriteErrors prometheus.Counter
+
+	// eventsCommittedLate counts, by type, events whose transactions committed
+	// too late for StreamEvents to be sure of sending them.
+	eventsCommittedLate *prometheus.CounterVec
 }
 
 // orderFQDNSet contains the SHA256 hash of the lowercased, comma joined names
@@ -89,13 +97,21 @@ func NewSQLStorageAuthority(
 	})
 	stats.MustRegister(rateLimitWriteErrors)
 
+	eventsCommittedLate := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "events_committed_late",
+		Help: "number of events, by type, which committed more than the gap timeout after they were created and which StreamEvents consumers may have skipped",
+	}, []string{"type"})
+	stats.MustRegister(eventsCommittedLate)
+
 	ssa := &SQLStorageAuthority{
 		dbMap:                dbMap,
-		dbReadOnlyMap:        dbReadOnlyMap,
+		dialect:              dbMap.SQLDialect(),
 		clk:                  clk,
 		log:                  logger,
+		router:               newReadRouter(dbMap, dbReadOnlyMap, stats),
 		parallelismPerRPC:    parallelismPerRPC,
 		rateLimitWriteErrors: rateLimitWriteErrors,
+		eventsCommittedLate:  eventsCommittedLate,
 	}
 
 	ssa.countCertificatesByName = ssa.countCertificates
@@ -110,7 +126,7 @@ func (ssa *SQLStorageAuthority) GetRegistration(ctx context.Context, req *sapb.R
 	}
 
 	const query = "WHERE id = ?"
-	model, err := selectRegistration(ssa.dbMap.WithContext(ctx), query, req.Id)
+	model, err := selectRegistration(ssa.reader(ctx, "GetRegistration"), query, req.Id)
 	if err != nil {
 		if db.IsNoRows(err) {
 			return nil, berrors.NotFoundError("registration with ID '%d' not found", req.Id)
@@ -138,7 +154,7 @@ func (ssa *SQLStorageAuthority) GetRegistrationByKey(ctx context.Context, req *s
 	if err != nil {
 		return nil, err
 	}
-	model, err := selectRegistration(ssa.dbMap.WithContext(ctx), query, sha)
+	model, err := selectRegistration(ssa.reader(ctx, "GetRegistrationByKey"), query, sha)
 	if err != nil {
 		if db.IsNoRows(err) {
 			return nil, berrors.NotFoundError("no registrations with public key sha256 %q", sha)
@@ -203,7 +219,7 @@ func (ssa *SQLStorageAuthority) CountRegistrationsByIP(ctx context.Context, req
 	}
 
 	var count int64
-	err := ssa.dbReadOnlyMap.WithContext(ctx).SelectOne(
+	err := ssa.reader(ctx, "CountRegistrationsByIP").SelectOne(
 		&count,
 		`SELECT COUNT(1) FROM registrations
 		 WHERE
@@ -232,7 +248,7 @@ func (ssa *SQLStorageAuthority) CountRegistrationsByIPRange(ctx context.Context,
 
 	var count int64
 	beginIP, endIP := ipRange(req.Ip)
-	err := ssa.dbReadOnlyMap.WithContext(ctx).SelectOne(
+	err := ssa.reader(ctx, "CountRegistrationsByIPRange").SelectOne(
 		&count,
 		`SELECT COUNT(1) FROM registrations
 		 WHERE
@@ -255,7 +271,9 @@ func (ssa *SQLStorageAuthority) CountRegistrationsByIPRange(ctx context.Context,
 // CountCertificatesByNames counts, for each input domain, the number of
 // certificates issued in the given time range for that domain and its
 // subdomains. It returns a map from domains to counts, which is guaranteed to
-// contain an entry for each input domain, so long as err is nil.
+// contain an entry for each input domain, so long as err is nil, and a map from
+// domains to the time of the earliest issuance counted, for domains with a
+// non-zero count.
 // Queries will be run in parallel. If any of them error, only one error will
 // be returned.
 func (ssa *SQLStorageAuthority) CountCertificatesByNames(ctx context.Context, req *sapb.CountCertificatesByNamesRequest) (*sapb.CountByNames, error) {
@@ -265,9 +283,10 @@ func (ssa *SQLStorageAuthority) CountCertificatesByNames(ctx context.Context, re
 
 	work := make(chan string, len(req.Names))
 	type result struct {
-		err    error
-		count  int64
-		domain string
+		err      error
+		count    int64
+		earliest time.Time
+		domain   string
 	}
 	results := make(chan result, len(req.Names))
 	for _, domain := range req.Names {
@@ -291,7 +310,7 @@ func (ssa *SQLStorageAuthority) CountCertificatesByNames(ctx context.Context, re
 					return
 				default:
 				}
-				currentCount, err := ssa.countCertificatesByName(ssa.dbReadOnlyMap.WithContext(ctx), domain, req.Range)
+				currentCount, earliest, err := ssa.countCertificatesByName(ssa.reader(ctx, "CountCertificatesByNames"), domain, req.Range)
 				if err != nil {
 					results <- result{err: err}
 					// Skip any further work
@@ -299,8 +318,9 @@ func (ssa *SQLStorageAuthority) CountCertificatesByNames(ctx context.Context, re
 					return
 				}
 				results <- result{
-					count:  currentCount,
-					domain: domain,
+					count:    currentCount,
+					earliest: earliest,
+					domain:   domain,
 				}
 			}
 		}()
@@ -308,13 +328,17 @@ func (ssa *SQLStorageAuthority) CountCertificatesByNames(ctx context.Context, re
 	wg.Wait()
 	close(results)
 	counts := make(map[string]int64)
+	earliest := make(map[string]int64)
 	for r := range results {
 		if r.err != nil {
 			return nil, r.err
 		}
 		counts[r.domain] = r.count
+		if r.count > 0 {
+			earliest[r.domain] = r.earliest.UnixNano()
+		}
 	}
-	return &sapb.CountByNames{Counts: counts}, nil
+	return &sapb.CountByNames{Counts: counts, Earliest: earliest}, nil
 }
 
 func ReverseName(domain string) string {
@@ -335,7 +359,7 @@ func (ssa *SQLStorageAuthority) GetCertificate(ctx context.Context, req *sapb.Se
 		return nil, fmt.Errorf("Invalid certificate serial %s", req.Serial)
 	}
 
-	cert, err := SelectCertificate(ssa.dbMap.WithContext(ctx), req.Serial)
+	cert, err := SelectCertificate(ssa.reader(ctx, "GetCertificate"), req.Serial)
 	if db.IsNoRows(err) {
 		return nil, berrors.NotFoundError("certificate with serial %q not found", req.Serial)
 	}
@@ -357,7 +381,7 @@ func (ssa *SQLStorageAuthority) GetCertificateStatus(ctx context.Context, req *s
 		return nil, err
 	}
 
-	certStatus, err := SelectCertificateStatus(ssa.dbMap.WithContext(ctx), req.Serial)
+	certStatus, err := SelectCertificateStatus(ssa.reader(ctx, "GetCertificateStatus"), req.Serial)
 	if err != nil {
 		return nil, err
 	}
@@ -376,17 +400,42 @@ func (ssa *SQLStorageAuthority) NewRegistration(ctx context.Context, req *corepb
 		return nil, err
 	}
 
-	reg.CreatedAt = ssa.clk.Now()
+	now := ssa.clk.Now()
+	reg.CreatedAt = now
 
-	err = ssa.dbMap.WithContext(ctx).Insert(reg)
-	if err != nil {
-		if db.IsDuplicate(err) {
-			// duplicate entry error can only happen when jwk_sha256 collides, indicate
-			// to caller that the provided key is already in use
-			return nil, berrors.DuplicateError("key is already in use for a different account")
+	var event *eventModel
+	_, overallError := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		err := checkKeyReusable(txWithCtx, reg.KeySHA256, now)
+		if err != nil {
+			return nil, err
 		}
+		err = txWithCtx.Insert(reg)
+		if err != nil {
+			if db.IsDuplicate(err) {
+				// duplicate entry error can only happen when jwk_sha256 collides, indicate
+				// to caller that the provided key is already in use
+				return nil, berrors.DuplicateError("key is already in use for a different account")
+			}
+			return nil, err
+		}
+		err = txWithCtx.Insert(&accountKeyModel{
+			RegistrationID: reg.ID,
+			KeySHA256:      reg.KeySHA256,
+			Added:          &now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		event, err = insertEvent(txWithCtx, EventAccountCreated, now, reg.ID, "", accountEventDetails{
+			Status:    reg.Status,
+			KeySHA256: reg.KeySHA256,
+		})
 		return nil, err
+	})
+	if overallError != nil {
+		return nil, overallError
 	}
+	ssa.checkEventCommitted(event)
 	return registrationModelToPb(reg)
 }
 
@@ -413,18 +462,45 @@ func (ssa *SQLStorageAuthority) UpdateRegistration(ctx context.Context, req *cor
 	// Copy the existing registration model's LockCol to the new updated
 	// registration model's LockCol
 	update.LockCol = curr.LockCol
-	n, err := ssa.dbMap.WithContext(ctx).Update(update)
-	if err != nil {
-		if db.IsDuplicate(err) {
-			// duplicate entry error can only happen when jwk_sha256 collides, indicate
-			// to caller that the provided key is already in use
-			return nil, berrors.DuplicateError("key is already in use for a different account")
+	keyChanged := update.KeySHA256 != curr.KeySHA256
+	now := ssa.clk.Now()
+	var event *eventModel
+	_, overallError := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		if keyChanged {
+			err := checkKeyReusable(txWithCtx, update.KeySHA256, now)
+			if err != nil {
+				return nil, err
+			}
+		}
+		n, err := txWithCtx.Update(update)
+		if err != nil {
+			if db.IsDuplicate(err) {
+				// duplicate entry error can only happen when jwk_sha256 collides, indicate
+				// to caller that the provided key is already in use
+				return nil, berrors.DuplicateError("key is already in use for a different account")
+			}
+			return nil, err
 		}
+		if n == 0 {
+			return nil, berrors.NotFoundError("registration with ID '%d' not found", req.Id)
+		}
+		if keyChanged {
+			err = recordKeyRollover(txWithCtx, req.Id, curr.KeySHA256, update.KeySHA256, now)
+			if err != nil {
+				return nil, err
+			}
+		}
+		event, err = insertEvent(txWithCtx, EventAccountUpdated, now, req.Id, "", accountEventDetails{
+			Status:     update.Status,
+			KeySHA256:  update.KeySHA256,
+			KeyChanged: keyChanged,
+		})
 		return nil, err
+	})
+	if overallError != nil {
+		return nil, overallError
 	}
-	if n == 0 {
-		return nil, berrors.NotFoundError("registration with ID '%d' not found", req.Id)
-	}
+	ssa.checkEventCommitted(event)
 
 	return &emptypb.Empty{}, nil
 }
@@ -451,6 +527,7 @@ func (ssa *SQLStorageAuthority) AddCertificate(ctx context.Context, req *sapb.Ad
 		Expires:        parsedCertificate.NotAfter,
 	}
 
+	var event *eventModel
 	isRenewalRaw, overallError := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
 		// Select to see if cert exists
 		var row struct {
@@ -476,7 +553,19 @@ func (ssa *SQLStorageAuthority) AddCertificate(ctx context.Context, req *sapb.Ad
 		// not for the purpose of rate limiting is the least of our troubles.
 		isRenewal, err := ssa.checkFQDNSetExists(
 			txWithCtx.SelectOne,
-			parsedCertificate.DNSNames)
+			parsedCertificate.DNSNames,
+			time.Time{})
+		if err != nil {
+			return nil, err
+		}
+
+		event, err = insertEvent(txWithCtx, EventCertificateIssued, ssa.clk.Now(), req.RegID, serial, certificateIssuedDetails{
+			Digest:    digest,
+			IssuerID:  req.IssuerID,
+			Names:     parsedCertificate.DNSNames,
+			NotBefore: parsedCertificate.NotBefore,
+			NotAfter:  parsedCertificate.NotAfter,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -486,6 +575,7 @@ func (ssa *SQLStorageAuthority) AddCertificate(ctx context.Context, req *sapb.Ad
 	if overallError != nil {
 		return nil, overallError
 	}
+	ssa.checkEventCommitted(event)
 
 	// Recast the interface{} return from db.WithTransaction as a bool, returning
 	// an error if we can't.
@@ -542,11 +632,11 @@ func (ssa *SQLStorageAuthority) CountOrders(ctx context.Context, req *sapb.Count
 	}
 
 	if features.Enabled(features.FasterNewOrdersRateLimit) {
-		return countNewOrders(ctx, ssa.dbReadOnlyMap, req)
+		return countNewOrders(ctx, ssa.reader(ctx, "CountOrders"), req)
 	}
 
 	var count int64
-	err := ssa.dbReadOnlyMap.WithContext(ctx).SelectOne(
+	err := ssa.reader(ctx, "CountOrders").SelectOne(
 		&count,
 		`SELECT count(1) FROM orders
 		WHERE registrationID = :acctID AND
@@ -655,7 +745,7 @@ func (ssa *SQLStorageAuthority) CountFQDNSets(ctx context.Context, req *sapb.Cou
 	}
 
 	var count int64
-	err := ssa.dbReadOnlyMap.WithContext(ctx).SelectOne(
+	err := ssa.reader(ctx, "CountFQDNSets").SelectOne(
 		&count,
 		// We don't do a select across both fqdnSets and fqdnSets_old here because
 		// this method is only used for rate-limiting and we don't care to spend the
@@ -676,7 +766,11 @@ func (ssa *SQLStorageAuthority) FQDNSetExists(ctx context.Context, req *sapb.FQD
 	if len(req.Domains) == 0 {
 		return nil, errIncompleteRequest
 	}
-	exists, err := ssa.checkFQDNSetExists(ssa.dbMap.WithContext(ctx).SelectOne, req.Domains)
+	var issuedAfter time.Time
+	if req.IssuedAfter != 0 {
+		issuedAfter = time.Unix(0, req.IssuedAfter)
+	}
+	exists, err := ssa.checkFQDNSetExists(ssa.reader(ctx, "FQDNSetExists").SelectOne, req.Domains, issuedAfter)
 	if err != nil {
 		return nil, err
 	}
@@ -688,9 +782,16 @@ func (ssa *SQLStorageAuthority) FQDNSetExists(ctx context.Context, req *sapb.FQD
 type oneSelectorFunc func(holder interface{}, query string, args ...interface{}) error
 
 // checkFQDNSetExists uses the given oneSelectorFunc to check whether an fqdnSet
-// for the given names exists.
-func (ssa *SQLStorageAuthority) checkFQDNSetExists(selector oneSelectorFunc, names []string) (bool, error) {
+// for the given names exists. If issuedAfter isn't zero, only sets issued after
+// it are considered.
+func (ssa *SQLStorageAuthority) checkFQDNSetExists(selector oneSelectorFunc, names []string, issuedAfter time.Time) (bool, error) {
 	namehash := HashNames(names)
+	issuedClause := ""
+	args := []interface{}{namehash}
+	if !issuedAfter.IsZero() {
+		issuedClause = " AND issued > ?"
+		args = append(args, issuedAfter)
+	}
 	var exists bool
 	err := selector(
 		&exists,
@@ -698,10 +799,9 @@ func (ssa *SQLStorageAuthority) checkFQDNSetExists(selector oneSelectorFunc, nam
 		// if a given issuance is a renewal, and for that we care about 90 days
 		// worth of data, not just 7 days like the current fqdnSets table holds.
 		// TODO(#5670): Remove this OR when the partitioning is fixed.
-		`SELECT EXISTS (SELECT id FROM fqdnSets WHERE setHash = ? LIMIT 1)
-		OR EXISTS (SELECT id FROM fqdnSets_old WHERE setHash = ? LIMIT 1)`,
-		namehash,
-		namehash,
+		fmt.Sprintf(`SELECT EXISTS (SELECT id FROM fqdnSets WHERE setHash = ?%s LIMIT 1)
+		OR EXISTS (SELECT id FROM fqdnSets_old WHERE setHash = ?%s LIMIT 1)`, issuedClause, issuedClause),
+		append(args, args...)...,
 	)
 	return exists, err
 }
@@ -723,7 +823,7 @@ func (ssa *SQLStorageAuthority) PreviousCertificateExists(ctx context.Context, r
 
 	// Find the most recently issued certificate containing this domain name.
 	var serial string
-	err := ssa.dbMap.WithContext(ctx).SelectOne(
+	err := ssa.reader(ctx, "PreviousCertificateExists").SelectOne(
 		&serial,
 		`SELECT serial FROM issuedNames
 		WHERE reversedName = ?
@@ -740,7 +840,7 @@ func (ssa *SQLStorageAuthority) PreviousCertificateExists(ctx context.Context, r
 
 	// Check whether that certificate was issued to the specified account.
 	var count int
-	err = ssa.dbMap.WithContext(ctx).SelectOne(
+	err = ssa.reader(ctx, "PreviousCertificateExists").SelectOne(
 		&count,
 		`SELECT COUNT(1) FROM certificates
 		WHERE serial = ?
@@ -763,16 +863,16 @@ func (ssa *SQLStorageAuthority) PreviousCertificateExists(ctx context.Context, r
 	return notExists, nil
 }
 
-// DeactivateRegistration deactivates a currently valid registration
-func (ssa *SQLStorageAuthority) DeactivateRegistration(ctx context.Context, req *sapb.RegistrationID) (*emptypb.Empty, error) {
-	if req == nil || req.Id == 0 {
+// MarkExpirationNagSent records when the subscriber was last warned that a
+// certificate is about to expire.
+func (ssa *SQLStorageAuthority) MarkExpirationNagSent(ctx context.Context, req *sapb.MarkExpirationNagSentRequest) (*emptypb.Empty, error) {
+	if req == nil || core.IsAnyNilOrZero(req.Serial, req.Sent) {
 		return nil, errIncompleteRequest
 	}
 	_, err := ssa.dbMap.WithContext(ctx).Exec(
-		"UPDATE registrations SET status = ? WHERE status = ? AND id = ?",
-		string(core.StatusDeactivated),
-		string(core.StatusValid),
-		req.Id,
+		"UPDATE certificateStatus SET lastExpirationNagSent = ? WHERE serial = ?",
+		time.Unix(0, req.Sent),
+		req.Serial,
 	)
 	if err != nil {
 		return nil, err
@@ -780,6 +880,36 @@ func (ssa *SQLStorageAuthority) DeactivateRegistration(ctx context.Context, req
 	return &emptypb.Empty{}, nil
 }
 
+// DeactivateRegistration deactivates a currently valid registration
+func (ssa *SQLStorageAuthority) DeactivateRegistration(ctx context.Context, req *sapb.RegistrationID) (*emptypb.Empty, error) {
+	if req == nil || req.Id == 0 {
+		return nil, errIncompleteRequest
+	}
+	var event *eventModel
+	_, overallError := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		result, err := txWithCtx.Exec(
+			"UPDATE registrations SET status = ? WHERE status = ? AND id = ?",
+			string(core.StatusDeactivated),
+			string(core.StatusValid),
+			req.Id,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := result.RowsAffected()
+		if err != nil || rows == 0 {
+			return nil, err
+		}
+		event, err = insertEvent(txWithCtx, EventAccountDeactivated, ssa.clk.Now(), req.Id, "", struct{}{})
+		return nil, err
+	})
+	if overallError != nil {
+		return nil, overallError
+	}
+	ssa.checkEventCommitted(event)
+	return &emptypb.Empty{}, nil
+}
+
 // DeactivateAuthorization2 deactivates a currently valid or pending authorization.
 // This method is intended to deprecate DeactivateAuthorization.
 func (ssa *SQLStorageAuthority) DeactivateAuthorization2(ctx context.Context, req *sapb.AuthorizationID2) (*emptypb.Empty, error) {
@@ -859,7 +989,7 @@ func (ssa *SQLStorageAuthority) NewOrder(ctx context.Context, req *sapb.NewOrder
 
 	if features.Enabled(features.FasterNewOrdersRateLimit) {
 		// Increment the order creation count
-		if err := addNewOrdersRateLimit(ctx, ssa.dbMap, req.RegistrationID, ssa.clk.Now().Truncate(time.Minute)); err != nil {
+		if err := addNewOrdersRateLimit(ctx, ssa.dbMap, ssa.dialect, req.RegistrationID, ssa.clk.Now().Truncate(time.Minute)); err != nil {
 			return nil, err
 		}
 	}
@@ -910,7 +1040,7 @@ func (ssa *SQLStorageAuthority) NewOrderAndAuthzs(ctx context.Context, req *sapb
 					return nil, err
 				}
 				err = inserter.Add([]interface{}{
-					am.ID,
+					autoIncrementID(am.ID),
 					am.IdentifierType,
 					am.IdentifierValue,
 					am.RegistrationID,
@@ -927,7 +1057,7 @@ func (ssa *SQLStorageAuthority) NewOrderAndAuthzs(ctx context.Context, req *sapb
 					return nil, err
 				}
 			}
-			newAuthzIDs, err = inserter.Insert(txWithCtx)
+			newAuthzIDs, err = inserter.Insert(txWithCtx, ssa.dialect)
 			if err != nil {
 				return nil, err
 			}
@@ -960,7 +1090,7 @@ func (ssa *SQLStorageAuthority) NewOrderAndAuthzs(ctx context.Context, req *sapb
 				return nil, err
 			}
 		}
-		_, err = inserter.Insert(txWithCtx)
+		_, err = inserter.Insert(txWithCtx, ssa.dialect)
 		if err != nil {
 			return nil, err
 		}
@@ -976,7 +1106,7 @@ func (ssa *SQLStorageAuthority) NewOrderAndAuthzs(ctx context.Context, req *sapb
 				return nil, err
 			}
 		}
-		_, err = inserter.Insert(txWithCtx)
+		_, err = inserter.Insert(txWithCtx, ssa.dialect)
 		if err != nil {
 			return nil, err
 		}
@@ -1013,7 +1143,7 @@ func (ssa *SQLStorageAuthority) NewOrderAndAuthzs(ctx context.Context, req *sapb
 
 	if features.Enabled(features.FasterNewOrdersRateLimit) {
 		// Increment the order creation count
-		if err := addNewOrdersRateLimit(ctx, ssa.dbMap, req.NewOrder.RegistrationID, ssa.clk.Now().Truncate(time.Minute)); err != nil {
+		if err := addNewOrdersRateLimit(ctx, ssa.dbMap, ssa.dialect, req.NewOrder.RegistrationID, ssa.clk.Now().Truncate(time.Minute)); err != nil {
 			return nil, err
 		}
 	}
@@ -1454,6 +1584,16 @@ func AuthzMapToPB(m map[string]*core.Authorization) (*sapb.Authorizations, error
 	return resp, nil
 }
 
+// autoIncrementID returns the value to insert for an auto-increment ID column:
+// nil, so that the database assigns the ID, if id is zero. MySQL also assigns
+// one for zero, but SQLite stores the zero.
+func autoIncrementID(id int64) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
 // NewAuthorizations2 adds a set of new style authorizations to the database and
 // returns either the IDs of the authorizations or an error.
 // TODO(#5816): Consider removing this method, as it has no callers.
@@ -1462,10 +1602,10 @@ func (ssa *SQLStorageAuthority) NewAuthorizations2(ctx context.Context, req *sap
 		return nil, errIncompleteRequest
 	}
 
-	ids := &sapb.Authorization2IDs{}
-	var queryArgs []interface{}
-	var questionsBuf strings.Builder
-
+	inserter, err := db.NewMultiInserter("authz2", authzFields, "id")
+	if err != nil {
+		return nil, err
+	}
 	for _, authz := range req.Authz {
 		if authz.Status != string(core.StatusPending) {
 			return nil, berrors.InternalServerError("authorization must be pending")
@@ -1475,13 +1615,9 @@ func (ssa *SQLStorageAuthority) NewAuthorizations2(ctx context.Context, req *sap
 			return nil, err
 		}
 
-		// Each authz needs a (?,?...), in the VALUES block. We need one
-		// for each element in the authzFields string.
-		fmt.Fprint(&questionsBuf, "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?),")
-
-		// The query arguments must follow the order of the authzFields string.
-		queryArgs = append(queryArgs,
-			am.ID,
+		// The row must follow the order of the authzFields string.
+		err = inserter.Add([]interface{}{
+			autoIncrementID(am.ID),
 			am.IdentifierType,
 			am.IdentifierValue,
 			am.RegistrationID,
@@ -1493,34 +1629,17 @@ func (ssa *SQLStorageAuthority) NewAuthorizations2(ctx context.Context, req *sap
 			am.Token,
 			am.ValidationError,
 			am.ValidationRecord,
-		)
-	}
-
-	// At this point, the VALUES block question-string has a trailing comma, we need
-	// to remove it to make sure we're valid SQL.
-	questionsTrimmed := strings.TrimRight(questionsBuf.String(), ",")
-	query := fmt.Sprintf("INSERT INTO authz2 (%s) VALUES %s RETURNING id;", authzFields, questionsTrimmed)
-
-	rows, err := ssa.dbMap.Db.QueryContext(ctx, query, queryArgs...)
-	if err != nil {
-		return nil, err
-	}
-	for rows.Next() {
-		var idField int64
-		err = rows.Scan(&idField)
+		})
 		if err != nil {
-			rows.Close()
 			return nil, err
 		}
-		ids.Ids = append(ids.Ids, idField)
 	}
 
-	// Ensure the query wasn't interrupted before it could complete.
-	err = rows.Close()
+	ids, err := inserter.Insert(ssa.dbMap.WithContext(ctx), ssa.dialect)
 	if err != nil {
 		return nil, err
 	}
-	return ids, nil
+	return &sapb.Authorization2IDs{Ids: ids}, nil
 }
 
 // GetAuthorization2 returns the authz2 style authorization identified by the provided ID or an error.
@@ -1529,7 +1648,7 @@ func (ssa *SQLStorageAuthority) GetAuthorization2(ctx context.Context, req *sapb
 	if req.Id == 0 {
 		return nil, errIncompleteRequest
 	}
-	obj, err := ssa.dbMap.Get(authzModel{}, req.Id)
+	obj, err := ssa.reader(ctx, "GetAuthorization2").Get(authzModel{}, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -1570,7 +1689,7 @@ func (ssa *SQLStorageAuthority) GetAuthorizations2(ctx context.Context, req *sap
 
 	useIndex := ""
 	if features.Enabled(features.GetAuthzUseIndex) {
-		useIndex = "USE INDEX (regID_identifier_status_expires_idx)"
+		useIndex = ssa.dialect.UseIndex("regID_identifier_status_expires_idx")
 	}
 
 	qmarks := make([]string, len(req.Domains))
@@ -1592,11 +1711,7 @@ func (ssa *SQLStorageAuthority) GetAuthorizations2(ctx context.Context, req *sap
 		strings.Join(qmarks, ","),
 	)
 
-	dbMap := ssa.dbMap
-	if features.Enabled(features.GetAuthzReadOnly) {
-		dbMap = ssa.dbReadOnlyMap
-	}
-	_, err := dbMap.Select(
+	_, err := ssa.reader(ctx, "GetAuthorizations2").Select(
 		&authzModels,
 		query,
 		params...,
@@ -1708,34 +1823,54 @@ func (ssa *SQLStorageAuthority) RevokeCertificate(ctx context.Context, req *sapb
 		return nil, errIncompleteRequest
 	}
 	revokedDate := time.Unix(0, req.Date)
-	res, err := ssa.dbMap.Exec(
-		`UPDATE certificateStatus SET
-				status = ?,
-				revokedReason = ?,
-				revokedDate = ?,
-				ocspLastUpdated = ?,
-				ocspResponse = ?
-			WHERE serial = ? AND status != ?`,
-		string(core.OCSPStatusRevoked),
-		revocation.Reason(req.Reason),
-		revokedDate,
-		revokedDate,
-		req.Response,
-		req.Serial,
-		string(core.OCSPStatusRevoked),
-	)
-	if err != nil {
-		return nil, err
-	}
-	rows, err := res.RowsAffected()
-	if err != nil {
+	var event *eventModel
+	_, overallError := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		res, err := txWithCtx.Exec(
+			`UPDATE certificateStatus SET
+					status = ?,
+					revokedReason = ?,
+					revokedDate = ?,
+					ocspLastUpdated = ?,
+					ocspResponse = ?
+				WHERE serial = ? AND status != ?`,
+			string(core.OCSPStatusRevoked),
+			revocation.Reason(req.Reason),
+			revokedDate,
+			revokedDate,
+			req.Response,
+			req.Serial,
+			string(core.OCSPStatusRevoked),
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if rows == 0 {
+			// InternalServerError because we expected this certificate status to exist and
+			// not be revoked.
+			return nil, berrors.InternalServerError("no certificate with serial %s and status other than %s", req.Serial, string(core.OCSPStatusRevoked))
+		}
+		// Every certificate should have a serials row, but a missing one
+		// shouldn't prevent its revocation, so the event's account is left
+		// unset instead.
+		var regID int64
+		err = txWithCtx.SelectOne(&regID, "SELECT registrationID FROM serials WHERE serial = ?", req.Serial)
+		if err != nil && !db.IsNoRows(err) {
+			return nil, err
+		}
+		event, err = insertEvent(txWithCtx, EventCertificateRevoked, ssa.clk.Now(), regID, req.Serial, certificateRevokedDetails{
+			Reason:      req.Reason,
+			RevokedDate: revokedDate.UTC(),
+		})
 		return nil, err
+	})
+	if overallError != nil {
+		return nil, overallError
 	}
-	if rows == 0 {
-		// InternalServerError because we expected this certificate status to exist and
-		// not be revoked.
-		return nil, berrors.InternalServerError("no certificate with serial %s and status other than %s", req.Serial, string(core.OCSPStatusRevoked))
-	}
+	ssa.checkEventCommitted(event)
 	return &emptypb.Empty{}, nil
 }
 
@@ -1747,7 +1882,7 @@ func (ssa *SQLStorageAuthority) GetPendingAuthorization2(ctx context.Context, re
 		return nil, errIncompleteRequest
 	}
 	var am authzModel
-	err := ssa.dbMap.WithContext(ctx).SelectOne(
+	err := ssa.reader(ctx, "GetPendingAuthorization2").SelectOne(
 		&am,
 		fmt.Sprintf(`SELECT %s FROM authz2 WHERE
 			registrationID = :regID AND
@@ -1782,7 +1917,7 @@ func (ssa *SQLStorageAuthority) CountPendingAuthorizations2(ctx context.Context,
 	}
 
 	var count int64
-	err := ssa.dbReadOnlyMap.WithContext(ctx).SelectOne(&count,
+	err := ssa.reader(ctx, "CountPendingAuthorizations2").SelectOne(&count,
 		`SELECT COUNT(1) FROM authz2 WHERE
 		registrationID = :regID AND
 		expires > :expires AND
@@ -1807,7 +1942,7 @@ func (ssa *SQLStorageAuthority) GetValidOrderAuthorizations2(ctx context.Context
 	}
 
 	var ams []authzModel
-	_, err := ssa.dbMap.WithContext(ctx).Select(
+	_, err := ssa.reader(ctx, "GetValidOrderAuthorizations2").Select(
 		&ams,
 		fmt.Sprintf(`SELECT %s FROM authz2
 			LEFT JOIN orderToAuthz2 ON authz2.ID = orderToAuthz2.authzID
@@ -1850,7 +1985,7 @@ func (ssa *SQLStorageAuthority) CountInvalidAuthorizations2(ctx context.Context,
 	}
 
 	var count int64
-	err := ssa.dbReadOnlyMap.WithContext(ctx).SelectOne(
+	err := ssa.reader(ctx, "CountInvalidAuthorizations2").SelectOne(
 		&count,
 		`SELECT COUNT(1) FROM authz2 WHERE
 		registrationID = :regID AND
@@ -1895,7 +2030,7 @@ func (ssa *SQLStorageAuthority) GetValidAuthorizations2(ctx context.Context, req
 		qmarks[i] = "?"
 		params = append(params, n)
 	}
-	_, err := ssa.dbMap.Select(
+	_, err := ssa.reader(ctx, "GetValidAuthorizations2").Select(
 		&authzModels,
 		fmt.Sprintf(
 			`SELECT %s FROM authz2 WHERE
@@ -1987,7 +2122,7 @@ func (ssa *SQLStorageAuthority) KeyBlocked(ctx context.Context, req *sapb.KeyBlo
 	}
 	exists := false
 	var id int64
-	if err := ssa.dbMap.SelectOne(&id, `SELECT ID FROM blockedKeys WHERE keyHash = ?`, req.KeyHash); err != nil {
+	if err := ssa.reader(ctx, "KeyBlocked").SelectOne(&id, `SELECT ID FROM blockedKeys WHERE keyHash = ?`, req.KeyHash); err != nil {
 		if db.IsNoRows(err) {
 			return &sapb.Exists{Exists: exists}, nil
 		}
@@ -1996,3 +2131,161 @@ func (ssa *SQLStorageAuthority) KeyBlocked(ctx context.Context, req *sapb.KeyBlo
 	exists = true
 	return &sapb.Exists{Exists: exists}, nil
 }
+
+// MarkBlockedKeyChecked records that the certificates with a blocked key have
+// been checked for, and any found revoked.
+func (ssa *SQLStorageAuthority) MarkBlockedKeyChecked(ctx context.Context, req *sapb.SPKIHash) (*emptypb.Empty, error) {
+	if req == nil || len(req.KeyHash) != 32 {
+		return nil, errIncompleteRequest
+	}
+	_, err := ssa.dbMap.WithContext(ctx).Exec(
+		"UPDATE blockedKeys SET extantCertificatesChecked = ? WHERE keyHash = ?",
+		true,
+		req.KeyHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetSerialsByKey streams the serials of all unexpired certificates and
+// precertificates whose public key has the given SPKI hash, in the order they
+// were added.
+func (ssa *SQLStorageAuthority) GetSerialsByKey(req *sapb.SPKIHash, stream sapb.StorageAuthority_GetSerialsByKeyServer) error {
+	if req == nil || len(req.KeyHash) != 32 {
+		return errIncompleteRequest
+	}
+	return ssa.streamSerials(stream, "GetSerialsByKey", "id",
+		`SELECT id, certSerial AS serial
+		FROM keyHashToSerial
+		WHERE keyHash = ? AND certNotAfter > ?`,
+		req.KeyHash,
+		ssa.clk.Now(),
+	)
+}
+
+// GetSerialsByAccount streams the serials of all unexpired certificates and
+// precertificates issued to the given account, in the order they were added.
+func (ssa *SQLStorageAuthority) GetSerialsByAccount(req *sapb.RegistrationID, stream sapb.StorageAuthority_GetSerialsByAccountServer) error {
+	if req == nil || req.Id == 0 {
+		return errIncompleteRequest
+	}
+	return ssa.streamSerials(stream, "GetSerialsByAccount", "id",
+		`SELECT id, serial
+		FROM serials
+		WHERE registrationID = ? AND expires > ?`,
+		req.Id,
+		ssa.clk.Now(),
+	)
+}
+
+// likeEscaper escapes the characters which are special in a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// GetSerialsByName streams the serials of all unexpired certificates and
+// precertificates which include the given name and, if includeSubdomains is
+// set, any of its subdomains. issuedNames is written when a precertificate is
+// stored, so precertificates without a final certificate are included.
+func (ssa *SQLStorageAuthority) GetSerialsByName(req *sapb.GetSerialsByNameRequest, stream sapb.StorageAuthority_GetSerialsByNameServer) error {
+	if req == nil || req.Name == "" {
+		return errIncompleteRequest
+	}
+	reversedName := ReverseName(strings.ToLower(req.Name))
+	query := `SELECT i.id, i.serial
+		FROM issuedNames AS i
+		JOIN serials AS s ON s.serial = i.serial
+		WHERE (i.reversedName = ?`
+	args := []interface{}{reversedName}
+	if req.IncludeSubdomains {
+		query += ` OR i.reversedName LIKE ? ` + ssa.dialect.LikeEscape()
+		args = append(args, likeEscaper.Replace(reversedName)+".%")
+	}
+	query += `) AND s.expires > ?`
+	args = append(args, ssa.clk.Now())
+	return ssa.streamSerials(stream, "GetSerialsByName", "i.id", query, args...)
+}
+
+const (
+	// defaultCertificateSearchLimit is the number of results
+	// SearchCertificatesByName returns when the request doesn't set a limit.
+	defaultCertificateSearchLimit = 100
+	// maxCertificateSearchLimit is the most results SearchCertificatesByName
+	// returns at once.
+	maxCertificateSearchLimit = 1000
+)
+
+// SearchCertificatesByName returns a page of the certificates and
+// precertificates which include the given name and, if includeSubdomains is
+// set, any of its subdomains, optionally only those issued in a given range.
+// Unlike GetSerialsByName, expired certificates are included. A certificate
+// is returned once for each of its names which match, in the order they were
+// stored.
+func (ssa *SQLStorageAuthority) SearchCertificatesByName(ctx context.Context, req *sapb.SearchCertificatesByNameRequest) (*sapb.CertificateSearchResults, error) {
+	if req == nil || req.Name == "" {
+		return nil, errIncompleteRequest
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultCertificateSearchLimit
+	}
+	if limit > maxCertificateSearchLimit {
+		return nil, berrors.MalformedError("cannot return more than %d search results at once", maxCertificateSearchLimit)
+	}
+
+	reversedName := ReverseName(strings.ToLower(req.Name))
+	query := `SELECT i.id, i.reversedName, i.notBefore, i.serial, s.registrationID, cs.status, cs.revokedReason, cs.notAfter
+		FROM issuedNames AS i ` + ssa.dialect.UseIndex("reversedName_notBefore_Idx") + `
+		JOIN serials AS s ON s.serial = i.serial
+		LEFT JOIN certificateStatus AS cs ON cs.serial = i.serial
+		WHERE (i.reversedName = ?`
+	args := []interface{}{reversedName}
+	if req.IncludeSubdomains {
+		query += ` OR i.reversedName LIKE ? ` + ssa.dialect.LikeEscape()
+		args = append(args, likeEscaper.Replace(reversedName)+".%")
+	}
+	query += `) AND i.id > ?`
+	args = append(args, req.AfterID)
+	if req.Issued != nil && req.Issued.Earliest != 0 {
+		query += ` AND i.notBefore >= ?`
+		args = append(args, time.Unix(0, req.Issued.Earliest))
+	}
+	if req.Issued != nil && req.Issued.Latest != 0 {
+		query += ` AND i.notBefore < ?`
+		args = append(args, time.Unix(0, req.Issued.Latest))
+	}
+	query += ` ORDER BY i.id LIMIT ?`
+	args = append(args, limit)
+
+	var models []*certificateSearchModel
+	_, err := ssa.reader(ctx, "SearchCertificatesByName").Select(&models, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	results := &sapb.CertificateSearchResults{
+		Results: make([]*sapb.CertificateSearchResult, len(models)),
+	}
+	for i, m := range models {
+		result := &sapb.CertificateSearchResult{
+			Id:             m.ID,
+			Serial:         m.Serial,
+			Name:           ReverseName(m.ReversedName),
+			RegistrationID: m.RegistrationID,
+			NotBefore:      m.NotBefore.UnixNano(),
+		}
+		if m.Status != nil {
+			result.Status = *m.Status
+		}
+		if m.RevokedReason != nil {
+			result.RevokedReason = *m.RevokedReason
+		}
+		if m.NotAfter != nil {
+			result.NotAfter = m.NotAfter.UnixNano()
+		}
+		results.Results[i] = result
+	}
+	if int64(len(models)) == limit {
+		results.LastID = models[len(models)-1].ID
+	}
+	return results, nil
+}
@@ -8,11 +8,14 @@ import (
 	"crypto/x509"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/big"
 	"math/bits"
 	"net"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sync"
 	"testing"
@@ -51,11 +54,52 @@ var (
 }`
 )
 
+// sqliteTestEngine is true when the SA's unit tests are run against a SQLite
+// database, with BOULDER_SA_TEST_ENGINE=sqlite, rather than the MySQL test
+// database.
+var sqliteTestEngine = os.Getenv("BOULDER_SA_TEST_ENGINE") == "sqlite"
+
+// skipUnlessMySQL skips tests of MySQL-specific behaviour when the tests are
+// run against SQLite.
+func skipUnlessMySQL(t *testing.T) {
+	t.Helper()
+	if sqliteTestEngine {
+		t.Skip("test requires the MySQL test database")
+	}
+}
+
+// initSQLiteTestDB creates a SQLite database in a temporary directory, with
+// the schema from SQLiteMigrations, and returns a map for it and a clean up
+// function which closes it.
+func initSQLiteTestDB(t *testing.T) (*db.WrappedMap, func()) {
+	t.Helper()
+	dbMap, err := NewDbMap(db.SQLiteDSNPrefix+filepath.Join(t.TempDir(), "boulder_sa_test.db"), DbSettings{})
+	if err != nil {
+		t.Fatalf("Failed to create dbMap: %s", err)
+	}
+	migrations, err := SQLiteMigrations()
+	test.AssertNotError(t, err, "failed to load SQLite migrations")
+	_, err = MigrateUp(dbMap, migrations, log)
+	test.AssertNotError(t, err, "failed to create SQLite schema")
+	return dbMap, func() { _ = dbMap.Db.Close() }
+}
+
 // initSA constructs a SQLStorageAuthority and a clean up function
 // that should be defer'ed to the end of the test.
 func initSA(t *testing.T) (*SQLStorageAuthority, clock.FakeClock, func()) {
 	features.Reset()
 
+	if sqliteTestEngine {
+		dbMap, cleanUp := initSQLiteTestDB(t)
+		fc := clock.NewFake()
+		fc.Set(time.Date(2015, 3, 4, 5, 0, 0, 0, time.UTC))
+		sa, err := NewSQLStorageAuthority(dbMap, dbMap, fc, log, metrics.NoopRegisterer, 1)
+		if err != nil {
+			t.Fatalf("Failed to create SA: %s", err)
+		}
+		return sa, fc, cleanUp
+	}
+
 	dbMap, err := NewDbMap(vars.DBConnSA, DbSettings{})
 	if err != nil {
 		t.Fatalf("Failed to create dbMap: %s", err)
@@ -373,7 +417,7 @@ func TestCountCertificatesByNames(t *testing.T) {
 	interlocker.Add(len(names))
 	sa.parallelismPerRPC = len(names)
 	oldCertCountFunc := sa.countCertificatesByName
-	sa.countCertificatesByName = func(sel db.Selector, domain string, timeRange *sapb.Range) (int64, error) {
+	sa.countCertificatesByName = func(sel db.Selector, domain string, timeRange *sapb.Range) (int64, time.Time, error) {
 		interlocker.Done()
 		interlocker.Wait()
 		return oldCertCountFunc(sel, domain, timeRange)
@@ -2359,6 +2403,222 @@ func TestBlockedKeyRevokedBy(t *testing.T) {
 	test.AssertNotError(t, err, "AddBlockedKey failed")
 }
 
+func TestGetSerialsByKeyAndAccount(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+	reg := createWorkingRegistration(t, sa)
+	initialIP, _ := net.ParseIP("43.34.43.34").MarshalText()
+	otherReg, err := sa.NewRegistration(ctx, &corepb.Registration{
+		Key:       []byte(anotherKey),
+		InitialIP: initialIP,
+	})
+	test.AssertNotError(t, err, "failed to create second registration")
+
+	keyHash := make([]byte, 32)
+	keyHash[0] = 1
+	otherKeyHash := make([]byte, 32)
+	otherKeyHash[0] = 2
+	for _, row := range []struct {
+		serial  string
+		regID   int64
+		keyHash []byte
+		expires time.Time
+	}{
+		{"1", reg.Id, keyHash, fc.Now().Add(time.Hour)},
+		{"2", reg.Id, keyHash, fc.Now().Add(-time.Hour)},
+		{"3", otherReg.Id, keyHash, fc.Now().Add(time.Hour)},
+		{"4", reg.Id, otherKeyHash, fc.Now().Add(time.Hour)},
+	} {
+		err := sa.dbMap.Insert(&recordedSerialModel{
+			Serial:         row.serial,
+			RegistrationID: row.regID,
+			Created:        fc.Now(),
+			Expires:        row.expires,
+		})
+		test.AssertNotError(t, err, "failed to insert serial")
+		err = sa.dbMap.Insert(&keyHashModel{
+			KeyHash:      row.keyHash,
+			CertNotAfter: row.expires,
+			CertSerial:   row.serial,
+		})
+		test.AssertNotError(t, err, "failed to insert key hash")
+	}
+
+	// Page through the serials one at a time.
+	defer func(pageSize int) { streamPageSize = pageSize }(streamPageSize)
+	streamPageSize = 1
+
+	err = sa.GetSerialsByKey(&sapb.SPKIHash{KeyHash: []byte{1}}, &serialStream{})
+	test.AssertEquals(t, err, errIncompleteRequest)
+	serials := &serialStream{}
+	err = sa.GetSerialsByKey(&sapb.SPKIHash{KeyHash: keyHash}, serials)
+	test.AssertNotError(t, err, "GetSerialsByKey failed")
+	test.AssertDeepEquals(t, serials.sent, []string{"1", "3"})
+
+	err = sa.GetSerialsByAccount(&sapb.RegistrationID{}, &serialStream{})
+	test.AssertEquals(t, err, errIncompleteRequest)
+	serials = &serialStream{}
+	err = sa.GetSerialsByAccount(&sapb.RegistrationID{Id: reg.Id}, serials)
+	test.AssertNotError(t, err, "GetSerialsByAccount failed")
+	test.AssertDeepEquals(t, serials.sent, []string{"1", "4"})
+}
+
+func TestGetSerialsByName(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+	reg := createWorkingRegistration(t, sa)
+
+	for _, row := range []struct {
+		serial  string
+		names   []string
+		expires time.Time
+	}{
+		{"1", []string{"example.com", "www.example.com"}, fc.Now().Add(time.Hour)},
+		{"2", []string{"example.com"}, fc.Now().Add(-time.Hour)},
+		{"3", []string{"*.example.com"}, fc.Now().Add(time.Hour)},
+		{"4", []string{"a.b.example.com"}, fc.Now().Add(time.Hour)},
+		{"5", []string{"notexample.com", "example.net"}, fc.Now().Add(time.Hour)},
+		{"6", []string{"www.ex_mple.com"}, fc.Now().Add(time.Hour)},
+	} {
+		err := sa.dbMap.Insert(&recordedSerialModel{
+			Serial:         row.serial,
+			RegistrationID: reg.Id,
+			Created:        fc.Now(),
+			Expires:        row.expires,
+		})
+		test.AssertNotError(t, err, "failed to insert serial")
+		for _, name := range row.names {
+			err = sa.dbMap.Insert(&issuedNameModel{
+				ReversedName: ReverseName(name),
+				NotBefore:    fc.Now(),
+				Serial:       row.serial,
+			})
+			test.AssertNotError(t, err, "failed to insert issued name")
+		}
+	}
+
+	// Serial "1" has two names under example.com, so it's sent once despite
+	// being on two pages.
+	defer func(pageSize int) { streamPageSize = pageSize }(streamPageSize)
+	streamPageSize = 1
+
+	err := sa.GetSerialsByName(&sapb.GetSerialsByNameRequest{}, &serialStream{})
+	test.AssertEquals(t, err, errIncompleteRequest)
+
+	serials := &serialStream{}
+	err = sa.GetSerialsByName(&sapb.GetSerialsByNameRequest{Name: "Example.com"}, serials)
+	test.AssertNotError(t, err, "GetSerialsByName failed")
+	test.AssertDeepEquals(t, serials.sent, []string{"1"})
+
+	serials = &serialStream{}
+	err = sa.GetSerialsByName(&sapb.GetSerialsByNameRequest{Name: "example.com", IncludeSubdomains: true}, serials)
+	test.AssertNotError(t, err, "GetSerialsByName failed")
+	test.AssertDeepEquals(t, serials.sent, []string{"1", "3", "4"})
+
+	serials = &serialStream{}
+	err = sa.GetSerialsByName(&sapb.GetSerialsByNameRequest{Name: "b.example.com", IncludeSubdomains: true}, serials)
+	test.AssertNotError(t, err, "GetSerialsByName failed")
+	test.AssertDeepEquals(t, serials.sent, []string{"4"})
+
+	// The underscore must only match itself.
+	serials = &serialStream{}
+	err = sa.GetSerialsByName(&sapb.GetSerialsByNameRequest{Name: "ex_mple.com", IncludeSubdomains: true}, serials)
+	test.AssertNotError(t, err, "GetSerialsByName failed")
+	test.AssertDeepEquals(t, serials.sent, []string{"6"})
+}
+
+func TestSearchCertificatesByName(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+	reg := createWorkingRegistration(t, sa)
+
+	// Serial "2" was issued a day after the others, and "3" is revoked.
+	for _, row := range []struct {
+		serial    string
+		names     []string
+		notBefore time.Time
+	}{
+		{"1", []string{"example.com", "www.example.com"}, fc.Now()},
+		{"2", []string{"example.com"}, fc.Now().Add(24 * time.Hour)},
+		{"3", []string{"*.example.com"}, fc.Now()},
+		{"4", []string{"notexample.com"}, fc.Now()},
+	} {
+		err := sa.dbMap.Insert(&recordedSerialModel{
+			Serial:         row.serial,
+			RegistrationID: reg.Id,
+			Created:        row.notBefore,
+			Expires:        row.notBefore.Add(90 * 24 * time.Hour),
+		})
+		test.AssertNotError(t, err, "failed to insert serial")
+		status := core.OCSPStatusGood
+		if row.serial == "3" {
+			status = core.OCSPStatusRevoked
+		}
+		err = sa.dbMap.Insert(&core.CertificateStatus{
+			Serial:        row.serial,
+			Status:        status,
+			RevokedReason: 1,
+			NotAfter:      row.notBefore.Add(90 * 24 * time.Hour),
+		})
+		test.AssertNotError(t, err, "failed to insert certificate status")
+		for _, name := range row.names {
+			err = sa.dbMap.Insert(&issuedNameModel{
+				ReversedName: ReverseName(name),
+				NotBefore:    row.notBefore,
+				Serial:       row.serial,
+			})
+			test.AssertNotError(t, err, "failed to insert issued name")
+		}
+	}
+
+	search := func(req *sapb.SearchCertificatesByNameRequest) ([]string, int64) {
+		t.Helper()
+		results, err := sa.SearchCertificatesByName(ctx, req)
+		test.AssertNotError(t, err, "SearchCertificatesByName failed")
+		var matches []string
+		for _, r := range results.Results {
+			test.AssertEquals(t, r.RegistrationID, reg.Id)
+			matches = append(matches, r.Serial+" "+r.Name)
+		}
+		return matches, results.LastID
+	}
+
+	_, err := sa.SearchCertificatesByName(ctx, &sapb.SearchCertificatesByNameRequest{})
+	test.AssertEquals(t, err, errIncompleteRequest)
+	_, err = sa.SearchCertificatesByName(ctx, &sapb.SearchCertificatesByNameRequest{Name: "example.com", Limit: maxCertificateSearchLimit + 1})
+	test.Assert(t, errors.Is(err, berrors.Malformed), "returned too many results")
+
+	matches, lastID := search(&sapb.SearchCertificatesByNameRequest{Name: "Example.com"})
+	test.AssertDeepEquals(t, matches, []string{"1 example.com", "2 example.com"})
+	test.AssertEquals(t, lastID, int64(0))
+
+	matches, _ = search(&sapb.SearchCertificatesByNameRequest{
+		Name:   "example.com",
+		Issued: &sapb.Range{Earliest: fc.Now().Add(time.Hour).UnixNano()},
+	})
+	test.AssertDeepEquals(t, matches, []string{"2 example.com"})
+	matches, _ = search(&sapb.SearchCertificatesByNameRequest{
+		Name:   "example.com",
+		Issued: &sapb.Range{Latest: fc.Now().Add(time.Hour).UnixNano()},
+	})
+	test.AssertDeepEquals(t, matches, []string{"1 example.com"})
+
+	// Subdomains are paged through in the order they were stored.
+	matches, lastID = search(&sapb.SearchCertificatesByNameRequest{Name: "example.com", IncludeSubdomains: true, Limit: 3})
+	test.AssertDeepEquals(t, matches, []string{"1 example.com", "1 www.example.com", "2 example.com"})
+	test.Assert(t, lastID != 0, "lastID wasn't set for a full page")
+	matches, lastID = search(&sapb.SearchCertificatesByNameRequest{Name: "example.com", IncludeSubdomains: true, Limit: 3, AfterID: lastID})
+	test.AssertDeepEquals(t, matches, []string{"3 *.example.com"})
+	test.AssertEquals(t, lastID, int64(0))
+
+	results, err := sa.SearchCertificatesByName(ctx, &sapb.SearchCertificatesByNameRequest{Name: "*.example.com"})
+	test.AssertNotError(t, err, "SearchCertificatesByName failed")
+	test.AssertEquals(t, len(results.Results), 1)
+	test.AssertEquals(t, results.Results[0].Status, string(core.OCSPStatusRevoked))
+	test.AssertEquals(t, results.Results[0].RevokedReason, int64(1))
+	test.AssertEquals(t, results.Results[0].NotAfter, fc.Now().Add(90*24*time.Hour).UnixNano())
+}
+
 func TestHashNames(t *testing.T) {
 	// Test that it is deterministic
 	h1 := HashNames([]string{"a"})
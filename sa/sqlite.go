@@ -0,0 +1,77 @@
+package sa
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-gorp/gorp/v3"
+
+	boulderDB "github.com/letsencrypt/boulder/db"
+)
+
+// sqliteDriverName is the database/sql driver used for SQLite databases. It
+// is go-sqlite3, wrapped by sqliteDriver when built with cgo.
+const sqliteDriverName = "boulder-sqlite3"
+
+// sqliteParams are the go-sqlite3 connection parameters set on every
+// connection, overriding any in the DSN. Times are stored and read as UTC,
+// which the comparisons in Boulder's queries rely on since SQLite stores them
+// as text. Transactions take the write lock when they begin, so that
+// concurrent read-then-write transactions wait on the busy timeout rather
+// than failing to upgrade their lock.
+var sqliteParams = map[string]string{
+	"_loc":          "UTC",
+	"_busy_timeout": "10000",
+	"_journal_mode": "WAL",
+	"_txlock":       "immediate",
+	"_foreign_keys": "1",
+}
+
+// sqliteDSN converts a DSN of the form "sqlite:<path>[?<params>]" to the
+// DSN go-sqlite3 expects, with sqliteParams added.
+func sqliteDSN(dbConnect string) (string, error) {
+	path := strings.TrimPrefix(dbConnect, boulderDB.SQLiteDSNPrefix)
+	var rawQuery string
+	if i := strings.Index(path, "?"); i >= 0 {
+		path, rawQuery = path[:i], path[i+1:]
+	}
+	if path == "" {
+		return "", fmt.Errorf("SQLite DSN %q has no database file path", dbConnect)
+	}
+	params, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", fmt.Errorf("parsing SQLite DSN parameters: %w", err)
+	}
+	for k, v := range sqliteParams {
+		params.Set(k, v)
+	}
+	return "file:" + path + "?" + params.Encode(), nil
+}
+
+// newSQLiteDbMap creates a wrapped gorp mapping for the SQLite database file
+// named by a DSN of the form "sqlite:<path>". The schema is created by
+// MigrateUp with SQLiteMigrations.
+func newSQLiteDbMap(dbConnect string, settings DbSettings) (*boulderDB.WrappedMap, error) {
+	dsn, err := sqliteDSN(dbConnect)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sqlOpen(sqliteDriverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+	setMaxOpenConns(db, settings.MaxOpenConns)
+	setMaxIdleConns(db, settings.MaxIdleConns)
+	setConnMaxLifetime(db, settings.ConnMaxLifetime)
+	setConnMaxIdleTime(db, settings.ConnMaxIdleTime)
+
+	dbmap := &gorp.DbMap{Db: db, Dialect: gorp.SqliteDialect{}, TypeConverter: BoulderTypeConverter{}}
+
+	initTables(dbmap)
+
+	return &boulderDB.WrappedMap{DbMap: dbmap}, nil
+}
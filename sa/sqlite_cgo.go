@@ -0,0 +1,44 @@
+//go:build cgo
+
+package sa
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+func init() {
+	sql.Register(sqliteDriverName, &sqliteDriver{})
+}
+
+// sqliteDriver wraps go-sqlite3's driver so that its connections convert
+// times to UTC.
+type sqliteDriver struct {
+	sqlite3.SQLiteDriver
+}
+
+func (d *sqliteDriver) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.SQLiteDriver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteConn{conn.(*sqlite3.SQLiteConn)}, nil
+}
+
+// sqliteConn converts time.Time arguments to UTC before go-sqlite3 formats
+// them as text, which it does in the time's own location. Otherwise times
+// stored from different locations wouldn't compare correctly.
+type sqliteConn struct {
+	*sqlite3.SQLiteConn
+}
+
+func (c *sqliteConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if t, ok := nv.Value.(time.Time); ok {
+		nv.Value = t.UTC()
+		return nil
+	}
+	return driver.ErrSkip
+}
@@ -0,0 +1,15 @@
+//go:build !cgo
+
+package sa
+
+import (
+	"database/sql"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// Without cgo, go-sqlite3's driver is a stub whose Open returns an error
+// explaining that cgo is required.
+func init() {
+	sql.Register(sqliteDriverName, &sqlite3.SQLiteDriver{})
+}
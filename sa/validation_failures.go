@@ -0,0 +1,141 @@
+package sa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/db"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RecordValidationFailure increments the number of consecutive failed
+// validations for the account and identifier, returning the new count.
+func (ssa *SQLStorageAuthority) RecordValidationFailure(ctx context.Context, req *sapb.AccountIdentifier) (*sapb.Count, error) {
+	if req == nil || core.IsAnyNilOrZero(req.RegistrationID, req.Identifier) {
+		return nil, errIncompleteRequest
+	}
+	result, overallError := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		_, err := txWithCtx.Exec(
+			`INSERT INTO validationFailures
+			(registrationID, identifier, consecutiveFailures, lastFailure)
+			VALUES (?, ?, 1, ?) `+
+				ssa.dialect.OnDuplicateKeyUpdate([]string{"registrationID", "identifier"}, fmt.Sprintf(
+					`consecutiveFailures = consecutiveFailures + 1,
+					lastFailure = %s`, ssa.dialect.Inserted("lastFailure"))),
+			req.RegistrationID,
+			req.Identifier,
+			ssa.clk.Now(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		var count int64
+		err = txWithCtx.SelectOne(
+			&count,
+			`SELECT consecutiveFailures FROM validationFailures
+			WHERE registrationID = ? AND identifier = ?`,
+			req.RegistrationID,
+			req.Identifier,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return count, nil
+	})
+	if overallError != nil {
+		return nil, overallError
+	}
+	return &sapb.Count{Count: result.(int64)}, nil
+}
+
+// ResetValidationFailures forgets the failed validations for the account and
+// identifier after a successful validation, which also lifts any pause.
+func (ssa *SQLStorageAuthority) ResetValidationFailures(ctx context.Context, req *sapb.AccountIdentifier) (*emptypb.Empty, error) {
+	if req == nil || core.IsAnyNilOrZero(req.RegistrationID, req.Identifier) {
+		return nil, errIncompleteRequest
+	}
+	_, err := ssa.dbMap.WithContext(ctx).Exec(
+		`DELETE FROM validationFailures WHERE registrationID = ? AND identifier = ?`,
+		req.RegistrationID,
+		req.Identifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// PauseIdentifier pauses the account from ordering certificates for the
+// identifier, which must have at least one recorded validation failure. It
+// returns whether the identifier was newly paused, as opposed to already
+// paused.
+func (ssa *SQLStorageAuthority) PauseIdentifier(ctx context.Context, req *sapb.AccountIdentifier) (*sapb.Exists, error) {
+	if req == nil || core.IsAnyNilOrZero(req.RegistrationID, req.Identifier) {
+		return nil, errIncompleteRequest
+	}
+	result, err := ssa.dbMap.WithContext(ctx).Exec(
+		`UPDATE validationFailures SET pausedAt = ?
+		WHERE registrationID = ? AND identifier = ? AND pausedAt IS NULL`,
+		ssa.clk.Now(),
+		req.RegistrationID,
+		req.Identifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	return &sapb.Exists{Exists: rows > 0}, nil
+}
+
+// CheckIdentifiersPaused returns those of the given identifiers which are
+// paused for the account, or all of its paused identifiers if none are given.
+func (ssa *SQLStorageAuthority) CheckIdentifiersPaused(ctx context.Context, req *sapb.CheckIdentifiersPausedRequest) (*sapb.Identifiers, error) {
+	if req == nil || req.RegistrationID == 0 {
+		return nil, errIncompleteRequest
+	}
+	query := `SELECT identifier FROM validationFailures
+		WHERE registrationID = ? AND pausedAt IS NOT NULL`
+	args := []interface{}{req.RegistrationID}
+	if len(req.Identifiers) > 0 {
+		qmarks := make([]string, len(req.Identifiers))
+		for i, ident := range req.Identifiers {
+			qmarks[i] = "?"
+			args = append(args, ident)
+		}
+		query += fmt.Sprintf(` AND identifier IN (%s)`, strings.Join(qmarks, ","))
+	}
+	query += ` ORDER BY identifier`
+
+	var paused []string
+	_, err := ssa.reader(ctx, "CheckIdentifiersPaused").Select(&paused, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &sapb.Identifiers{Identifiers: paused}, nil
+}
+
+// UnpauseAccount lifts all of the account's paused identifiers, resetting their
+// failed validation counts, and returns how many were unpaused.
+func (ssa *SQLStorageAuthority) UnpauseAccount(ctx context.Context, req *sapb.RegistrationID) (*sapb.Count, error) {
+	if req == nil || req.Id == 0 {
+		return nil, errIncompleteRequest
+	}
+	result, err := ssa.dbMap.WithContext(ctx).Exec(
+		`DELETE FROM validationFailures WHERE registrationID = ? AND pausedAt IS NOT NULL`,
+		req.Id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	return &sapb.Count{Count: rows}, nil
+}
@@ -0,0 +1,74 @@
+package sa
+
+import (
+	"context"
+	"testing"
+
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestValidationFailures(t *testing.T) {
+	sa, _, cleanUp := initSA(t)
+	defer cleanUp()
+	ctx := context.Background()
+
+	reg := createWorkingRegistration(t, sa)
+	a := &sapb.AccountIdentifier{RegistrationID: reg.Id, Identifier: "a.example.com"}
+	b := &sapb.AccountIdentifier{RegistrationID: reg.Id, Identifier: "b.example.com"}
+
+	for i := int64(1); i <= 3; i++ {
+		count, err := sa.RecordValidationFailure(ctx, a)
+		test.AssertNotError(t, err, "RecordValidationFailure failed")
+		test.AssertEquals(t, count.Count, i)
+	}
+	count, err := sa.RecordValidationFailure(ctx, b)
+	test.AssertNotError(t, err, "RecordValidationFailure failed")
+	test.AssertEquals(t, count.Count, int64(1))
+
+	// A success resets the count.
+	_, err = sa.ResetValidationFailures(ctx, b)
+	test.AssertNotError(t, err, "ResetValidationFailures failed")
+	count, err = sa.RecordValidationFailure(ctx, b)
+	test.AssertNotError(t, err, "RecordValidationFailure failed")
+	test.AssertEquals(t, count.Count, int64(1))
+
+	paused, err := sa.CheckIdentifiersPaused(ctx, &sapb.CheckIdentifiersPausedRequest{RegistrationID: reg.Id})
+	test.AssertNotError(t, err, "CheckIdentifiersPaused failed")
+	test.AssertEquals(t, len(paused.Identifiers), 0)
+
+	newlyPaused, err := sa.PauseIdentifier(ctx, a)
+	test.AssertNotError(t, err, "PauseIdentifier failed")
+	test.AssertEquals(t, newlyPaused.Exists, true)
+	newlyPaused, err = sa.PauseIdentifier(ctx, a)
+	test.AssertNotError(t, err, "PauseIdentifier failed")
+	test.AssertEquals(t, newlyPaused.Exists, false)
+
+	paused, err = sa.CheckIdentifiersPaused(ctx, &sapb.CheckIdentifiersPausedRequest{
+		RegistrationID: reg.Id,
+		Identifiers:    []string{"a.example.com", "b.example.com", "c.example.com"},
+	})
+	test.AssertNotError(t, err, "CheckIdentifiersPaused failed")
+	test.AssertDeepEquals(t, paused.Identifiers, []string{"a.example.com"})
+	paused, err = sa.CheckIdentifiersPaused(ctx, &sapb.CheckIdentifiersPausedRequest{
+		RegistrationID: reg.Id,
+		Identifiers:    []string{"b.example.com"},
+	})
+	test.AssertNotError(t, err, "CheckIdentifiersPaused failed")
+	test.AssertEquals(t, len(paused.Identifiers), 0)
+
+	// Unpausing lifts the pause and resets the count, but leaves the counts of
+	// identifiers which aren't paused alone.
+	unpaused, err := sa.UnpauseAccount(ctx, &sapb.RegistrationID{Id: reg.Id})
+	test.AssertNotError(t, err, "UnpauseAccount failed")
+	test.AssertEquals(t, unpaused.Count, int64(1))
+	paused, err = sa.CheckIdentifiersPaused(ctx, &sapb.CheckIdentifiersPausedRequest{RegistrationID: reg.Id})
+	test.AssertNotError(t, err, "CheckIdentifiersPaused failed")
+	test.AssertEquals(t, len(paused.Identifiers), 0)
+	count, err = sa.RecordValidationFailure(ctx, a)
+	test.AssertNotError(t, err, "RecordValidationFailure failed")
+	test.AssertEquals(t, count.Count, int64(1))
+	count, err = sa.RecordValidationFailure(ctx, b)
+	test.AssertNotError(t, err, "RecordValidationFailure failed")
+	test.AssertEquals(t, count.Count, int64(2))
+}
@@ -88,6 +88,16 @@ function run_unit_tests() {
     # https://github.com/letsencrypt/boulder/issues/1499
     go test "${UNIT_PACKAGES[@]}" "${FILTER[@]}"
   fi
+
+  # Run the SA's tests a second time against SQLite. Each test creates its
+  # own database file, so they don't need to run serially.
+  if [[ "${UNIT_PACKAGES[@]}" =~ "./..." ]] || [[ "${UNIT_PACKAGES[@]}" =~ "./sa" ]]; then
+    local race=()
+    if [ "${RACE}" == true ]; then
+      race=("-race")
+    fi
+    BOULDER_SA_TEST_ENGINE=sqlite go test "${race[@]+"${race[@]}"}" ./sa/... "${FILTER[@]}"
+  fi
 }
 
 #
@@ -1,5 +1,6 @@
 // This is a test server that implements the subset of RFC6962 APIs needed to
 // run Boulder's CT log submission code. Currently it only implements add-chain.
+// Personalities can also emulate a Static CT API (tiled) log, see static.go.
 // This is used by startservers.py.
 package main
 
@@ -37,6 +38,8 @@ type integrationSrv struct {
 	latencySchedule []float64
 	latencyItem     int
 	userAgent       string
+	// If non-nil, this personality emulates a Static CT API log.
+	tiled *tiledLog
 }
 
 func readJSON(w http.ResponseWriter, r *http.Request, output interface{}) error {
@@ -166,6 +169,16 @@ func (is *integrationSrv) addChainOrPre(w http.ResponseWriter, r *http.Request,
 		is.Unlock()
 		time.Sleep(sleepTime)
 	}
+	if is.tiled != nil {
+		sct, err := is.tiled.sequence(addChainReq.Chain, precert)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(sct)
+		return
+	}
 	w.WriteHeader(http.StatusOK)
 	w.Write(publisher.CreateTestingSignedSCT(addChainReq.Chain, is.key, precert, time.Now()))
 }
@@ -201,6 +214,12 @@ type Personality struct {
 	// If present, sleep for the given number of seconds before replying. Each
 	// request uses the next number in the list, eventually cycling through.
 	LatencySchedule []float64
+	// If true, emulate a Static CT API log: SCTs carry a leaf_index extension
+	// and the tree is served as a checkpoint and tiles. The submission and
+	// monitoring prefixes are both the root of Addr.
+	Static bool
+	// The checkpoint origin line of a Static CT API log.
+	Origin string
 }
 
 func runPersonality(p Personality) {
@@ -223,12 +242,22 @@ func runPersonality(p Personality) {
 		rejectHosts:     make(map[string]bool),
 		userAgent:       p.UserAgent,
 	}
+	if p.Static {
+		is.tiled = &tiledLog{
+			origin: p.Origin,
+			key:    key,
+		}
+	}
 	m := http.NewServeMux()
 	m.HandleFunc("/submissions", is.getSubmissions)
 	m.HandleFunc("/ct/v1/add-pre-chain", is.addPreChain)
 	m.HandleFunc("/ct/v1/add-chain", is.addChain)
 	m.HandleFunc("/add-reject-host", is.addRejectHost)
 	m.HandleFunc("/get-rejections", is.getRejections)
+	if is.tiled != nil {
+		m.HandleFunc("/checkpoint", is.tiled.checkpoint)
+		m.HandleFunc("/tile/0/", is.tiled.tile)
+	}
 	srv := &http.Server{
 		Addr:    p.Addr,
 		Handler: m,
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ct "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
+
+	"github.com/letsencrypt/boulder/publisher"
+)
+
+// tileWidth is the number of hashes in a full tile, per
+// https://c2sp.org/tlog-tiles
+const tileWidth = 256
+
+// tiledLog emulates the parts of a Static CT API log
+// (https://c2sp.org/static-ct-api) that Boulder interacts with: it sequences
+// every submission into a Merkle tree, returns SCTs carrying a leaf_index
+// extension, and serves a signed checkpoint along with the level 0 hash tiles
+// of the tree. Higher level tiles and data tiles are not served.
+type tiledLog struct {
+	sync.Mutex
+	origin string
+	key    *ecdsa.PrivateKey
+	// leafHashes holds the RFC 6962 Merkle leaf hash of each entry, in order.
+	leafHashes [][sha256.Size]byte
+}
+
+// sequence appends the given chain to the tree and returns a JSON encoded
+// SCT for it, carrying the entry's leaf_index.
+func (tl *tiledLog) sequence(chain []string, precert bool) ([]byte, error) {
+	rawChain := make([]ct.ASN1Cert, len(chain))
+	for i, str := range chain {
+		b, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return nil, err
+		}
+		rawChain[i] = ct.ASN1Cert{Data: b}
+	}
+	etype := ct.X509LogEntryType
+	if precert {
+		etype = ct.PrecertLogEntryType
+	}
+
+	tl.Lock()
+	defer tl.Unlock()
+
+	// Truncate to milliseconds so that the timestamp in the Merkle leaf matches
+	// the one publisher.CreateTestingSignedSCTWithExtensions puts in the SCT.
+	now := time.Unix(0, time.Now().UnixNano()/1e6*1e6)
+	index := uint64(len(tl.leafHashes))
+	exts := publisher.MarshalLeafIndex(index)
+
+	leaf, err := ct.MerkleTreeLeafFromRawChain(rawChain, etype, uint64(now.UnixNano()/1e6))
+	if err != nil {
+		return nil, err
+	}
+	leaf.TimestampedEntry.Extensions = exts
+	leafHash, err := ct.LeafHashForLeaf(leaf)
+	if err != nil {
+		return nil, err
+	}
+	tl.leafHashes = append(tl.leafHashes, leafHash)
+	return publisher.CreateTestingSignedSCTWithExtensions(chain, tl.key, precert, now, exts), nil
+}
+
+// rootHash computes the RFC 6962 Merkle tree hash of the given leaf hashes.
+func rootHash(leaves [][sha256.Size]byte) [sha256.Size]byte {
+	switch len(leaves) {
+	case 0:
+		return sha256.Sum256(nil)
+	case 1:
+		return leaves[0]
+	}
+	// Split at the largest power of two strictly less than the number of
+	// leaves.
+	k := 1
+	for k<<1 < len(leaves) {
+		k <<= 1
+	}
+	left := rootHash(leaves[:k])
+	right := rootHash(leaves[k:])
+	return sha256.Sum256(append(append([]byte{1}, left[:]...), right[:]...))
+}
+
+// checkpoint serves the log's current checkpoint as a signed note, using the
+// RFC 6962 note signature type described in the Static CT API.
+func (tl *tiledLog) checkpoint(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.NotFound(w, r)
+		return
+	}
+	tl.Lock()
+	size := uint64(len(tl.leafHashes))
+	root := rootHash(tl.leafHashes)
+	tl.Unlock()
+
+	timestamp := uint64(time.Now().UnixNano() / 1e6)
+	input, err := ct.SerializeSTHSignatureInput(ct.SignedTreeHead{
+		Version:        ct.V1,
+		Timestamp:      timestamp,
+		TreeSize:       size,
+		SHA256RootHash: ct.SHA256Hash(root),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	hashed := sha256.Sum256(input)
+	var ecdsaSig struct {
+		R, S *big.Int
+	}
+	ecdsaSig.R, ecdsaSig.S, err = ecdsa.Sign(rand.Reader, tl.key, hashed[:])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sig, err := asn1.Marshal(ecdsaSig)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ds, err := cttls.Marshal(ct.DigitallySigned{
+		Algorithm: cttls.SignatureAndHashAlgorithm{
+			Hash:      cttls.SHA256,
+			Signature: cttls.ECDSA,
+		},
+		Signature: sig,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// The note key ID is the first four bytes of
+	// SHA-256(origin || "\n" || 0x05 || SHA-256(SubjectPublicKeyInfo)).
+	spki, err := x509.MarshalPKIXPublicKey(&tl.key.PublicKey)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	logID := sha256.Sum256(spki)
+	keyID := sha256.Sum256(append([]byte(tl.origin+"\n\x05"), logID[:]...))
+
+	noteSig := make([]byte, 4+8, 4+8+len(ds))
+	copy(noteSig, keyID[:4])
+	binary.BigEndian.PutUint64(noteSig[4:], timestamp)
+	noteSig = append(noteSig, ds...)
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintf(w, "%s\n%d\n%s\n\n— %s %s\n",
+		tl.origin, size, base64.StdEncoding.EncodeToString(root[:]),
+		tl.origin, base64.StdEncoding.EncodeToString(noteSig))
+}
+
+// parseTileIndex parses a tile index encoded as in https://c2sp.org/tlog-tiles,
+// e.g. "x001/x234/067" for tile 1234067.
+func parseTileIndex(path string) (uint64, error) {
+	var index uint64
+	elems := strings.Split(path, "/")
+	for i, elem := range elems {
+		if i < len(elems)-1 {
+			if !strings.HasPrefix(elem, "x") {
+				return 0, fmt.Errorf("malformed tile path element %q", elem)
+			}
+			elem = elem[1:]
+		}
+		if len(elem) != 3 {
+			return 0, fmt.Errorf("malformed tile path element %q", elem)
+		}
+		n, err := strconv.ParseUint(elem, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		index = index*1000 + n
+	}
+	return index, nil
+}
+
+// tile serves level 0 hash tiles, which hold the leaf hashes of the tree.
+// Partial tiles are requested with a ".p/<width>" suffix.
+func (tl *tiledLog) tile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.NotFound(w, r)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/tile/0/")
+	width := tileWidth
+	if i := strings.Index(path, ".p/"); i >= 0 {
+		n, err := strconv.Atoi(path[i+len(".p/"):])
+		if err != nil || n < 1 || n >= tileWidth {
+			http.NotFound(w, r)
+			return
+		}
+		width = n
+		path = path[:i]
+	}
+	index, err := parseTileIndex(path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tl.Lock()
+	defer tl.Unlock()
+	start := index * tileWidth
+	end := start + uint64(width)
+	if end > uint64(len(tl.leafHashes)) {
+		http.NotFound(w, r)
+		return
+	}
+	for _, h := range tl.leafHashes[start:end] {
+		w.Write(h[:])
+	}
+}
@@ -10,7 +10,8 @@ command -v minica >/dev/null 2>&1 || {
 }
 
 for SERVICE in admin-revoker expiration-mailer ocsp-updater orphan-finder wfe \
-  akamai-purger nonce bad-key-revoker health-checker; do
+  akamai-purger nonce bad-key-revoker health-checker cert-checker \
+  contact-auditor id-exporter event-consumer; do
   minica -domains "${SERVICE}.boulder"
 done
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/mocks"
 	"github.com/letsencrypt/boulder/sa"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 
@@ -128,6 +129,172 @@ func (sa SA) AddBlockedKey(ctx context.Context, req *sapb.AddBlockedKeyRequest,
 	return sa.Impl.AddBlockedKey(ctx, req)
 }
 
+func (sa SA) GetRateLimitOverrides(ctx context.Context, req *sapb.GetRateLimitOverridesRequest, _ ...grpc.CallOption) (*sapb.RateLimitOverrides, error) {
+	return sa.Impl.GetRateLimitOverrides(ctx, req)
+}
+
+func (sa SA) GetAccountIdentifierPolicy(ctx context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*sapb.AccountIdentifierPolicy, error) {
+	return sa.Impl.GetAccountIdentifierPolicy(ctx, req)
+}
+
+func (sa SA) GetAccountSuspension(ctx context.Context, req *sapb.RegistrationID, _ ...grpc.CallOption) (*sapb.AccountSuspension, error) {
+	return sa.Impl.GetAccountSuspension(ctx, req)
+}
+
+func (sa SA) SuspendAccount(ctx context.Context, req *sapb.AccountSuspension, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return sa.Impl.SuspendAccount(ctx, req)
+}
+
+func (sa SA) CheckIdentifiersPaused(ctx context.Context, req *sapb.CheckIdentifiersPausedRequest, _ ...grpc.CallOption) (*sapb.Identifiers, error) {
+	return sa.Impl.CheckIdentifiersPaused(ctx, req)
+}
+
+func (sa SA) GetOrderApproval(ctx context.Context, req *sapb.OrderRequest, _ ...grpc.CallOption) (*sapb.OrderApproval, error) {
+	return sa.Impl.GetOrderApproval(ctx, req)
+}
+
+func (sa SA) HoldOrderForApproval(ctx context.Context, req *sapb.OrderApproval, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return sa.Impl.HoldOrderForApproval(ctx, req)
+}
+
+func (sa SA) DecideOrderApproval(ctx context.Context, req *sapb.DecideOrderApprovalRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return sa.Impl.DecideOrderApproval(ctx, req)
+}
+
+func (sa SA) CheckNamesBlocked(ctx context.Context, req *sapb.Identifiers, _ ...grpc.CallOption) (*sapb.Identifiers, error) {
+	return sa.Impl.CheckNamesBlocked(ctx, req)
+}
+
 func (sa SA) FQDNSetExists(ctx context.Context, req *sapb.FQDNSetExistsRequest, _ ...grpc.CallOption) (*sapb.Exists, error) {
 	return sa.Impl.FQDNSetExists(ctx, req)
 }
+
+func (sa SA) GetCertificate(ctx context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	return sa.Impl.GetCertificate(ctx, req)
+}
+
+func (sa SA) MarkBlockedKeyChecked(ctx context.Context, req *sapb.SPKIHash, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return sa.Impl.MarkBlockedKeyChecked(ctx, req)
+}
+
+func (sa SA) MarkExpirationNagSent(ctx context.Context, req *sapb.MarkExpirationNagSentRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return sa.Impl.MarkExpirationNagSent(ctx, req)
+}
+
+// The streaming methods below run the SA's method to completion, collecting
+// what it sends, and then return a client stream of the collected messages.
+
+// serverStream is the part of a streaming SA method's server stream which
+// doesn't depend on the type it sends.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s serverStream) Context() context.Context {
+	return s.ctx
+}
+
+type certificateServerStream struct {
+	serverStream
+	sent []*corepb.Certificate
+}
+
+func (s *certificateServerStream) Send(cert *corepb.Certificate) error {
+	s.sent = append(s.sent, cert)
+	return nil
+}
+
+func (sa SA) StreamCertificates(ctx context.Context, req *sapb.StreamCertificatesRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamCertificatesClient, error) {
+	stream := &certificateServerStream{serverStream: serverStream{ctx: ctx}}
+	err := sa.Impl.StreamCertificates(req, stream)
+	if err != nil {
+		return nil, err
+	}
+	return &mocks.CertificateStream{Certificates: stream.sent}, nil
+}
+
+func (sa SA) StreamUnrevokedCertificatesByKey(ctx context.Context, req *sapb.SPKIHash, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamUnrevokedCertificatesByKeyClient, error) {
+	stream := &certificateServerStream{serverStream: serverStream{ctx: ctx}}
+	err := sa.Impl.StreamUnrevokedCertificatesByKey(req, stream)
+	if err != nil {
+		return nil, err
+	}
+	return &mocks.CertificateStream{Certificates: stream.sent}, nil
+}
+
+type serialServerStream struct {
+	serverStream
+	sent []*sapb.Serial
+}
+
+func (s *serialServerStream) Send(serial *sapb.Serial) error {
+	s.sent = append(s.sent, serial)
+	return nil
+}
+
+func (sa SA) StreamExpiringSerials(ctx context.Context, req *sapb.StreamExpiringSerialsRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamExpiringSerialsClient, error) {
+	stream := &serialServerStream{serverStream: serverStream{ctx: ctx}}
+	err := sa.Impl.StreamExpiringSerials(req, stream)
+	if err != nil {
+		return nil, err
+	}
+	return &mocks.SerialStream{Serials: stream.sent}, nil
+}
+
+type registrationContactsServerStream struct {
+	serverStream
+	sent []*sapb.RegistrationContacts
+}
+
+func (s *registrationContactsServerStream) Send(reg *sapb.RegistrationContacts) error {
+	s.sent = append(s.sent, reg)
+	return nil
+}
+
+func (sa SA) StreamRegistrationsWithContacts(ctx context.Context, req *sapb.StreamRegistrationsWithContactsRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamRegistrationsWithContactsClient, error) {
+	stream := &registrationContactsServerStream{serverStream: serverStream{ctx: ctx}}
+	err := sa.Impl.StreamRegistrationsWithContacts(req, stream)
+	if err != nil {
+		return nil, err
+	}
+	return &mocks.RegistrationContactsStream{Registrations: stream.sent}, nil
+}
+
+type registrationNameServerStream struct {
+	serverStream
+	sent []*sapb.RegistrationName
+}
+
+func (s *registrationNameServerStream) Send(reg *sapb.RegistrationName) error {
+	s.sent = append(s.sent, reg)
+	return nil
+}
+
+func (sa SA) StreamRegistrationsWithNames(ctx context.Context, req *sapb.StreamRegistrationsWithNamesRequest, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamRegistrationsWithNamesClient, error) {
+	stream := &registrationNameServerStream{serverStream: serverStream{ctx: ctx}}
+	err := sa.Impl.StreamRegistrationsWithNames(req, stream)
+	if err != nil {
+		return nil, err
+	}
+	return &mocks.RegistrationNameStream{Registrations: stream.sent}, nil
+}
+
+type blockedKeyServerStream struct {
+	serverStream
+	sent []*sapb.BlockedKey
+}
+
+func (s *blockedKeyServerStream) Send(key *sapb.BlockedKey) error {
+	s.sent = append(s.sent, key)
+	return nil
+}
+
+func (sa SA) StreamUncheckedBlockedKeys(ctx context.Context, req *emptypb.Empty, _ ...grpc.CallOption) (sapb.StorageAuthority_StreamUncheckedBlockedKeysClient, error) {
+	stream := &blockedKeyServerStream{serverStream: serverStream{ctx: ctx}}
+	err := sa.Impl.StreamUncheckedBlockedKeys(req, stream)
+	if err != nil {
+		return nil, err
+	}
+	return &mocks.BlockedKeyStream{Keys: stream.sent}, nil
+}
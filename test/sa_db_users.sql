@@ -9,11 +9,11 @@ CREATE USER IF NOT EXISTS 'ocsp_resp'@'localhost';
 CREATE USER IF NOT EXISTS 'revoker'@'localhost';
 CREATE USER IF NOT EXISTS 'importer'@'localhost';
 CREATE USER IF NOT EXISTS 'mailer'@'localhost';
-CREATE USER IF NOT EXISTS 'cert_checker'@'localhost';
 CREATE USER IF NOT EXISTS 'ocsp_update'@'localhost';
 CREATE USER IF NOT EXISTS 'ocsp_update_ro'@'localhost';
 CREATE USER IF NOT EXISTS 'test_setup'@'localhost';
-CREATE USER IF NOT EXISTS 'badkeyrevoker'@'localhost';
+CREATE USER IF NOT EXISTS 'purger'@'localhost';
+CREATE USER IF NOT EXISTS 'purger_ro'@'localhost';
 
 -- Storage Authority
 GRANT SELECT,INSERT ON certificates TO 'sa'@'localhost';
@@ -30,12 +30,25 @@ GRANT SELECT,INSERT ON orderToAuthz2 TO 'sa'@'localhost';
 GRANT INSERT,SELECT ON serials TO 'sa'@'localhost';
 GRANT SELECT,INSERT ON precertificates TO 'sa'@'localhost';
 GRANT SELECT,INSERT ON keyHashToSerial TO 'sa'@'localhost';
-GRANT SELECT,INSERT ON blockedKeys TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON blockedKeys TO 'sa'@'localhost';
 GRANT SELECT,INSERT,UPDATE ON newOrdersRL TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON rateLimitOverrides TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE,DELETE ON accountIdentifierPolicies TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE,DELETE ON accountSuspensions TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE,DELETE ON validationFailures TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON orderApprovals TO 'sa'@'localhost';
+GRANT SELECT,INSERT ON blockedNames TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON replicationHeartbeats TO 'sa'@'localhost';
+GRANT SELECT,INSERT ON incidents TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON incidentSerials TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON accountKeys TO 'sa'@'localhost';
+GRANT SELECT,INSERT ON events TO 'sa'@'localhost';
+GRANT SELECT ON goose_db_version TO 'sa'@'localhost';
 
 GRANT SELECT ON certificates TO 'sa_ro'@'localhost';
 GRANT SELECT ON certificateStatus TO 'sa_ro'@'localhost';
 GRANT SELECT ON issuedNames TO 'sa_ro'@'localhost';
+GRANT SELECT ON serials TO 'sa_ro'@'localhost';
 GRANT SELECT ON certificatesPerName TO 'sa_ro'@'localhost';
 GRANT SELECT ON registrations TO 'sa_ro'@'localhost';
 GRANT SELECT on fqdnSets TO 'sa_ro'@'localhost';
@@ -48,6 +61,17 @@ GRANT SELECT ON precertificates TO 'sa_ro'@'localhost';
 GRANT SELECT ON keyHashToSerial TO 'sa_ro'@'localhost';
 GRANT SELECT ON blockedKeys TO 'sa_ro'@'localhost';
 GRANT SELECT ON newOrdersRL TO 'sa_ro'@'localhost';
+GRANT SELECT ON rateLimitOverrides TO 'sa_ro'@'localhost';
+GRANT SELECT ON accountIdentifierPolicies TO 'sa_ro'@'localhost';
+GRANT SELECT ON accountSuspensions TO 'sa_ro'@'localhost';
+GRANT SELECT ON validationFailures TO 'sa_ro'@'localhost';
+GRANT SELECT ON orderApprovals TO 'sa_ro'@'localhost';
+GRANT SELECT ON blockedNames TO 'sa_ro'@'localhost';
+GRANT SELECT ON replicationHeartbeats TO 'sa_ro'@'localhost';
+GRANT SELECT ON incidents TO 'sa_ro'@'localhost';
+GRANT SELECT ON incidentSerials TO 'sa_ro'@'localhost';
+GRANT SELECT ON accountKeys TO 'sa_ro'@'localhost';
+GRANT SELECT ON events TO 'sa_ro'@'localhost';
 
 -- OCSP Responder
 GRANT SELECT ON certificateStatus TO 'ocsp_resp'@'localhost';
@@ -68,21 +92,25 @@ GRANT SELECT ON precertificates TO 'revoker'@'localhost';
 GRANT SELECT ON keyHashToSerial TO 'revoker'@'localhost';
 GRANT SELECT,UPDATE ON blockedKeys TO 'revoker'@'localhost';
 
--- Expiration mailer
-GRANT SELECT ON certificates TO 'mailer'@'localhost';
+-- Notify mailer
 GRANT SELECT ON registrations TO 'mailer'@'localhost';
-GRANT SELECT,UPDATE ON certificateStatus TO 'mailer'@'localhost';
-GRANT SELECT ON fqdnSets TO 'mailer'@'localhost';
 
--- Cert checker
-GRANT SELECT ON certificates TO 'cert_checker'@'localhost';
+-- Purger
+GRANT SELECT,DELETE ON authz2 TO 'purger'@'localhost';
+GRANT SELECT,DELETE ON orders TO 'purger'@'localhost';
+GRANT SELECT,DELETE ON orderToAuthz2 TO 'purger'@'localhost';
+GRANT SELECT,DELETE ON orderFqdnSets TO 'purger'@'localhost';
+GRANT SELECT,DELETE ON requestedNames TO 'purger'@'localhost';
+GRANT SELECT,DELETE ON orderApprovals TO 'purger'@'localhost';
+GRANT SELECT,DELETE ON certificates TO 'purger'@'localhost';
+GRANT SELECT,DELETE ON precertificates TO 'purger'@'localhost';
+GRANT SELECT,DELETE ON fqdnSets TO 'purger'@'localhost';
+GRANT SELECT,DELETE ON keyHashToSerial TO 'purger'@'localhost';
+GRANT SELECT,DELETE ON issuedNames TO 'purger'@'localhost';
+GRANT SELECT ON serials TO 'purger'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON replicationHeartbeats TO 'purger'@'localhost';
 
--- Bad Key Revoker
-GRANT SELECT,UPDATE ON blockedKeys TO 'badkeyrevoker'@'localhost';
-GRANT SELECT ON keyHashToSerial TO 'badkeyrevoker'@'localhost';
-GRANT SELECT ON certificateStatus TO 'badkeyrevoker'@'localhost';
-GRANT SELECT ON precertificates TO 'badkeyrevoker'@'localhost';
-GRANT SELECT ON registrations TO 'badkeyrevoker'@'localhost';
+GRANT SELECT ON replicationHeartbeats TO 'purger_ro'@'localhost';
 
 -- Test setup and teardown
 GRANT ALL PRIVILEGES ON * to 'test_setup'@'localhost';
@@ -91,4 +119,3 @@ GRANT ALL PRIVILEGES ON * to 'test_setup'@'localhost';
 -- TODO(#5670): Remove these when partitioning is fixed.
 GRANT SELECT,INSERT on fqdnSets_old TO 'sa'@'localhost';
 GRANT SELECT on fqdnSets_old TO 'sa_ro'@'localhost';
-GRANT SELECT ON fqdnSets_old TO 'mailer'@'localhost';
@@ -93,7 +93,7 @@ SERVICES = (
     Service('bad-key-revoker',
         8020, None,
         ('./bin/bad-key-revoker', '--config', os.path.join(config_dir, 'bad-key-revoker.json')),
-        ('sd-test-srv', 'boulder-ra-1', 'boulder-ra-2', 'mail-test-srv')),
+        ('sd-test-srv', 'boulder-ra-1', 'boulder-ra-2', 'boulder-sa-1', 'boulder-sa-2', 'mail-test-srv')),
     Service('nonce-service-taro',
         8111, 'nonce1.boulder:9101',
         ('./bin/nonce-service', '--config', os.path.join(config_dir, 'nonce.json'), '--addr', 'nonce1.boulder:9101', '--debug-addr', ':8111', '--prefix', 'taro'),
@@ -774,6 +774,48 @@ def test_revoke_by_privkey():
     verify_ocsp(cert_file.name, "/hierarchy/intermediate-cert-rsa-a.pem", "http://localhost:4002", "revoked")
     verify_akamai_purge()
 
+def test_admin_cert_revoke():
+    cert_file = temppath('test_admin_cert_revoke.pem')
+    order = chisel2.auth_and_issue([random_domain()], cert_output=cert_file.name)
+    serial = '%036x' % parse_cert(order).serial_number
+
+    # A dry run doesn't revoke the certificate.
+    run(["./bin/admin", "--config", "%s/admin.json" % config_dir,
+        "cert-revoke", "-serial", serial, "-reason", "superseded"])
+    verify_ocsp(cert_file.name, "/hierarchy/intermediate-cert-rsa-a.pem", "http://localhost:4002", "good")
+
+    reset_akamai_purges()
+    output = subprocess.check_output(["./bin/admin", "--config", "%s/admin.json" % config_dir, "-dry-run=false",
+        "cert-revoke", "-serial", serial, "-reason", "superseded"]).decode()
+    if json.loads(output)["revoked"] != [serial]:
+        raise(Exception("admin cert-revoke didn't report revoking %s: %s" % (serial, output)))
+    verify_ocsp(cert_file.name, "/hierarchy/intermediate-cert-rsa-a.pem", "http://localhost:4002", "revoked")
+    verify_akamai_purge()
+
+    output = subprocess.check_output(["./bin/admin", "--config", "%s/admin.json" % config_dir,
+        "cert-show", "-serial", serial]).decode()
+    if json.loads(output)["revokedReason"] != "superseded":
+        raise(Exception("admin cert-show didn't report the revocation: %s" % output))
+
+def test_admin_cert_revoke_domain():
+    parent = random_domain()
+    cert_file = temppath('test_admin_cert_revoke_domain.pem')
+    order = chisel2.auth_and_issue(["www." + parent], cert_output=cert_file.name)
+    serial = '%036x' % parse_cert(order).serial_number
+
+    # The exact name doesn't match the subdomain's certificate.
+    output = subprocess.check_output(["./bin/admin", "--config", "%s/admin.json" % config_dir,
+        "cert-revoke", "-domain", parent, "-reason", "cessationOfOperation"]).decode()
+    if json.loads(output)["revoked"] != []:
+        raise(Exception("admin cert-revoke -domain matched a subdomain: %s" % output))
+
+    output = subprocess.check_output(["./bin/admin", "--config", "%s/admin.json" % config_dir, "-dry-run=false",
+        "cert-revoke", "-domain", parent, "-include-subdomains", "-reason", "cessationOfOperation",
+        "-batch-size", "10", "-rate", "5"]).decode()
+    if json.loads(output)["revoked"] != [serial]:
+        raise(Exception("admin cert-revoke -domain didn't report revoking %s: %s" % (serial, output)))
+    verify_ocsp(cert_file.name, "/hierarchy/intermediate-cert-rsa-a.pem", "http://localhost:4002", "revoked")
+
 def test_sct_embedding():
     order = chisel2.auth_and_issue([random_domain()])
     print(order.fullchain_pem.encode())
@@ -3,6 +3,7 @@ package web
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/probs"
@@ -20,6 +21,16 @@ func problemDetailsForBoulderError(err *berrors.BoulderError, msg string) *probs
 		outProb = probs.NotFound(fmt.Sprintf("%s :: %s", msg, err))
 	case berrors.RateLimit:
 		outProb = probs.RateLimited(fmt.Sprintf("%s :: %s", msg, err))
+		if err.RateLimitDetails != nil {
+			outProb.RetryAfter = err.RateLimitDetails.RetryAfter
+			outProb.RateLimit = &probs.RateLimitDetails{
+				Limit:      err.RateLimitDetails.Limit,
+				Key:        err.RateLimitDetails.Key,
+				Count:      err.RateLimitDetails.Count,
+				Threshold:  err.RateLimitDetails.Threshold,
+				RetryAfter: retryAfterSeconds(err.RateLimitDetails.RetryAfter),
+			}
+		}
 	case berrors.InternalServer:
 		// Internal server error messages may include sensitive data, so we do
 		// not include it.
@@ -40,6 +51,8 @@ func problemDetailsForBoulderError(err *berrors.BoulderError, msg string) *probs
 		outProb = probs.BadPublicKey(fmt.Sprintf("%s :: %s", msg, err))
 	case berrors.BadCSR:
 		outProb = probs.BadCSR(fmt.Sprintf("%s :: %s", msg, err))
+	case berrors.RestrictedIdentifier:
+		outProb = probs.RestrictedIdentifier(fmt.Sprintf("%s :: %s", msg, err))
 	default:
 		// Internal server error messages may include sensitive data, so we do
 		// not include it.
@@ -57,6 +70,12 @@ func problemDetailsForBoulderError(err *berrors.BoulderError, msg string) *probs
 	return outProb
 }
 
+// retryAfterSeconds rounds d up to a whole number of seconds, so that clients
+// which wait exactly that long aren't early.
+func retryAfterSeconds(d time.Duration) int64 {
+	return int64((d + time.Second - 1) / time.Second)
+}
+
 // problemDetailsForError turns an error into a ProblemDetails with the special
 // case of returning the same error back if its already a ProblemDetails. If the
 // error is of an type unknown to ProblemDetailsForError, it will return a
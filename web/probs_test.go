@@ -37,6 +37,7 @@ func TestProblemDetailsFromError(t *testing.T) {
 		{berrors.RateLimitError(detailMsg), 429, probs.RateLimitedProblem, fullDetail + ": see https://letsencrypt.org/docs/rate-limits/"},
 		{berrors.InvalidEmailError(detailMsg), 400, probs.InvalidEmailProblem, fullDetail},
 		{berrors.RejectedIdentifierError(detailMsg), 400, probs.RejectedIdentifierProblem, fullDetail},
+		{berrors.RestrictedIdentifierError(detailMsg), 403, probs.RestrictedIdentifierProblem, fullDetail},
 	}
 	for _, c := range testCases {
 		p := ProblemDetailsForError(c.err, errMsg)
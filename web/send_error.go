@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -19,6 +20,7 @@ import (
 //  - If the ProblemDetails provided is a ServerInternalProblem, audit logs the
 //    internal error.
 //  - Prefixes the Type field of the ProblemDetails with a namespace.
+//  - Sets a Retry-After header if the ProblemDetails has a RetryAfter.
 //  - Sends an HTTP response containing the error and an error code to the user.
 func SendError(
 	log blog.Logger,
@@ -70,6 +72,9 @@ func SendError(
 	}
 
 	// Write the JSON problem response
+	if prob.RetryAfter > 0 {
+		response.Header().Set("Retry-After", strconv.FormatInt(retryAfterSeconds(prob.RetryAfter), 10))
+	}
 	response.Header().Set("Content-Type", "application/problem+json")
 	response.WriteHeader(code)
 	response.Write(problemDoc)
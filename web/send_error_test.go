@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/identifier"
@@ -94,3 +95,36 @@ func TestSendErrorSubProbLogging(t *testing.T) {
 
 	test.AssertEquals(t, logEvent.Error, `400 :: malformed :: dfoop :: bad ["example.com :: malformed :: dfoop :: nop", "what about example.com :: malformed :: dfoop :: nah"]`)
 }
+
+func TestSendErrorRateLimitDetails(t *testing.T) {
+	rw := httptest.NewRecorder()
+	prob := ProblemDetailsForError(berrors.RateLimitErrorWithDetails(berrors.RateLimitDetails{
+		Limit:      "certificatesPerName",
+		Key:        "example.com",
+		Count:      50,
+		Threshold:  50,
+		RetryAfter: 90*time.Minute + 500*time.Millisecond,
+	}, "too many certificates already issued for: example.com"), "dfoop")
+	SendError(log.NewMock(), "namespace:test:", rw, &RequestEvent{}, prob, nil)
+
+	test.AssertEquals(t, rw.Code, 429)
+	test.AssertEquals(t, rw.Header().Get("Retry-After"), "5401")
+	test.AssertUnmarshaledEquals(t, rw.Body.String(), `{
+		"type": "namespace:test:rateLimited",
+		"detail": "dfoop :: too many certificates already issued for: example.com: see https://letsencrypt.org/docs/rate-limits/",
+		"status": 429,
+		"rateLimit": {
+			"limit": "certificatesPerName",
+			"key": "example.com",
+			"count": 50,
+			"threshold": 50,
+			"retryAfter": 5401
+		}
+	}`)
+
+	// Rate limit errors without details have no Retry-After.
+	rw = httptest.NewRecorder()
+	prob = ProblemDetailsForError(berrors.RateLimitError("slow down"), "dfoop")
+	SendError(log.NewMock(), "namespace:test:", rw, &RequestEvent{}, prob, nil)
+	test.AssertEquals(t, rw.Header().Get("Retry-After"), "")
+}
@@ -8,34 +8,53 @@ import (
 
 	"github.com/golang/groupcache/lru"
 	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/boulder/core"
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
+	jose "gopkg.in/square/go-jose.v2"
 )
 
-// AccountGetter represents the ability to get an account by ID - either from the SA
-// or from a cache.
+// AccountGetter represents the ability to get an account by ID or by key -
+// either from the SA or from a cache.
 type AccountGetter interface {
 	GetRegistration(ctx context.Context, regID *sapb.RegistrationID, opts ...grpc.CallOption) (*corepb.Registration, error)
+	GetRegistrationByKey(ctx context.Context, jwk *sapb.JSONWebKey, opts ...grpc.CallOption) (*corepb.Registration, error)
 }
 
 // accountCache is an implementation of AccountGetter that first tries a local
 // in-memory cache, and if the account is not there, calls out to an underlying
 // AccountGetter. It is safe for concurrent access so long as the underlying
 // AccountGetter is.
+//
+// Accounts are cached by ID, and lookups by key are cached as a mapping from
+// the key's digest to the account's ID. A lookup by key is only a hit when the
+// cached account still has that key, so dropping an account with
+// InvalidateAccount also drops it for lookups by any of its keys. Each WFE only
+// invalidates the accounts it changes, so changes made through other WFEs,
+// such as a key rollover or a deactivation, are only seen once the TTL passes.
+// Until then, the other WFEs go on accepting requests signed by the account's
+// old key, or from the deactivated account. MaxAccountCacheTTL bounds how long
+// that can be.
 type accountCache struct {
 	// Note: This must be a regular mutex, not an RWMutex, because cache.Get()
 	// actually mutates the lru.Cache (by updating the last-used info).
 	sync.Mutex
-	under    AccountGetter
-	ttl      time.Duration
-	cache    *lru.Cache
-	clk      clock.Clock
-	requests *prometheus.CounterVec
+	under         AccountGetter
+	ttl           time.Duration
+	cache         *lru.Cache
+	clk           clock.Clock
+	requests      *prometheus.CounterVec
+	invalidations prometheus.Counter
 }
 
+// MaxAccountCacheTTL is the longest TTL an account cache may be configured
+// with, and so the longest that a WFE may go on using an account which was
+// changed through another WFE.
+const MaxAccountCacheTTL = time.Minute
+
 func NewAccountCache(
 	under AccountGetter,
 	maxEntries int,
@@ -45,55 +64,87 @@ func NewAccountCache(
 ) *accountCache {
 	requestsCount := prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "cache_requests",
-	}, []string{"status"})
+		Help: "number of account cache lookups by method and status, such as hit or miss",
+	}, []string{"method", "status"})
 	stats.MustRegister(requestsCount)
+	invalidations := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "cache_invalidations",
+		Help: "number of accounts dropped from the account cache after the WFE changed them",
+	})
+	stats.MustRegister(invalidations)
 	return &accountCache{
-		under:    under,
-		ttl:      ttl,
-		cache:    lru.New(maxEntries),
-		clk:      clk,
-		requests: requestsCount,
+		under:         under,
+		ttl:           ttl,
+		cache:         lru.New(maxEntries),
+		clk:           clk,
+		requests:      requestsCount,
+		invalidations: invalidations,
 	}
 }
 
 type accountEntry struct {
 	account *corepb.Registration
+	// keyDigest is the digest of the account's key, or empty if it couldn't
+	// be computed, in which case lookups by key always miss.
+	keyDigest string
+	expires   time.Time
+}
+
+// keyDigestKey is the cache key for a lookup by key. It's distinct from the
+// int64 account IDs which accounts are cached under.
+type keyDigestKey string
+
+type keyEntry struct {
+	regID   int64
 	expires time.Time
 }
 
-func (ac *accountCache) GetRegistration(ctx context.Context, regID *sapb.RegistrationID, opts ...grpc.CallOption) (*corepb.Registration, error) {
+// get returns the unexpired cache entry for the given key, or a status
+// describing why there isn't one. Expired entries are removed.
+func (ac *accountCache) get(key lru.Key) (interface{}, string) {
 	ac.Lock()
-	val, ok := ac.cache.Get(regID.Id)
-	ac.Unlock()
+	defer ac.Unlock()
+	val, ok := ac.cache.Get(key)
 	if !ok {
-		ac.requests.WithLabelValues("miss").Inc()
-		return ac.queryAndStore(ctx, regID)
+		return nil, "miss"
+	}
+	var expires time.Time
+	switch entry := val.(type) {
+	case accountEntry:
+		expires = entry.expires
+	case keyEntry:
+		expires = entry.expires
+	default:
+		return val, "wrongtype"
+	}
+	if expires.Before(ac.clk.Now()) {
+		// We have to actively remove expired entries, because otherwise each
+		// retrieval counts as a "use" and they won't exit the cache on their
+		// own.
+		ac.cache.Remove(key)
+		return nil, "expired"
+	}
+	return val, "hit"
+}
+
+func (ac *accountCache) GetRegistration(ctx context.Context, regID *sapb.RegistrationID, opts ...grpc.CallOption) (*corepb.Registration, error) {
+	const method = "GetRegistration"
+	val, status := ac.get(regID.Id)
+	if status == "wrongtype" {
+		ac.requests.WithLabelValues(method, status).Inc()
+		return nil, fmt.Errorf("shouldn't happen: wrong type %T for cache entry", val)
 	}
 	entry, ok := val.(accountEntry)
 	if !ok {
-		ac.requests.WithLabelValues("wrongtype").Inc()
-		return nil, fmt.Errorf("shouldn't happen: wrong type %T for cache entry", entry)
-	}
-	if entry.expires.Before(ac.clk.Now()) {
-		// Note: this has a slight TOCTOU issue but it's benign. If the entry for this account
-		// was expired off by some other goroutine and then a fresh one added, removing it a second
-		// time will just cause a slightly lower cache rate.
-		// We have to actively remove expired entries, because otherwise each retrieval counts as
-		// a "use" and they won't exit the cache on their own.
-		ac.Lock()
-		ac.cache.Remove(regID.Id)
-		ac.Unlock()
-		ac.requests.WithLabelValues("expired").Inc()
+		ac.requests.WithLabelValues(method, status).Inc()
 		return ac.queryAndStore(ctx, regID)
 	}
 	if entry.account.Id != regID.Id {
-		ac.requests.WithLabelValues("wrong id from cache").Inc()
+		ac.requests.WithLabelValues(method, "wrong id from cache").Inc()
 		return nil, fmt.Errorf("shouldn't happen: wrong account ID. expected %d, got %d", regID.Id, entry.account.Id)
 	}
-	copied := new(corepb.Registration)
-	proto.Merge(copied, entry.account)
-	ac.requests.WithLabelValues("hit").Inc()
-	return copied, nil
+	ac.requests.WithLabelValues(method, "hit").Inc()
+	return copyAccount(entry.account), nil
 }
 
 func (ac *accountCache) queryAndStore(ctx context.Context, regID *sapb.RegistrationID) (*corepb.Registration, error) {
@@ -102,17 +153,94 @@ func (ac *accountCache) queryAndStore(ctx context.Context, regID *sapb.Registrat
 		return nil, err
 	}
 	if account.Id != regID.Id {
-		ac.requests.WithLabelValues("wrong id from SA").Inc()
+		ac.requests.WithLabelValues("GetRegistration", "wrong id from SA").Inc()
 		return nil, fmt.Errorf("shouldn't happen: wrong account ID from backend. expected %d, got %d", regID.Id, account.Id)
 	}
+	ac.store(account)
+	return account, nil
+}
+
+// GetRegistrationByKey looks up an account by the digest of the given key.
+// Keys which can't be parsed are passed on to the underlying AccountGetter,
+// which returns the appropriate error.
+func (ac *accountCache) GetRegistrationByKey(ctx context.Context, jwk *sapb.JSONWebKey, opts ...grpc.CallOption) (*corepb.Registration, error) {
+	const method = "GetRegistrationByKey"
+	digest := jwkDigest(jwk.Jwk)
+	if digest == "" {
+		ac.requests.WithLabelValues(method, "bad key").Inc()
+		return ac.under.GetRegistrationByKey(ctx, jwk)
+	}
+	val, status := ac.get(keyDigestKey(digest))
+	if entry, ok := val.(keyEntry); ok {
+		val, status = ac.get(entry.regID)
+		account, ok := val.(accountEntry)
+		if ok && account.keyDigest == digest && account.account.Id == entry.regID {
+			ac.requests.WithLabelValues(method, "hit").Inc()
+			return copyAccount(account.account), nil
+		}
+		if ok {
+			// The account no longer has this key.
+			status = "stale"
+		}
+	}
+	ac.requests.WithLabelValues(method, status).Inc()
+
+	account, err := ac.under.GetRegistrationByKey(ctx, jwk)
+	if err != nil {
+		return nil, err
+	}
+	if ac.store(account) == digest {
+		ac.Lock()
+		ac.cache.Add(keyDigestKey(digest), keyEntry{
+			regID:   account.Id,
+			expires: ac.clk.Now().Add(ac.ttl),
+		})
+		ac.Unlock()
+	}
+	return account, nil
+}
+
+// store caches a copy of the account by its ID, returning the digest of its
+// key.
+func (ac *accountCache) store(account *corepb.Registration) string {
 	// Make sure we have our own copy that no one has a pointer to.
-	copied := new(corepb.Registration)
-	proto.Merge(copied, account)
+	entry := accountEntry{
+		account:   copyAccount(account),
+		keyDigest: jwkDigest(account.Key),
+		expires:   ac.clk.Now().Add(ac.ttl),
+	}
 	ac.Lock()
-	ac.cache.Add(regID.Id, accountEntry{
-		account: copied,
-		expires: ac.clk.Now().Add(ac.ttl),
-	})
+	ac.cache.Add(account.Id, entry)
 	ac.Unlock()
-	return account, nil
+	return entry.keyDigest
+}
+
+// InvalidateAccount drops an account from the cache, so that the next lookup
+// of it, by ID or by any of its keys, goes to the underlying AccountGetter.
+func (ac *accountCache) InvalidateAccount(regID int64) {
+	ac.Lock()
+	ac.cache.Remove(regID)
+	ac.Unlock()
+	ac.invalidations.Inc()
+}
+
+func copyAccount(account *corepb.Registration) *corepb.Registration {
+	copied := new(corepb.Registration)
+	proto.Merge(copied, account)
+	return copied
+}
+
+// jwkDigest returns the digest of a serialized JWK, or an empty string if it
+// can't be parsed.
+func jwkDigest(jwkJSON []byte) string {
+	var jwk jose.JSONWebKey
+	err := jwk.UnmarshalJSON(jwkJSON)
+	if err != nil {
+		return ""
+	}
+	digest, err := core.KeyDigestB64(jwk.Key)
+	if err != nil {
+		return ""
+	}
+	return digest
 }
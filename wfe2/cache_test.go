@@ -1,21 +1,31 @@
 package wfe2
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/jmhodges/clock"
 	corepb "github.com/letsencrypt/boulder/core/proto"
+	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/metrics"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/test"
 	"google.golang.org/grpc"
+	jose "gopkg.in/square/go-jose.v2"
 )
 
+// recordingBackend serves accounts whose key is keys[id], if set, and whose
+// ID is the key's index in keys when looked up by key.
 type recordingBackend struct {
-	requests []int64
+	requests    []int64
+	keyRequests [][]byte
+	keys        map[int64][]byte
 }
 
 func (rb *recordingBackend) GetRegistration(
@@ -26,10 +36,29 @@ func (rb *recordingBackend) GetRegistration(
 	rb.requests = append(rb.requests, regID.Id)
 	return &corepb.Registration{
 		Id:      regID.Id,
+		Key:     rb.keys[regID.Id],
 		Contact: []string{"example@example.com"},
 	}, nil
 }
 
+func (rb *recordingBackend) GetRegistrationByKey(
+	ctx context.Context,
+	jwk *sapb.JSONWebKey,
+	opts ...grpc.CallOption,
+) (*corepb.Registration, error) {
+	rb.keyRequests = append(rb.keyRequests, jwk.Jwk)
+	for id, key := range rb.keys {
+		if bytes.Equal(key, jwk.Jwk) {
+			return &corepb.Registration{
+				Id:      id,
+				Key:     key,
+				Contact: []string{"example@example.com"},
+			}, nil
+		}
+	}
+	return nil, berrors.NotFoundError("no account with key")
+}
+
 func TestCacheAddRetrieve(t *testing.T) {
 	ctx := context.Background()
 	backend := &recordingBackend{}
@@ -118,6 +147,14 @@ func (wib wrongIDBackend) GetRegistration(
 	}, nil
 }
 
+func (wib wrongIDBackend) GetRegistrationByKey(
+	ctx context.Context,
+	jwk *sapb.JSONWebKey,
+	opts ...grpc.CallOption,
+) (*corepb.Registration, error) {
+	return nil, errors.New("not implemented")
+}
+
 func TestWrongId(t *testing.T) {
 	ctx := context.Background()
 	cache := NewAccountCache(wrongIDBackend{}, 10, time.Second, clock.NewFake(), metrics.NoopRegisterer)
@@ -135,6 +172,13 @@ func (eb errorBackend) GetRegistration(ctx context.Context,
 	return nil, errors.New("some error")
 }
 
+func (eb errorBackend) GetRegistrationByKey(ctx context.Context,
+	jwk *sapb.JSONWebKey,
+	opts ...grpc.CallOption,
+) (*corepb.Registration, error) {
+	return nil, errors.New("some error")
+}
+
 func TestErrorPassthrough(t *testing.T) {
 	ctx := context.Background()
 	cache := NewAccountCache(errorBackend{}, 10, time.Second, clock.NewFake(), metrics.NoopRegisterer)
@@ -143,3 +187,71 @@ func TestErrorPassthrough(t *testing.T) {
 	test.AssertError(t, err, "expected error when backend errors")
 	test.AssertEquals(t, err.Error(), "some error")
 }
+
+func newTestJWK(t *testing.T) []byte {
+	t.Helper()
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+	jwkJSON, err := jose.JSONWebKey{Key: k.Public()}.MarshalJSON()
+	test.AssertNotError(t, err, "marshaling key")
+	return jwkJSON
+}
+
+func TestCacheByKey(t *testing.T) {
+	ctx := context.Background()
+	key := newTestJWK(t)
+	backend := &recordingBackend{keys: map[int64][]byte{1234: key}}
+	clk := clock.NewFake()
+	cache := NewAccountCache(backend, 10, time.Second, clk, metrics.NoopRegisterer)
+
+	result, err := cache.GetRegistrationByKey(ctx, &sapb.JSONWebKey{Jwk: key})
+	test.AssertNotError(t, err, "getting registration by key")
+	test.AssertEquals(t, result.Id, int64(1234))
+	test.AssertEquals(t, len(backend.keyRequests), 1)
+
+	// Both lookups by key and by ID now hit the cache.
+	result, err = cache.GetRegistrationByKey(ctx, &sapb.JSONWebKey{Jwk: key})
+	test.AssertNotError(t, err, "getting registration by key")
+	test.AssertEquals(t, result.Id, int64(1234))
+	_, err = cache.GetRegistration(ctx, &sapb.RegistrationID{Id: 1234})
+	test.AssertNotError(t, err, "getting registration")
+	test.AssertEquals(t, len(backend.keyRequests), 1)
+	test.AssertEquals(t, len(backend.requests), 0)
+
+	// Accounts which aren't found aren't cached.
+	_, err = cache.GetRegistrationByKey(ctx, &sapb.JSONWebKey{Jwk: newTestJWK(t)})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+	test.AssertEquals(t, len(backend.keyRequests), 2)
+
+	// Lookups by key expire too.
+	clk.Sleep(10 * time.Second)
+	_, err = cache.GetRegistrationByKey(ctx, &sapb.JSONWebKey{Jwk: key})
+	test.AssertNotError(t, err, "getting registration by key")
+	test.AssertEquals(t, len(backend.keyRequests), 3)
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	ctx := context.Background()
+	oldKey := newTestJWK(t)
+	backend := &recordingBackend{keys: map[int64][]byte{1234: oldKey}}
+	cache := NewAccountCache(backend, 10, time.Second, clock.NewFake(), metrics.NoopRegisterer)
+
+	_, err := cache.GetRegistrationByKey(ctx, &sapb.JSONWebKey{Jwk: oldKey})
+	test.AssertNotError(t, err, "getting registration by key")
+
+	// After the account rolls over its key and is invalidated, neither its
+	// ID nor its old key are served from the cache.
+	newKey := newTestJWK(t)
+	backend.keys[1234] = newKey
+	cache.InvalidateAccount(1234)
+	result, err := cache.GetRegistration(ctx, &sapb.RegistrationID{Id: 1234})
+	test.AssertNotError(t, err, "getting registration")
+	test.AssertByteEquals(t, result.Key, newKey)
+	test.AssertEquals(t, len(backend.requests), 1)
+
+	// The old key's entry now points at an account with a different key, so
+	// it's a miss even though the account is cached again.
+	_, err = cache.GetRegistrationByKey(ctx, &sapb.JSONWebKey{Jwk: oldKey})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+	test.AssertEquals(t, len(backend.keyRequests), 2)
+}
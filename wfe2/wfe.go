@@ -2,14 +2,19 @@ package wfe2
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"html/template"
 	"net"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -31,6 +36,7 @@ import (
 	noncepb "github.com/letsencrypt/boulder/nonce/proto"
 	"github.com/letsencrypt/boulder/probs"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
+	"github.com/letsencrypt/boulder/ratelimit"
 	"github.com/letsencrypt/boulder/revocation"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/web"
@@ -70,6 +76,12 @@ const (
 	// Draft or likely-to-change paths
 	renewalInfoPath = getAPIPrefix + "draft-aaron-ari/renewalInfo/"
 
+	// Boulder-specific POST-as-GET paths
+	rateLimitsPath = "/acme/rate-limits/"
+
+	// Boulder-specific paths which accept both GET and POST
+	unpausePath = "/acme/unpause/"
+
 	// Non-ACME paths
 	aiaIssuerPath = "/aia/issuer/"
 )
@@ -142,6 +154,15 @@ type WebFrontEndImpl struct {
 	// match the ones used by the RA.
 	authorizationLifetime        time.Duration
 	pendingAuthorizationLifetime time.Duration
+
+	// UnpauseKey signs the links to the unpause page returned to accounts which
+	// are paused from ordering certificates for some identifiers. If it is
+	// empty, no links are returned.
+	UnpauseKey []byte
+
+	// UnpauseLinkLifetime is how long the links to the unpause page are valid
+	// for.
+	UnpauseLinkLifetime time.Duration
 }
 
 // NewWebFrontEndImpl constructs a web service for Boulder
@@ -401,6 +422,9 @@ func (wfe *WebFrontEndImpl) Handler(stats prometheus.Registerer) http.Handler {
 	wfe.HandleFunc(m, authzPath, wfe.Authorization, "GET", "POST")
 	wfe.HandleFunc(m, challengePath, wfe.Challenge, "GET", "POST")
 	wfe.HandleFunc(m, certPath, wfe.Certificate, "GET", "POST")
+	// Boulder-specific POST-as-GETable endpoints
+	wfe.HandleFunc(m, rateLimitsPath, wfe.RateLimits, "POST")
+	wfe.HandleFunc(m, unpausePath, wfe.Unpause, "GET", "POST")
 	// Boulder-specific GET-able resource endpoints
 	wfe.HandleFunc(m, getOrderPath, wfe.GetOrder, "GET")
 	wfe.HandleFunc(m, getAuthzPath, wfe.Authorization, "GET")
@@ -461,6 +485,18 @@ func (wfe *WebFrontEndImpl) Index(ctx context.Context, logEvent *web.RequestEven
 	`, directoryPath, directoryPath)
 }
 
+// invalidateAccount drops an account the WFE is changing from the account
+// cache, if there is one. It's called both before and after the RA makes the
+// change: before, so that requests arriving while the change is in progress
+// look the account up afresh rather than using an entry which may be up to
+// the TTL old, and after, to drop the old account if one of those requests
+// cached it. The change may have been made even if the RA returned an error.
+func (wfe *WebFrontEndImpl) invalidateAccount(regID int64) {
+	if cache, ok := wfe.accountGetter.(*accountCache); ok {
+		cache.InvalidateAccount(regID)
+	}
+}
+
 func addNoCacheHeader(w http.ResponseWriter) {
 	w.Header().Add("Cache-Control", "public, max-age=0, no-cache")
 }
@@ -648,7 +684,7 @@ func (wfe *WebFrontEndImpl) NewAccount(
 			web.ProblemDetailsForError(err, "Error creating new account"), err)
 		return
 	}
-	existingAcct, err := wfe.sa.GetRegistrationByKey(ctx, &sapb.JSONWebKey{Jwk: keyBytes})
+	existingAcct, err := wfe.accountGetter.GetRegistrationByKey(ctx, &sapb.JSONWebKey{Jwk: keyBytes})
 	if err == nil {
 		returnExistingAcct(existingAcct)
 		return
@@ -709,7 +745,7 @@ func (wfe *WebFrontEndImpl) NewAccount(
 	acctPB, err := wfe.ra.NewRegistration(ctx, &reg)
 	if err != nil {
 		if errors.Is(err, berrors.Duplicate) {
-			existingAcct, err := wfe.sa.GetRegistrationByKey(ctx, &sapb.JSONWebKey{Jwk: keyBytes})
+			existingAcct, err := wfe.accountGetter.GetRegistrationByKey(ctx, &sapb.JSONWebKey{Jwk: keyBytes})
 			if err == nil {
 				returnExistingAcct(existingAcct)
 				return
@@ -1479,7 +1515,9 @@ func (wfe *WebFrontEndImpl) updateAccount(
 		if updatePb.Status != string(core.StatusDeactivated) {
 			return nil, probs.Malformed("Invalid value provided for status field")
 		}
+		wfe.invalidateAccount(basePb.Id)
 		_, err := wfe.ra.DeactivateRegistration(ctx, basePb)
+		wfe.invalidateAccount(basePb.Id)
 		if err != nil {
 			return nil, web.ProblemDetailsForError(err, "Unable to deactivate account")
 		}
@@ -1494,7 +1532,9 @@ func (wfe *WebFrontEndImpl) updateAccount(
 	// JSON to send via RPC to the RA.
 	updatePb.Key = basePb.Key
 
+	wfe.invalidateAccount(basePb.Id)
 	updatedAcct, err := wfe.ra.UpdateRegistration(ctx, &rapb.UpdateRegistrationRequest{Base: basePb, Update: updatePb})
+	wfe.invalidateAccount(basePb.Id)
 	if err != nil {
 		return nil, web.ProblemDetailsForError(err, "Unable to update account")
 	}
@@ -1975,7 +2015,9 @@ func (wfe *WebFrontEndImpl) KeyRollover(
 	updatePb := &corepb.Registration{Key: newKeyBytes}
 
 	// Update the account key to the new key
+	wfe.invalidateAccount(regPb.Id)
 	updatedAcctPb, err := wfe.ra.UpdateRegistration(ctx, &rapb.UpdateRegistrationRequest{Base: regPb, Update: updatePb})
+	wfe.invalidateAccount(regPb.Id)
 	if err != nil {
 		if errors.Is(err, berrors.Duplicate) {
 			// It is possible that between checking for the existing key, and preforming the update
@@ -2134,7 +2176,18 @@ func (wfe *WebFrontEndImpl) NewOrder(
 		RegistrationID: acct.ID,
 		Names:          names,
 	})
-	if err != nil || order == nil || order.Id == 0 || order.Created == 0 || order.RegistrationID == 0 || order.Expires == 0 || len(order.Names) == 0 {
+	if err != nil {
+		prob := web.ProblemDetailsForError(err, "Error creating new order")
+		if prob.RateLimit != nil && prob.RateLimit.Limit == ratelimit.PausedIdentifiers {
+			link := wfe.unpauseLink(request, acct.ID)
+			if link != "" {
+				prob.Detail += fmt.Sprintf(". To unpause it, visit %s", link)
+			}
+		}
+		wfe.sendError(response, logEvent, prob, err)
+		return
+	}
+	if order == nil || order.Id == 0 || order.Created == 0 || order.RegistrationID == 0 || order.Expires == 0 || len(order.Names) == 0 {
 		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Error creating new order"), err)
 		return
 	}
@@ -2423,6 +2476,238 @@ func (wfe *WebFrontEndImpl) RenewalInfo(ctx context.Context, logEvent *web.Reque
 	response.Header().Set("Retry-After", fmt.Sprintf("%d", pollPeriod))
 }
 
+// rateLimitUsage is the JSON representation of an account's usage of a single
+// rate limit, as returned by the RateLimits endpoint.
+type rateLimitUsage struct {
+	Limit     string `json:"limit"`
+	Key       string `json:"key,omitempty"`
+	Count     int64  `json:"count"`
+	Threshold int64  `json:"threshold"`
+	// Window is omitted for limits which aren't counted over a window.
+	Window string `json:"window,omitempty"`
+	// Reset is when the count will next decrease, if that is known.
+	Reset *time.Time `json:"reset,omitempty"`
+}
+
+// RateLimits reports the requesting account's current usage of each rate
+// limit. If the path after the prefix is a comma-separated list of names, the
+// limits which apply to a certificate for those names are also reported. It
+// only accepts POST-as-GET requests.
+func (wfe *WebFrontEndImpl) RateLimits(ctx context.Context, logEvent *web.RequestEvent, response http.ResponseWriter, request *http.Request) {
+	acct, prob := wfe.validPOSTAsGETForAccount(request, ctx, logEvent)
+	if prob != nil {
+		wfe.sendError(response, logEvent, prob, nil)
+		return
+	}
+
+	var names []string
+	if request.URL.Path != "" {
+		names = strings.Split(request.URL.Path, ",")
+	}
+	status, err := wfe.ra.GetRateLimitStatus(ctx, &rapb.GetRateLimitStatusRequest{
+		RegistrationID: acct.ID,
+		Names:          names,
+	})
+	if err != nil {
+		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Unable to get rate limit status"), err)
+		return
+	}
+
+	limits := make([]rateLimitUsage, len(status.Limits))
+	for i, u := range status.Limits {
+		limits[i] = rateLimitUsage{
+			Limit:     u.Limit,
+			Key:       u.Key,
+			Count:     u.Count,
+			Threshold: u.Threshold,
+		}
+		if u.Window != 0 {
+			limits[i].Window = time.Duration(u.Window).String()
+		}
+		if u.ResetTime != 0 {
+			reset := time.Unix(0, u.ResetTime).UTC()
+			limits[i].Reset = &reset
+		}
+	}
+	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, struct {
+		Limits []rateLimitUsage `json:"limits"`
+	}{limits})
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Error marshalling rate limit status"), err)
+		return
+	}
+}
+
+const unpauseInstructions = `This account is paused from requesting certificates for some
+identifiers because it failed to validate them too many times in a row. Before
+unpausing it, fix whatever is causing validation to fail, or stop requesting
+certificates for those identifiers.
+
+To unpause the account, send a POST request to %s
+signed with the account's key, in the same way as any other ACME request, with
+an empty JSON object ({}) as its payload.
+`
+
+var unpauseFormPage = template.Must(template.New("unpauseForm").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Unpause account</title></head>
+<body>
+<h1>Unpause account {{.RegID}}</h1>
+<p>This account is paused from requesting certificates for some identifiers
+because it failed to validate them too many times in a row. Before unpausing
+it, fix whatever is causing validation to fail, or stop requesting certificates
+for those identifiers. Otherwise it will soon be paused again.</p>
+<form method="POST" action="{{.Action}}">
+<button type="submit">Unpause account</button>
+</form>
+</body>
+</html>
+`))
+
+var unpausedPage = template.Must(template.New("unpaused").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Account unpaused</title></head>
+<body>
+<h1>Account {{.RegID}} unpaused</h1>
+<p>Unpaused {{.Count}} identifiers. The account may request certificates for
+them again.</p>
+</body>
+</html>
+`))
+
+// unpauseLink returns a link to the unpause page for the account, carrying an
+// expiry and an HMAC of the account ID and expiry. The link is only returned
+// in response to requests signed with the account's key, so holding an
+// unexpired link proves control of the key. It returns "" if no UnpauseKey is
+// configured.
+func (wfe *WebFrontEndImpl) unpauseLink(request *http.Request, regID int64) string {
+	if len(wfe.UnpauseKey) == 0 {
+		return ""
+	}
+	expires := wfe.clk.Now().Add(wfe.UnpauseLinkLifetime).Unix()
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("sig", base64.RawURLEncoding.EncodeToString(wfe.unpauseMAC(regID, expires)))
+	return web.RelativeEndpoint(request, fmt.Sprintf("%s%d", unpausePath, regID)) + "?" + query.Encode()
+}
+
+// unpauseMAC returns the HMAC of the account ID and expiry of a link to the
+// unpause page.
+func (wfe *WebFrontEndImpl) unpauseMAC(regID int64, expires int64) []byte {
+	mac := hmac.New(sha256.New, wfe.UnpauseKey)
+	fmt.Fprintf(mac, "%d.%d", regID, expires)
+	return mac.Sum(nil)
+}
+
+// validUnpauseLink returns true if the query is from an unexpired link to the
+// unpause page for the account, as returned by unpauseLink.
+func (wfe *WebFrontEndImpl) validUnpauseLink(query url.Values, regID int64) bool {
+	if len(wfe.UnpauseKey) == 0 {
+		return false
+	}
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil || !wfe.clk.Now().Before(time.Unix(expires, 0)) {
+		return false
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(query.Get("sig"))
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(sig, wfe.unpauseMAC(regID, expires))
+}
+
+// Unpause serves the page linked to by the problem document returned when an
+// account is paused from requesting certificates for some identifiers.
+//
+// With a signed link, as returned by unpauseLink, a GET serves a page which
+// explains why the account is paused and lets the subscriber unpause it, and a
+// POST unpauses it. Without one, a GET explains how to unpause the account
+// using an ACME client, and a POST signed with the account's key unpauses it.
+func (wfe *WebFrontEndImpl) Unpause(ctx context.Context, logEvent *web.RequestEvent, response http.ResponseWriter, request *http.Request) {
+	regID, err := strconv.ParseInt(request.URL.Path, 10, 64)
+	if err != nil || regID <= 0 {
+		wfe.sendError(response, logEvent, probs.NotFound("Account not found"), nil)
+		return
+	}
+
+	query := request.URL.Query()
+	if query.Get("sig") != "" {
+		wfe.unpauseWithLink(ctx, logEvent, response, request, regID, query)
+		return
+	}
+
+	if request.Method != "POST" {
+		response.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		response.WriteHeader(http.StatusOK)
+		fmt.Fprintf(response, unpauseInstructions, web.RelativeEndpoint(request, fmt.Sprintf("%s%d", unpausePath, regID)))
+		return
+	}
+
+	_, _, acct, prob := wfe.validPOSTForAccount(request, ctx, logEvent)
+	addRequesterHeader(response, logEvent.Requester)
+	if prob != nil {
+		wfe.sendError(response, logEvent, prob, nil)
+		return
+	}
+	if acct.ID != regID {
+		wfe.sendError(response, logEvent, probs.Unauthorized("Request signed by a different account"), nil)
+		return
+	}
+
+	resp, err := wfe.ra.UnpauseAccount(ctx, &rapb.UnpauseAccountRequest{RegistrationID: acct.ID})
+	if err != nil {
+		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Unable to unpause account"), err)
+		return
+	}
+	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, struct {
+		Unpaused int64 `json:"unpaused"`
+	}{resp.Count})
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Error marshalling unpause response"), err)
+		return
+	}
+}
+
+// unpauseWithLink handles requests to the unpause page made with a signed
+// link. A GET serves a form which POSTs back to the same link, and the POST
+// unpauses the account.
+func (wfe *WebFrontEndImpl) unpauseWithLink(ctx context.Context, logEvent *web.RequestEvent, response http.ResponseWriter, request *http.Request, regID int64, query url.Values) {
+	if !wfe.validUnpauseLink(query, regID) {
+		wfe.sendError(response, logEvent, probs.Unauthorized(
+			"Unpause link is invalid or has expired. Request a new order to get a new link"), nil)
+		return
+	}
+	logEvent.Requester = regID
+
+	if request.Method != "POST" {
+		response.Header().Set("Content-Type", "text/html; charset=utf-8")
+		response.WriteHeader(http.StatusOK)
+		err := unpauseFormPage.Execute(response, struct {
+			RegID  int64
+			Action string
+		}{regID, web.RelativeEndpoint(request, fmt.Sprintf("%s%d", unpausePath, regID)) + "?" + query.Encode()})
+		if err != nil {
+			logEvent.AddError("rendering unpause page: %s", err)
+		}
+		return
+	}
+
+	resp, err := wfe.ra.UnpauseAccount(ctx, &rapb.UnpauseAccountRequest{RegistrationID: regID})
+	if err != nil {
+		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Unable to unpause account"), err)
+		return
+	}
+	response.Header().Set("Content-Type", "text/html; charset=utf-8")
+	response.WriteHeader(http.StatusOK)
+	err = unpausedPage.Execute(response, struct {
+		RegID int64
+		Count int64
+	}{regID, resp.Count})
+	if err != nil {
+		logEvent.AddError("rendering unpaused page: %s", err)
+	}
+}
+
 func extractRequesterIP(req *http.Request) (net.IP, error) {
 	ip := net.ParseIP(req.Header.Get("X-Real-IP"))
 	if ip != nil {
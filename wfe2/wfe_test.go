@@ -14,6 +14,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"html/template"
 	"io"
 	"io/ioutil"
 	"math/big"
@@ -46,6 +47,7 @@ import (
 	"github.com/letsencrypt/boulder/nonce"
 	"github.com/letsencrypt/boulder/probs"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
+	"github.com/letsencrypt/boulder/ratelimit"
 	"github.com/letsencrypt/boulder/revocation"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/test"
@@ -238,6 +240,47 @@ func (ra *MockRegistrationAuthority) FinalizeOrder(ctx context.Context, in *rapb
 	return in.Order, nil
 }
 
+func (ra *MockRegistrationAuthority) SuspendAccount(_ context.Context, _ *rapb.SuspendAccountRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (ra *MockRegistrationAuthority) UnsuspendAccount(_ context.Context, _ *rapb.UnsuspendAccountRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (ra *MockRegistrationAuthority) UnpauseAccount(_ context.Context, _ *rapb.UnpauseAccountRequest, _ ...grpc.CallOption) (*rapb.UnpauseAccountResponse, error) {
+	return &rapb.UnpauseAccountResponse{Count: 2}, nil
+}
+
+func (ra *MockRegistrationAuthority) ApproveOrder(_ context.Context, _ *rapb.OrderApprovalRequest, _ ...grpc.CallOption) (*corepb.Order, error) {
+	return &corepb.Order{}, nil
+}
+
+func (ra *MockRegistrationAuthority) RejectOrder(_ context.Context, _ *rapb.OrderApprovalRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (ra *MockRegistrationAuthority) GetRateLimitStatus(_ context.Context, in *rapb.GetRateLimitStatusRequest, _ ...grpc.CallOption) (*rapb.RateLimitStatus, error) {
+	status := &rapb.RateLimitStatus{
+		Limits: []*rapb.RateLimitUsage{{
+			Limit:     "newOrdersPerAccount",
+			Count:     2,
+			Threshold: 300,
+			Window:    (3 * time.Hour).Nanoseconds(),
+			ResetTime: time.Date(2021, 1, 1, 3, 0, 0, 0, time.UTC).UnixNano(),
+		}},
+	}
+	for _, name := range in.Names {
+		status.Limits = append(status.Limits, &rapb.RateLimitUsage{
+			Limit:     "certificatesPerName",
+			Key:       name,
+			Threshold: 50,
+			Window:    (168 * time.Hour).Nanoseconds(),
+		})
+	}
+	return status, nil
+}
+
 func makeBody(s string) io.ReadCloser {
 	return ioutil.NopCloser(strings.NewReader(s))
 }
@@ -2649,6 +2692,42 @@ func TestFinalizeOrder(t *testing.T) {
 	test.AssertContains(t, responseBody, "Error parsing certificate request")
 }
 
+// mockRAWithCacheCheck records whether an account was cached when it was
+// deactivated, and then caches it again, as a request which arrived during the
+// change might.
+type mockRAWithCacheCheck struct {
+	rapb.RegistrationAuthorityClient
+	cache          *accountCache
+	cachedDuringRA bool
+}
+
+func (ra *mockRAWithCacheCheck) DeactivateRegistration(ctx context.Context, reg *corepb.Registration, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	_, ra.cachedDuringRA = ra.cache.cache.Get(reg.Id)
+	_, err := ra.cache.GetRegistration(ctx, &sapb.RegistrationID{Id: reg.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func TestDeactivateAccountInvalidatesCache(t *testing.T) {
+	wfe, _ := setupWFE(t)
+	cache := NewAccountCache(wfe.sa, 10, time.Hour, wfe.clk, metrics.NoopRegisterer)
+	wfe.accountGetter = cache
+	ra := &mockRAWithCacheCheck{RegistrationAuthorityClient: wfe.ra, cache: cache}
+	wfe.ra = ra
+	_, err := cache.GetRegistration(ctx, &sapb.RegistrationID{Id: 1})
+	test.AssertNotError(t, err, "getting registration")
+	test.AssertEquals(t, cache.cache.Len(), 1)
+
+	// The account is dropped both before and after the RA deactivates it.
+	_, _, body := signRequestKeyID(t, 1, nil, "http://localhost/1", `{"status":"deactivated"}`, wfe.nonceService)
+	wfe.Account(ctx, newRequestEvent(), httptest.NewRecorder(), makePostRequestWithPath("1", body))
+	test.Assert(t, !ra.cachedDuringRA, "account was still cached while it was being deactivated")
+	_, ok := cache.cache.Get(int64(1))
+	test.Assert(t, !ok, "deactivated account is still cached")
+}
+
 func TestKeyRollover(t *testing.T) {
 	responseWriter := httptest.NewRecorder()
 	wfe, _ := setupWFE(t)
@@ -3221,6 +3300,7 @@ func (sa *mockSAGetRegByKeyFails) GetRegistrationByKey(_ context.Context, req *s
 func TestNewAccountWhenGetRegByKeyFails(t *testing.T) {
 	wfe, _ := setupWFE(t)
 	wfe.sa = &mockSAGetRegByKeyFails{wfe.sa}
+	wfe.accountGetter = wfe.sa
 	key := loadKey(t, []byte(testE2KeyPrivatePEM))
 	_, ok := key.(*ecdsa.PrivateKey)
 	test.Assert(t, ok, "Couldn't load ECDSA key")
@@ -3250,6 +3330,7 @@ func (sa *mockSAGetRegByKeyNotFound) GetRegistrationByKey(_ context.Context, req
 func TestNewAccountWhenGetRegByKeyNotFound(t *testing.T) {
 	wfe, _ := setupWFE(t)
 	wfe.sa = &mockSAGetRegByKeyNotFound{wfe.sa}
+	wfe.accountGetter = wfe.sa
 	key := loadKey(t, []byte(testE2KeyPrivatePEM))
 	_, ok := key.(*ecdsa.PrivateKey)
 	test.Assert(t, ok, "Couldn't load ECDSA key")
@@ -3646,3 +3727,185 @@ func TestARI(t *testing.T) {
 	test.AssertEquals(t, resp.Code, 404)
 	test.AssertEquals(t, resp.Header().Get("Retry-After"), "")
 }
+
+func TestRateLimits(t *testing.T) {
+	wfe, _ := setupWFE(t)
+
+	makePost := func(path, body string) *http.Request {
+		// The prefix is stripped from the request path, as it would be by
+		// the handler.
+		signedURL := "http://localhost"
+		if path != "" {
+			signedURL += "/" + path
+		}
+		_, _, jwsBody := signRequestKeyID(t, 1, nil, signedURL, body, wfe.nonceService)
+		return makePostRequestWithPath(path, jwsBody)
+	}
+
+	testCases := []struct {
+		Name     string
+		Request  *http.Request
+		Response string
+	}{
+		{
+			Name:     "Account limits only",
+			Request:  makePost("", ""),
+			Response: `{"limits":[{"limit":"newOrdersPerAccount","count":2,"threshold":300,"window":"3h0m0s","reset":"2021-01-01T03:00:00Z"}]}`,
+		},
+		{
+			Name:    "With names",
+			Request: makePost("example.com,example.net", ""),
+			Response: `{"limits":[
+				{"limit":"newOrdersPerAccount","count":2,"threshold":300,"window":"3h0m0s","reset":"2021-01-01T03:00:00Z"},
+				{"limit":"certificatesPerName","key":"example.com","count":0,"threshold":50,"window":"168h0m0s"},
+				{"limit":"certificatesPerName","key":"example.net","count":0,"threshold":50,"window":"168h0m0s"}
+			]}`,
+		},
+		{
+			Name:     "Non-empty payload",
+			Request:  makePost("", "{}"),
+			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"POST-as-GET requests must have an empty payload","status":400}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			responseWriter := httptest.NewRecorder()
+			wfe.RateLimits(ctx, newRequestEvent(), responseWriter, tc.Request)
+			test.AssertUnmarshaledEquals(t, responseWriter.Body.String(), tc.Response)
+		})
+	}
+}
+
+type mockRAWithPausedIdentifiers struct {
+	rapb.RegistrationAuthorityClient
+}
+
+func (ra *mockRAWithPausedIdentifiers) NewOrder(_ context.Context, _ *rapb.NewOrderRequest, _ ...grpc.CallOption) (*corepb.Order, error) {
+	return nil, berrors.RateLimitErrorWithDetails(berrors.RateLimitDetails{
+		Limit:     ratelimit.PausedIdentifiers,
+		Count:     100,
+		Threshold: 100,
+	}, "Your account is paused from requesting certificates for not-example.com after too many failed validations in a row")
+}
+
+func TestNewOrderPausedIdentifiers(t *testing.T) {
+	wfe, fc := setupWFE(t)
+	wfe.ra = &mockRAWithPausedIdentifiers{wfe.ra}
+
+	body := `{"Identifiers": [{"type": "dns", "value": "not-example.com"}]}`
+	detail := "Error creating new order :: Your account is paused from requesting certificates for not-example.com after too many failed validations in a row: see https://letsencrypt.org/docs/rate-limits/"
+
+	// Without an unpause key no link is returned.
+	responseWriter := httptest.NewRecorder()
+	wfe.NewOrder(ctx, newRequestEvent(), responseWriter,
+		signAndPost(t, "new-order", "http://localhost/new-order", body, 1, wfe.nonceService))
+	test.AssertEquals(t, responseWriter.Code, http.StatusTooManyRequests)
+	test.AssertUnmarshaledEquals(t, responseWriter.Body.String(),
+		`{"type":"`+probs.V2ErrorNS+`rateLimited","detail":"`+detail+`","status":429,"rateLimit":{"limit":"pausedIdentifiers","count":100,"threshold":100}}`)
+
+	wfe.UnpauseKey = []byte("0123456789abcdef0123456789abcdef")
+	wfe.UnpauseLinkLifetime = time.Hour
+	responseWriter = httptest.NewRecorder()
+	wfe.NewOrder(ctx, newRequestEvent(), responseWriter,
+		signAndPost(t, "new-order", "http://localhost/new-order", body, 1, wfe.nonceService))
+	test.AssertEquals(t, responseWriter.Code, http.StatusTooManyRequests)
+	var prob probs.ProblemDetails
+	err := json.Unmarshal(responseWriter.Body.Bytes(), &prob)
+	test.AssertNotError(t, err, "unmarshalling problem")
+	prefix := detail + ". To unpause it, visit "
+	test.Assert(t, strings.HasPrefix(prob.Detail, prefix), fmt.Sprintf("unexpected detail %q", prob.Detail))
+
+	link, err := url.Parse(strings.TrimPrefix(prob.Detail, prefix))
+	test.AssertNotError(t, err, "parsing unpause link")
+	test.AssertEquals(t, link.Path, "/acme/unpause/1")
+	test.AssertEquals(t, link.Query().Get("expires"), strconv.FormatInt(fc.Now().Add(time.Hour).Unix(), 10))
+	test.Assert(t, wfe.validUnpauseLink(link.Query(), 1), "unpause link isn't valid")
+	test.Assert(t, !wfe.validUnpauseLink(link.Query(), 2), "unpause link is valid for another account")
+
+	fc.Add(time.Hour)
+	test.Assert(t, !wfe.validUnpauseLink(link.Query(), 1), "unpause link is valid after it expired")
+}
+
+func TestUnpause(t *testing.T) {
+	wfe, _ := setupWFE(t)
+
+	makePost := func(path string, keyID int64) *http.Request {
+		_, _, jwsBody := signRequestKeyID(t, keyID, nil, "http://localhost/"+path, "{}", wfe.nonceService)
+		return makePostRequestWithPath(path, jwsBody)
+	}
+
+	responseWriter := httptest.NewRecorder()
+	wfe.Unpause(ctx, newRequestEvent(), responseWriter, &http.Request{
+		Method: "GET",
+		URL:    mustParseURL("1"),
+	})
+	test.AssertEquals(t, responseWriter.Code, http.StatusOK)
+	test.AssertContains(t, responseWriter.Body.String(), "send a POST request to http://localhost/acme/unpause/1")
+
+	responseWriter = httptest.NewRecorder()
+	wfe.Unpause(ctx, newRequestEvent(), responseWriter, &http.Request{
+		Method: "GET",
+		URL:    mustParseURL("bogus"),
+	})
+	test.AssertEquals(t, responseWriter.Code, http.StatusNotFound)
+
+	responseWriter = httptest.NewRecorder()
+	wfe.Unpause(ctx, newRequestEvent(), responseWriter, makePost("1", 1))
+	test.AssertEquals(t, responseWriter.Code, http.StatusOK)
+	test.AssertUnmarshaledEquals(t, responseWriter.Body.String(), `{"unpaused":2}`)
+
+	// An account can only unpause itself.
+	responseWriter = httptest.NewRecorder()
+	wfe.Unpause(ctx, newRequestEvent(), responseWriter, makePost("2", 1))
+	test.AssertEquals(t, responseWriter.Code, http.StatusForbidden)
+	test.AssertUnmarshaledEquals(t, responseWriter.Body.String(),
+		`{"type":"`+probs.V2ErrorNS+`unauthorized","detail":"Request signed by a different account","status":403}`)
+
+}
+
+func TestUnpauseWithLink(t *testing.T) {
+	wfe, fc := setupWFE(t)
+	wfe.UnpauseKey = []byte("0123456789abcdef0123456789abcdef")
+	wfe.UnpauseLinkLifetime = time.Hour
+
+	link, err := url.Parse(wfe.unpauseLink(&http.Request{}, 1))
+	test.AssertNotError(t, err, "parsing unpause link")
+	// The handler sees the path with the unpause prefix stripped.
+	link.Path = strings.TrimPrefix(link.Path, unpausePath)
+
+	responseWriter := httptest.NewRecorder()
+	wfe.Unpause(ctx, newRequestEvent(), responseWriter, &http.Request{Method: "GET", URL: link})
+	test.AssertEquals(t, responseWriter.Code, http.StatusOK)
+	test.AssertEquals(t, responseWriter.Header().Get("Content-Type"), "text/html; charset=utf-8")
+	test.AssertContains(t, responseWriter.Body.String(),
+		`<form method="POST" action="http://localhost/acme/unpause/1?`+template.HTMLEscapeString(link.RawQuery)+`">`)
+
+	responseWriter = httptest.NewRecorder()
+	wfe.Unpause(ctx, newRequestEvent(), responseWriter, &http.Request{Method: "POST", URL: link})
+	test.AssertEquals(t, responseWriter.Code, http.StatusOK)
+	test.AssertContains(t, responseWriter.Body.String(), "Unpaused 2 identifiers.")
+
+	// The link is only valid for the account it was issued to.
+	otherAccount := *link
+	otherAccount.Path = "2"
+	responseWriter = httptest.NewRecorder()
+	wfe.Unpause(ctx, newRequestEvent(), responseWriter, &http.Request{Method: "POST", URL: &otherAccount})
+	test.AssertEquals(t, responseWriter.Code, http.StatusForbidden)
+	test.AssertUnmarshaledEquals(t, responseWriter.Body.String(),
+		`{"type":"`+probs.V2ErrorNS+`unauthorized","detail":"Unpause link is invalid or has expired. Request a new order to get a new link","status":403}`)
+
+	// Nor can its expiry be extended.
+	query := link.Query()
+	query.Set("expires", strconv.FormatInt(fc.Now().Add(2*time.Hour).Unix(), 10))
+	extended := *link
+	extended.RawQuery = query.Encode()
+	responseWriter = httptest.NewRecorder()
+	wfe.Unpause(ctx, newRequestEvent(), responseWriter, &http.Request{Method: "GET", URL: &extended})
+	test.AssertEquals(t, responseWriter.Code, http.StatusForbidden)
+
+	fc.Add(time.Hour)
+	responseWriter = httptest.NewRecorder()
+	wfe.Unpause(ctx, newRequestEvent(), responseWriter, &http.Request{Method: "POST", URL: link})
+	test.AssertEquals(t, responseWriter.Code, http.StatusForbidden)
+}